@@ -0,0 +1,91 @@
+package libasciidoc
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/renderer/sgml/html5"
+	"github.com/bytesparadise/libasciidoc/pkg/renderer/sgml/xhtml5"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+)
+
+// DefaultBackend the name of the backend used when none is specified in the configuration
+const DefaultBackend = "html5"
+
+// RenderFunc the function which renders a document in the output format of a backend
+type RenderFunc func(ctx *renderer.Context, doc types.Document, output io.Writer) (types.Metadata, error)
+
+// Backend a backend, which renders the documents in a given output format
+type Backend struct {
+	Name    string
+	Aliases []string
+	// OutFileSuffix the extension of the output files (eg: `.html`), which is also the default value
+	// of the `outfilesuffix` attribute of the documents converted with this backend
+	OutFileSuffix string
+	Render        RenderFunc
+}
+
+var (
+	backendsMutex sync.RWMutex
+	backends      = map[string]Backend{} // the backends, indexed by name and by alias
+)
+
+func init() {
+	RegisterBackend("html5", []string{"html"}, ".html", html5.Render)
+	RegisterBackend("xhtml5", []string{"xhtml"}, ".html", xhtml5.Render)
+}
+
+// RegisterBackend registers a backend with the given name and aliases, which can then be
+// set in the configuration (see `configuration.WithBackEnd()`) or with the `-b` flag of the command line interface.
+// This function is meant to be called in the `init()` function of the package which provides the backend,
+// and it panics if the render func is nil or if the name or one of the aliases is already registered.
+func RegisterBackend(name string, aliases []string, outfilesuffix string, render RenderFunc) {
+	backendsMutex.Lock()
+	defer backendsMutex.Unlock()
+	if render == nil {
+		panic(fmt.Sprintf("missing render func of backend '%s'", name))
+	}
+	b := Backend{
+		Name:          name,
+		Aliases:       aliases,
+		OutFileSuffix: outfilesuffix,
+		Render:        render,
+	}
+	for _, n := range append([]string{name}, aliases...) {
+		if _, found := backends[n]; found {
+			panic(fmt.Sprintf("backend '%s' already registered", n))
+		}
+	}
+	for _, n := range append([]string{name}, aliases...) {
+		backends[n] = b
+	}
+}
+
+// LookupBackend returns the backend registered with the given name or alias
+// (or the default backend if the given name is empty)
+func LookupBackend(name string) (Backend, bool) {
+	if name == "" {
+		name = DefaultBackend
+	}
+	backendsMutex.RLock()
+	defer backendsMutex.RUnlock()
+	b, found := backends[name]
+	return b, found
+}
+
+// BackendNames returns the sorted names of the registered backends (excluding the aliases)
+func BackendNames() []string {
+	backendsMutex.RLock()
+	defer backendsMutex.RUnlock()
+	names := make([]string, 0, len(backends))
+	for n, b := range backends {
+		if n == b.Name {
+			names = append(names, n)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,87 @@
+package libasciidoc_test
+
+import (
+	"bytes"
+	"io"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc"
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+	"github.com/bytesparadise/libasciidoc/pkg/parser"
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/renderer/sgml/html5"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	. "github.com/onsi/ginkgo" //nolint golint
+	. "github.com/onsi/gomega" //nolint golint
+)
+
+var _ = Describe("backends", func() {
+
+	// a backend which renders the number of top-level elements of the document
+	renderCount := func(ctx *renderer.Context, doc types.Document, output io.Writer) (types.Metadata, error) {
+		_, err := output.Write([]byte(strings.Repeat("*", len(doc.Elements))))
+		return types.Metadata{Title: "count"}, err
+	}
+
+	It("should lookup the builtin backends", func() {
+		for _, name := range []string{"", "html", "html5"} {
+			b, found := libasciidoc.LookupBackend(name)
+			Expect(found).To(BeTrue())
+			Expect(b.Name).To(Equal("html5"))
+			Expect(b.OutFileSuffix).To(Equal(".html"))
+		}
+		b, found := libasciidoc.LookupBackend("xhtml")
+		Expect(found).To(BeTrue())
+		Expect(b.Name).To(Equal("xhtml5"))
+		_, found = libasciidoc.LookupBackend("unknown")
+		Expect(found).To(BeFalse())
+	})
+
+	It("should convert with a registered backend", func() {
+		libasciidoc.RegisterBackend("count", []string{"cnt"}, ".count", renderCount)
+		Expect(libasciidoc.BackendNames()).To(ContainElement("count"))
+		Expect(libasciidoc.BackendNames()).NotTo(ContainElement("cnt"))
+		output := &bytes.Buffer{}
+		metadata, err := libasciidoc.Convert(strings.NewReader("a paragraph\n\nanother paragraph"), output,
+			configuration.NewConfiguration(configuration.WithBackEnd("cnt")))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(metadata.Title).To(Equal("count"))
+		Expect(output.String()).To(Equal("**"))
+	})
+
+	It("should set the outfilesuffix attribute of the registered backend", func() {
+		libasciidoc.RegisterBackend("htm", nil, ".htm", html5.Render)
+		source := `see xref:other.adoc#section[the other document] with the {outfilesuffix} extension`
+		convert := func(settings ...configuration.Setting) string {
+			output := &bytes.Buffer{}
+			settings = append([]configuration.Setting{configuration.WithBackEnd("htm")}, settings...)
+			_, err := libasciidoc.Convert(strings.NewReader(source), output, configuration.NewConfiguration(settings...))
+			Expect(err).NotTo(HaveOccurred())
+			return output.String()
+		}
+		Expect(convert()).To(Equal(`<div class="paragraph">
+<p>see <a href="other.htm#section">the other document</a> with the .htm extension</p>
+</div>`))
+		// the attribute can still be set in the configuration or in the document
+		Expect(convert(configuration.WithAttribute(types.AttrOutFileSuffix, ".xml"))).To(ContainSubstring(`<a href="other.xml#section">`))
+		source = ":outfilesuffix: .php\n\n" + source
+		Expect(convert()).To(ContainSubstring(`<a href="other.php#section">`))
+		// also when the document was already parsed
+		doc, err := parser.ParseDocument(strings.NewReader(`see xref:other.adoc[]`), configuration.NewConfiguration())
+		Expect(err).NotTo(HaveOccurred())
+		output := &bytes.Buffer{}
+		_, err = libasciidoc.ConvertDocument(doc, output, configuration.NewConfiguration(configuration.WithBackEnd("htm")))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(output.String()).To(ContainSubstring(`<a href="other.htm">`))
+	})
+
+	It("should not register a backend twice", func() {
+		Expect(func() {
+			libasciidoc.RegisterBackend("other-html", []string{"html"}, ".html", renderCount)
+		}).To(Panic())
+		// the backend was not partially registered
+		_, found := libasciidoc.LookupBackend("other-html")
+		Expect(found).To(BeFalse())
+	})
+})
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/bytesparadise/libasciidoc/pkg/ast"
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+	"github.com/bytesparadise/libasciidoc/pkg/parser"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+// NewASTCmd returns the command which writes the parsed document in JSON or in YAML
+func NewASTCmd() *cobra.Command {
+	var format string
+	var attributes []string
+	var safeMode string
+
+	astCmd := &cobra.Command{
+		Use:   "ast [flags] FILE",
+		Short: "Print the parsed document (its abstract syntax tree) in JSON or in YAML",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			f, err := ast.ParseFormat(format)
+			if err != nil {
+				return err
+			}
+			mode, err := configuration.ParseSafeMode(safeMode)
+			if err != nil {
+				return err
+			}
+			sourcePath := args[0]
+			config := configuration.NewConfiguration(
+				configuration.WithFilename(sourcePath),
+				configuration.WithAttributes(parseAttributes(attributes)),
+				configuration.WithSafeMode(mode),
+				configuration.WithSourcePositions(true),
+			)
+			source, err := config.Open(sourcePath)
+			if err != nil {
+				return errors.Wrapf(err, "error opening %s", sourcePath)
+			}
+			defer source.Close()
+			doc, err := parser.ParseDocument(source, config)
+			if err != nil {
+				return err
+			}
+			return ast.Encode(cmd.OutOrStdout(), doc, f)
+		},
+	}
+	astCmd.SilenceUsage = true
+	flags := astCmd.Flags()
+	flags.StringVarP(&format, "format", "f", string(ast.JSON), fmt.Sprintf("format of the output [%s|%s]", ast.JSON, ast.YAML))
+	flags.StringArrayVarP(&attributes, "attribute", "a", []string{}, "a document attribute to set in the form of name, name!, name=value or name=value@ (soft set) pair")
+	flags.StringVarP(&safeMode, "safe-mode", "S", "unsafe", "safe mode in which the file is processed [unsafe|safe|server|secure]")
+	return astCmd
+}
@@ -0,0 +1,72 @@
+package main_test
+
+import (
+	"bytes"
+
+	main "github.com/bytesparadise/libasciidoc/cmd/libasciidoc"
+	"github.com/bytesparadise/libasciidoc/pkg/ast"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	. "github.com/onsi/ginkgo" //nolint golint
+	. "github.com/onsi/gomega" //nolint golint
+)
+
+var _ = Describe("ast cmd", func() {
+
+	It("print document in JSON", func() {
+		// given
+		astCmd := main.NewASTCmd()
+		buf := new(bytes.Buffer)
+		astCmd.SetOutput(buf)
+		astCmd.SetArgs([]string{"test/test.adoc"})
+		// when
+		err := astCmd.Execute()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buf.String()).To(HavePrefix("{\n  \"version\": 1,\n"))
+		doc, err := ast.Decode(buf, ast.JSON)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(doc.Elements).ToNot(BeEmpty())
+	})
+
+	It("print document in YAML", func() {
+		// given
+		astCmd := main.NewASTCmd()
+		buf := new(bytes.Buffer)
+		astCmd.SetOutput(buf)
+		astCmd.SetArgs([]string{"--format", "yaml", "-a", "foo=bar", "test/test.adoc"})
+		// when
+		err := astCmd.Execute()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buf.String()).To(HavePrefix("version: 1\n"))
+		doc, err := ast.Decode(buf, ast.YAML)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(doc.Elements).ToNot(BeEmpty())
+		Expect(doc.Elements[0]).To(BeAssignableToTypeOf(types.DelimitedBlock{}))
+	})
+
+	It("fail with unsupported format", func() {
+		// given
+		astCmd := main.NewASTCmd()
+		buf := new(bytes.Buffer)
+		astCmd.SetOutput(buf)
+		astCmd.SetArgs([]string{"--format", "xml", "test/test.adoc"})
+		// when
+		err := astCmd.Execute()
+		// then
+		Expect(err).To(MatchError("unsupported format: 'xml'"))
+	})
+
+	It("fail without file", func() {
+		// given
+		astCmd := main.NewASTCmd()
+		buf := new(bytes.Buffer)
+		astCmd.SetOutput(buf)
+		astCmd.SetArgs([]string{})
+		// when
+		err := astCmd.Execute()
+		// then
+		Expect(err).To(HaveOccurred())
+	})
+})
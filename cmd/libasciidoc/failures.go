@@ -0,0 +1,65 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+)
+
+// failure a problem which makes the command fail, as reported in the summary
+type failure struct {
+	Severity types.DiagnosticSeverity `json:"severity"`
+	Code     types.DiagnosticCode     `json:"code"`
+	Message  string                   `json:"message"`
+	File     string                   `json:"file"`
+	Line     int                      `json:"line,omitempty"`
+	Column   int                      `json:"column,omitempty"`
+}
+
+// newFailures returns the failures for the given diagnostics of the given source file
+func newFailures(sourcePath string, diagnostics []types.Diagnostic) []failure {
+	result := make([]failure, len(diagnostics))
+	for i, d := range diagnostics {
+		result[i] = failure{
+			Severity: d.Severity,
+			Code:     d.Code,
+			Message:  d.Message,
+			File:     d.Position.Start.Filename,
+			Line:     d.Position.Start.Line,
+			Column:   d.Position.Start.Column,
+		}
+		if result[i].File == "" {
+			result[i].File = sourcePath
+		}
+	}
+	return result
+}
+
+// writeFailureReport writes the summary of the given failures in JSON in the file with the given name
+func writeFailureReport(name string, level types.DiagnosticSeverity, failures []failure) error {
+	f, err := os.Create(name)
+	if err != nil {
+		return errors.Wrap(err, "unable to report the failures")
+	}
+	defer f.Close()
+	encoder := json.NewEncoder(f)
+	encoder.SetIndent("", "  ")
+	err = encoder.Encode(struct {
+		FailureLevel types.DiagnosticSeverity `json:"failure_level"`
+		Failures     []failure                `json:"failures"`
+	}{
+		FailureLevel: level,
+		Failures:     failures,
+	})
+	if err != nil {
+		return errors.Wrap(err, "unable to report the failures")
+	}
+	return f.Close()
+}
+
+// failuresError returns the error of the command when some problems were found at or above the given level
+func failuresError(level types.DiagnosticSeverity, failures []failure) error {
+	return errors.Errorf("%d problem(s) found at or above the '%s' level", len(failures), level)
+}
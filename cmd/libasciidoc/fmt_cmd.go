@@ -0,0 +1,165 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+	"github.com/bytesparadise/libasciidoc/pkg/formatter"
+
+	"github.com/pkg/errors"
+	"github.com/pmezard/go-difflib/difflib"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+// NewFmtCmd returns the command which formats the documents, in the same way as `gofmt`
+func NewFmtCmd() *cobra.Command {
+	var write bool
+	var diff bool
+	var list bool
+	rules := formatter.DefaultRules()
+
+	fmtCmd := &cobra.Command{
+		Use:   "fmt [flags] [FILE|DIR...]",
+		Short: "Format the documents (or the standard input if no file is given)",
+		Long: `Format the documents (or the standard input if no file is given).
+The directories are processed recursively, for the files with an '.adoc', '.asciidoc' or '.asc' extension.
+By default, the formatted documents are written on the standard output.
+A document is not formatted if its formatting would change its rendering.`,
+		Args: cobra.ArbitraryArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// the problems in the documents are reported when they are converted, not when they are formatted
+			log.SetOutput(ioutil.Discard)
+			if len(args) == 0 {
+				if write {
+					return errors.New("cannot use -w with standard input")
+				}
+				return formatFile(cmd, "<standard input>", cmd.InOrStdin(), rules, false, diff, list)
+			}
+			count := 0
+			// keep processing the other files, to report all the problems at once
+			report := func(err error) {
+				fmt.Fprintln(cmd.OutOrStderr(), err)
+				count++
+			}
+			for _, arg := range args {
+				err := walkDocuments(arg, func(path string) error {
+					source, err := os.Open(path)
+					if err != nil {
+						report(err)
+						return nil
+					}
+					defer source.Close()
+					if err := formatFile(cmd, path, source, rules, write, diff, list); err != nil {
+						report(err)
+					}
+					return nil
+				})
+				if err != nil {
+					report(err)
+				}
+			}
+			if count > 0 {
+				return errors.Errorf("%d file(s) could not be formatted", count)
+			}
+			return nil
+		},
+	}
+	fmtCmd.SilenceUsage = true
+	flags := fmtCmd.Flags()
+	flags.BoolVarP(&write, "write", "w", false, "write the result to the source file instead of the standard output")
+	flags.BoolVarP(&diff, "diff", "d", false, "display the diffs instead of the formatted documents")
+	flags.BoolVarP(&list, "list", "l", false, "list the files whose formatting differs")
+	flags.BoolVar(&rules.SentencePerLine, "sentence-per-line", rules.SentencePerLine, "write each sentence of the paragraphs and list items on its own line")
+	flags.BoolVar(&rules.ListMarkers, "list-markers", rules.ListMarkers, "write the list markers with '*' and '.' depending on the level of the items")
+	flags.BoolVar(&rules.AttributeLists, "attribute-lists", rules.AttributeLists, "remove the spaces around the commas and equal signs in the attribute lists")
+	flags.IntVar(&rules.MaxBlankLines, "blank-lines", rules.MaxBlankLines, "maximum number of consecutive blank lines (0 for no limit)")
+	flags.BoolVar(&rules.AlignTables, "align-tables", rules.AlignTables, "align the cells of the tables in columns")
+	return fmtCmd
+}
+
+// walkDocuments calls the given function with the given path if it is a file,
+// or with the paths of all the AsciiDoc documents in the given directory and its subdirectories
+func walkDocuments(path string, f func(path string) error) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+	if !info.IsDir() {
+		return f(path)
+	}
+	return filepath.Walk(path, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		switch strings.ToLower(filepath.Ext(path)) {
+		case ".adoc", ".asciidoc", ".asc":
+			if !info.IsDir() {
+				return f(path)
+			}
+		}
+		return nil
+	})
+}
+
+// formatFile formats the document read in the given reader, and writes the result depending on the flags
+func formatFile(cmd *cobra.Command, path string, r io.Reader, rules formatter.Rules, write, diff, list bool) error {
+	original, err := ioutil.ReadAll(r)
+	if err != nil {
+		return errors.Wrapf(err, "error reading %s", path)
+	}
+	config := configuration.NewConfiguration(configuration.WithFilename(path))
+	formatted, err := formatter.Format(bytes.NewReader(original), config, rules)
+	if err != nil {
+		return err
+	}
+	if err := formatter.Verify(original, formatted, config); err != nil {
+		return err
+	}
+	if bytes.Equal(original, formatted) {
+		if !write && !diff && !list {
+			_, err = cmd.OutOrStdout().Write(formatted)
+		}
+		return err
+	}
+	if list {
+		fmt.Fprintln(cmd.OutOrStdout(), path)
+	}
+	if write {
+		info, err := os.Stat(path)
+		if err != nil {
+			return err
+		}
+		if err := ioutil.WriteFile(path, formatted, info.Mode().Perm()); err != nil {
+			return errors.Wrapf(err, "error writing %s", path)
+		}
+	}
+	if diff {
+		return difflib.WriteUnifiedDiff(cmd.OutOrStdout(), difflib.UnifiedDiff{
+			A:        splitLines(original),
+			B:        splitLines(formatted),
+			FromFile: path + ".orig",
+			ToFile:   path,
+			Context:  3,
+		})
+	}
+	if !write && !list {
+		_, err = cmd.OutOrStdout().Write(formatted)
+	}
+	return err
+}
+
+// splitLines splits the given content after each newline
+func splitLines(content []byte) []string {
+	lines := strings.SplitAfter(string(content), "\n")
+	if lines[len(lines)-1] == "" {
+		return lines[:len(lines)-1]
+	}
+	return lines
+}
@@ -0,0 +1,167 @@
+package main_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	main "github.com/bytesparadise/libasciidoc/cmd/libasciidoc"
+
+	. "github.com/onsi/ginkgo" //nolint golint
+	. "github.com/onsi/gomega" //nolint golint
+)
+
+var _ = Describe("fmt cmd", func() {
+
+	var dir string
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "libasciidoc-fmt")
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	// writeFile writes a document with the given content in the temporary directory, and returns its path
+	writeFile := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		Expect(os.MkdirAll(filepath.Dir(path), 0755)).To(Succeed())
+		Expect(ioutil.WriteFile(path, []byte(content), 0644)).To(Succeed())
+		return path
+	}
+
+	readFile := func(path string) string {
+		content, err := ioutil.ReadFile(path)
+		Expect(err).NotTo(HaveOccurred())
+		return string(content)
+	}
+
+	const source = "[source, go]\n----\nfunc main() {}\n----\n\n\nA sentence. Another sentence.\n"
+	const formatted = "[source,go]\n----\nfunc main() {}\n----\n\nA sentence.\nAnother sentence.\n"
+
+	It("should print formatted document", func() {
+		// given
+		path := writeFile("doc.adoc", source)
+		fmtCmd := main.NewFmtCmd()
+		buf := new(bytes.Buffer)
+		fmtCmd.SetOutput(buf)
+		fmtCmd.SetArgs([]string{path})
+		// when
+		err := fmtCmd.Execute()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buf.String()).To(Equal(formatted))
+		Expect(readFile(path)).To(Equal(source)) // unchanged
+	})
+
+	It("should format standard input", func() {
+		// given
+		fmtCmd := main.NewFmtCmd()
+		buf := new(bytes.Buffer)
+		fmtCmd.SetOutput(buf)
+		fmtCmd.SetIn(strings.NewReader(source))
+		fmtCmd.SetArgs([]string{})
+		// when
+		err := fmtCmd.Execute()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buf.String()).To(Equal(formatted))
+	})
+
+	It("should write formatted documents in directory", func() {
+		// given
+		path := writeFile("doc.adoc", source)
+		other := writeFile("chapters/chapter.adoc", source)
+		unchanged := writeFile("unchanged.adoc", formatted)
+		text := writeFile("notes.txt", source)
+		fmtCmd := main.NewFmtCmd()
+		buf := new(bytes.Buffer)
+		fmtCmd.SetOutput(buf)
+		fmtCmd.SetArgs([]string{"-w", "-l", dir})
+		// when
+		err := fmtCmd.Execute()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buf.String()).To(Equal(other + "\n" + path + "\n"))
+		Expect(readFile(path)).To(Equal(formatted))
+		Expect(readFile(other)).To(Equal(formatted))
+		Expect(readFile(unchanged)).To(Equal(formatted))
+		Expect(readFile(text)).To(Equal(source)) // not a document
+	})
+
+	It("should display diff", func() {
+		// given
+		path := writeFile("doc.adoc", source)
+		fmtCmd := main.NewFmtCmd()
+		buf := new(bytes.Buffer)
+		fmtCmd.SetOutput(buf)
+		fmtCmd.SetArgs([]string{"-d", path})
+		// when
+		err := fmtCmd.Execute()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buf.String()).To(Equal(strings.Join([]string{
+			"--- " + path + ".orig",
+			"+++ " + path,
+			"@@ -1,7 +1,7 @@",
+			"-[source, go]",
+			"+[source,go]",
+			" ----",
+			" func main() {}",
+			" ----",
+			" ",
+			"-",
+			"-A sentence. Another sentence.",
+			"+A sentence.",
+			"+Another sentence.",
+			"",
+		}, "\n")))
+		Expect(readFile(path)).To(Equal(source)) // unchanged
+	})
+
+	It("should apply the given rules", func() {
+		// given
+		path := writeFile("doc.adoc", source)
+		fmtCmd := main.NewFmtCmd()
+		buf := new(bytes.Buffer)
+		fmtCmd.SetOutput(buf)
+		fmtCmd.SetArgs([]string{"--sentence-per-line=false", "--attribute-lists=false", "--blank-lines", "0", path})
+		// when
+		err := fmtCmd.Execute()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buf.String()).To(Equal(source))
+	})
+
+	It("should report the files which could not be formatted", func() {
+		// given
+		path := writeFile("doc.adoc", source)
+		fmtCmd := main.NewFmtCmd()
+		buf := new(bytes.Buffer)
+		fmtCmd.SetOutput(buf)
+		fmtCmd.SetArgs([]string{"-w", filepath.Join(dir, "unknown.adoc"), path})
+		// when
+		err := fmtCmd.Execute()
+		// then
+		Expect(err).To(MatchError("1 file(s) could not be formatted"))
+		Expect(buf.String()).To(ContainSubstring("unknown.adoc: no such file or directory"))
+		Expect(readFile(path)).To(Equal(formatted)) // other files are still formatted
+	})
+
+	It("fail to write standard input", func() {
+		// given
+		fmtCmd := main.NewFmtCmd()
+		buf := new(bytes.Buffer)
+		fmtCmd.SetOutput(buf)
+		fmtCmd.SetArgs([]string{"-w"})
+		// when
+		err := fmtCmd.Execute()
+		// then
+		Expect(err).To(MatchError("cannot use -w with standard input"))
+	})
+})
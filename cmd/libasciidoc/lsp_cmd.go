@@ -0,0 +1,39 @@
+package main
+
+import (
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+	"github.com/bytesparadise/libasciidoc/pkg/lsp"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+// NewLSPCmd returns the command which runs a Language Server Protocol server over the standard input and output
+func NewLSPCmd() *cobra.Command {
+	var attributes []string
+	var safeMode string
+
+	lspCmd := &cobra.Command{
+		Use:   "lsp [flags]",
+		Short: "Run a Language Server Protocol server over the standard input and output, for the editors with LSP support",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			mode, err := configuration.ParseSafeMode(safeMode)
+			if err != nil {
+				return err
+			}
+			// the standard output is reserved for the messages of the protocol
+			log.SetOutput(cmd.ErrOrStderr())
+			server := lsp.NewServer(
+				configuration.WithAttributes(parseAttributes(attributes)),
+				configuration.WithSafeMode(mode),
+			)
+			return server.Serve(cmd.InOrStdin(), cmd.OutOrStdout())
+		},
+	}
+	lspCmd.SilenceUsage = true
+	flags := lspCmd.Flags()
+	flags.StringArrayVarP(&attributes, "attribute", "a", []string{}, "a document attribute to set in the form of name, name!, name=value or name=value@ (soft set) pair")
+	flags.StringVarP(&safeMode, "safe-mode", "S", "unsafe", "safe mode in which the files are processed [unsafe|safe|server|secure]")
+	return lspCmd
+}
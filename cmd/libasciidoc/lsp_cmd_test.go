@@ -0,0 +1,39 @@
+package main_test
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	main "github.com/bytesparadise/libasciidoc/cmd/libasciidoc"
+
+	. "github.com/onsi/ginkgo" //nolint golint
+	. "github.com/onsi/gomega" //nolint golint
+)
+
+var _ = Describe("lsp cmd", func() {
+
+	// message returns the given JSON-RPC message with its header
+	message := func(content string) string {
+		return fmt.Sprintf("Content-Length: %d\r\n\r\n%s", len(content), content)
+	}
+
+	It("should serve over standard input and output", func() {
+		// given
+		lspCmd := main.NewLSPCmd()
+		out := new(bytes.Buffer)
+		lspCmd.SetOut(out)
+		lspCmd.SetErr(new(bytes.Buffer))
+		lspCmd.SetIn(strings.NewReader(message(`{"jsonrpc":"2.0","id":1,"method":"initialize","params":{}}`) +
+			message(`{"jsonrpc":"2.0","id":2,"method":"shutdown"}`) +
+			message(`{"jsonrpc":"2.0","method":"exit"}`)))
+		lspCmd.SetArgs([]string{})
+		// when
+		err := lspCmd.Execute()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(out.String()).To(HavePrefix("Content-Length: "))
+		Expect(out.String()).To(ContainSubstring(`"serverInfo":{"name":"libasciidoc"}`))
+		Expect(out.String()).To(HaveSuffix(message(`{"jsonrpc":"2.0","id":2,"result":null}`)))
+	})
+})
@@ -13,6 +13,10 @@ func main() {
 	rootCmd := NewRootCmd()
 	versionCmd := NewVersionCmd()
 	rootCmd.AddCommand(versionCmd)
+	rootCmd.AddCommand(NewASTCmd())
+	rootCmd.AddCommand(NewFmtCmd())
+	rootCmd.AddCommand(NewLSPCmd())
+	rootCmd.AddCommand(NewServeCmd())
 	rootCmd.SetHelpCommand(helpCommand)
 	if err := rootCmd.Execute(); err != nil {
 		fmt.Println(err)
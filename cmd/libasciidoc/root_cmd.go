@@ -10,6 +10,7 @@ import (
 
 	"github.com/bytesparadise/libasciidoc"
 	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+	"github.com/bytesparadise/libasciidoc/pkg/diagram"
 	logsupport "github.com/bytesparadise/libasciidoc/pkg/log"
 
 	log "github.com/sirupsen/logrus"
@@ -25,6 +26,10 @@ func NewRootCmd() *cobra.Command {
 	var css string
 	var backend string
 	var attributes []string
+	var safeMode string
+	var failureLevel string
+	var failureReport string
+	var diagrams bool
 
 	rootCmd := &cobra.Command{
 		Use:   "libasciidoc [flags] FILE",
@@ -46,23 +51,55 @@ func NewRootCmd() *cobra.Command {
 				return helpCommand.RunE(cmd, args)
 			}
 			attrs := parseAttributes(attributes)
+			mode, err := configuration.ParseSafeMode(safeMode)
+			if err != nil {
+				return err
+			}
+			level, err := configuration.ParseFailureLevel(failureLevel)
+			if err != nil {
+				return err
+			}
+			b, found := libasciidoc.LookupBackend(backend)
+			if !found {
+				return fmt.Errorf("backend '%s' not supported", backend)
+			}
+			failures := []failure{}
 			for _, sourcePath := range args {
-				out, close := getOut(cmd, sourcePath, outputName)
+				out, close := getOut(cmd, sourcePath, outputName, b.OutFileSuffix)
 				if out != nil {
 					defer close()
 					path, _ := filepath.Abs(sourcePath)
 					log.Debugf("Starting to process file %v", path)
-					config := configuration.NewConfiguration(
+					settings := []configuration.Setting{
 						configuration.WithFilename(sourcePath),
 						configuration.WithAttributes(attrs),
 						configuration.WithCSS(css),
 						configuration.WithBackEnd(backend),
-						configuration.WithHeaderFooter(!noHeaderFooter))
+						configuration.WithSafeMode(mode),
+						configuration.WithSourcePositions(true),
+						configuration.WithFailureLevel(level),
+						configuration.WithHeaderFooter(!noHeaderFooter),
+					}
+					if diagrams {
+						settings = append(settings, diagram.WithDiagrams())
+					}
+					config := configuration.NewConfiguration(settings...)
 					_, err := libasciidoc.ConvertFile(out, config)
-					if err != nil {
+					if e, ok := err.(libasciidoc.FailureError); ok {
+						// keep processing the other files, to report all the problems at once
+						failures = append(failures, newFailures(sourcePath, e.Diagnostics)...)
+					} else if err != nil {
+						return err
+					}
+				}
+			}
+			if len(failures) > 0 {
+				if failureReport != "" {
+					if err := writeFailureReport(failureReport, level, failures); err != nil {
 						return err
 					}
 				}
+				return failuresError(level, failures)
 			}
 			return nil
 		},
@@ -73,8 +110,12 @@ func NewRootCmd() *cobra.Command {
 	flags.StringVarP(&outputName, "out-file", "o", "", "output file (default: based on path of input file); use - to output to STDOUT")
 	flags.StringVar(&logLevel, "log", "warning", "log level to set [debug|info|warning|error|fatal|panic]")
 	flags.StringVar(&css, "css", "", "the path to the CSS file to link to the document")
-	flags.StringArrayVarP(&attributes, "attribute", "a", []string{}, "a document attribute to set in the form of name, name!, or name=value pair")
-	flags.StringVarP(&backend, "backend", "b", "html5", "backend to format the file")
+	flags.StringArrayVarP(&attributes, "attribute", "a", []string{}, "a document attribute to set in the form of name, name!, name=value or name=value@ (soft set) pair")
+	flags.StringVarP(&backend, "backend", "b", libasciidoc.DefaultBackend, fmt.Sprintf("backend to format the file [%s]", strings.Join(libasciidoc.BackendNames(), "|")))
+	flags.StringVarP(&safeMode, "safe-mode", "S", "unsafe", "safe mode in which the file is processed [unsafe|safe|server|secure]")
+	flags.BoolVar(&diagrams, "diagrams", false, "generate the images of the diagram blocks (graphviz, plantuml, mermaid, ditaa) with their command-line tools (default: false)")
+	flags.StringVar(&failureLevel, "failure-level", "", "minimum severity of the problems which make the command fail [WARN|ERROR]")
+	flags.StringVar(&failureReport, "failure-report", "", "file in which the problems which make the command fail are reported in JSON")
 	return rootCmd
 }
 
@@ -90,7 +131,9 @@ func newCloseFileFunc(c io.Closer) closeFunc {
 	}
 }
 
-func getOut(cmd *cobra.Command, sourcePath, outputName string) (io.Writer, closeFunc) {
+// getOut returns the writer of the output document, which is based on the path of the source document
+// and the suffix of the output files of the backend, unless an output file (or STDOUT) is specified
+func getOut(cmd *cobra.Command, sourcePath, outputName, outfilesuffix string) (io.Writer, closeFunc) {
 	if outputName == "-" {
 		// outfile is STDOUT
 		return cmd.OutOrStdout(), defaultCloseFunc()
@@ -104,7 +147,7 @@ func getOut(cmd *cobra.Command, sourcePath, outputName string) (io.Writer, close
 	} else if sourcePath != "" {
 		// outfile is based on sourcePath
 		path, _ := filepath.Abs(sourcePath)
-		outname := strings.TrimSuffix(path, filepath.Ext(path)) + ".html"
+		outname := strings.TrimSuffix(path, filepath.Ext(path)) + outfilesuffix
 		outfile, err := os.Create(outname)
 		if err != nil {
 			log.Warnf("Cannot create output file - %v, skipping", outname)
@@ -115,11 +158,13 @@ func getOut(cmd *cobra.Command, sourcePath, outputName string) (io.Writer, close
 	return cmd.OutOrStdout(), defaultCloseFunc()
 }
 
-// converts the `name`, `!name` and `name=value` into a map
+// converts the `name`, `!name`, `name!`, `name@`, `name=value` and `name=value@` into a map
+// in which the `!` and `@` markers are retained, so they can be processed
+// along with the other attribute overrides
 func parseAttributes(attributes []string) map[string]string {
 	result := make(map[string]string, len(attributes))
 	for _, attr := range attributes {
-		data := strings.Split(attr, "=")
+		data := strings.SplitN(attr, "=", 2)
 		if len(data) > 1 {
 			result[data[0]] = data[1]
 		} else {
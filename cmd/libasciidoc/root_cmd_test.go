@@ -2,9 +2,16 @@ package main_test
 
 import (
 	"bytes"
+	"encoding/json"
+	"io"
 	"io/ioutil"
+	"os"
+	"path/filepath"
 
+	"github.com/bytesparadise/libasciidoc"
 	main "github.com/bytesparadise/libasciidoc/cmd/libasciidoc"
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
 
 	. "github.com/onsi/ginkgo" //nolint golint
 	. "github.com/onsi/gomega" //nolint golint
@@ -102,6 +109,210 @@ var _ = Describe("root cmd", func() {
 </div>`))
 	})
 
+	It("render with attribute reset with suffix", func() {
+		// given
+		root := main.NewRootCmd()
+		buf := new(bytes.Buffer)
+		root.SetOutput(buf)
+		root.SetArgs([]string{"-s", "-o", "-", "-afoo1=bar1", "-afoo2!", "test/doc_with_attributes.adoc"})
+		// when
+		err := root.Execute()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buf.String()).To(Equal(`level=warning msg="unable to find attribute 'foo2'"
+<div class="paragraph">
+<p>bar1 and {foo2}</p>
+</div>`))
+	})
+
+	It("render with attribute soft set", func() {
+		// given
+		root := main.NewRootCmd()
+		buf := new(bytes.Buffer)
+		root.SetOutput(buf)
+		root.SetArgs([]string{"-s", "-o", "-", "-afoo1=bar1@", "-afoo2@=bar2", "test/doc_with_attributes.adoc"})
+		// when
+		err := root.Execute()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		// `foo1` is reset in the document
+		Expect(buf.String()).To(Equal(`level=warning msg="unable to find attribute 'foo1'"
+<div class="paragraph">
+<p>{foo1} and bar2</p>
+</div>`))
+	})
+
+	It("render with safe mode", func() {
+		// given
+		root := main.NewRootCmd()
+		buf := new(bytes.Buffer)
+		root.SetOutput(buf)
+		root.SetArgs([]string{"-s", "-S", "safe", "-o", "-", "test/doc_with_include.adoc"})
+		// when
+		err := root.Execute()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buf.String()).To(ContainSubstring(`<div class="admonitionblock note">`))
+	})
+
+	It("render with secure mode", func() {
+		// given
+		root := main.NewRootCmd()
+		buf := new(bytes.Buffer)
+		root.SetOutput(buf)
+		root.SetArgs([]string{"-s", "--safe-mode", "secure", "-o", "-", "test/doc_with_include.adoc"})
+		// when
+		err := root.Execute()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buf.String()).To(ContainSubstring(`<a href="admonition.adoc"`))
+		Expect(buf.String()).ToNot(ContainSubstring(`<div class="admonitionblock note">`))
+	})
+
+	It("fail to parse bad safe mode", func() {
+		// given
+		root := main.NewRootCmd()
+		buf := new(bytes.Buffer)
+		root.SetOutput(buf)
+		root.SetArgs([]string{"-S", "unknown", "-o", "-", "test/test.adoc"})
+		// when
+		err := root.Execute()
+		// then
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("render with problems below the failure level", func() {
+		// given
+		root := main.NewRootCmd()
+		buf := new(bytes.Buffer)
+		root.SetOutput(buf)
+		root.SetArgs([]string{"-s", "--failure-level", "ERROR", "-o", "-", "test/doc_with_problems.adoc"})
+		// when
+		err := root.Execute()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buf.String()).ToNot(ContainSubstring(`"failures"`))
+	})
+
+	It("fail to render with problems at the failure level", func() {
+		// given
+		root := main.NewRootCmd()
+		buf := new(bytes.Buffer)
+		root.SetOutput(buf)
+		root.SetArgs([]string{"-s", "--failure-level", "WARN", "-o", "-", "test/doc_with_problems.adoc"})
+		// when
+		err := root.Execute()
+		// then
+		Expect(err).To(MatchError("2 problem(s) found at or above the 'warning' level"))
+		// the document is rendered, but the problems are not reported in JSON
+		Expect(buf.String()).To(ContainSubstring(`<p>a paragraph with an <a href="#unknown">[unknown]</a> reference and a {missing} attribute.</p>`))
+		Expect(buf.String()).ToNot(ContainSubstring(`"failures"`))
+	})
+
+	It("fail to render with problems at the failure level and report them in JSON", func() {
+		// given
+		dir, err := ioutil.TempDir("", "libasciidoc")
+		Expect(err).ToNot(HaveOccurred())
+		defer os.RemoveAll(dir)
+		report := filepath.Join(dir, "failures.json")
+		root := main.NewRootCmd()
+		buf := new(bytes.Buffer)
+		root.SetOutput(buf)
+		root.SetArgs([]string{"-s", "--failure-level", "WARN", "--failure-report", report, "-o", "-", "test/doc_with_problems.adoc"})
+		// when
+		err = root.Execute()
+		// then
+		Expect(err).To(MatchError("2 problem(s) found at or above the 'warning' level"))
+		Expect(buf.String()).ToNot(ContainSubstring(`"failures"`))
+		content, err := ioutil.ReadFile(report)
+		Expect(err).ToNot(HaveOccurred())
+		summary := map[string]interface{}{}
+		Expect(json.Unmarshal(content, &summary)).To(Succeed())
+		Expect(summary).To(Equal(map[string]interface{}{
+			"failure_level": "warning",
+			"failures": []interface{}{
+				map[string]interface{}{
+					"severity": "warning",
+					"code":     "missing-attribute",
+					"message":  "unable to find attribute 'missing'",
+					"file":     "test/doc_with_problems.adoc",
+					"line":     float64(3),
+					"column":   float64(49),
+				},
+				map[string]interface{}{
+					"severity": "warning",
+					"code":     "unresolved-xref",
+					"message":  "possible invalid reference: 'unknown'",
+					"file":     "test/doc_with_problems.adoc",
+					"line":     float64(3),
+					"column":   float64(21),
+				},
+			},
+		}))
+	})
+
+	It("render without failure report when there is no problem at the failure level", func() {
+		// given
+		dir, err := ioutil.TempDir("", "libasciidoc")
+		Expect(err).ToNot(HaveOccurred())
+		defer os.RemoveAll(dir)
+		report := filepath.Join(dir, "failures.json")
+		root := main.NewRootCmd()
+		root.SetOutput(new(bytes.Buffer))
+		root.SetArgs([]string{"-s", "--failure-level", "ERROR", "--failure-report", report, "-o", "-", "test/doc_with_problems.adoc"})
+		// when
+		err = root.Execute()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		_, err = os.Stat(report)
+		Expect(os.IsNotExist(err)).To(BeTrue())
+	})
+
+	It("fail to parse bad failure level", func() {
+		// given
+		root := main.NewRootCmd()
+		buf := new(bytes.Buffer)
+		root.SetOutput(buf)
+		root.SetArgs([]string{"--failure-level", "info", "-o", "-", "test/test.adoc"})
+		// when
+		err := root.Execute()
+		// then
+		Expect(err).To(MatchError("unknown failure level: 'info' (expected 'WARN' or 'ERROR')"))
+	})
+
+	It("render with a registered backend", func() {
+		// given
+		libasciidoc.RegisterBackend("text", []string{"txt"}, ".txt", func(ctx *renderer.Context, doc types.Document, output io.Writer) (types.Metadata, error) {
+			_, err := output.Write([]byte("text"))
+			return types.Metadata{}, err
+		})
+		root := main.NewRootCmd()
+		buf := new(bytes.Buffer)
+		root.SetOutput(buf)
+		root.SetArgs([]string{"-b", "txt", "test/test.adoc"})
+		// when
+		err := root.Execute()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		// the output file has the suffix of the backend
+		defer os.Remove("test/test.txt")
+		content, err := ioutil.ReadFile("test/test.txt")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(content)).To(Equal("text"))
+	})
+
+	It("fail to render with unknown backend", func() {
+		// given
+		root := main.NewRootCmd()
+		buf := new(bytes.Buffer)
+		root.SetOutput(buf)
+		root.SetArgs([]string{"-b", "unknown", "test/test.adoc"})
+		// when
+		err := root.Execute()
+		// then
+		Expect(err).To(MatchError("backend 'unknown' not supported"))
+	})
+
 	It("render multiple files", func() {
 		// given
 		root := main.NewRootCmd()
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+	"github.com/bytesparadise/libasciidoc/pkg/diagram"
+	"github.com/bytesparadise/libasciidoc/pkg/preview"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+// NewServeCmd returns the command which serves the documents over HTTP, and reloads them in the browser when they change
+func NewServeCmd() *cobra.Command {
+	var address string
+	var interval time.Duration
+	var css string
+	var attributes []string
+	var safeMode string
+	var diagrams bool
+
+	serveCmd := &cobra.Command{
+		Use:   "serve [flags] FILE|DIR",
+		Short: "Serve the document (or the documents in the directory) over HTTP, and reload them in the browser when they change",
+		Long: `Serve the document (or the documents in the directory) over HTTP, and reload them in the browser when they change.
+The documents are converted when they are requested, and their pages are reloaded when their source file,
+or any file they include, changes.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			mode, err := configuration.ParseSafeMode(safeMode)
+			if err != nil {
+				return err
+			}
+			settings := []configuration.Setting{
+				configuration.WithAttributes(parseAttributes(attributes)),
+				configuration.WithCSS(css),
+				configuration.WithSafeMode(mode),
+				configuration.WithSourcePositions(true),
+			}
+			if diagrams {
+				settings = append(settings, diagram.WithDiagrams())
+			}
+			server, err := preview.NewServer(args[0], settings...)
+			if err != nil {
+				return err
+			}
+			l, err := net.Listen("tcp", address)
+			if err != nil {
+				return errors.Wrapf(err, "unable to listen on %s", address)
+			}
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			go server.Watch(ctx, interval)
+			fmt.Fprintf(cmd.OutOrStdout(), "serving %s on http://%s/\n", args[0], l.Addr())
+			return http.Serve(l, server)
+		},
+	}
+	serveCmd.SilenceUsage = true
+	flags := serveCmd.Flags()
+	flags.StringVar(&address, "address", "localhost:8080", "the address on which the documents are served")
+	flags.DurationVar(&interval, "interval", 500*time.Millisecond, "the interval at which the files are checked for changes")
+	flags.StringVar(&css, "css", "", "the path to the CSS file to link to the documents")
+	flags.StringArrayVarP(&attributes, "attribute", "a", []string{}, "a document attribute to set in the form of name, name!, name=value or name=value@ (soft set) pair")
+	flags.StringVarP(&safeMode, "safe-mode", "S", "unsafe", "safe mode in which the files are processed [unsafe|safe|server|secure]")
+	flags.BoolVar(&diagrams, "diagrams", false, "generate the images of the diagram blocks (graphviz, plantuml, mermaid, ditaa) with their command-line tools (default: false)")
+	return serveCmd
+}
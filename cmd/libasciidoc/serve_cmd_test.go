@@ -0,0 +1,39 @@
+package main_test
+
+import (
+	"bytes"
+
+	main "github.com/bytesparadise/libasciidoc/cmd/libasciidoc"
+
+	. "github.com/onsi/ginkgo" //nolint golint
+	. "github.com/onsi/gomega" //nolint golint
+)
+
+var _ = Describe("serve cmd", func() {
+
+	It("should fail to serve missing file", func() {
+		// given
+		serveCmd := main.NewServeCmd()
+		buf := new(bytes.Buffer)
+		serveCmd.SetOutput(buf)
+		serveCmd.SetArgs([]string{"test/unknown.adoc"})
+		// when
+		err := serveCmd.Execute()
+		// then
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(HavePrefix("unable to serve"))
+	})
+
+	It("should fail with invalid address", func() {
+		// given
+		serveCmd := main.NewServeCmd()
+		buf := new(bytes.Buffer)
+		serveCmd.SetOutput(buf)
+		serveCmd.SetArgs([]string{"--address", "localhost:-1", "test/test.adoc"})
+		// when
+		err := serveCmd.Execute()
+		// then
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(HavePrefix("unable to listen on localhost:-1"))
+	})
+})
@@ -0,0 +1,72 @@
+package libasciidoc
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+)
+
+// applyPreprocessors applies the given preprocessors on the lines of the given reader,
+// and returns a reader of the resulting lines
+func applyPreprocessors(r io.Reader, preprocessors []configuration.Preprocessor) (io.Reader, error) {
+	if len(preprocessors) == 0 {
+		return r, nil
+	}
+	content, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to read the document to preprocess")
+	}
+	var lines []string
+	if len(content) > 0 {
+		lines = strings.Split(strings.TrimSuffix(string(content), "\n"), "\n")
+		for i, l := range lines {
+			lines[i] = strings.TrimSuffix(l, "\r")
+		}
+	}
+	for _, p := range preprocessors {
+		if lines, err = p(lines); err != nil {
+			return nil, errors.Wrap(err, "unable to preprocess the document")
+		}
+	}
+	result := &strings.Builder{}
+	for _, l := range lines {
+		result.WriteString(l)
+		result.WriteString("\n")
+	}
+	return strings.NewReader(result.String()), nil
+}
+
+// applyTreeProcessors applies the given tree processors on the given document
+func applyTreeProcessors(doc *types.Document, processors []configuration.TreeProcessor) error {
+	for _, p := range processors {
+		if err := p(doc); err != nil {
+			return errors.Wrap(err, "unable to process the document")
+		}
+	}
+	return nil
+}
+
+// newOutput returns the writer in which the document is rendered, and the function to call once the document was
+// rendered, which applies the given postprocessors (if any) before writing the result in the given output
+func newOutput(output io.Writer, doc types.Document, postprocessors []configuration.Postprocessor) (io.Writer, func() error) {
+	if len(postprocessors) == 0 {
+		return output, func() error { return nil }
+	}
+	buf := &bytes.Buffer{}
+	return buf, func() error {
+		result := buf.Bytes()
+		for _, p := range postprocessors {
+			var err error
+			if result, err = p(doc, result); err != nil {
+				return errors.Wrap(err, "unable to postprocess the output")
+			}
+		}
+		_, err := output.Write(result)
+		return err
+	}
+}
@@ -0,0 +1,140 @@
+package libasciidoc_test
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc"
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo" //nolint golint
+	. "github.com/onsi/gomega" //nolint golint
+)
+
+var _ = Describe("extensions", func() {
+
+	Context("preprocessors", func() {
+
+		It("should process the lines before parsing", func() {
+			source := `first line
+SECRET
+last line`
+			redact := func(lines []string) ([]string, error) {
+				result := make([]string, 0, len(lines))
+				for _, l := range lines {
+					if l != "SECRET" {
+						result = append(result, l)
+					}
+				}
+				return result, nil
+			}
+			expected := `<div class="paragraph">
+<p>first line
+last line</p>
+</div>`
+			Expect(RenderHTML(source, configuration.WithPreprocessor(redact))).To(MatchHTML(expected))
+		})
+
+		It("should apply the preprocessors in order", func() {
+			source := `a paragraph`
+			appendLine := func(line string) configuration.Preprocessor {
+				return func(lines []string) ([]string, error) {
+					return append(lines, line), nil
+				}
+			}
+			expected := `<div class="paragraph">
+<p>a paragraph
+first
+second</p>
+</div>`
+			Expect(RenderHTML(source,
+				configuration.WithPreprocessor(appendLine("first")),
+				configuration.WithPreprocessor(appendLine("second")))).To(MatchHTML(expected))
+		})
+
+		It("should fail when preprocessor fails", func() {
+			_, err := RenderHTML("a paragraph", configuration.WithPreprocessor(func(lines []string) ([]string, error) {
+				return nil, fmt.Errorf("mock error")
+			}))
+			Expect(err).To(MatchError("unable to preprocess the document: mock error"))
+		})
+	})
+
+	Context("tree processors", func() {
+
+		It("should change the document before rendering", func() {
+			source := `== Section A
+
+a paragraph`
+			// appends a paragraph in all the sections
+			appendParagraph := func(doc *types.Document) error {
+				for i, e := range doc.Elements {
+					if s, ok := e.(types.Section); ok {
+						s.AddElement(types.Paragraph{
+							Lines: [][]interface{}{
+								{
+									types.StringElement{Content: "another paragraph"},
+								},
+							},
+						})
+						doc.Elements[i] = s
+					}
+				}
+				return nil
+			}
+			expected := `<div class="sect1">
+<h2 id="_section_a">Section A</h2>
+<div class="sectionbody">
+<div class="paragraph">
+<p>a paragraph</p>
+</div>
+<div class="paragraph">
+<p>another paragraph</p>
+</div>
+</div>
+</div>`
+			Expect(RenderHTML(source, configuration.WithTreeProcessor(appendParagraph))).To(MatchHTML(expected))
+		})
+
+		It("should fail when tree processor fails", func() {
+			_, err := RenderHTML("a paragraph", configuration.WithTreeProcessor(func(doc *types.Document) error {
+				return fmt.Errorf("mock error")
+			}))
+			Expect(err).To(MatchError("unable to process the document: mock error"))
+		})
+	})
+
+	Context("postprocessors", func() {
+
+		It("should change the output before writing", func() {
+			source := `a paragraph`
+			upper := func(doc types.Document, output []byte) ([]byte, error) {
+				return bytes.ToUpper(output), nil
+			}
+			wrap := func(doc types.Document, output []byte) ([]byte, error) {
+				return []byte(fmt.Sprintf("<!-- %d element(s) -->\n%s", len(doc.Elements), output)), nil
+			}
+			expected := `<!-- 1 element(s) -->
+<DIV CLASS="PARAGRAPH">
+<P>A PARAGRAPH</P>
+</DIV>`
+			Expect(RenderHTML(source,
+				configuration.WithPostprocessor(upper),
+				configuration.WithPostprocessor(wrap))).To(MatchHTML(expected))
+		})
+
+		It("should fail when postprocessor fails", func() {
+			output := &bytes.Buffer{}
+			_, err := libasciidoc.Convert(strings.NewReader("a paragraph"), output, configuration.NewConfiguration(
+				configuration.WithPostprocessor(func(doc types.Document, output []byte) ([]byte, error) {
+					return nil, fmt.Errorf("mock error")
+				})))
+			Expect(err).To(MatchError("unable to postprocess the output: mock error"))
+			// nothing was written
+			Expect(output.String()).To(BeEmpty())
+		})
+	})
+})
@@ -4,15 +4,12 @@ package libasciidoc
 
 import (
 	"fmt"
-	"github.com/bytesparadise/libasciidoc/pkg/renderer/sgml/xhtml5"
 	"io"
-	"os"
 	"time"
 
 	"github.com/bytesparadise/libasciidoc/pkg/configuration"
 	"github.com/bytesparadise/libasciidoc/pkg/parser"
 	"github.com/bytesparadise/libasciidoc/pkg/renderer"
-	"github.com/bytesparadise/libasciidoc/pkg/renderer/sgml/html5"
 	"github.com/bytesparadise/libasciidoc/pkg/types"
 	"github.com/bytesparadise/libasciidoc/pkg/validator"
 	"github.com/pkg/errors"
@@ -29,17 +26,28 @@ var (
 	BuildTime = ""
 )
 
+// FailureError the error returned when the conversion produced diagnostics at or above the failure level
+type FailureError struct {
+	Level       types.DiagnosticSeverity
+	Diagnostics []types.Diagnostic // the diagnostics at or above the failure level
+}
+
+func (e FailureError) Error() string {
+	return fmt.Sprintf("%d problem(s) found at or above the '%s' level", len(e.Diagnostics), e.Level)
+}
+
 // ConvertFile converts the content of the given filename into an output document.
 // The conversion result is written in the given writer `output`, whereas the document metadata (title, etc.) (or an error if a problem occurred) is returned
 // as the result of the function call.  The output format is determined by config.Backend (HTML5 default).
+// The file is read in the file system of the configuration (see `configuration.WithFS()`), or in the local file system.
 func ConvertFile(output io.Writer, config configuration.Configuration) (types.Metadata, error) {
-	file, err := os.Open(config.Filename)
+	file, err := config.Open(config.Filename)
 	if err != nil {
 		return types.Metadata{}, errors.Wrapf(err, "error opening %s", config.Filename)
 	}
 	defer file.Close()
 	// use the file mtime as the `last updated` value
-	stat, err := os.Stat(config.Filename)
+	stat, err := file.Stat()
 	if err != nil {
 		return types.Metadata{}, errors.Wrapf(err, "error opening %s", config.Filename)
 	}
@@ -48,46 +56,152 @@ func ConvertFile(output io.Writer, config configuration.Configuration) (types.Me
 }
 
 // Convert converts the content of the given reader `r` into a full output document, written in the given writer `output`.
-// Returns an error if a problem occurred. The default will be HTML5, but depends on the config.BackEnd value.
+// Returns an error if a problem occurred. The default will be HTML5, but depends on the config.BackEnd value,
+// which is the name or an alias of a registered backend (see `RegisterBackend()`).
+// The problems found in the document (unresolved file inclusions and cross references, missing attributes, etc.)
+// do not prevent the conversion, but are returned in the `Diagnostics` of the metadata. However, if some problems
+// are at or above the `config.FailureLevel`, the output is written but a `FailureError` is returned along with the metadata.
+// The extensions of the configuration are applied during the conversion: the preprocessors on the lines of the document
+// before it is parsed, the tree processors on the parsed document, and the postprocessors on the rendered output.
 func Convert(r io.Reader, output io.Writer, config configuration.Configuration) (types.Metadata, error) {
 
-	var render func(*renderer.Context, types.Document, io.Writer) (types.Metadata, error)
-	switch config.BackEnd {
-	case "html", "html5", "":
-		render = html5.Render
-	case "xhtml", "xhtml5":
-		render = xhtml5.Render
-	default:
+	backend, found := LookupBackend(config.BackEnd)
+	if !found {
 		return types.Metadata{}, fmt.Errorf("backend '%s' not supported", config.BackEnd)
 	}
+	config = withOutFileSuffix(config, backend)
+	config, merge := withOwnDiagnostics(config)
+	defer merge()
+	r, err := applyPreprocessors(r, config.Preprocessors)
+	if err != nil {
+		return types.Metadata{}, err
+	}
+	log.Debugf("parsing the asciidoc source...")
+	doc, err := parser.ParseDocument(r, config) //, parser.Debug(true))
+	if err != nil {
+		return types.Metadata{}, err
+	}
+	return convertDocument(doc, output, config, backend)
+}
+
+// ConvertDocument converts the given document, which was already parsed (eg: a document decoded with `ast.Decode()`),
+// into a full output document, written in the given writer `output`, in the same way as `Convert()`
+// (except for the preprocessors, which only apply to the source of a document).
+func ConvertDocument(doc types.Document, output io.Writer, config configuration.Configuration) (types.Metadata, error) {
+	backend, found := LookupBackend(config.BackEnd)
+	if !found {
+		return types.Metadata{}, fmt.Errorf("backend '%s' not supported", config.BackEnd)
+	}
+	config = withOutFileSuffix(config, backend)
+	config, merge := withOwnDiagnostics(config)
+	defer merge()
+	return convertDocument(withoutLockedAttributes(doc, config), output, config, backend)
+}
+
+// withoutLockedAttributes returns a copy of the given document without the attributes that it is not allowed
+// to set in the safe mode of the given configuration (unless they were overridden via the API or the CLI)
+func withoutLockedAttributes(doc types.Document, config configuration.Configuration) types.Document {
+	locked := config.LockedAttributes()
+	if len(locked) == 0 || len(doc.Attributes) == 0 {
+		return doc
+	}
+	attrs := make(types.Attributes, len(doc.Attributes))
+	attrs.Add(doc.Attributes)
+	for _, name := range locked {
+		delete(attrs, name)
+	}
+	doc.Attributes = attrs
+	return doc
+}
+
+// withOwnDiagnostics returns a copy of the given configuration with a new collector of diagnostics (and with the
+// source positions, to locate the problems), so that the metadata of a conversion only contains its own diagnostics.
+// Also returns the function which adds these diagnostics to the collector of the given configuration, if any.
+func withOwnDiagnostics(config configuration.Configuration) (configuration.Configuration, func()) {
+	collector := config.Diagnostics
+	diagnostics := types.NewDiagnostics()
+	config.Diagnostics = diagnostics
+	config.SourcePositions = true
+	return config, func() {
+		for _, d := range diagnostics.All() {
+			collector.Add(d)
+		}
+	}
+}
+
+// withOutFileSuffix returns a copy of the given configuration in which the `outfilesuffix` attribute is soft-set
+// to the extension of the output files of the given backend, unless this attribute is already overridden
+func withOutFileSuffix(config configuration.Configuration, backend Backend) configuration.Configuration {
+	if backend.OutFileSuffix == "" {
+		return config
+	}
+	overrides := make(map[string]string, len(config.AttributeOverrides)+1)
+	for k, v := range config.AttributeOverrides {
+		if types.NewAttributeOverride(k, v).Name == types.AttrOutFileSuffix {
+			return config
+		}
+		overrides[k] = v
+	}
+	overrides[types.AttrOutFileSuffix+"@"] = backend.OutFileSuffix
+	config.AttributeOverrides = overrides
+	return config
+}
+
+// convertDocument renders the given document with the given backend, once the `outfilesuffix` of this backend
+// was set in the given configuration
+func convertDocument(doc types.Document, output io.Writer, config configuration.Configuration, backend Backend) (types.Metadata, error) {
 
 	start := time.Now()
 	defer func() {
 		duration := time.Since(start)
 		log.Debugf("rendered the output in %v", duration)
 	}()
-	log.Debugf("parsing the asciidoc source...")
-	doc, err := parser.ParseDocument(r, config) //, parser.Debug(true))
-	if err != nil {
+	if _, found := doc.Attributes[types.AttrOutFileSuffix]; !found {
+		// the document was parsed without the attribute (eg: when it was decoded)
+		if suffix, found := types.NewAttributesWithOverrides(config.AttributeOverrides).GetAsString(types.AttrOutFileSuffix); found {
+			attrs := make(types.Attributes, len(doc.Attributes)+1)
+			attrs.Add(doc.Attributes)
+			attrs[types.AttrOutFileSuffix] = suffix
+			doc.Attributes = attrs
+		}
+	}
+	if err := applyTreeProcessors(&doc, config.TreeProcessors); err != nil {
 		return types.Metadata{}, err
 	}
 	// validate the document
 	problems := validator.Validate(&doc)
 	for _, problem := range problems {
+		logger := log.NewEntry(log.StandardLogger())
+		if !problem.Position.Start.IsZero() {
+			logger = logger.WithField("position", problem.Position.Start.String())
+		}
 		switch problem.Severity {
 		case validator.Error:
-			log.Error(problem.Message)
+			logger.Error(problem.Message)
+			config.Diagnostics.Errorf(types.InvalidDocument, problem.Position, "%s", problem.Message)
 		case validator.Warning:
-			log.Warn(problem.Message)
+			logger.Warn(problem.Message)
+			config.Diagnostics.Warnf(types.InvalidDocument, problem.Position, "%s", problem.Message)
 		}
 	}
 	// render
 	ctx := renderer.NewContext(doc, config)
-	metadata, err := render(ctx, doc, output)
+	out, postprocess := newOutput(output, doc, config.Postprocessors)
+	metadata, err := backend.Render(ctx, doc, out)
 	if err != nil {
 		return types.Metadata{}, err
 	}
+	if err := postprocess(); err != nil {
+		return types.Metadata{}, err
+	}
+	metadata.Diagnostics = config.Diagnostics.All()
 	log.Debugf("Done processing document")
+	if failures := types.DiagnosticsAtLeast(metadata.Diagnostics, config.FailureLevel); len(failures) > 0 {
+		return metadata, FailureError{
+			Level:       config.FailureLevel,
+			Diagnostics: failures,
+		}
+	}
 	return metadata, nil
 
 }
@@ -1,10 +1,16 @@
 package libasciidoc_test
 
 import (
+	"bytes"
 	"os"
+	"path/filepath"
+	"strings"
 	"time"
 
+	"github.com/bytesparadise/libasciidoc"
+	"github.com/bytesparadise/libasciidoc/pkg/ast"
 	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+	"github.com/bytesparadise/libasciidoc/pkg/parser"
 	"github.com/bytesparadise/libasciidoc/pkg/types"
 	. "github.com/bytesparadise/libasciidoc/testsupport"
 
@@ -269,6 +275,43 @@ Last updated {{.LastUpdated}}
 				Expect(err).NotTo(HaveOccurred())
 				Expect(RenderHTML5Document(filename, configuration.WithCSS("path/to/style.css"), configuration.WithHeaderFooter(true))).To(MatchHTMLTemplate(expectedContent, stat.ModTime()))
 			})
+
+			It("using existing file in file system", func() {
+				expectedContent := `<div class="paragraph">
+<p>child preamble</p>
+</div>
+<div class="sect1">
+<h2 id="_child_section_1">child section 1</h2>
+<div class="sectionbody">
+<div class="paragraph">
+<p>first line of child</p>
+</div>
+<div class="sect2">
+<h3 id="_grandchild_title">grandchild title</h3>
+<div class="paragraph">
+<p>first line of grandchild</p>
+</div>
+<div class="paragraph">
+<p>last line of grandchild</p>
+</div>
+</div>
+<div class="sect2">
+<h3 id="_child_section_2">child section 2</h3>
+<div class="paragraph">
+<p>last line of child</p>
+</div>
+</div>
+</div>
+</div>`
+				output := &strings.Builder{}
+				_, err := libasciidoc.ConvertFile(output, configuration.NewConfiguration(
+					configuration.WithFilename("child-include-preamble.adoc"),
+					configuration.WithFS(dirFS("test/includes")),
+					configuration.WithSafeMode(configuration.Safe),
+				))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(output.String()).To(MatchHTML(expectedContent))
+			})
 		})
 	})
 
@@ -557,4 +600,173 @@ Our story begins.`
 
 	})
 
+	Context("decoded document", func() {
+
+		It("should render the decoded document as the source document", func() {
+			source := `= a document title
+
+a paragraph with *bold* content
+
+== Section A
+
+* an item
+* another item`
+			config := configuration.NewConfiguration(configuration.WithHeaderFooter(true), configuration.WithLastUpdated(lastUpdated))
+			expected := &strings.Builder{}
+			_, err := libasciidoc.Convert(strings.NewReader(source), expected, config)
+			Expect(err).NotTo(HaveOccurred())
+			doc, err := parser.ParseDocument(strings.NewReader(source), config)
+			Expect(err).NotTo(HaveOccurred())
+			encoded := &bytes.Buffer{}
+			Expect(ast.Encode(encoded, doc, ast.JSON)).To(Succeed())
+			decoded, err := ast.Decode(encoded, ast.JSON)
+			Expect(err).NotTo(HaveOccurred())
+			output := &strings.Builder{}
+			_, err = libasciidoc.ConvertDocument(decoded, output, config)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(output.String()).To(Equal(expected.String()))
+		})
+
+		It("should not render the attributes that the decoded document cannot set in secure mode", func() {
+			source := `:icons: font
+
+NOTE: a note`
+			doc, err := parser.ParseDocument(strings.NewReader(source), configuration.NewConfiguration())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(doc.Attributes).To(HaveKeyWithValue("icons", "font"))
+			encoded := &bytes.Buffer{}
+			Expect(ast.Encode(encoded, doc, ast.JSON)).To(Succeed())
+			decoded, err := ast.Decode(encoded, ast.JSON)
+			Expect(err).NotTo(HaveOccurred())
+			config := configuration.NewConfiguration()
+			config.SafeMode = configuration.Secure
+			expected := &strings.Builder{}
+			_, err = libasciidoc.Convert(strings.NewReader(source), expected, config)
+			Expect(err).NotTo(HaveOccurred())
+			output := &strings.Builder{}
+			_, err = libasciidoc.ConvertDocument(decoded, output, config)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(output.String()).To(Equal(expected.String()))
+			Expect(output.String()).NotTo(ContainSubstring("fa icon-note"))
+		})
+	})
+
+	It("should return the last update date in the language of the document", func() {
+		source := `= a document title
+:lang: fr`
+		metadata, err := DocumentMetadata(source, lastUpdated)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(metadata.LastUpdated).To(Equal(lastUpdated.Format("02/01/2006 15:04:05 -0700")))
+	})
+
+	Context("diagnostics", func() {
+
+		It("should return no diagnostic", func() {
+			source := `= a document title
+
+a paragraph with a <<_section_a,reference>>
+
+== Section A`
+			metadata, err := DocumentMetadata(source, lastUpdated)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(metadata.Diagnostics).To(BeNil())
+		})
+
+		It("should return the diagnostics with their position", func() {
+			source := `= a document title
+
+a paragraph with a <<unknown,reference>> and {unknown}
+
+include::unknown.adoc[]`
+			// the source positions are recorded without being explicitly enabled
+			metadata, err := DocumentMetadata(source, lastUpdated,
+				configuration.WithFilename("test.adoc"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(metadata.Diagnostics).To(HaveLen(3))
+			Expect(metadata.Diagnostics[0].Code).To(Equal(types.UnresolvedInclude))
+			Expect(metadata.Diagnostics[0].Severity).To(Equal(types.DiagnosticError))
+			Expect(metadata.Diagnostics[0].Position.Start).To(Equal(types.SourceLocation{Filename: "test.adoc", Line: 5, Column: 1}))
+			Expect(metadata.Diagnostics[1]).To(Equal(types.Diagnostic{
+				Severity: types.DiagnosticWarning,
+				Code:     types.MissingAttribute,
+				Message:  "unable to find attribute 'unknown'",
+				Position: types.Position{
+					Start: types.SourceLocation{Filename: "test.adoc", Line: 3, Column: 46},
+					End:   types.SourceLocation{Filename: "test.adoc", Line: 3, Column: 55},
+				},
+			}))
+			Expect(metadata.Diagnostics[2]).To(Equal(types.Diagnostic{
+				Severity: types.DiagnosticWarning,
+				Code:     types.UnresolvedCrossReference,
+				Message:  "possible invalid reference: 'unknown'",
+				Position: types.Position{
+					Start: types.SourceLocation{Filename: "test.adoc", Line: 3, Column: 20},
+					End:   types.SourceLocation{Filename: "test.adoc", Line: 3, Column: 41},
+				},
+			}))
+		})
+
+		It("should fail with problems at the failure level", func() {
+			source := `a paragraph with a <<unknown,reference>>`
+			metadata, err := DocumentMetadata(source, lastUpdated,
+				configuration.WithFailureLevel(types.DiagnosticWarning))
+			Expect(err).To(MatchError("1 problem(s) found at or above the 'warning' level"))
+			// the metadata is returned along with the error
+			Expect(metadata.Diagnostics).To(HaveLen(1))
+			Expect(err.(libasciidoc.FailureError).Diagnostics).To(Equal(metadata.Diagnostics))
+		})
+
+		It("should not fail with problems below the failure level", func() {
+			source := `a paragraph with a <<unknown,reference>>`
+			metadata, err := DocumentMetadata(source, lastUpdated,
+				configuration.WithFailureLevel(types.DiagnosticError))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(metadata.Diagnostics).To(HaveLen(1))
+		})
+
+		It("should only return the diagnostics of each conversion with a shared collector", func() {
+			diagnostics := types.NewDiagnostics()
+			config := configuration.NewConfiguration(
+				configuration.WithDiagnostics(diagnostics),
+				configuration.WithFailureLevel(types.DiagnosticWarning))
+			// first conversion, with a problem
+			metadata, err := libasciidoc.Convert(strings.NewReader(`a paragraph with a <<unknown,reference>>`), &bytes.Buffer{}, config)
+			Expect(err).To(MatchError("1 problem(s) found at or above the 'warning' level"))
+			Expect(metadata.Diagnostics).To(HaveLen(1))
+			// second conversion, without any problem
+			metadata, err = libasciidoc.Convert(strings.NewReader(`a paragraph`), &bytes.Buffer{}, config)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(metadata.Diagnostics).To(BeEmpty())
+			// third conversion, with another problem
+			metadata, err = libasciidoc.Convert(strings.NewReader(`a {missing} attribute`), &bytes.Buffer{}, config)
+			Expect(err).To(MatchError("1 problem(s) found at or above the 'warning' level"))
+			Expect(metadata.Diagnostics).To(HaveLen(1))
+			Expect(metadata.Diagnostics[0].Code).To(Equal(types.MissingAttribute))
+			// the collector contains the problems of all the conversions
+			Expect(diagnostics.All()).To(HaveLen(2))
+			Expect(diagnostics.All()[0].Code).To(Equal(types.UnresolvedCrossReference))
+			Expect(diagnostics.All()[1].Code).To(Equal(types.MissingAttribute))
+		})
+
+		It("should return the problems of an invalid manpage", func() {
+			source := `= eve(1)
+
+== Foo
+
+eve - analyzes an image to determine if it's a picture of a life form`
+			metadata, err := DocumentMetadata(source, lastUpdated,
+				configuration.WithAttribute(types.AttrDocType, "manpage"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(metadata.Diagnostics).To(HaveLen(1))
+			Expect(metadata.Diagnostics[0].Code).To(Equal(types.InvalidDocument))
+			Expect(metadata.Diagnostics[0].Severity).To(Equal(types.DiagnosticError))
+		})
+	})
 })
+
+// dirFS a file system in which the files are read in the given directory
+type dirFS string
+
+func (dir dirFS) Open(name string) (configuration.File, error) {
+	return os.Open(filepath.Join(string(dir), filepath.FromSlash(name)))
+}
@@ -0,0 +1,83 @@
+// Package ast encodes the documents in JSON or in YAML, and decodes them back into a `types.Document`,
+// so that a document can be processed by external tools and then rendered.
+//
+// The encoded document is wrapped in an envelope with the version of the encoding:
+//
+//	{"version": 1, "document": {"type": "document", "elements": [...]}}
+//
+// Each element is an object with its `type` (eg: `section`), followed by its fields (eg: `level`), in which
+// the fields with a zero value are omitted. The names of the types and of the fields are defined in the `kinds`
+// and `fields` tables of this package, rather than derived from the Go types, so that the encoding does not change
+// when the `types` package is refactored. Their schema is pinned in `test/ast/schema.json`, and any change
+// of this schema requires a new `Version`.
+// In the fields whose type is not known in advance (eg: the elements of a section, or the values of the attributes),
+// the strings, booleans, arrays and null values are encoded as-is, whereas the other values (eg: numbers or
+// named types such as `AdmonitionKind`) are wrapped in an object with their `type` and their `value`.
+package ast
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+)
+
+// Version the version of the encoding of the documents, which changes with the names of the types and of the fields
+const Version = 1
+
+// Format the format of an encoded document
+type Format string
+
+const (
+	// JSON the JSON format
+	JSON Format = "json"
+	// YAML the YAML format
+	YAML Format = "yaml"
+)
+
+// ParseFormat parses the given format (case insensitive)
+func ParseFormat(format string) (Format, error) {
+	switch strings.ToLower(format) {
+	case "json":
+		return JSON, nil
+	case "yaml", "yml":
+		return YAML, nil
+	default:
+		return "", fmt.Errorf("unsupported format: '%s'", format)
+	}
+}
+
+// Encode writes the given document in the given format
+func Encode(w io.Writer, doc types.Document, format Format) error {
+	node, err := encodeEnvelope(doc)
+	if err != nil {
+		return err
+	}
+	switch format {
+	case JSON:
+		return writeJSON(w, node)
+	case YAML:
+		return writeYAML(w, node)
+	default:
+		return fmt.Errorf("unsupported format: '%s'", format)
+	}
+}
+
+// Decode reads a document in the given format
+func Decode(r io.Reader, format Format) (types.Document, error) {
+	var node interface{}
+	var err error
+	switch format {
+	case JSON:
+		node, err = readJSON(r)
+	case YAML:
+		node, err = readYAML(r)
+	default:
+		return types.Document{}, fmt.Errorf("unsupported format: '%s'", format)
+	}
+	if err != nil {
+		return types.Document{}, err
+	}
+	return decodeEnvelope(node)
+}
@@ -0,0 +1,15 @@
+package ast_test
+
+import (
+	"testing"
+
+	_ "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo" //nolint golint
+	. "github.com/onsi/gomega" //nolint golint
+)
+
+func TestAST(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "AST Suite")
+}
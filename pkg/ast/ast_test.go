@@ -0,0 +1,217 @@
+package ast_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/ast"
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+	"github.com/bytesparadise/libasciidoc/pkg/parser"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	. "github.com/onsi/ginkgo"                  //nolint golint
+	. "github.com/onsi/ginkgo/extensions/table" //nolint golint
+	. "github.com/onsi/gomega"                  //nolint golint
+)
+
+var _ = Describe("document encoding", func() {
+
+	doc := types.Document{
+		Attributes: types.Attributes{
+			types.AttrTitle: "title",
+		},
+		Elements: []interface{}{
+			types.Paragraph{
+				Attributes: types.Attributes{
+					types.AttrAdmonitionKind: types.Note,
+				},
+				Lines: [][]interface{}{
+					{
+						types.StringElement{Content: "a <b>"},
+						types.QuotedText{
+							Kind: types.Italic,
+							Elements: []interface{}{
+								types.StringElement{Content: "bold"},
+							},
+						},
+					},
+				},
+				Position: types.Position{
+					Start: types.SourceLocation{Filename: "test.adoc", Line: 1, Column: 1},
+					End:   types.SourceLocation{Filename: "test.adoc", Line: 1, Column: 15},
+				},
+			},
+		},
+		ElementReferences: types.ElementReferences{},
+	}
+
+	It("should encode document in JSON", func() {
+		out := &bytes.Buffer{}
+		Expect(ast.Encode(out, doc, ast.JSON)).To(Succeed())
+		Expect(out.String()).To(Equal(`{
+  "version": 1,
+  "document": {
+    "type": "document",
+    "attributes": {
+      "title": "title"
+    },
+    "elements": [
+      {
+        "type": "paragraph",
+        "attributes": {
+          "admonitionKind": {
+            "type": "admonitionKind",
+            "value": "note"
+          }
+        },
+        "lines": [
+          [
+            {
+              "type": "stringElement",
+              "content": "a <b>"
+            },
+            {
+              "type": "quotedText",
+              "kind": 1,
+              "elements": [
+                {
+                  "type": "stringElement",
+                  "content": "bold"
+                }
+              ]
+            }
+          ]
+        ],
+        "position": {
+          "type": "position",
+          "start": {
+            "type": "sourceLocation",
+            "filename": "test.adoc",
+            "line": 1,
+            "column": 1
+          },
+          "end": {
+            "type": "sourceLocation",
+            "filename": "test.adoc",
+            "line": 1,
+            "column": 15
+          }
+        }
+      }
+    ],
+    "elementReferences": {}
+  }
+}
+`))
+	})
+
+	It("should encode document in YAML", func() {
+		out := &bytes.Buffer{}
+		Expect(ast.Encode(out, types.Document{
+			Elements: []interface{}{
+				types.StringElement{Content: "content"},
+			},
+		}, ast.YAML)).To(Succeed())
+		Expect(out.String()).To(Equal(`version: 1
+document:
+  type: document
+  elements:
+  - type: stringElement
+    content: content
+`))
+	})
+
+	DescribeTable("should decode encoded document",
+		func(format ast.Format) {
+			out := &bytes.Buffer{}
+			Expect(ast.Encode(out, doc, format)).To(Succeed())
+			Expect(ast.Decode(out, format)).To(Equal(doc))
+		},
+		Entry("in JSON", ast.JSON),
+		Entry("in YAML", ast.YAML),
+	)
+
+	It("should decode document changed by another tool", func() {
+		source := `{
+  "version": 1,
+  "document": {
+    "type": "document",
+    "elements": [
+      {
+        "type": "paragraph",
+        "attributes": {"level": 2},
+        "lines": [[{"type": "stringElement", "content": "changed"}]]
+      }
+    ]
+  }
+}`
+		Expect(ast.Decode(strings.NewReader(source), ast.JSON)).To(Equal(types.Document{
+			Elements: []interface{}{
+				types.Paragraph{
+					Attributes: types.Attributes{
+						"level": 2,
+					},
+					Lines: [][]interface{}{
+						{
+							types.StringElement{Content: "changed"},
+						},
+					},
+				},
+			},
+		}))
+	})
+
+	DescribeTable("should not decode invalid document",
+		func(source, expectedError string) {
+			_, err := ast.Decode(strings.NewReader(source), ast.JSON)
+			Expect(err).To(MatchError(ContainSubstring(expectedError)))
+		},
+		Entry("unsupported version", `{"version": 2, "document": {}}`, "unsupported version '2'"),
+		Entry("missing version", `{"document": {}}`, "unsupported version '<nil>'"),
+		Entry("unknown type", `{"version": 1, "document": {"elements": [{"type": "unknown"}]}}`, "unknown type: 'unknown'"),
+		Entry("missing type", `{"version": 1, "document": {"elements": [{"content": "foo"}]}}`, "missing type of object"),
+		Entry("unknown field", `{"version": 1, "document": {"elements": [{"type": "stringElement", "text": "foo"}]}}`, "unknown field 'text' in 'stringElement'"),
+		Entry("name of Go field", `{"version": 1, "document": {"elements": [{"type": "stringElement", "Content": "foo"}]}}`, "unknown field 'Content' in 'stringElement'"),
+		Entry("invalid field", `{"version": 1, "document": {"elements": [{"type": "stringElement", "content": 1}]}}`, "expected a string for 'string' but got '1'"),
+	)
+
+	It("should parse format", func() {
+		Expect(ast.ParseFormat("JSON")).To(Equal(ast.JSON))
+		Expect(ast.ParseFormat("yml")).To(Equal(ast.YAML))
+		_, err := ast.ParseFormat("xml")
+		Expect(err).To(MatchError("unsupported format: 'xml'"))
+	})
+
+	Context("round-trip of parsed documents", func() {
+
+		// all the documents of the test fixtures, with their source positions
+		filenames, err := filepath.Glob("../../test/*/*.adoc")
+		if err != nil {
+			panic(err)
+		}
+		fixtures, err := filepath.Glob("../../test/fixtures/*/*.adoc")
+		if err != nil {
+			panic(err)
+		}
+		filenames = append(filenames, fixtures...)
+		for _, filename := range filenames {
+			filename := filename
+			It("should decode encoded "+filepath.Base(filename), func() {
+				f, err := os.Open(filename)
+				Expect(err).NotTo(HaveOccurred())
+				defer f.Close()
+				doc, err := parser.ParseDocument(f, configuration.NewConfiguration(
+					configuration.WithFilename(filename),
+					configuration.WithSourcePositions(true)))
+				Expect(err).NotTo(HaveOccurred())
+				for _, format := range []ast.Format{ast.JSON, ast.YAML} {
+					out := &bytes.Buffer{}
+					Expect(ast.Encode(out, doc, format)).To(Succeed())
+					Expect(ast.Decode(out, format)).To(Equal(doc))
+				}
+			})
+		}
+	})
+})
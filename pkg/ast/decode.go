@@ -0,0 +1,285 @@
+package ast
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"reflect"
+
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	"github.com/pkg/errors"
+	yaml "gopkg.in/yaml.v2"
+)
+
+func readJSON(r io.Reader) (interface{}, error) {
+	dec := json.NewDecoder(r)
+	dec.UseNumber() // keep the integers as-is
+	var node interface{}
+	if err := dec.Decode(&node); err != nil {
+		return nil, errors.Wrap(err, "unable to read document in JSON")
+	}
+	return node, nil
+}
+
+func readYAML(r io.Reader) (interface{}, error) {
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to read document in YAML")
+	}
+	var node interface{}
+	if err := yaml.Unmarshal(data, &node); err != nil {
+		return nil, errors.Wrap(err, "unable to read document in YAML")
+	}
+	return normalizeYAML(node), nil
+}
+
+// normalizeYAML converts the maps of the given YAML node into maps with string keys, as in JSON
+func normalizeYAML(node interface{}) interface{} {
+	switch n := node.(type) {
+	case map[interface{}]interface{}:
+		result := make(map[string]interface{}, len(n))
+		for k, v := range n {
+			result[fmt.Sprintf("%v", k)] = normalizeYAML(v)
+		}
+		return result
+	case []interface{}:
+		for i, v := range n {
+			n[i] = normalizeYAML(v)
+		}
+		return n
+	default:
+		return node
+	}
+}
+
+// decodeEnvelope decodes the document in the given node, after checking the version of the encoding
+func decodeEnvelope(node interface{}) (types.Document, error) {
+	envelope, ok := node.(map[string]interface{})
+	if !ok {
+		return types.Document{}, errors.New("unable to decode document: expected an object with a 'version' and a 'document'")
+	}
+	for k := range envelope {
+		if k != "version" && k != "document" {
+			return types.Document{}, errors.Errorf("unable to decode document: unknown field '%s'", k)
+		}
+	}
+	if version, err := toInt64(envelope["version"]); err != nil || version != Version {
+		return types.Document{}, errors.Errorf("unable to decode document: unsupported version '%v' (expected %d)", envelope["version"], Version)
+	}
+	doc, err := decodeValue(envelope["document"], reflect.TypeOf(types.Document{}))
+	if err != nil {
+		return types.Document{}, errors.Wrap(err, "unable to decode document")
+	}
+	return doc.Interface().(types.Document), nil
+}
+
+// decodeValue decodes the given node into a value of the given type
+func decodeValue(node interface{}, t reflect.Type) (reflect.Value, error) {
+	result := reflect.New(t).Elem()
+	if node == nil {
+		return result, nil
+	}
+	switch t.Kind() {
+	case reflect.Interface:
+		v, err := decodeAny(node)
+		if err != nil {
+			return result, err
+		}
+		if v != nil {
+			result.Set(reflect.ValueOf(v))
+		}
+		return result, nil
+	case reflect.Struct:
+		return result, decodeStruct(node, result)
+	case reflect.Map:
+		m, ok := node.(map[string]interface{})
+		if !ok {
+			return result, errors.Errorf("expected an object for '%s' but got '%v'", t.String(), node)
+		}
+		result.Set(reflect.MakeMapWithSize(t, len(m)))
+		for k, n := range m {
+			v, err := decodeValue(n, t.Elem())
+			if err != nil {
+				return result, errors.Wrapf(err, "unable to decode '%s'", k)
+			}
+			result.SetMapIndex(reflect.ValueOf(k).Convert(t.Key()), v)
+		}
+		return result, nil
+	case reflect.Slice:
+		a, ok := node.([]interface{})
+		if !ok {
+			return result, errors.Errorf("expected an array for '%s' but got '%v'", t.String(), node)
+		}
+		result.Set(reflect.MakeSlice(t, len(a), len(a)))
+		for i, n := range a {
+			v, err := decodeValue(n, t.Elem())
+			if err != nil {
+				return result, err
+			}
+			result.Index(i).Set(v)
+		}
+		return result, nil
+	case reflect.String:
+		s, ok := node.(string)
+		if !ok {
+			return result, errors.Errorf("expected a string for '%s' but got '%v'", t.String(), node)
+		}
+		result.SetString(s)
+		return result, nil
+	case reflect.Bool:
+		b, ok := node.(bool)
+		if !ok {
+			return result, errors.Errorf("expected a boolean for '%s' but got '%v'", t.String(), node)
+		}
+		result.SetBool(b)
+		return result, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := toInt64(node)
+		if err != nil {
+			return result, errors.Wrapf(err, "unable to decode '%s'", t.String())
+		}
+		result.SetInt(i)
+		return result, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		i, err := toInt64(node)
+		if err != nil || i < 0 {
+			return result, errors.Errorf("expected a positive integer for '%s' but got '%v'", t.String(), node)
+		}
+		result.SetUint(uint64(i))
+		return result, nil
+	case reflect.Float32, reflect.Float64:
+		f, err := toFloat64(node)
+		if err != nil {
+			return result, errors.Wrapf(err, "unable to decode '%s'", t.String())
+		}
+		result.SetFloat(f)
+		return result, nil
+	default:
+		return result, errors.Errorf("unsupported type: '%s'", t.String())
+	}
+}
+
+// decodeStruct decodes the fields of the given node into the given struct value
+func decodeStruct(node interface{}, result reflect.Value) error {
+	t := result.Type()
+	m, ok := node.(map[string]interface{})
+	if !ok {
+		return errors.Errorf("expected an object for '%s' but got '%v'", t.String(), node)
+	}
+	kind, err := kindOf(t)
+	if err != nil {
+		return err
+	}
+	for k, n := range m {
+		if k == typeKey {
+			if n != kind {
+				return errors.Errorf("expected an object of type '%s' but got '%v'", kind, n)
+			}
+			continue
+		}
+		f, found := t.FieldByName(fieldsByName[k])
+		if !found || len(f.Index) != 1 || f.PkgPath != "" {
+			return errors.Errorf("unknown field '%s' in '%s'", k, kind)
+		}
+		v, err := decodeValue(n, f.Type)
+		if err != nil {
+			return errors.Wrapf(err, "unable to decode field '%s' of '%s'", k, kind)
+		}
+		result.Field(f.Index[0]).Set(v)
+	}
+	return nil
+}
+
+// decodeAny decodes the given node, whose type is given by its kind in its `type` member (unless it is a string, a boolean, an array or null)
+func decodeAny(node interface{}) (interface{}, error) {
+	switch n := node.(type) {
+	case nil, string, bool:
+		return n, nil
+	case []interface{}:
+		v, err := decodeValue(n, sliceType)
+		if err != nil {
+			return nil, err
+		}
+		return v.Interface(), nil
+	case map[string]interface{}:
+		kind, ok := n[typeKey].(string)
+		if !ok {
+			return nil, errors.Errorf("missing type of object '%v'", n)
+		}
+		t, err := typeOf(kind)
+		if err != nil {
+			return nil, err
+		}
+		if t.Kind() == reflect.Struct {
+			v, err := decodeValue(n, t)
+			if err != nil {
+				return nil, err
+			}
+			return v.Interface(), nil
+		}
+		for k := range n {
+			if k != typeKey && k != valueKey {
+				return nil, errors.Errorf("unknown field '%s' in value of type '%s'", k, kind)
+			}
+		}
+		v, err := decodeValue(n[valueKey], t)
+		if err != nil {
+			return nil, err
+		}
+		return v.Interface(), nil
+	default:
+		// numbers without kind
+		if i, err := toInt64(n); err == nil {
+			return int(i), nil
+		}
+		if f, err := toFloat64(n); err == nil {
+			return f, nil
+		}
+		return nil, errors.Errorf("unsupported value: '%v'", n)
+	}
+}
+
+// toInt64 returns the given number as an int64, as long as it is an integer
+func toInt64(node interface{}) (int64, error) {
+	switch n := node.(type) {
+	case json.Number:
+		return n.Int64()
+	case int:
+		return int64(n), nil
+	case int64:
+		return n, nil
+	case uint64:
+		if n > math.MaxInt64 {
+			return 0, errors.Errorf("integer overflow: '%d'", n)
+		}
+		return int64(n), nil
+	case float64:
+		if n != math.Trunc(n) {
+			return 0, errors.Errorf("expected an integer but got '%v'", n)
+		}
+		return int64(n), nil
+	default:
+		return 0, errors.Errorf("expected an integer but got '%v'", n)
+	}
+}
+
+// toFloat64 returns the given number as a float64
+func toFloat64(node interface{}) (float64, error) {
+	switch n := node.(type) {
+	case json.Number:
+		return n.Float64()
+	case int:
+		return float64(n), nil
+	case int64:
+		return float64(n), nil
+	case uint64:
+		return float64(n), nil
+	case float64:
+		return n, nil
+	default:
+		return 0, errors.Errorf("expected a number but got '%v'", n)
+	}
+}
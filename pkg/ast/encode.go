@@ -0,0 +1,236 @@
+package ast
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"reflect"
+	"sort"
+
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	"github.com/pkg/errors"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// member a member of an object
+type member struct {
+	key   string
+	value interface{}
+}
+
+// object an object whose members are encoded in order (eg: the `type` of an element before its fields)
+type object []member
+
+// MarshalJSON encodes the members of this object in order
+func (o object) MarshalJSON() ([]byte, error) {
+	buf := &bytes.Buffer{}
+	buf.WriteString("{")
+	for i, m := range o {
+		if i > 0 {
+			buf.WriteString(",")
+		}
+		if err := writeJSONValue(buf, m.key); err != nil {
+			return nil, err
+		}
+		buf.WriteString(":")
+		if err := writeJSONValue(buf, m.value); err != nil {
+			return nil, err
+		}
+	}
+	buf.WriteString("}")
+	return buf.Bytes(), nil
+}
+
+// MarshalYAML encodes the members of this object in order
+func (o object) MarshalYAML() (interface{}, error) {
+	result := make(yaml.MapSlice, len(o))
+	for i, m := range o {
+		result[i] = yaml.MapItem{
+			Key:   m.key,
+			Value: m.value,
+		}
+	}
+	return result, nil
+}
+
+// writeJSONValue writes the given value in JSON, without escaping the HTML characters
+// (which may be in the content of the elements)
+func writeJSONValue(buf *bytes.Buffer, value interface{}) error {
+	enc := json.NewEncoder(buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(value); err != nil {
+		return err
+	}
+	buf.Truncate(buf.Len() - 1) // remove the trailing newline
+	return nil
+}
+
+func writeJSON(w io.Writer, node interface{}) error {
+	enc := json.NewEncoder(w)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	return errors.Wrap(enc.Encode(node), "unable to encode document in JSON")
+}
+
+func writeYAML(w io.Writer, node interface{}) error {
+	out, err := yaml.Marshal(node)
+	if err != nil {
+		return errors.Wrap(err, "unable to encode document in YAML")
+	}
+	_, err = w.Write(out)
+	return err
+}
+
+// encodeEnvelope encodes the given document along with the version of the encoding
+func encodeEnvelope(doc types.Document) (interface{}, error) {
+	node, err := encodeValue(reflect.ValueOf(doc))
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to encode document")
+	}
+	return object{
+		{key: "version", value: Version},
+		{key: "document", value: node},
+	}, nil
+}
+
+// encodeValue encodes the given value, whose type is known when it is decoded
+func encodeValue(v reflect.Value) (interface{}, error) {
+	switch v.Kind() {
+	case reflect.Interface:
+		if v.IsNil() {
+			return nil, nil
+		}
+		return encodeAny(v.Elem())
+	case reflect.Struct:
+		return encodeStruct(v)
+	case reflect.Map:
+		if v.IsNil() {
+			return nil, nil
+		}
+		if v.Type().Key().Kind() != reflect.String {
+			return nil, errors.Errorf("unsupported type: '%s'", v.Type().String())
+		}
+		keys := v.MapKeys()
+		sort.Slice(keys, func(i, j int) bool {
+			return keys[i].String() < keys[j].String()
+		})
+		result := make(object, len(keys))
+		for i, k := range keys {
+			value, err := encodeValue(v.MapIndex(k))
+			if err != nil {
+				return nil, err
+			}
+			result[i] = member{
+				key:   k.String(),
+				value: value,
+			}
+		}
+		return result, nil
+	case reflect.Slice:
+		if v.IsNil() {
+			return nil, nil
+		}
+		result := make([]interface{}, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			e, err := encodeValue(v.Index(i))
+			if err != nil {
+				return nil, err
+			}
+			result[i] = e
+		}
+		return result, nil
+	case reflect.String:
+		return v.String(), nil
+	case reflect.Bool:
+		return v.Bool(), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int(), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return v.Uint(), nil
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), nil
+	default:
+		return nil, errors.Errorf("unsupported type: '%s'", v.Type().String())
+	}
+}
+
+// encodeStruct encodes the given struct with its kind, followed by its fields (except those with a zero value),
+// with their names in the `fields` table
+func encodeStruct(v reflect.Value) (interface{}, error) {
+	t := v.Type()
+	kind, err := kindOf(t)
+	if err != nil {
+		return nil, err
+	}
+	result := object{
+		{key: typeKey, value: kind},
+	}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.PkgPath != "" {
+			return nil, errors.Errorf("unsupported unexported field '%s' in type '%s'", f.Name, t.String())
+		}
+		name, err := fieldOf(f)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to encode '%s'", kind)
+		}
+		if v.Field(i).IsZero() {
+			continue
+		}
+		value, err := encodeValue(v.Field(i))
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to encode field '%s' of '%s'", name, kind)
+		}
+		result = append(result, member{
+			key:   name,
+			value: value,
+		})
+	}
+	return result, nil
+}
+
+const (
+	// typeKey the key of the kind of the encoded values
+	typeKey = "type"
+	// valueKey the key of the values which are wrapped in an object with their kind
+	valueKey = "value"
+)
+
+var (
+	stringType = reflect.TypeOf("")
+	boolType   = reflect.TypeOf(false)
+	sliceType  = reflect.TypeOf([]interface{}{})
+)
+
+// encodeAny encodes the given value, whose type is unknown when it is decoded (eg: an element in a slice of elements):
+// the strings, booleans and slices of elements are encoded as-is, the structs are encoded with their kind,
+// and the other values are wrapped in an object with their kind and their value.
+func encodeAny(v reflect.Value) (interface{}, error) {
+	switch {
+	case v.Type() == stringType, v.Type() == boolType:
+		return encodeValue(v)
+	case v.Type() == sliceType && !v.IsNil():
+		return encodeValue(v)
+	case v.Kind() == reflect.Struct:
+		return encodeStruct(v)
+	}
+	kind, err := kindOf(v.Type())
+	if err != nil {
+		return nil, err
+	}
+	result := object{
+		{key: typeKey, value: kind},
+	}
+	if !v.IsZero() {
+		value, err := encodeValue(v)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, member{
+			key:   valueKey,
+			value: value,
+		})
+	}
+	return result, nil
+}
@@ -0,0 +1,261 @@
+package ast
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	"github.com/pkg/errors"
+)
+
+// kinds the types which can be encoded, indexed by their kind, ie, the `type` of the encoded values.
+// The kinds are part of the encoding, so they do not change when a type is renamed in the `types` package
+// (and a change of kind requires a new `Version`).
+var kinds = map[string]interface{}{
+	// the builtin types
+	"string":  "",
+	"bool":    false,
+	"int":     int(0),
+	"int8":    int8(0),
+	"int16":   int16(0),
+	"int32":   int32(0),
+	"int64":   int64(0),
+	"uint":    uint(0),
+	"uint8":   uint8(0),
+	"uint16":  uint16(0),
+	"uint32":  uint32(0),
+	"uint64":  uint64(0),
+	"float32": float32(0),
+	"float64": float64(0),
+	// the types of the elements and of their fields
+	"admonitionKind":              types.AdmonitionKind(""),
+	"attributeDeclaration":        types.AttributeDeclaration{},
+	"attributeOverride":           types.AttributeOverride{},
+	"attributeReset":              types.AttributeReset{},
+	"attributeSubstitution":       types.AttributeSubstitution{},
+	"attributes":                  types.Attributes{},
+	"attributesWithOverrides":     types.AttributesWithOverrides{},
+	"blankLine":                   types.BlankLine{},
+	"blockKind":                   types.BlockKind(""),
+	"bulletStyle":                 types.BulletStyle(""),
+	"callout":                     types.Callout{},
+	"calloutList":                 types.CalloutList{},
+	"calloutListItem":             types.CalloutListItem{},
+	"concealedIndexTerm":          types.ConcealedIndexTerm{},
+	"continuedListItemElement":    types.ContinuedListItemElement{},
+	"delimitedBlock":              types.DelimitedBlock{},
+	"diagnostic":                  types.Diagnostic{},
+	"diagnosticCode":              types.DiagnosticCode(""),
+	"diagnosticSeverity":          types.DiagnosticSeverity(""),
+	"document":                    types.Document{},
+	"documentAuthor":              types.DocumentAuthor{},
+	"documentRevision":            types.DocumentRevision{},
+	"draftDocument":               types.DraftDocument{},
+	"elementReference":            types.ElementReference{},
+	"elementReferences":           types.ElementReferences{},
+	"externalCrossReference":      types.ExternalCrossReference{},
+	"fileInclusion":               types.FileInclusion{},
+	"footnote":                    types.Footnote{},
+	"footnoteReference":           types.FootnoteReference{},
+	"frontMatter":                 types.FrontMatter{},
+	"icon":                        types.Icon{},
+	"imageBlock":                  types.ImageBlock{},
+	"includedFileEndTag":          types.IncludedFileEndTag{},
+	"includedFileLine":            types.IncludedFileLine{},
+	"includedFileStartTag":        types.IncludedFileStartTag{},
+	"indexTerm":                   types.IndexTerm{},
+	"inlineAnchor":                types.InlineAnchor{},
+	"inlineImage":                 types.InlineImage{},
+	"inlineLink":                  types.InlineLink{},
+	"inlinePassthrough":           types.InlinePassthrough{},
+	"internalCrossReference":      types.InternalCrossReference{},
+	"labeledList":                 types.LabeledList{},
+	"labeledListItem":             types.LabeledListItem{},
+	"lineBreak":                   types.LineBreak{},
+	"lineRange":                   types.LineRange{},
+	"lineRanges":                  types.LineRanges{},
+	"literalBlock":                types.LiteralBlock{},
+	"location":                    types.Location{},
+	"macroKind":                   types.MacroKind(""),
+	"numberingStyle":              types.NumberingStyle(""),
+	"orderedList":                 types.OrderedList{},
+	"orderedListItem":             types.OrderedListItem{},
+	"orderedListItemPrefix":       types.OrderedListItemPrefix{},
+	"paragraph":                   types.Paragraph{},
+	"passthroughKind":             types.PassthroughKind(0),
+	"position":                    types.Position{},
+	"positionalAttribute":         types.PositionalAttribute{},
+	"preamble":                    types.Preamble{},
+	"quotedAttributeValue":        types.QuotedAttributeValue{},
+	"quotedString":                types.QuotedString{},
+	"quotedStringKind":            types.QuotedStringKind(0),
+	"quotedText":                  types.QuotedText{},
+	"quotedTextKind":              types.QuotedTextKind(0),
+	"section":                     types.Section{},
+	"singleLineComment":           types.SingleLineComment{},
+	"sourceLocation":              types.SourceLocation{},
+	"stringElement":               types.StringElement{},
+	"table":                       types.Table{},
+	"tableLine":                   types.TableLine{},
+	"tableOfContents":             types.TableOfContents{},
+	"tableOfContentsPlaceHolder":  types.TableOfContentsPlaceHolder{},
+	"tagRange":                    types.TagRange{},
+	"tagRanges":                   types.TagRanges{},
+	"tocSection":                  types.ToCSection{},
+	"unorderedList":               types.UnorderedList{},
+	"unorderedListItem":           types.UnorderedListItem{},
+	"unorderedListItemCheckStyle": types.UnorderedListItemCheckStyle(""),
+	"unorderedListItemPrefix":     types.UnorderedListItemPrefix{},
+	"userMacro":                   types.UserMacro{},
+	"verbatimLine":                types.VerbatimLine{},
+}
+
+// fields the names of the encoded fields, indexed by the name of the fields of the types in the `types` package
+// (eg: `RawText` is encoded as `rawText`). As for the kinds, the names of the fields are part of the encoding, and
+// a field which is not in this table cannot be encoded.
+var fields = map[string]string{
+	"Attributes":        "attributes",
+	"Blocks":            "blocks",
+	"BulletStyle":       "bulletStyle",
+	"Callouts":          "callouts",
+	"Caption":           "caption",
+	"Cells":             "cells",
+	"CheckStyle":        "checkStyle",
+	"Children":          "children",
+	"Class":             "class",
+	"Code":              "code",
+	"Column":            "column",
+	"Content":           "content",
+	"Duplicate":         "duplicate",
+	"Element":           "element",
+	"ElementReferences": "elementReferences",
+	"Elements":          "elements",
+	"Email":             "email",
+	"End":               "end",
+	"EndLine":           "endLine",
+	"Filename":          "filename",
+	"Footnotes":         "footnotes",
+	"FrontMatter":       "frontMatter",
+	"FullName":          "fullName",
+	"Header":            "header",
+	"ID":                "id",
+	"Included":          "included",
+	"Items":             "items",
+	"Kind":              "kind",
+	"Label":             "label",
+	"Level":             "level",
+	"Line":              "line",
+	"Lines":             "lines",
+	"Location":          "location",
+	"Message":           "message",
+	"Name":              "name",
+	"NumberingStyle":    "numberingStyle",
+	"Offset":            "offset",
+	"Overrides":         "overrides",
+	"Path":              "path",
+	"Position":          "position",
+	"Positionals":       "positionals",
+	"Quoted":            "quoted",
+	"RawText":           "rawText",
+	"Ref":               "ref",
+	"RefText":           "refText",
+	"Revdate":           "revdate",
+	"Revnumber":         "revnumber",
+	"Revremark":         "revremark",
+	"Scheme":            "scheme",
+	"Sections":          "sections",
+	"Severity":          "severity",
+	"Soft":              "soft",
+	"Start":             "start",
+	"StartLine":         "startLine",
+	"Term":              "term",
+	"Term1":             "term1",
+	"Term2":             "term2",
+	"Term3":             "term3",
+	"Title":             "title",
+	"Unset":             "unset",
+	"Value":             "value",
+}
+
+var (
+	// typesByKind the types which can be encoded, indexed by their kind
+	typesByKind = map[string]reflect.Type{}
+	// kindsByType the kinds of the types which can be encoded
+	kindsByType = map[reflect.Type]string{}
+	// fieldsByName the names of the fields of the types, indexed by their encoded name
+	fieldsByName = map[string]string{}
+)
+
+func init() {
+	for kind, v := range kinds {
+		t := reflect.TypeOf(v)
+		typesByKind[kind] = t
+		kindsByType[t] = kind
+	}
+	for field, name := range fields {
+		fieldsByName[name] = field
+	}
+}
+
+const (
+	interfaceKind = "any"
+	sliceKind     = "[]"
+	mapKind       = "map[string]"
+)
+
+// kindOf returns the kind of the given type (eg: `section` or `int`), or a description of the unnamed
+// slices and maps of these types (eg: `[]documentAuthor` or `map[string]any`)
+func kindOf(t reflect.Type) (string, error) {
+	switch {
+	case t.Name() != "":
+		kind, found := kindsByType[t]
+		if !found {
+			return "", errors.Errorf("unsupported type: '%s'", t.String())
+		}
+		return kind, nil
+	case t.Kind() == reflect.Interface && t.NumMethod() == 0:
+		return interfaceKind, nil
+	case t.Kind() == reflect.Slice:
+		k, err := kindOf(t.Elem())
+		return sliceKind + k, err
+	case t.Kind() == reflect.Map && t.Key().Kind() == reflect.String && t.Key().Name() == "string":
+		k, err := kindOf(t.Elem())
+		return mapKind + k, err
+	default:
+		return "", errors.Errorf("unsupported type: '%s'", t.String())
+	}
+}
+
+// fieldOf returns the encoded name of the given field
+func fieldOf(f reflect.StructField) (string, error) {
+	if name, found := fields[f.Name]; found {
+		return name, nil
+	}
+	return "", errors.Errorf("unsupported field: '%s'", f.Name)
+}
+
+// typeOf returns the type of the given kind
+func typeOf(kind string) (reflect.Type, error) {
+	switch {
+	case kind == interfaceKind:
+		return reflect.TypeOf((*interface{})(nil)).Elem(), nil
+	case strings.HasPrefix(kind, sliceKind):
+		t, err := typeOf(strings.TrimPrefix(kind, sliceKind))
+		if err != nil {
+			return nil, err
+		}
+		return reflect.SliceOf(t), nil
+	case strings.HasPrefix(kind, mapKind):
+		t, err := typeOf(strings.TrimPrefix(kind, mapKind))
+		if err != nil {
+			return nil, err
+		}
+		return reflect.MapOf(reflect.TypeOf(""), t), nil
+	default:
+		if t, found := typesByKind[kind]; found {
+			return t, nil
+		}
+		return nil, errors.Errorf("unknown type: '%s'", kind)
+	}
+}
@@ -0,0 +1,106 @@
+package ast
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"sort"
+
+	. "github.com/onsi/ginkgo" //nolint golint
+	. "github.com/onsi/gomega" //nolint golint
+)
+
+// the file in which the schema of the encoding is pinned (it can be written again with `UPDATE_SCHEMA=true`,
+// along with a new `Version`)
+const schemaFile = "../../test/ast/schema.json"
+
+var _ = Describe("encoding schema", func() {
+
+	It("should match the pinned schema", func() {
+		s, err := schema()
+		Expect(err).NotTo(HaveOccurred())
+		out := &bytes.Buffer{}
+		Expect(writeJSON(out, s)).To(Succeed())
+		if os.Getenv("UPDATE_SCHEMA") == "true" {
+			Expect(ioutil.WriteFile(schemaFile, out.Bytes(), 0644)).To(Succeed())
+		}
+		expected, err := ioutil.ReadFile(schemaFile)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out.String()).To(Equal(string(expected)), "the schema of the encoding changed: a new version is required")
+	})
+
+	It("should have a name for all fields", func() {
+		for kind, t := range typesByKind {
+			if t.Kind() != reflect.Struct {
+				continue
+			}
+			for i := 0; i < t.NumField(); i++ {
+				_, err := fieldOf(t.Field(i))
+				Expect(err).NotTo(HaveOccurred(), "field of '%s'", kind)
+			}
+		}
+	})
+})
+
+// underlyingKindOf returns the kind of the underlying type of the given named type (eg: `map[string]any` for `attributes`)
+func underlyingKindOf(t reflect.Type) (string, error) {
+	switch t.Kind() {
+	case reflect.Slice:
+		k, err := kindOf(t.Elem())
+		return sliceKind + k, err
+	case reflect.Map:
+		k, err := kindOf(t.Elem())
+		return mapKind + k, err
+	default:
+		return t.Kind().String(), nil
+	}
+}
+
+// schema returns the version of the encoding along with all the kinds, which are described with their fields
+// and the kinds of these fields (for the structs), or with their underlying builtin type
+func schema() (object, error) {
+	names := make([]string, 0, len(typesByKind))
+	for kind := range typesByKind {
+		names = append(names, kind)
+	}
+	sort.Strings(names)
+	result := make(object, len(names))
+	for i, kind := range names {
+		t := typesByKind[kind]
+		if t.Kind() != reflect.Struct {
+			underlying, err := underlyingKindOf(t)
+			if err != nil {
+				return nil, err
+			}
+			result[i] = member{
+				key:   kind,
+				value: underlying,
+			}
+			continue
+		}
+		fs := make(object, t.NumField())
+		for j := 0; j < t.NumField(); j++ {
+			name, err := fieldOf(t.Field(j))
+			if err != nil {
+				return nil, err
+			}
+			k, err := kindOf(t.Field(j).Type)
+			if err != nil {
+				return nil, err
+			}
+			fs[j] = member{
+				key:   name,
+				value: k,
+			}
+		}
+		result[i] = member{
+			key:   kind,
+			value: fs,
+		}
+	}
+	return object{
+		{key: "version", value: Version},
+		{key: "types", value: result},
+	}, nil
+}
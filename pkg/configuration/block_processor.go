@@ -0,0 +1,38 @@
+package configuration
+
+import (
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+)
+
+// BlockContext the context in which a delimited block is processed
+type BlockContext struct {
+	// Document the document in which the block is processed, along with its attributes
+	Document types.Document
+	// Config the configuration of the conversion
+	Config Configuration
+	// Kind the kind of the block (listing, literal, open or example)
+	Kind types.BlockKind
+	// Position the position of the block in the source files (if recorded)
+	Position types.Position
+}
+
+// BlockProcessor a function which processes the listing, literal, open or example blocks with a given style (eg: `[graphviz]`).
+// The processor receives the raw lines of the block (without any substitution) along with its attributes, and returns the blocks which
+// replace the delimited block in the document. The processor can also return some rendered content with `types.NewPassthroughBlock()`.
+type BlockProcessor func(ctx BlockContext, lines []string, attributes types.Attributes) ([]interface{}, error)
+
+// WithBlockProcessor defines the processor of the delimited blocks with the given style
+func WithBlockProcessor(style string, p BlockProcessor) Setting {
+	return func(config *Configuration) {
+		if config.blockProcessors == nil {
+			config.blockProcessors = map[string]BlockProcessor{}
+		}
+		config.blockProcessors[style] = p
+	}
+}
+
+// BlockProcessor returns the processor of the delimited blocks with the given style, if defined
+func (c Configuration) BlockProcessor(style string) (BlockProcessor, bool) {
+	p, found := c.blockProcessors[style]
+	return p, found
+}
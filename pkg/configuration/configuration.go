@@ -2,13 +2,18 @@ package configuration
 
 import (
 	"errors"
+	"net/http"
 	"time"
+
+	"github.com/bytesparadise/libasciidoc/pkg/types"
 )
 
 // NewConfiguration returns a new configuration
 func NewConfiguration(settings ...Setting) Configuration {
 	config := Configuration{
 		AttributeOverrides: make(map[string]string),
+		HTTPClient:         http.DefaultClient,
+		HTTPTimeout:        DefaultHTTPTimeout,
 		macros:             make(map[string]MacroTemplate),
 	}
 	for _, set := range settings {
@@ -25,17 +30,77 @@ type Configuration struct {
 	IncludeHeaderFooter bool
 	CSS                 string
 	BackEnd             string
-	macros              map[string]MacroTemplate
+	// HTTPClient the client used to read remote content, such as the files to include
+	// when the `allow-uri-read` attribute is set
+	HTTPClient HTTPClient
+	// HTTPTimeout the maximum duration of a request to read remote content
+	HTTPTimeout time.Duration
+	// URICacheDir the directory in which the remote content is cached (no caching if empty)
+	URICacheDir string
+	// URICacheTTL the duration after which the content in the cache directory expires and is read again
+	// (`DefaultURICacheTTL` if zero)
+	URICacheTTL time.Duration
+	// MaxRemoteSize the maximum size (in bytes) of the remote content (`DefaultMaxRemoteSize` if zero)
+	MaxRemoteSize int64
+	// SafeMode the safe mode in which the document is processed (default is `Unsafe`)
+	SafeMode SafeMode
+	// BaseDir the directory to which the files to include are restricted in `Safe` and `Server` modes
+	// (by default, the directory of the document)
+	BaseDir string
+	// FS the file system from which the documents and the files to include are read (the local file system if nil)
+	FS FS
+	// SourcePositions whether the position in the source files is recorded on each element of the document
+	SourcePositions bool
+	// Diagnostics the collector of the problems found while processing the document (no collection if nil).
+	// The source positions are always recorded when the problems are collected
+	Diagnostics *types.Diagnostics
+	// FailureLevel the minimum severity of the diagnostics which make the conversion fail (never fails if empty)
+	FailureLevel types.DiagnosticSeverity
+	// Preprocessors the extensions which process the lines of the document before they are parsed
+	Preprocessors []Preprocessor
+	// TreeProcessors the extensions which process the document before it is rendered
+	TreeProcessors []TreeProcessor
+	// Postprocessors the extensions which process the output before it is written
+	Postprocessors        []Postprocessor
+	macros                map[string]MacroTemplate
+	blockMacroProcessors  map[string]BlockMacroProcessor
+	inlineMacroProcessors map[string]InlineMacroProcessor
+	blockProcessors       map[string]BlockProcessor
+	includeProcessors     map[string]IncludeProcessor
+}
+
+// HTTPClient the interface of the client used to read remote content.
+// It is satisfied by `*http.Client`.
+type HTTPClient interface {
+	Do(req *http.Request) (*http.Response, error)
 }
 
 // Clone return a clone of the current configuration
 func (c Configuration) Clone() Configuration {
 	return Configuration{
-		CSS:                 c.CSS,
-		AttributeOverrides:  c.AttributeOverrides,
-		Filename:            c.Filename,
-		IncludeHeaderFooter: c.IncludeHeaderFooter,
-		LastUpdated:         c.LastUpdated,
+		CSS:                   c.CSS,
+		AttributeOverrides:    c.AttributeOverrides,
+		Filename:              c.Filename,
+		IncludeHeaderFooter:   c.IncludeHeaderFooter,
+		LastUpdated:           c.LastUpdated,
+		HTTPClient:            c.HTTPClient,
+		HTTPTimeout:           c.HTTPTimeout,
+		URICacheDir:           c.URICacheDir,
+		URICacheTTL:           c.URICacheTTL,
+		MaxRemoteSize:         c.MaxRemoteSize,
+		SafeMode:              c.SafeMode,
+		BaseDir:               c.BaseDir,
+		FS:                    c.FS,
+		SourcePositions:       c.SourcePositions,
+		Diagnostics:           c.Diagnostics,
+		FailureLevel:          c.FailureLevel,
+		Preprocessors:         c.Preprocessors,
+		TreeProcessors:        c.TreeProcessors,
+		Postprocessors:        c.Postprocessors,
+		blockMacroProcessors:  c.blockMacroProcessors,
+		inlineMacroProcessors: c.inlineMacroProcessors,
+		blockProcessors:       c.blockProcessors,
+		includeProcessors:     c.includeProcessors,
 	}
 }
 
@@ -50,7 +115,15 @@ func (c Configuration) MacroTemplate(name string) (MacroTemplate, error) {
 
 const (
 	// LastUpdatedFormat key to the time format for the `last updated` document attribute
+	//
+	// Deprecated: use `types.LastUpdatedFormat()`, which returns the format in the language of the document
 	LastUpdatedFormat string = "2006-01-02 15:04:05 -0700"
+	// DefaultHTTPTimeout the default maximum duration of a request to read remote content
+	DefaultHTTPTimeout = 10 * time.Second
+	// DefaultURICacheTTL the default duration after which the cached remote content expires
+	DefaultURICacheTTL = time.Hour
+	// DefaultMaxRemoteSize the default maximum size (in bytes) of the remote content
+	DefaultMaxRemoteSize = 10 * 1024 * 1024
 )
 
 // Setting a setting to customize the configuration used during parsing and rendering of a document
@@ -63,20 +136,64 @@ func WithLastUpdated(value time.Time) Setting {
 	}
 }
 
-// WithAttributes function to set the `attribute overrides`
+// WithAttributes function to set the `attribute overrides`.
+// Keys and values may use the `name!`, `!name`, `name@` and `value@` notations
+// to unset or soft-set the attributes.
 func WithAttributes(attrs map[string]string) Setting {
 	return func(config *Configuration) {
 		config.AttributeOverrides = attrs
 	}
 }
 
-// WithAttribute function to set an attribute as if it was passed as an argument in the CLI
+// WithAttribute function to set an attribute as if it was passed as an argument in the CLI.
+// The attribute is hard-set, i.e., the document cannot change its value.
 func WithAttribute(key, value string) Setting {
 	return func(config *Configuration) {
-		config.AttributeOverrides[key] = value
+		config.setAttributeOverride(key, key, value)
+	}
+}
+
+// WithSoftAttribute function to soft-set an attribute, i.e., the given value is a default
+// that the document can change (equivalent to `-a key=value@` in the CLI)
+func WithSoftAttribute(key, value string) Setting {
+	return func(config *Configuration) {
+		config.setAttributeOverride(key, key+"@", value)
+	}
+}
+
+// WithAttributeUnset function to hard-unset an attribute, i.e., the document cannot set it
+// (equivalent to `-a key!` in the CLI)
+func WithAttributeUnset(key string) Setting {
+	return func(config *Configuration) {
+		config.setAttributeOverride(key, "!"+key, "")
+	}
+}
+
+// WithAttributeSoftUnset function to soft-unset an attribute, i.e., the attribute is unset
+// unless the document sets it (equivalent to `-a key!@` in the CLI)
+func WithAttributeSoftUnset(key string) Setting {
+	return func(config *Configuration) {
+		config.setAttributeOverride(key, "!"+key+"@", "")
 	}
 }
 
+// setAttributeOverride sets the attribute override with the given (decorated) key,
+// after removing all other overrides for the same attribute
+func (c *Configuration) setAttributeOverride(name, key, value string) {
+	if c.AttributeOverrides == nil {
+		c.AttributeOverrides = map[string]string{}
+	}
+	for _, k := range overrideKeys(name) {
+		delete(c.AttributeOverrides, k)
+	}
+	c.AttributeOverrides[key] = value
+}
+
+// overrideKeys returns all the keys of the overrides of the attribute with the given name
+func overrideKeys(name string) []string {
+	return []string{name, name + "@", "!" + name, name + "!", "!" + name + "@", name + "!@"}
+}
+
 // WithHeaderFooter function to set the `include header/footer` setting in the config
 func WithHeaderFooter(value bool) Setting {
 	return func(config *Configuration) {
@@ -91,7 +208,8 @@ func WithCSS(href string) Setting {
 	}
 }
 
-// WithBackEnd sets the backend format, valid values are "html", "html5", "xhtml", "xhtml5", and "" (defaults to html5)
+// WithBackEnd sets the backend format, valid values are the names and aliases of the registered backends
+// (out of the box: "html", "html5", "xhtml" and "xhtml5"), and "" (defaults to html5)
 func WithBackEnd(backend string) Setting {
 	return func(config *Configuration) {
 		config.BackEnd = backend
@@ -111,3 +229,78 @@ func WithMacroTemplate(name string, t MacroTemplate) Setting {
 		config.macros[name] = t
 	}
 }
+
+// WithHTTPClient function to set the client used to read remote content (default is `http.DefaultClient`)
+func WithHTTPClient(client HTTPClient) Setting {
+	return func(config *Configuration) {
+		config.HTTPClient = client
+	}
+}
+
+// WithHTTPTimeout function to set the maximum duration of a request to read remote content (default is 10s)
+func WithHTTPTimeout(timeout time.Duration) Setting {
+	return func(config *Configuration) {
+		config.HTTPTimeout = timeout
+	}
+}
+
+// WithURICacheDir function to set the directory in which the remote content is cached
+func WithURICacheDir(dir string) Setting {
+	return func(config *Configuration) {
+		config.URICacheDir = dir
+	}
+}
+
+// WithURICacheTTL function to set the duration after which the cached remote content expires (default is 1h)
+func WithURICacheTTL(ttl time.Duration) Setting {
+	return func(config *Configuration) {
+		config.URICacheTTL = ttl
+	}
+}
+
+// WithMaxRemoteSize function to set the maximum size (in bytes) of the remote content (default is 10MiB)
+func WithMaxRemoteSize(size int64) Setting {
+	return func(config *Configuration) {
+		config.MaxRemoteSize = size
+	}
+}
+
+// WithSafeMode function to set the safe mode in which the document is processed (default is `Unsafe`)
+func WithSafeMode(mode SafeMode) Setting {
+	return func(config *Configuration) {
+		config.SafeMode = mode
+	}
+}
+
+// WithBaseDir function to set the directory to which the files to include are restricted
+// in `Safe` and `Server` modes (default is the directory of the document)
+func WithBaseDir(dir string) Setting {
+	return func(config *Configuration) {
+		config.BaseDir = dir
+	}
+}
+
+// WithSourcePositions function to record the position in the source files (file, line and column)
+// on each element of the document (default is `false`)
+func WithSourcePositions(value bool) Setting {
+	return func(config *Configuration) {
+		config.SourcePositions = value
+	}
+}
+
+// WithDiagnostics function to set the collector of the problems found while processing the document
+// (eg: unresolved file inclusions or cross references, missing attributes, etc.).
+// The collector may be shared by several conversions, in which case it contains the problems of all of them
+func WithDiagnostics(diagnostics *types.Diagnostics) Setting {
+	return func(config *Configuration) {
+		config.Diagnostics = diagnostics
+	}
+}
+
+// WithFailureLevel function to set the minimum severity of the diagnostics which make the conversion fail
+// (default is empty, ie, the conversion does not fail because of the diagnostics)
+func WithFailureLevel(level types.DiagnosticSeverity) Setting {
+	return func(config *Configuration) {
+		config.FailureLevel = level
+	}
+}
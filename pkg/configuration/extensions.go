@@ -0,0 +1,38 @@
+package configuration
+
+import (
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+)
+
+// Preprocessor an extension which processes the raw lines of the document (without their line terminators)
+// before they are parsed. The `include::` directives have not been processed yet, so the preprocessors
+// only see the lines of the document itself.
+type Preprocessor func(lines []string) ([]string, error)
+
+// TreeProcessor an extension which processes the document once it has been parsed, and before it is rendered.
+// The processor may change the given document in place.
+type TreeProcessor func(doc *types.Document) error
+
+// Postprocessor an extension which processes the rendered output of the document before it is written
+type Postprocessor func(doc types.Document, output []byte) ([]byte, error)
+
+// WithPreprocessor function to add a preprocessor, which is applied after the previously added ones
+func WithPreprocessor(p Preprocessor) Setting {
+	return func(config *Configuration) {
+		config.Preprocessors = append(config.Preprocessors, p)
+	}
+}
+
+// WithTreeProcessor function to add a tree processor, which is applied after the previously added ones
+func WithTreeProcessor(p TreeProcessor) Setting {
+	return func(config *Configuration) {
+		config.TreeProcessors = append(config.TreeProcessors, p)
+	}
+}
+
+// WithPostprocessor function to add a postprocessor, which is applied after the previously added ones
+func WithPostprocessor(p Postprocessor) Setting {
+	return func(config *Configuration) {
+		config.Postprocessors = append(config.Postprocessors, p)
+	}
+}
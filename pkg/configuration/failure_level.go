@@ -0,0 +1,23 @@
+package configuration
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+)
+
+// ParseFailureLevel returns the severity for the given failure level (`WARN` or `ERROR`, case-insensitive).
+// An empty value means that the conversion never fails because of the diagnostics.
+func ParseFailureLevel(value string) (types.DiagnosticSeverity, error) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "":
+		return "", nil
+	case "warn", "warning":
+		return types.DiagnosticWarning, nil
+	case "error":
+		return types.DiagnosticError, nil
+	default:
+		return "", fmt.Errorf("unknown failure level: '%s' (expected 'WARN' or 'ERROR')", value)
+	}
+}
@@ -0,0 +1,42 @@
+package configuration
+
+import (
+	"os"
+)
+
+// FS the file system from which the documents and the files to include are read, in the style of `io/fs.FS`
+// (eg: to read the documents from a database or from a zip archive).
+// The names of the files are slash-separated paths which are resolved relatively to the document being processed
+// (and relatively to the base directory in `Safe` and `Server` modes), but which are never made absolute.
+type FS interface {
+	// Open opens the file with the given name
+	Open(name string) (File, error)
+}
+
+// File a file opened in a FS
+type File interface {
+	Stat() (os.FileInfo, error)
+	Read([]byte) (int, error)
+	Close() error
+}
+
+// WithFS function to set the file system from which the documents and the files to include are read
+// (default is the local file system)
+func WithFS(fsys FS) Setting {
+	return func(config *Configuration) {
+		config.FS = fsys
+	}
+}
+
+// Open opens the file with the given name in the file system of this configuration, or in the local file system
+// if no file system was set
+func (c Configuration) Open(name string) (File, error) {
+	if c.FS != nil {
+		return c.FS.Open(name)
+	}
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err // avoid returning a non-nil `File` with a nil `*os.File`
+	}
+	return f, nil
+}
@@ -0,0 +1,39 @@
+package configuration
+
+import (
+	"io"
+
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+)
+
+// IncludeContext the context in which a file to include is read by an include processor
+type IncludeContext struct {
+	// Config the configuration, in which `Filename` is the document which contains the `include::` directive
+	Config Configuration
+	// Position the position of the `include::` directive in the source files (if recorded)
+	Position types.Position
+}
+
+// IncludeProcessor a function which reads the files to include with a given scheme, eg: `git` in `include::git:main:README.adoc[]`,
+// for which the target is `main:README.adoc`. The returned reader is closed once it was read, if it is an `io.Closer`.
+// The relative locations of the files to include in the content returned by an include processor are resolved
+// relatively to its target, and they are read by the same processor (eg: `include::git:main:docs/other.adoc[]` for
+// `include::other.adoc[]` in `git:main:docs/index.adoc`).
+type IncludeProcessor func(ctx IncludeContext, target string, attributes types.Attributes) (io.Reader, error)
+
+// WithIncludeProcessor defines the processor of the files to include with the given scheme.
+// The files to include with this scheme are read by the processor regardless of the safe mode.
+func WithIncludeProcessor(scheme string, p IncludeProcessor) Setting {
+	return func(config *Configuration) {
+		if config.includeProcessors == nil {
+			config.includeProcessors = map[string]IncludeProcessor{}
+		}
+		config.includeProcessors[scheme] = p
+	}
+}
+
+// IncludeProcessor returns the processor of the files to include with the given scheme, if defined
+func (c Configuration) IncludeProcessor(scheme string) (IncludeProcessor, bool) {
+	p, found := c.includeProcessors[scheme]
+	return p, found
+}
@@ -0,0 +1,61 @@
+package configuration
+
+import (
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+)
+
+// MacroContext the state of the rendering in which a user macro is processed
+type MacroContext struct {
+	// Config the configuration of the conversion
+	Config Configuration
+	// Attributes the attributes of the document
+	Attributes types.Attributes
+	// ElementReferences the elements which can be the target of a cross reference, indexed by ID
+	ElementReferences types.ElementReferences
+	// WithinDelimitedBlock true if the macro is rendered within a delimited block
+	WithinDelimitedBlock bool
+	// WithinList the depth of the list in which the macro is rendered (or 0 if it is not in a list)
+	WithinList int
+}
+
+// BlockMacroProcessor a function which processes the user block macros with a given name (eg: `gist::123[]`),
+// and returns the blocks which are rendered in place of the macro (eg: a paragraph, a delimited block, an image, etc.)
+type BlockMacroProcessor func(ctx MacroContext, macro types.UserMacro) ([]interface{}, error)
+
+// InlineMacroProcessor a function which processes the user inline macros with a given name (eg: `jira:PROJ-123[]`),
+// and returns the inline elements which are rendered in place of the macro (eg: a link, some quoted text, etc.)
+type InlineMacroProcessor func(ctx MacroContext, macro types.UserMacro) ([]interface{}, error)
+
+// WithBlockMacroProcessor defines the processor of the user block macros with the given name.
+// The processor takes precedence over a macro template with the same name.
+func WithBlockMacroProcessor(name string, p BlockMacroProcessor) Setting {
+	return func(config *Configuration) {
+		if config.blockMacroProcessors == nil {
+			config.blockMacroProcessors = map[string]BlockMacroProcessor{}
+		}
+		config.blockMacroProcessors[name] = p
+	}
+}
+
+// WithInlineMacroProcessor defines the processor of the user inline macros with the given name.
+// The processor takes precedence over a macro template with the same name.
+func WithInlineMacroProcessor(name string, p InlineMacroProcessor) Setting {
+	return func(config *Configuration) {
+		if config.inlineMacroProcessors == nil {
+			config.inlineMacroProcessors = map[string]InlineMacroProcessor{}
+		}
+		config.inlineMacroProcessors[name] = p
+	}
+}
+
+// BlockMacroProcessor returns the processor of the user block macros with the given name, if defined
+func (c Configuration) BlockMacroProcessor(name string) (BlockMacroProcessor, bool) {
+	p, found := c.blockMacroProcessors[name]
+	return p, found
+}
+
+// InlineMacroProcessor returns the processor of the user inline macros with the given name, if defined
+func (c Configuration) InlineMacroProcessor(name string) (InlineMacroProcessor, bool) {
+	p, found := c.inlineMacroProcessors[name]
+	return p, found
+}
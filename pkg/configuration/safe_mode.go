@@ -0,0 +1,105 @@
+package configuration
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// SafeMode the safe mode in which a document is processed, which restricts the access to the
+// file system and the attributes that the document can set, following the Asciidoctor semantics:
+// - `Unsafe`: no restriction
+// - `Safe`: the files to include must be located in the base directory (by default, the directory of the document)
+// - `Server`: same as `Safe`, and the document cannot set the `source-highlighter` attribute
+// - `Secure`: same as `Server`, and the file inclusions are replaced by a link to the file to include,
+// while the `docinfo`, `data-uri` and `icons` attributes cannot be set by the document
+type SafeMode int
+
+const (
+	// Unsafe the mode without any restriction (default)
+	Unsafe SafeMode = 0
+	// Safe the mode in which the file inclusions are restricted to the base directory
+	Safe SafeMode = 1
+	// Server the mode in which the document cannot set the attributes which affect the rendering
+	Server SafeMode = 10
+	// Secure the mode in which the file inclusions are disabled
+	Secure SafeMode = 20
+)
+
+var safeModeNames = map[SafeMode]string{
+	Unsafe: "unsafe",
+	Safe:   "safe",
+	Server: "server",
+	Secure: "secure",
+}
+
+func (m SafeMode) String() string {
+	if name, found := safeModeNames[m]; found {
+		return name
+	}
+	return strconv.Itoa(int(m))
+}
+
+// ParseSafeMode returns the safe mode for the given name (eg: `server`) or level (eg: `10`)
+func ParseSafeMode(value string) (SafeMode, error) {
+	value = strings.ToLower(strings.TrimSpace(value))
+	for m, name := range safeModeNames {
+		if value == name || value == strconv.Itoa(int(m)) {
+			return m, nil
+		}
+	}
+	return Unsafe, fmt.Errorf("unknown safe mode: '%s' (expected 'unsafe', 'safe', 'server' or 'secure')", value)
+}
+
+// attributes that the document cannot set (unless they were set via the API or the CLI), per safe mode
+var safeModeLockedAttributes = map[SafeMode][]string{
+	Server: {"source-highlighter"},
+	Secure: {"docinfo", "data-uri", "icons"},
+}
+
+// LockedAttributes returns the attributes that the document is not allowed to set in the current safe mode,
+// except the ones which were explicitly overridden via the API or the CLI
+func (c Configuration) LockedAttributes() []string {
+	locked := []string{}
+	for m, attrs := range safeModeLockedAttributes {
+		if c.SafeMode < m {
+			continue
+		}
+		for _, name := range attrs {
+			if !c.hasAttributeOverride(name) {
+				locked = append(locked, name)
+			}
+		}
+	}
+	return locked
+}
+
+// WithLockedAttributes returns a copy of the configuration in which the attributes that the document is not
+// allowed to set in the current safe mode are unset. The locks are based on the `SafeMode` at the time this
+// method is called, so it is called when the processing of a document starts (rather than in `NewConfiguration()`),
+// in case the safe mode was changed afterwards.
+func (c Configuration) WithLockedAttributes() Configuration {
+	locked := c.LockedAttributes()
+	if len(locked) == 0 {
+		return c
+	}
+	// copy the overrides, which may have been provided by the caller
+	overrides := make(map[string]string, len(c.AttributeOverrides)+len(locked))
+	for k, v := range c.AttributeOverrides {
+		overrides[k] = v
+	}
+	for _, name := range locked {
+		overrides["!"+name] = ""
+	}
+	c.AttributeOverrides = overrides
+	return c
+}
+
+func (c Configuration) hasAttributeOverride(name string) bool {
+	for _, k := range overrideKeys(name) {
+		if _, found := c.AttributeOverrides[k]; found {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,289 @@
+package diagram
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// AttrImagesOutDir the document attribute which defines the directory in which the images of the diagrams are written
+	// (defaults to the `imagesdir` in the base directory of the document)
+	AttrImagesOutDir = "imagesoutdir"
+	// AttrTarget the attribute which defines the name of the image of a diagram, without extension
+	// (can also be set with the second positional attribute, eg: `[graphviz, my-diagram]`)
+	AttrTarget = "target"
+	// AttrFormat the attribute which defines the format of the image of a diagram (`svg` by default)
+	// (can also be set with the third positional attribute, eg: `[graphviz, my-diagram, png]`)
+	AttrFormat = "format"
+	// CacheDir the name of the directory in the `imagesoutdir` which contains the hashes of the generated images
+	CacheDir = ".diagrams-cache"
+	// defaultFormat the format of the images when none is specified on the diagram block
+	defaultFormat = "svg"
+)
+
+// Tool the command-line tool which generates the images of a kind of diagram.
+// The tool reads the source of the diagram on its standard input and writes the image on its standard output.
+type Tool struct {
+	// Command the name of the executable (if it is in the PATH) or its path
+	Command string
+	// Args the arguments of the command for each supported image format (eg: `svg`, `png`)
+	Args map[string][]string
+}
+
+// DefaultTools the tools used to generate the diagrams, indexed by the style of their block (eg: `[graphviz]`)
+var DefaultTools = map[string]Tool{
+	"graphviz": {
+		Command: "dot",
+		Args: map[string][]string{
+			"svg": {"-Tsvg"},
+			"png": {"-Tpng"},
+		},
+	},
+	"plantuml": {
+		Command: "plantuml",
+		Args: map[string][]string{
+			"svg": {"-pipe", "-tsvg"},
+			"png": {"-pipe", "-tpng"},
+		},
+	},
+	"mermaid": {
+		Command: "mmdc",
+		Args: map[string][]string{
+			"svg": {"--input", "-", "--output", "-", "--outputFormat", "svg"},
+			"png": {"--input", "-", "--output", "-", "--outputFormat", "png"},
+		},
+	},
+	"ditaa": {
+		Command: "ditaa",
+		Args: map[string][]string{
+			"svg": {"-", "-", "--svg"},
+			"png": {"-", "-"},
+		},
+	},
+}
+
+// Setting a setting of the diagrams
+type Setting func(tools map[string]Tool)
+
+// WithTool sets the tool which generates the diagrams with the given style,
+// which overrides the default tool (eg: to use another executable) or adds a new kind of diagram
+func WithTool(style string, tool Tool) Setting {
+	return func(tools map[string]Tool) {
+		tools[style] = tool
+	}
+}
+
+// WithDiagrams registers the block processors which replace the diagram blocks (eg: `[graphviz]`)
+// with the images generated by their tools
+func WithDiagrams(settings ...Setting) configuration.Setting {
+	tools := make(map[string]Tool, len(DefaultTools))
+	for style, tool := range DefaultTools {
+		tools[style] = tool
+	}
+	for _, set := range settings {
+		set(tools)
+	}
+	return func(config *configuration.Configuration) {
+		for style, tool := range tools {
+			configuration.WithBlockProcessor(style, newProcessor(style, tool))(config)
+		}
+	}
+}
+
+// newProcessor returns the block processor which generates the diagrams with the given style using the given tool
+func newProcessor(style string, tool Tool) configuration.BlockProcessor {
+	return func(ctx configuration.BlockContext, lines []string, attributes types.Attributes) ([]interface{}, error) {
+		if ctx.Config.SafeMode >= configuration.Secure {
+			// no command is executed and no file is written in `Secure` mode
+			log.Debugf("'%s' diagram is rendered as a listing block in secure mode", style)
+			return listing(lines, attributes), nil
+		}
+		attrs := types.NewAttributesWithOverrides(ctx.Config.AttributeOverrides)
+		attrs.Add(ctx.Document.Attributes)
+		source := strings.Join(lines, "\n") + "\n"
+		format := attributeValue(attributes, AttrFormat, 3, defaultFormat)
+		args, supported := tool.Args[format]
+		if !supported {
+			return fallback(ctx, lines, attributes, "unsupported format of '%s' diagram: '%s'", style, format), nil
+		}
+		// the hash of everything which has an influence on the generated image
+		hash := sha256.New()
+		for _, s := range append([]string{style, format, tool.Command, source}, args...) {
+			hash.Write([]byte(s))
+			hash.Write([]byte{0})
+		}
+		checksum := hex.EncodeToString(hash.Sum(nil))
+		target := attributeValue(attributes, AttrTarget, 2, "diag-"+checksum[:16])
+		if !validTarget(target) {
+			return fallback(ctx, lines, attributes, "invalid target of '%s' diagram: '%s'", style, target), nil
+		}
+		filename := target + "." + format
+		outputDir, err := imagesOutDir(ctx.Config, attrs)
+		if err != nil {
+			return fallback(ctx, lines, attributes, "unable to generate the '%s' diagram: %s", style, err.Error()), nil
+		}
+		if err := generate(tool, args, source, checksum, outputDir, filename); err != nil {
+			return fallback(ctx, lines, attributes, "unable to generate the '%s' diagram: %s", style, err.Error()), nil
+		}
+		img := types.ImageBlock{
+			Location: types.Location{
+				Path: []interface{}{filename},
+			},
+			Attributes: imageAttributes(attributes),
+		}
+		return []interface{}{img.ResolveLocation(attrs)}, nil
+	}
+}
+
+// generate generates the image of the diagram in the given file of the output directory, unless the image
+// exists and was generated from the same source (as recorded in the cache directory)
+func generate(tool Tool, args []string, source, checksum, outputDir, filename string) error {
+	output := filepath.Join(outputDir, filename)
+	cache := filepath.Join(outputDir, CacheDir, filename+".sha256")
+	for _, f := range []string{output, cache} {
+		if !within(f, outputDir) {
+			return errors.Errorf("'%s' is outside of the output directory '%s'", f, outputDir)
+		}
+	}
+	if _, err := os.Stat(output); err == nil {
+		if c, err := ioutil.ReadFile(cache); err == nil && string(c) == checksum {
+			log.Debugf("diagram '%s' is up-to-date", output)
+			return nil
+		}
+	}
+	log.Debugf("generating diagram '%s' with '%s %s'", output, tool.Command, strings.Join(args, " "))
+	cmd := exec.Command(tool.Command, args...)
+	cmd.Stdin = strings.NewReader(source)
+	stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+	cmd.Stdout, cmd.Stderr = stdout, stderr
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return errors.Wrap(err, msg)
+		}
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(cache), 0755); err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(output, stdout.Bytes(), 0644); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(cache, []byte(checksum), 0644)
+}
+
+// imagesOutDir returns the directory in which the images are written: the `imagesoutdir` or the `imagesdir`,
+// relative to the base directory of the document (unless they are absolute).
+// In `Safe` mode and above, the `imagesoutdir` can only be set via the API or the CLI, and the `imagesdir`
+// of the document must be located in the base directory.
+func imagesOutDir(config configuration.Configuration, attrs types.AttributesWithOverrides) (string, error) {
+	baseDir := config.BaseDir
+	if baseDir == "" && config.Filename != "" {
+		baseDir = filepath.Dir(config.Filename)
+	}
+	if config.SafeMode >= configuration.Safe {
+		if dir, found := types.NewAttributesWithOverrides(config.AttributeOverrides).GetAsString(AttrImagesOutDir); found {
+			return resolveDir(baseDir, dir), nil
+		}
+		dir := resolveDir(baseDir, attrs.GetAsStringWithDefault("imagesdir", ""))
+		if !within(dir, baseDir) {
+			return "", errors.Errorf("'%s' is outside of the base directory '%s'", dir, baseDir)
+		}
+		return dir, nil
+	}
+	dir, found := attrs.GetAsString(AttrImagesOutDir)
+	if !found {
+		dir = attrs.GetAsStringWithDefault("imagesdir", "")
+	}
+	return resolveDir(baseDir, dir), nil
+}
+
+// resolveDir returns the given directory, relative to the base directory (unless it is absolute)
+func resolveDir(baseDir, dir string) string {
+	if filepath.IsAbs(dir) {
+		return dir
+	}
+	return filepath.Join(baseDir, dir)
+}
+
+// within returns true if the given path is the given directory or is located in this directory
+func within(path, dir string) bool {
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return false
+	}
+	absDir, err := filepath.Abs(dir)
+	if err != nil {
+		return false
+	}
+	rel, err := filepath.Rel(absDir, absPath)
+	return err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+// validTarget returns true if the given name of image is a plain file name, which does not refer
+// to another directory (eg: `../diagram` or `images/diagram`)
+func validTarget(target string) bool {
+	return !strings.ContainsAny(target, `/\`) && !strings.Contains(target, "..")
+}
+
+// attributeValue returns the value of the named attribute, or of the positional attribute at the given index,
+// or the given default value
+func attributeValue(attributes types.Attributes, key string, index int, defaultValue string) string {
+	if v, found := attributes.GetAsString(key); found && v != "" {
+		return v
+	}
+	if v, found := attributes.GetAsString(fmt.Sprintf("%s%d", types.AttrPositional, index)); found && v != "" {
+		return v
+	}
+	return defaultValue
+}
+
+// imageAttributes returns the attributes of the image which replaces the diagram block (eg: its ID, title or width)
+func imageAttributes(attributes types.Attributes) types.Attributes {
+	result := types.Attributes{}
+	for k, v := range attributes {
+		switch {
+		case k == types.AttrStyle, k == AttrTarget, k == AttrFormat, strings.HasPrefix(k, types.AttrPositional):
+			continue
+		default:
+			result[k] = v
+		}
+	}
+	return result
+}
+
+// fallback reports the given problem, and returns a listing block with the source of the diagram
+func fallback(ctx configuration.BlockContext, lines []string, attributes types.Attributes, format string, args ...interface{}) []interface{} {
+	ctx.Config.Diagnostics.Errorf(types.FailedDiagram, ctx.Position, format, args...)
+	log.Errorf(format, args...)
+	return listing(lines, attributes)
+}
+
+// listing returns a listing block with the source of the diagram
+func listing(lines []string, attributes types.Attributes) []interface{} {
+	elements := make([]interface{}, len(lines))
+	for i, l := range lines {
+		elements[i] = types.VerbatimLine{
+			Content: l,
+		}
+	}
+	return []interface{}{
+		types.DelimitedBlock{
+			Kind:       types.Listing,
+			Attributes: attributes,
+			Elements:   elements,
+		},
+	}
+}
@@ -0,0 +1,13 @@
+package diagram_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo" //nolint golint
+	. "github.com/onsi/gomega" //nolint golint
+)
+
+func TestDiagram(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Diagram Suite")
+}
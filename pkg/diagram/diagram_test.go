@@ -0,0 +1,285 @@
+package diagram_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+	"github.com/bytesparadise/libasciidoc/pkg/diagram"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo" //nolint golint
+	. "github.com/onsi/gomega" //nolint golint
+)
+
+var _ = Describe("diagrams", func() {
+
+	var dir string
+
+	// stub executable which wraps its input in an `<svg>` element, and records its arguments in the `runs.log` file
+	stub := `#!/bin/sh
+echo "$@" >> "$(dirname "$0")/runs.log"
+echo "<svg>"
+cat
+echo "</svg>"
+`
+	// stub executable which always fails
+	failingStub := `#!/bin/sh
+echo "syntax error in line 1" >&2
+exit 1
+`
+
+	newTool := func(script string) diagram.Tool {
+		command := filepath.Join(dir, "tool.sh")
+		err := ioutil.WriteFile(command, []byte(script), 0755)
+		Expect(err).NotTo(HaveOccurred())
+		return diagram.Tool{
+			Command: command,
+			Args: map[string][]string{
+				"svg": {"-Tsvg"},
+				"png": {"-Tpng"},
+			},
+		}
+	}
+
+	runs := func() []string {
+		content, err := ioutil.ReadFile(filepath.Join(dir, "runs.log"))
+		if os.IsNotExist(err) {
+			return []string{}
+		}
+		Expect(err).NotTo(HaveOccurred())
+		return strings.Split(strings.TrimSpace(string(content)), "\n")
+	}
+
+	render := func(source string, tool diagram.Tool, settings ...configuration.Setting) (string, error) {
+		settings = append([]configuration.Setting{
+			configuration.WithFilename(filepath.Join(dir, "test.adoc")),
+			diagram.WithDiagrams(diagram.WithTool("graphviz", tool)),
+		}, settings...)
+		return RenderHTML(source, settings...)
+	}
+
+	BeforeEach(func() {
+		if runtime.GOOS == "windows" {
+			Skip("stub executables are shell scripts")
+		}
+		var err error
+		dir, err = ioutil.TempDir("", "libasciidoc-diagrams")
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	It("should generate image in imagesdir", func() {
+		source := `:imagesdir: images
+
+[graphviz#diagram.wide, my-diagram, width=200]
+.A diagram
+----
+digraph { a -> b }
+----`
+		expected := `<div id="diagram" class="imageblock wide">
+<div class="content">
+<img src="images/my-diagram.svg" alt="my-diagram" width="200">
+</div>
+<div class="title">Figure 1. A diagram</div>
+</div>`
+		Expect(render(source, newTool(stub))).To(MatchHTML(expected))
+		Expect(runs()).To(Equal([]string{"-Tsvg"}))
+		content, err := ioutil.ReadFile(filepath.Join(dir, "images", "my-diagram.svg"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(content)).To(Equal("<svg>\ndigraph { a -> b }\n</svg>\n"))
+	})
+
+	It("should number diagram along with other figures", func() {
+		source := `.An image
+image::foo.png[]
+
+[graphviz, my-diagram]
+.A diagram
+----
+digraph { a -> b }
+----
+
+.Another image
+image::bar.png[]`
+		expected := `<div class="imageblock">
+<div class="content">
+<img src="foo.png" alt="foo">
+</div>
+<div class="title">Figure 1. An image</div>
+</div>
+<div class="imageblock">
+<div class="content">
+<img src="my-diagram.svg" alt="my-diagram">
+</div>
+<div class="title">Figure 2. A diagram</div>
+</div>
+<div class="imageblock">
+<div class="content">
+<img src="bar.png" alt="bar">
+</div>
+<div class="title">Figure 3. Another image</div>
+</div>`
+		Expect(render(source, newTool(stub))).To(MatchHTML(expected))
+	})
+
+	It("should generate image in imagesoutdir", func() {
+		source := `[graphviz, format=png]
+....
+digraph { a -> b }
+....`
+		result, err := render(source, newTool(stub), configuration.WithAttributes(map[string]string{
+			"imagesdir":    "assets",
+			"imagesoutdir": "out",
+		}))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(MatchRegexp(`<img src="assets/diag-[0-9a-f]{16}\.png" alt="diag-[0-9a-f]{16}">`))
+		Expect(runs()).To(Equal([]string{"-Tpng"}))
+		files, err := filepath.Glob(filepath.Join(dir, "out", "diag-*.png"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(files).To(HaveLen(1))
+	})
+
+	It("should use cached image", func() {
+		source := `[graphviz, my-diagram]
+----
+digraph { a -> b }
+----`
+		tool := newTool(stub)
+		_, err := render(source, tool)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = render(source, tool)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(runs()).To(HaveLen(1))
+		// regenerate the image when the source changed
+		_, err = render(strings.Replace(source, "b", "c", 1), tool)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(runs()).To(HaveLen(2))
+		// regenerate the image when it was removed
+		err = os.Remove(filepath.Join(dir, "my-diagram.svg"))
+		Expect(err).NotTo(HaveOccurred())
+		_, err = render(strings.Replace(source, "b", "c", 1), tool)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(runs()).To(HaveLen(3))
+	})
+
+	It("should fall back to listing block when tool fails", func() {
+		source := `[graphviz, my-diagram]
+----
+digraph { a -> b }
+----`
+		expected := `<div class="listingblock">
+<div class="content">
+<pre>digraph { a -&gt; b }</pre>
+</div>
+</div>`
+		diagnostics := types.NewDiagnostics()
+		Expect(render(source, newTool(failingStub), configuration.WithDiagnostics(diagnostics))).To(MatchHTML(expected))
+		Expect(diagnostics.All()).To(HaveLen(1))
+		Expect(diagnostics.All()[0].Severity).To(Equal(types.DiagnosticError))
+		Expect(diagnostics.All()[0].Code).To(Equal(types.FailedDiagram))
+		Expect(diagnostics.All()[0].Message).To(Equal("unable to generate the 'graphviz' diagram: syntax error in line 1: exit status 1"))
+	})
+
+	It("should fall back to listing block with unsupported format", func() {
+		source := `[graphviz, my-diagram, gif]
+----
+digraph { a -> b }
+----`
+		diagnostics := types.NewDiagnostics()
+		result, err := render(source, newTool(stub), configuration.WithDiagnostics(diagnostics))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(ContainSubstring(`<div class="listingblock">`))
+		Expect(runs()).To(BeEmpty())
+		Expect(diagnostics.All()).To(HaveLen(1))
+		Expect(diagnostics.All()[0].Message).To(Equal("unsupported format of 'graphviz' diagram: 'gif'"))
+	})
+
+	Context("safe modes", func() {
+
+		source := func(target string) string {
+			return `[graphviz, ` + target + `]
+----
+digraph { a -> b }
+----`
+		}
+
+		It("should reject target with path separator", func() {
+			diagnostics := types.NewDiagnostics()
+			result, err := render(source("../escaped"), newTool(stub), configuration.WithDiagnostics(diagnostics))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(ContainSubstring(`<div class="listingblock">`))
+			Expect(runs()).To(BeEmpty())
+			Expect(diagnostics.All()).To(HaveLen(1))
+			Expect(diagnostics.All()[0].Message).To(Equal("invalid target of 'graphviz' diagram: '../escaped'"))
+			_, err = os.Stat(filepath.Join(filepath.Dir(dir), "escaped.svg"))
+			Expect(os.IsNotExist(err)).To(BeTrue())
+		})
+
+		It("should reject target with parent directory", func() {
+			diagnostics := types.NewDiagnostics()
+			result, err := render(source("sub..dir"), newTool(stub), configuration.WithDiagnostics(diagnostics))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(ContainSubstring(`<div class="listingblock">`))
+			Expect(diagnostics.All()).To(HaveLen(1))
+			Expect(diagnostics.All()[0].Message).To(Equal("invalid target of 'graphviz' diagram: 'sub..dir'"))
+		})
+
+		It("should ignore imagesoutdir of document in safe mode", func() {
+			doc := ":imagesoutdir: " + filepath.Join(dir, "elsewhere") + "\n\n" + source("my-diagram")
+			_, err := render(doc, newTool(stub), configuration.WithSafeMode(configuration.Safe))
+			Expect(err).NotTo(HaveOccurred())
+			_, err = os.Stat(filepath.Join(dir, "my-diagram.svg"))
+			Expect(err).NotTo(HaveOccurred())
+			_, err = os.Stat(filepath.Join(dir, "elsewhere"))
+			Expect(os.IsNotExist(err)).To(BeTrue())
+		})
+
+		It("should use imagesoutdir of configuration in safe mode", func() {
+			_, err := render(source("my-diagram"), newTool(stub),
+				configuration.WithSafeMode(configuration.Safe),
+				configuration.WithAttributes(map[string]string{
+					"imagesoutdir": "out",
+				}))
+			Expect(err).NotTo(HaveOccurred())
+			_, err = os.Stat(filepath.Join(dir, "out", "my-diagram.svg"))
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should reject imagesdir outside of base directory in safe mode", func() {
+			diagnostics := types.NewDiagnostics()
+			doc := ":imagesdir: ../images\n\n" + source("my-diagram")
+			result, err := render(doc, newTool(stub),
+				configuration.WithSafeMode(configuration.Safe),
+				configuration.WithDiagnostics(diagnostics))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(ContainSubstring(`<div class="listingblock">`))
+			Expect(runs()).To(BeEmpty())
+			Expect(diagnostics.All()).To(HaveLen(1))
+			Expect(diagnostics.All()[0].Message).To(ContainSubstring("is outside of the base directory"))
+		})
+
+		It("should render listing block in secure mode", func() {
+			diagnostics := types.NewDiagnostics()
+			expected := `<div class="listingblock">
+<div class="content">
+<pre>digraph { a -&gt; b }</pre>
+</div>
+</div>`
+			Expect(render(source("my-diagram"), newTool(stub),
+				configuration.WithSafeMode(configuration.Secure),
+				configuration.WithDiagnostics(diagnostics))).To(MatchHTML(expected))
+			Expect(runs()).To(BeEmpty())
+			Expect(diagnostics.All()).To(BeEmpty())
+			_, err := os.Stat(filepath.Join(dir, "my-diagram.svg"))
+			Expect(os.IsNotExist(err)).To(BeTrue())
+		})
+	})
+})
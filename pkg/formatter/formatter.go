@@ -0,0 +1,338 @@
+// Package formatter writes the AsciiDoc documents back in a normalized form, in the same way as `gofmt` for the Go sources.
+//
+// The document is parsed without losing any content of its source (see `parser.ParseLosslessDocument()`),
+// so that the file inclusions, the attribute substitutions, the comments and the content of the delimited blocks
+// are retained. Each block is then written back from its lines in the source, after applying the `Rules`
+// of the formatting. The lines which do not belong to a known block are written as-is.
+package formatter
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+	"github.com/bytesparadise/libasciidoc/pkg/parser"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	"github.com/pkg/errors"
+)
+
+// Rules the rules of the formatting. The zero value only removes the trailing spaces at the end of the lines
+// (except in the verbatim blocks)
+type Rules struct {
+	// SentencePerLine writes each sentence of the paragraphs and list items on its own line
+	SentencePerLine bool
+	// ListMarkers writes the markers of the unordered list items with asterisks (`*`, `**`, etc.)
+	// and the markers of the ordered list items with dots (`.`, `..`, etc.), depending on their level in the list
+	ListMarkers bool
+	// AttributeLists removes the spaces around the commas and the equal signs in the attribute lists (eg: `[source, go]`)
+	AttributeLists bool
+	// MaxBlankLines the maximum number of consecutive blank lines between the blocks (or 0 for no limit).
+	// When set, the blank lines at the beginning and at the end of the document are also removed.
+	MaxBlankLines int
+	// AlignTables aligns the cells of the tables in columns (for the tables with one row per line)
+	AlignTables bool
+}
+
+// DefaultRules returns the default rules of the formatting
+func DefaultRules() Rules {
+	return Rules{
+		SentencePerLine: true,
+		ListMarkers:     true,
+		AttributeLists:  true,
+		MaxBlankLines:   1,
+		AlignTables:     true,
+	}
+}
+
+// Format reads the document in the given reader and returns it formatted with the given rules
+func Format(r io.Reader, config configuration.Configuration, rules Rules) ([]byte, error) {
+	lines, err := readLines(r)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to read '%s'", config.Filename)
+	}
+	config.SourcePositions = true
+	f := formatter{
+		config: config,
+		rules:  rules,
+	}
+	result, err := f.formatLines(lines)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to format '%s'", config.Filename)
+	}
+	buf := &bytes.Buffer{}
+	for _, l := range result {
+		buf.WriteString(l)
+		buf.WriteString("\n")
+	}
+	return buf.Bytes(), nil
+}
+
+func readLines(r io.Reader) ([]string, error) {
+	lines := []string{}
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, bufio.MaxScanTokenSize), 1024*1024)
+	for scanner.Scan() {
+		lines = append(lines, strings.TrimSuffix(scanner.Text(), "\r"))
+	}
+	return lines, scanner.Err()
+}
+
+type formatter struct {
+	config configuration.Configuration
+	rules  Rules
+}
+
+// formatLines formats the given lines, which are parsed as a (fragment of) document
+func (f formatter) formatLines(lines []string) ([]string, error) {
+	doc, err := parser.ParseLosslessDocument(strings.NewReader(strings.Join(lines, "\n")), f.config)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]string, 0, len(lines))
+	next := 0   // the index of the next line to write
+	blanks := 0 // the number of consecutive blank lines written so far
+	for _, b := range blocksOf(doc, doc.Elements) {
+		start, end, ok := b.linesOf(len(lines))
+		if !ok || start < next {
+			continue
+		}
+		// lines which are not part of a block are written as-is (except the extra blank lines)
+		for _, l := range lines[next:start] {
+			result, blanks = f.appendLine(result, l, blanks)
+		}
+		next = end + 1
+		if _, ok := b.element.(types.BlankLine); ok {
+			result, blanks = f.appendLine(result, "", blanks)
+			continue
+		}
+		formatted, err := f.formatBlock(b, lines[start:end+1])
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, formatted...)
+		blanks = 0
+	}
+	result = append(result, lines[next:]...)
+	if f.rules.MaxBlankLines > 0 {
+		for len(result) > 0 && strings.TrimSpace(result[len(result)-1]) == "" {
+			result = result[:len(result)-1]
+		}
+	}
+	return result, nil
+}
+
+// appendLine appends the given line to the result, unless it is an extra blank line
+// (given the number of consecutive blank lines written so far)
+func (f formatter) appendLine(result []string, line string, blanks int) ([]string, int) {
+	if strings.TrimSpace(line) != "" {
+		return append(result, line), 0
+	}
+	if f.rules.MaxBlankLines > 0 && (blanks >= f.rules.MaxBlankLines || len(result) == 0) {
+		return result, blanks
+	}
+	return append(result, ""), blanks + 1
+}
+
+// block a block of the document to format, along with the lines of its source which are not retained
+// in the element (see `types.LosslessDocument.Trivia()`)
+type block struct {
+	element interface{}
+	trivia  types.BlockTrivia
+	end     int // the number of the last line of the block, if the element spans over some other blocks (eg: a section)
+}
+
+// linesOf returns the indexes of the first and the last lines of the block
+func (b block) linesOf(count int) (int, int, bool) {
+	position, ok := types.PositionOf(b.element)
+	if !ok {
+		return 0, 0, false
+	}
+	start, end := position.Start.Line-1, position.End.Line-1
+	if b.end > 0 {
+		end = b.end - 1
+	}
+	if start < 0 || end < start || end >= count {
+		return 0, 0, false
+	}
+	return start, end, true
+}
+
+// blocksOf returns the blocks to format in the given elements, in the order of the source. The sections and the
+// list items only span over their own lines (ie, the title of the section and the first paragraph of the item),
+// and are followed by the blocks of their elements.
+func blocksOf(doc types.LosslessDocument, elements []interface{}) []block {
+	result := make([]block, 0, len(elements))
+	for _, element := range elements {
+		switch e := element.(type) {
+		case types.Preamble:
+			result = append(result, blocksOf(doc, e.Elements)...)
+		case types.Section:
+			trivia := doc.Trivia(e)
+			result = append(result, block{
+				element: e,
+				trivia:  trivia,
+				end:     e.Position.Start.Line + len(trivia.Attributes),
+			})
+			result = append(result, blocksOf(doc, e.Elements)...)
+		case types.UnorderedList:
+			for i, item := range e.Items {
+				result = append(result, listItemBlocks(doc, e, item, i == 0, item.Elements)...)
+			}
+		case types.OrderedList:
+			for i, item := range e.Items {
+				result = append(result, listItemBlocks(doc, e, item, i == 0, item.Elements)...)
+			}
+		case types.LabeledList:
+			for i, item := range e.Items {
+				result = append(result, listItemBlocks(doc, e, item, i == 0, item.Elements)...)
+			}
+		default:
+			result = append(result, block{
+				element: e,
+				trivia:  doc.Trivia(e),
+			})
+		}
+	}
+	return result
+}
+
+// listItemBlocks returns the block of the given list item, which spans over its attributes (those of the list
+// for its first item) and its first paragraph, followed by the blocks of its other elements
+func listItemBlocks(doc types.LosslessDocument, list, item interface{}, first bool, elements []interface{}) []block {
+	trivia := doc.Trivia(item)
+	if first {
+		trivia = doc.Trivia(list)
+	}
+	position, _ := types.PositionOf(item)
+	end := position.Start.Line + len(trivia.Attributes)
+	if len(elements) > 0 {
+		if p, ok := types.PositionOf(elements[0]); ok && p.Start.Line == end {
+			if _, ok := elements[0].(types.Paragraph); ok {
+				end = p.End.Line
+				elements = elements[1:]
+			}
+		}
+	}
+	return append([]block{
+		{
+			element: item,
+			trivia:  trivia,
+			end:     end,
+		},
+	}, blocksOf(doc, elements)...)
+}
+
+// formatBlock formats the lines of the given block
+func (f formatter) formatBlock(b block, lines []string) ([]string, error) {
+	switch e := b.element.(type) {
+	case types.Section:
+		header, body := f.splitHeader(b.trivia, lines)
+		return append(header, f.formatSectionTitle(body)...), nil
+	case types.AttributeDeclaration, types.AttributeReset:
+		if len(lines) == 1 {
+			return []string{formatAttributeDeclaration(lines[0])}, nil
+		}
+		return trimRight(lines), nil
+	case types.Paragraph:
+		header, body := f.splitHeader(b.trivia, lines)
+		if _, found := e.Attributes[types.AttrKind]; found || !f.rules.SentencePerLine {
+			// eg: verse or literal paragraph
+			return append(header, trimRight(body)...), nil
+		}
+		return append(header, reflow(body)...), nil
+	case types.UnorderedListItem:
+		header, body := f.splitHeader(b.trivia, lines)
+		return append(header, f.formatListItem(body, e.Level)...), nil
+	case types.OrderedListItem:
+		header, body := f.splitHeader(b.trivia, lines)
+		return append(header, f.formatListItem(body, e.Level)...), nil
+	case types.DelimitedBlock:
+		header, body := f.splitHeader(b.trivia, lines)
+		switch e.Kind {
+		case types.Example, types.Quote, types.Sidebar, types.Open:
+			if len(body) < 2 {
+				break
+			}
+			// format the blocks within the delimiters
+			content, err := f.formatLines(body[1 : len(body)-1])
+			if err != nil {
+				return nil, err
+			}
+			header = append(header, strings.TrimRight(body[0], " \t"))
+			header = append(header, content...)
+			return append(header, strings.TrimRight(body[len(body)-1], " \t")), nil
+		}
+		// verbatim content
+		return append(header, body...), nil
+	case types.Table:
+		header, body := f.splitHeader(b.trivia, lines)
+		if f.rules.AlignTables && !customSeparator(header) {
+			if aligned, ok := alignTable(body); ok {
+				return append(header, aligned...), nil
+			}
+		}
+		return append(header, trimRight(body)...), nil
+	case types.ImageBlock, types.LabeledListItem, types.SingleLineComment, types.FileInclusion:
+		header, body := f.splitHeader(b.trivia, lines)
+		return append(header, trimRight(body)...), nil
+	default:
+		// eg: literal blocks
+		return lines, nil
+	}
+}
+
+// splitHeader splits the given lines between the attributes and the title of the block (as returned by
+// `types.LosslessDocument.Trivia()`), and its body (the attribute lists are normalized if the rules require it)
+func (f formatter) splitHeader(trivia types.BlockTrivia, lines []string) ([]string, []string) {
+	i := len(trivia.Attributes)
+	if i > len(lines)-1 {
+		return nil, lines
+	}
+	header := make([]string, i, len(lines))
+	for j, l := range lines[:i] {
+		l = strings.TrimRight(l, " \t")
+		if f.rules.AttributeLists && strings.HasPrefix(l, "[") {
+			l = formatAttributeList(l)
+		}
+		header[j] = l
+	}
+	return header, lines[i:]
+}
+
+var sectionTitleRegexp = regexp.MustCompile(`^(=+|#+)\s+(.*?)\s*$`)
+
+// formatSectionTitle removes the extra spaces in the title of the section
+// (the other lines of the document header, if any, are retained as-is)
+func (f formatter) formatSectionTitle(lines []string) []string {
+	result := trimRight(lines)
+	if m := sectionTitleRegexp.FindStringSubmatch(result[0]); m != nil {
+		result[0] = m[1] + " " + m[2]
+	}
+	return result
+}
+
+var attributeDeclarationRegexp = regexp.MustCompile(`^(:[^:\s]+:)\s*(.*?)\s*$`)
+
+// formatAttributeDeclaration removes the extra spaces in the given attribute declaration (eg: `:name:   value`)
+func formatAttributeDeclaration(line string) string {
+	if m := attributeDeclarationRegexp.FindStringSubmatch(line); m != nil {
+		if m[2] == "" {
+			return m[1]
+		}
+		return m[1] + " " + m[2]
+	}
+	return strings.TrimRight(line, " \t")
+}
+
+// trimRight removes the trailing spaces of the given lines
+func trimRight(lines []string) []string {
+	result := make([]string, len(lines))
+	for i, l := range lines {
+		result[i] = strings.TrimRight(l, " \t")
+	}
+	return result
+}
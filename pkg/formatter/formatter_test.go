@@ -0,0 +1,275 @@
+package formatter_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+	"github.com/bytesparadise/libasciidoc/pkg/formatter"
+
+	. "github.com/onsi/ginkgo"                  //nolint golint
+	. "github.com/onsi/ginkgo/extensions/table" //nolint golint
+	. "github.com/onsi/gomega"                  //nolint golint
+)
+
+var _ = Describe("document formatting", func() {
+
+	format := func(source string, rules formatter.Rules) string {
+		result, err := formatter.Format(strings.NewReader(source), configuration.NewConfiguration(), rules)
+		Expect(err).NotTo(HaveOccurred())
+		return string(result)
+	}
+
+	Context("sentences", func() {
+
+		rules := formatter.Rules{
+			SentencePerLine: true,
+		}
+
+		DescribeTable("should write one sentence per line",
+			func(source, expected string) {
+				Expect(format(source, rules)).To(Equal(expected))
+			},
+			Entry("paragraph",
+				"First sentence. Second sentence! Third\nsentence? Fourth.\n",
+				"First sentence.\nSecond sentence!\nThird sentence?\nFourth.\n"),
+			Entry("quoted text",
+				"A *bold sentence.* _Another one._ Last.\n",
+				"A *bold sentence.*\n_Another one._\nLast.\n"),
+			Entry("hard line breaks",
+				"First line +\nsecond line. Third\nline.\n",
+				"First line +\nsecond line.\nThird line.\n"),
+			Entry("list items",
+				"* An item. Another sentence.\n. An ordered item. Another\nsentence.\n",
+				"* An item.\nAnother sentence.\n. An ordered item.\nAnother sentence.\n"),
+			Entry("admonition paragraph",
+				"NOTE: A note. Another sentence.\n",
+				"NOTE: A note.\nAnother sentence.\n"),
+		)
+
+		DescribeTable("should not split sentences",
+			func(source string) {
+				Expect(format(source, rules)).To(Equal(source))
+			},
+			Entry("lowercase after period", "A version 1.0. and more.\n"),
+			Entry("abbreviation", "Some tools, e.g. Foo and Bar.\n"),
+			Entry("initial", "Written by J. Doe.\n"),
+			Entry("macro", "See link:https://example.com[the site. Really] now.\n"),
+			Entry("monospace", "Call `foo. Bar` now.\n"),
+			Entry("admonition after split", "The end. NOTE: this is not a note.\n"),
+			Entry("labeled list after split", "The end. Term:: not a definition.\n"),
+			Entry("verse paragraph", "[verse]\nFirst line. Second line.\n"),
+			Entry("literal paragraph", " First line. Second line.\n"),
+			Entry("listing block", "----\nFirst line. Second line.\n----\n"),
+		)
+
+		It("should format the paragraphs in the compound blocks", func() {
+			source := "====\nFirst. Second.\n====\n\n____\nFirst. Second.\n____\n"
+			Expect(format(source, rules)).To(Equal("====\nFirst.\nSecond.\n====\n\n____\nFirst.\nSecond.\n____\n"))
+		})
+
+		It("should format the paragraphs attached to a list item", func() {
+			source := "* item\n+\nFirst. Second.\n"
+			Expect(format(source, rules)).To(Equal("* item\n+\nFirst.\nSecond.\n"))
+		})
+	})
+
+	Context("list markers", func() {
+
+		rules := formatter.Rules{
+			ListMarkers: true,
+		}
+
+		It("should normalize the markers of the unordered lists", func() {
+			source := "- item 1\n** item 1.1\n*** item 1.1.1\n- item 2\n"
+			Expect(format(source, rules)).To(Equal("* item 1\n** item 1.1\n*** item 1.1.1\n* item 2\n"))
+		})
+
+		It("should normalize the markers of the nested unordered lists", func() {
+			source := "** item 1\n* item 1.1\n- item 1.1.1\n"
+			Expect(format(source, rules)).To(Equal("* item 1\n** item 1.1\n*** item 1.1.1\n"))
+		})
+
+		It("should normalize the markers of the ordered lists", func() {
+			source := ".. item 1\n... item 1.1\n.. item 2\n"
+			Expect(format(source, rules)).To(Equal(". item 1\n.. item 1.1\n. item 2\n"))
+		})
+
+		It("should retain the explicit numbers", func() {
+			source := "1. item 1\n2. item 2\n"
+			Expect(format(source, rules)).To(Equal(source))
+		})
+
+		It("should reset the markers after another block", func() {
+			source := "- item 1\n\nparagraph\n\n** item 1\n"
+			Expect(format(source, rules)).To(Equal("* item 1\n\nparagraph\n\n* item 1\n"))
+		})
+
+		It("should reset the markers of a list with a title", func() {
+			source := "- item 1\n\n.title\n** item 1\n"
+			Expect(format(source, rules)).To(Equal("* item 1\n\n.title\n* item 1\n"))
+		})
+
+		It("should retain the check styles", func() {
+			source := "- [x] done\n- [ ] todo\n"
+			Expect(format(source, rules)).To(Equal("* [x] done\n* [ ] todo\n"))
+		})
+	})
+
+	Context("attribute lists", func() {
+
+		rules := formatter.Rules{
+			AttributeLists: true,
+		}
+
+		DescribeTable("should normalize the attribute lists",
+			func(source, expected string) {
+				Expect(format(source, rules)).To(Equal(expected))
+			},
+			Entry("positional attributes",
+				"[source, go ,linenums]\n----\nfunc main() {}\n----\n",
+				"[source,go,linenums]\n----\nfunc main() {}\n----\n"),
+			Entry("named attributes",
+				"[cols = \"1, 2\" , options= header]\n|===\n|a |b\n|===\n",
+				"[cols=\"1, 2\",options=header]\n|===\n|a |b\n|===\n"),
+			Entry("quoted attributes",
+				"[quote, \"Doe, John\"]\n____\nquoted\n____\n",
+				"[quote,\"Doe, John\"]\n____\nquoted\n____\n"),
+			Entry("section attributes",
+				"[#id.role]\n==  Section  \n",
+				"[#id.role]\n== Section\n"),
+			Entry("anchor",
+				"[[id]]\nparagraph\n",
+				"[[id]]\nparagraph\n"),
+		)
+	})
+
+	Context("blank lines", func() {
+
+		It("should collapse the blank lines", func() {
+			source := "\n\nfirst\n\n\n\nsecond\n\n\n"
+			Expect(format(source, formatter.Rules{
+				MaxBlankLines: 1,
+			})).To(Equal("first\n\nsecond\n"))
+			Expect(format(source, formatter.Rules{
+				MaxBlankLines: 2,
+			})).To(Equal("first\n\n\nsecond\n"))
+		})
+
+		It("should retain the blank lines", func() {
+			source := "first\n\n\nsecond\n"
+			Expect(format(source, formatter.Rules{})).To(Equal(source))
+		})
+
+		It("should retain the blank lines in the verbatim blocks", func() {
+			source := "----\nfirst\n\n\n\nsecond\n----\n"
+			Expect(format(source, formatter.Rules{
+				MaxBlankLines: 1,
+			})).To(Equal(source))
+		})
+	})
+
+	Context("tables", func() {
+
+		rules := formatter.Rules{
+			AlignTables: true,
+		}
+
+		It("should align the cells", func() {
+			source := "|===\n|a |long header\n\n|cell|b\n| ccc | d\n|===\n"
+			Expect(format(source, rules)).To(Equal("|===\n| a    | long header\n\n| cell | b\n| ccc  | d\n|===\n"))
+		})
+
+		It("should retain the escaped separators", func() {
+			source := "|===\n|a \\| b |c\n|d |e\n|===\n"
+			Expect(format(source, rules)).To(Equal("|===\n| a \\| b | c\n| d      | e\n|===\n"))
+		})
+
+		It("should not align the cells on multiple lines", func() {
+			source := "|===\n|a |b\n|c\n|d\n|===\n"
+			Expect(format(source, rules)).To(Equal(source))
+		})
+
+		It("should not align the cells with a specifier", func() {
+			source := "|===\n2+|a\n|c |d\n|===\n"
+			Expect(format(source, rules)).To(Equal(source))
+		})
+	})
+
+	It("should retain the elements which are not formatted", func() {
+		source := `= Title
+:toc:
+:name:   value
+
+// a comment
+include::chapter.adoc[]
+
+image::foo.png[]
+
+term:: definition {name}
+
+----
+include::code.go[]
+----
+`
+		Expect(format(source, formatter.DefaultRules())).To(Equal(`= Title
+:toc:
+:name: value
+
+// a comment
+include::chapter.adoc[]
+
+image::foo.png[]
+
+term:: definition {name}
+
+----
+include::code.go[]
+----
+`))
+	})
+
+	Context("verification", func() {
+
+		It("should verify unchanged rendering", func() {
+			original := []byte("- item. Another sentence.\n\n\nparagraph\n")
+			formatted, err := formatter.Format(bytes.NewReader(original), configuration.NewConfiguration(), formatter.DefaultRules())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(formatter.Verify(original, formatted, configuration.NewConfiguration())).To(Succeed())
+		})
+
+		It("should detect changed rendering", func() {
+			err := formatter.Verify([]byte("first\n\nsecond\n"), []byte("first\nsecond\n"), configuration.NewConfiguration(configuration.WithFilename("test.adoc")))
+			Expect(err).To(MatchError("the formatting of 'test.adoc' would change the rendering of the document"))
+		})
+	})
+
+	Context("fixtures", func() {
+
+		// all the documents of the test fixtures
+		filenames, err := filepath.Glob("../../test/*/*.adoc")
+		if err != nil {
+			panic(err)
+		}
+		fixtures, err := filepath.Glob("../../test/fixtures/*/*.adoc")
+		if err != nil {
+			panic(err)
+		}
+		filenames = append(filenames, fixtures...)
+		for _, filename := range filenames {
+			filename := filename
+			It("should format "+filepath.Base(filename)+" without changing its rendering", func() {
+				original, err := ioutil.ReadFile(filename)
+				Expect(err).NotTo(HaveOccurred())
+				config := configuration.NewConfiguration(configuration.WithFilename(filename))
+				formatted, err := formatter.Format(bytes.NewReader(original), config, formatter.DefaultRules())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(formatter.Verify(original, formatted, config)).To(Succeed())
+				// formatting is idempotent
+				Expect(formatter.Format(bytes.NewReader(formatted), config, formatter.DefaultRules())).To(Equal(formatted))
+			})
+		}
+	})
+})
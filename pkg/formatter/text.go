@@ -0,0 +1,277 @@
+package formatter
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ------------------------------------------
+// Sentences
+// ------------------------------------------
+
+// abbreviations the words which are usually followed by a period in the middle of a sentence
+var abbreviations = map[string]bool{
+	"e.g": true,
+	"i.e": true,
+	"etc": true,
+	"vs":  true,
+	"cf":  true,
+	"fig": true,
+	"no":  true,
+	"mr":  true,
+	"mrs": true,
+	"ms":  true,
+	"dr":  true,
+	"st":  true,
+}
+
+// lineStartRegexp the beginning of a line which would not be parsed as the continuation of a paragraph
+// (eg: an admonition, a list item or a labeled list item)
+var lineStartRegexp = regexp.MustCompile(`^(?:[A-Z]+:\s|[A-Za-z]\.\s|[IVXLCDMivxlcdm]+\)\s)|(?:::|;;)(?:\s|$)`)
+
+// delimiterRegexp the delimiters of the blocks
+var delimiterRegexp = regexp.MustCompile("^(?:-{4,}|\\.{4,}|={4,}|_{4,}|\\*{4,}|\\+{4,}|/{4,}|-{2}|```.*)$")
+
+// reflow writes each sentence of the given lines on its own line. The lines which end with a hard line break
+// (` +`) are not joined with the next line.
+func reflow(lines []string) []string {
+	for _, l := range lines {
+		if l = strings.TrimSpace(l); l == "" || strings.HasPrefix(l, "//") || delimiterRegexp.MatchString(l) {
+			// do not move the text around the comments, blank lines or delimiters
+			return trimRight(lines)
+		}
+	}
+	result := []string{}
+	text := []string{}
+	for _, l := range lines {
+		text = append(text, strings.TrimSpace(l))
+		if l = strings.TrimRight(l, " \t"); l == "+" || strings.HasSuffix(l, " +") {
+			result = append(result, sentences(strings.Join(text, " "))...)
+			text = text[:0]
+		}
+	}
+	if len(text) > 0 {
+		result = append(result, sentences(strings.Join(text, " "))...)
+	}
+	return result
+}
+
+// sentences splits the given text after each period, exclamation mark or question mark which is followed
+// by a space and an uppercase letter, unless it is within a macro or a monospace text.
+func sentences(text string) []string {
+	result := []string{}
+	depth := 0         // the depth of the brackets (eg: `link:url[text. More text]`)
+	monospace := false // within backticks
+	start := 0
+	for i, r := range text {
+		switch r {
+		case '[':
+			depth++
+		case ']':
+			if depth > 0 {
+				depth--
+			}
+		case '`':
+			monospace = !monospace
+		case '.', '!', '?':
+			if depth > 0 || monospace {
+				continue
+			}
+			end := i + 1
+			// include the closing characters (eg: the end of a quoted text: `*bold.*`)
+			for end < len(text) && strings.IndexByte(`)"'*_#`, text[end]) >= 0 {
+				end++
+			}
+			if end >= len(text) || text[end] != ' ' {
+				continue
+			}
+			rest := strings.TrimLeft(text[end:], " ")
+			// skip the beginning of a quoted text (eg: `_Next sentence._`)
+			first, _ := utf8.DecodeRuneInString(strings.TrimLeft(rest, "*_#`\"'("))
+			if !unicode.IsUpper(first) || lineStartRegexp.MatchString(rest) || isAbbreviation(text[start:i], r) {
+				continue
+			}
+			result = append(result, text[start:end])
+			start = len(text) - len(rest)
+		}
+	}
+	return append(result, text[start:])
+}
+
+// isAbbreviation returns true if the given text ends with an abbreviation or an initial (eg: `J.`)
+func isAbbreviation(text string, punctuation rune) bool {
+	if punctuation != '.' {
+		return false
+	}
+	word := text[strings.LastIndexAny(text, " ([")+1:]
+	if r, size := utf8.DecodeRuneInString(word); size == len(word) && unicode.IsLetter(r) {
+		return true
+	}
+	return abbreviations[strings.ToLower(word)]
+}
+
+// ------------------------------------------
+// Lists
+// ------------------------------------------
+
+var (
+	unorderedListItemRegexp = regexp.MustCompile(`^\s*(\*+|-)\s+(.*)$`)
+	orderedListItemRegexp   = regexp.MustCompile(`^\s*(\.+|\d+\.|[a-zA-Z]\.|[ivxlcdmIVXLCDM]+\))\s+(.*)$`)
+)
+
+// formatListItem formats the given lines of a list item at the given level, whose marker is normalized
+// if the rules require it
+func (f formatter) formatListItem(lines []string, level int) []string {
+	var marker, text string
+	if m := unorderedListItemRegexp.FindStringSubmatch(lines[0]); m != nil {
+		marker, text = m[1], m[2]
+		if f.rules.ListMarkers {
+			marker = strings.Repeat("*", level)
+		}
+	} else if m := orderedListItemRegexp.FindStringSubmatch(lines[0]); m != nil {
+		marker, text = m[1], m[2]
+		// explicit numbers are retained
+		if f.rules.ListMarkers && strings.Trim(marker, ".") == "" {
+			marker = strings.Repeat(".", level)
+		}
+	} else {
+		return trimRight(lines)
+	}
+	body := append([]string{text}, lines[1:]...)
+	if f.rules.SentencePerLine {
+		body = reflow(body)
+	} else {
+		body = trimRight(body)
+	}
+	body[0] = marker + " " + body[0]
+	return body
+}
+
+// ------------------------------------------
+// Attribute lists
+// ------------------------------------------
+
+// formatAttributeList removes the spaces around the commas and the equal signs of the given attribute list
+// (eg: `[source, go]`). The anchors (eg: `[[id]]`) and the lists with unbalanced quotes are returned as-is.
+func formatAttributeList(line string) string {
+	inner := strings.TrimSpace(line)
+	if strings.HasPrefix(inner, "[[") {
+		return inner
+	}
+	inner = inner[1 : len(inner)-1]
+	attributes := []string{}
+	var quote rune
+	start := 0
+	for i, r := range inner {
+		switch {
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			}
+		case r == '"' || r == '\'':
+			if strings.TrimSpace(inner[start:i]) == "" || strings.HasSuffix(strings.TrimSpace(inner[start:i]), "=") {
+				quote = r
+			}
+		case r == ',':
+			attributes = append(attributes, formatAttribute(inner[start:i]))
+			start = i + 1
+		}
+	}
+	if quote != 0 {
+		return line
+	}
+	attributes = append(attributes, formatAttribute(inner[start:]))
+	return "[" + strings.Join(attributes, ",") + "]"
+}
+
+// formatAttribute removes the spaces around the given attribute and around its equal sign (if it is a named attribute)
+func formatAttribute(attribute string) string {
+	attribute = strings.TrimSpace(attribute)
+	if i := strings.Index(attribute, "="); i > 0 && !strings.ContainsAny(attribute[:i], `"'`) {
+		return strings.TrimSpace(attribute[:i]) + "=" + strings.TrimSpace(attribute[i+1:])
+	}
+	return attribute
+}
+
+// ------------------------------------------
+// Tables
+// ------------------------------------------
+
+// customSeparator returns true if the given attribute lines of a table define another format or separator of the cells
+func customSeparator(header []string) bool {
+	for _, l := range header {
+		if strings.Contains(l, "separator") || strings.Contains(l, "format") {
+			return true
+		}
+	}
+	return false
+}
+
+// alignTable aligns the cells of the given table in columns, as long as each line (except the blank lines)
+// contains a row with the same number of cells, without any cell specifier (eg: `2+|`).
+// Returns `false` if the table cannot be aligned.
+func alignTable(lines []string) ([]string, bool) {
+	if len(lines) < 2 || strings.TrimSpace(lines[0]) != "|===" || strings.TrimSpace(lines[len(lines)-1]) != "|===" {
+		return nil, false
+	}
+	rows := make([][]string, len(lines)-2)
+	widths := []int{}
+	for i, l := range lines[1 : len(lines)-1] {
+		l = strings.TrimSpace(l)
+		if l == "" {
+			continue // blank line (eg: after the header row)
+		}
+		if !strings.HasPrefix(l, "|") {
+			return nil, false
+		}
+		cells := splitCells(l[1:])
+		if len(widths) == 0 {
+			widths = make([]int, len(cells))
+		} else if len(cells) != len(widths) {
+			return nil, false
+		}
+		for j, c := range cells {
+			if w := utf8.RuneCountInString(c); w > widths[j] {
+				widths[j] = w
+			}
+		}
+		rows[i] = cells
+	}
+	result := make([]string, 0, len(lines))
+	result = append(result, "|===")
+	for _, cells := range rows {
+		if cells == nil {
+			result = append(result, "")
+			continue
+		}
+		buf := &strings.Builder{}
+		for j, c := range cells {
+			if j > 0 {
+				buf.WriteString(" ")
+			}
+			buf.WriteString("| ")
+			buf.WriteString(c)
+			buf.WriteString(strings.Repeat(" ", widths[j]-utf8.RuneCountInString(c)))
+		}
+		result = append(result, strings.TrimRight(buf.String(), " "))
+	}
+	return append(result, "|==="), true
+}
+
+// splitCells splits the given row on the unescaped `|` characters, and trims the content of the cells
+func splitCells(row string) []string {
+	cells := []string{}
+	start := 0
+	for i := 0; i < len(row); i++ {
+		switch row[i] {
+		case '\\':
+			i++ // skip the escaped character
+		case '|':
+			cells = append(cells, strings.TrimSpace(row[start:i]))
+			start = i + 1
+		}
+	}
+	return append(cells, strings.TrimSpace(row[start:]))
+}
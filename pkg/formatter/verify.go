@@ -0,0 +1,48 @@
+package formatter
+
+import (
+	"bytes"
+	"regexp"
+
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+	"github.com/bytesparadise/libasciidoc/pkg/parser"
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/renderer/sgml/html5"
+
+	"github.com/pkg/errors"
+)
+
+// Verify checks that the formatted document is rendered in the same way as the original document (in HTML5,
+// regardless of the whitespaces), i.e., that the formatting did not change the meaning of the document.
+// Returns an error if the rendering is different.
+func Verify(original, formatted []byte, config configuration.Configuration) error {
+	expected, err := render(original, config)
+	if err != nil {
+		return err
+	}
+	actual, err := render(formatted, config)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(expected, actual) {
+		return errors.Errorf("the formatting of '%s' would change the rendering of the document", config.Filename)
+	}
+	return nil
+}
+
+var whitespacesRegexp = regexp.MustCompile(`\s+`)
+
+// render renders the given source in HTML5, and replaces the sequences of whitespaces with a single space
+func render(source []byte, config configuration.Configuration) ([]byte, error) {
+	config.Diagnostics = nil // the problems were already reported when the original document was processed
+	config.SourcePositions = false
+	doc, err := parser.ParseDocument(bytes.NewReader(source), config)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to verify the formatting of '%s'", config.Filename)
+	}
+	out := &bytes.Buffer{}
+	if _, err := html5.Render(renderer.NewContext(doc, config), doc, out); err != nil {
+		return nil, errors.Wrapf(err, "unable to verify the formatting of '%s'", config.Filename)
+	}
+	return whitespacesRegexp.ReplaceAll(out.Bytes(), []byte(" ")), nil
+}
@@ -0,0 +1,188 @@
+package lsp
+
+import (
+	"path"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+	"github.com/bytesparadise/libasciidoc/pkg/parser"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/bytesparadise/libasciidoc/pkg/validator"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// analysis the result of the analysis of a document
+type analysis struct {
+	// the document with its included files, and in which the attributes are substituted
+	doc types.Document
+	// the document without the included files, and without substitution
+	lossless types.LosslessDocument
+	// the problems found in the document and in its included files
+	diagnostics []types.Diagnostic
+	// the elements with an ID in the document and in its included files
+	anchors map[string]anchor
+}
+
+// anchor an element with an ID
+type anchor struct {
+	label    string // the title of the element (or its `reftext`), if any
+	position types.Position
+}
+
+// analyze parses the given document, validates it and collects the problems that were found
+func (s *Server) analyze(d *document) *analysis {
+	diagnostics := types.NewDiagnostics()
+	config := s.configuration(d.path, diagnostics)
+	result := &analysis{
+		anchors: map[string]anchor{},
+	}
+	doc, err := parser.ParseDocument(strings.NewReader(d.text), config)
+	if err != nil {
+		log.WithError(err).Debugf("failed to parse '%s'", d.path)
+		diagnostics.Add(parseFailure(err))
+	} else {
+		result.doc = doc
+		for _, problem := range validator.Validate(&doc) {
+			switch problem.Severity {
+			case validator.Error:
+				diagnostics.Errorf(types.InvalidDocument, problem.Position, "%s", problem.Message)
+			case validator.Warning:
+				diagnostics.Warnf(types.InvalidDocument, problem.Position, "%s", problem.Message)
+			}
+		}
+		result.anchors = anchorsOf(doc)
+	}
+	result.diagnostics = diagnostics.All()
+	// also, parse the document without losing its content, to find the elements at a given position
+	// (the problems were already reported)
+	config.Diagnostics = nil
+	if result.lossless, err = parser.ParseLosslessDocument(strings.NewReader(d.text), config); err != nil {
+		log.WithError(err).Debugf("failed to parse '%s' in lossless mode", d.path)
+	}
+	return result
+}
+
+// configuration returns the configuration to parse the document at the given path
+func (s *Server) configuration(path string, diagnostics *types.Diagnostics) configuration.Configuration {
+	settings := append([]configuration.Setting{}, s.settings...)
+	settings = append(settings,
+		configuration.WithFilename(path),
+		configuration.WithFS(overlayFS{
+			documents: s.documentsByPath(),
+		}),
+		configuration.WithSourcePositions(true),
+		configuration.WithDiagnostics(diagnostics),
+	)
+	return configuration.NewConfiguration(settings...)
+}
+
+// parseFailureRegexp the location of the parse errors (eg: `doc.adoc:3:5 (24): no match found`)
+var parseFailureRegexp = regexp.MustCompile(`^(.*):(\d+):(\d+) \(\d+\): (.*)$`)
+
+// parseFailure returns the diagnostic of the given parse error
+func parseFailure(err error) types.Diagnostic {
+	msg := strings.SplitN(err.Error(), "\n", 2)[0]
+	d := types.Diagnostic{
+		Severity: types.DiagnosticError,
+		Code:     "parse-failure",
+		Message:  msg,
+	}
+	if m := parseFailureRegexp.FindStringSubmatch(msg); m != nil {
+		line, _ := strconv.Atoi(m[2])
+		column, _ := strconv.Atoi(m[3])
+		location := types.SourceLocation{
+			Filename: m[1],
+			Line:     line,
+			Column:   column,
+		}
+		d.Message = m[4]
+		d.Position = types.Position{
+			Start: location,
+			End:   location,
+		}
+	}
+	return d
+}
+
+// anchorsOf returns the elements with an ID in the given document
+func anchorsOf(doc types.Document) map[string]anchor {
+	result := map[string]anchor{}
+	_ = types.Walk(doc, types.VisitorFuncs{
+		EnterFunc: func(element interface{}) (bool, error) {
+			switch e := element.(type) {
+			case types.InlineAnchor:
+				result[e.ID] = anchor{
+					label:    e.RefText,
+					position: e.Position,
+				}
+			case types.Section:
+				if id, found := e.Attributes.GetAsString(types.AttrID); found {
+					result[id] = anchor{
+						label:    plainText(e.Title),
+						position: e.Position,
+					}
+				}
+			default:
+				attrs := attributesOf(element)
+				if id, found := attrs.GetAsString(types.AttrID); found {
+					label, found := attrs.GetAsString(types.AttrRefText)
+					if !found {
+						label, _ = attrs.GetAsString(types.AttrTitle)
+					}
+					p, _ := types.PositionOf(element)
+					result[id] = anchor{
+						label:    label,
+						position: p,
+					}
+				}
+			}
+			return true, nil
+		},
+	})
+	return result
+}
+
+var attributesType = reflect.TypeOf(types.Attributes{})
+
+// attributesOf returns the attributes of the given element, if any
+func attributesOf(element interface{}) types.Attributes {
+	v := reflect.ValueOf(element)
+	if v.Kind() != reflect.Struct {
+		return nil
+	}
+	if f := v.FieldByName("Attributes"); f.IsValid() && f.Type() == attributesType {
+		return f.Interface().(types.Attributes)
+	}
+	return nil
+}
+
+// plainText returns the text of the given inline elements, without their formatting
+func plainText(elements []interface{}) string {
+	buf := &strings.Builder{}
+	_ = types.Walk(elements, types.VisitorFuncs{
+		EnterFunc: func(element interface{}) (bool, error) {
+			switch e := element.(type) {
+			case types.StringElement:
+				buf.WriteString(e.Content)
+			case types.AttributeSubstitution:
+				buf.WriteString(e.String())
+			}
+			return true, nil
+		},
+	})
+	return strings.TrimSpace(buf.String())
+}
+
+// includePath returns the path of the file included with the given directive in the document at the given path
+func includePath(incl types.FileInclusion, attrs types.AttributesWithOverrides, docPath string) string {
+	location := incl.Location.Resolve(attrs).String()
+	if filepath.IsAbs(location) || strings.Contains(location, "://") {
+		return location
+	}
+	return filepath.Join(filepath.Dir(docPath), filepath.FromSlash(path.Clean(location)))
+}
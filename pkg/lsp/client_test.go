@@ -0,0 +1,175 @@
+package lsp_test
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bytesparadise/libasciidoc/pkg/lsp"
+
+	. "github.com/onsi/gomega" //nolint golint
+)
+
+// fakeClient a client which sends its messages to a server running in the background
+type fakeClient struct {
+	in            io.WriteCloser // the input of the server
+	out           *bufio.Reader  // the output of the server
+	nextID        int
+	notifications []message // the notifications received while waiting for a response
+	done          chan error
+}
+
+type message struct {
+	JSONRPC string             `json:"jsonrpc"`
+	ID      *int               `json:"id,omitempty"`
+	Method  string             `json:"method,omitempty"`
+	Params  json.RawMessage    `json:"params,omitempty"`
+	Result  json.RawMessage    `json:"result,omitempty"`
+	Error   *lsp.ResponseError `json:"error,omitempty"`
+}
+
+// newFakeClient starts the given server and returns a client connected to this server
+func newFakeClient(server *lsp.Server) *fakeClient {
+	inReader, inWriter := io.Pipe()
+	outReader, outWriter := io.Pipe()
+	c := &fakeClient{
+		in:   inWriter,
+		out:  bufio.NewReader(outReader),
+		done: make(chan error, 1),
+	}
+	go func() {
+		err := server.Serve(inReader, outWriter)
+		outWriter.Close()
+		c.done <- err
+	}()
+	return c
+}
+
+func (c *fakeClient) send(m message) {
+	m.JSONRPC = "2.0"
+	content, err := json.Marshal(m)
+	Expect(err).NotTo(HaveOccurred())
+	_, err = fmt.Fprintf(c.in, "Content-Length: %d\r\n\r\n%s", len(content), content)
+	Expect(err).NotTo(HaveOccurred())
+}
+
+func (c *fakeClient) receive() message {
+	length := 0
+	for {
+		line, err := c.out.ReadString('\n')
+		Expect(err).NotTo(HaveOccurred())
+		line = strings.TrimSpace(line)
+		if line == "" {
+			break
+		}
+		if strings.HasPrefix(line, "Content-Length:") {
+			length, err = strconv.Atoi(strings.TrimSpace(strings.TrimPrefix(line, "Content-Length:")))
+			Expect(err).NotTo(HaveOccurred())
+		}
+	}
+	content := make([]byte, length)
+	_, err := io.ReadFull(c.out, content)
+	Expect(err).NotTo(HaveOccurred())
+	m := message{}
+	Expect(json.Unmarshal(content, &m)).To(Succeed())
+	Expect(m.JSONRPC).To(Equal("2.0"))
+	return m
+}
+
+// request sends a request and returns its response (the notifications received in the meantime are retained)
+func (c *fakeClient) request(method string, params interface{}) message {
+	c.nextID++
+	id := c.nextID
+	c.send(message{
+		ID:     &id,
+		Method: method,
+		Params: marshal(params),
+	})
+	for {
+		m := c.receive()
+		if m.ID == nil {
+			c.notifications = append(c.notifications, m)
+			continue
+		}
+		Expect(*m.ID).To(Equal(id))
+		return m
+	}
+}
+
+// call sends a request and decodes its result
+func (c *fakeClient) call(method string, params interface{}, result interface{}) {
+	m := c.request(method, params)
+	Expect(m.Error).To(BeNil())
+	Expect(json.Unmarshal(m.Result, result)).To(Succeed())
+}
+
+func (c *fakeClient) notify(method string, params interface{}) {
+	c.send(message{
+		Method: method,
+		Params: marshal(params),
+	})
+}
+
+// diagnostics returns the next diagnostics published for the given document
+func (c *fakeClient) diagnostics(uri string) []lsp.Diagnostic {
+	for {
+		var m message
+		if len(c.notifications) > 0 {
+			m, c.notifications = c.notifications[0], c.notifications[1:]
+		} else {
+			m = c.receive()
+		}
+		Expect(m.Method).To(Equal("textDocument/publishDiagnostics"))
+		p := lsp.PublishDiagnosticsParams{}
+		Expect(json.Unmarshal(m.Params, &p)).To(Succeed())
+		if p.URI == uri {
+			return p.Diagnostics
+		}
+	}
+}
+
+// initialize sends the `initialize` request and the `initialized` notification
+func (c *fakeClient) initialize() lsp.InitializeResult {
+	result := lsp.InitializeResult{}
+	c.call("initialize", lsp.InitializeParams{}, &result)
+	c.notify("initialized", struct{}{})
+	return result
+}
+
+// open opens the given document, and returns its diagnostics
+func (c *fakeClient) open(uri, text string) []lsp.Diagnostic {
+	c.notify("textDocument/didOpen", lsp.DidOpenTextDocumentParams{
+		TextDocument: lsp.TextDocumentItem{
+			URI:        uri,
+			LanguageID: "asciidoc",
+			Version:    1,
+			Text:       text,
+		},
+	})
+	return c.diagnostics(uri)
+}
+
+// stop shuts the server down, and returns the error returned by the server
+func (c *fakeClient) stop() error {
+	Expect(c.request("shutdown", nil).Error).To(BeNil())
+	c.notify("exit", nil)
+	select {
+	case err := <-c.done:
+		return err
+	case <-time.After(5 * time.Second):
+		return fmt.Errorf("timeout")
+	}
+}
+
+func marshal(v interface{}) json.RawMessage {
+	if v == nil {
+		return nil
+	}
+	content, err := json.Marshal(v)
+	Expect(err).NotTo(HaveOccurred())
+	return content
+}
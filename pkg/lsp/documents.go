@@ -0,0 +1,201 @@
+package lsp
+
+import (
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+	"unicode/utf16"
+	"unicode/utf8"
+
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+)
+
+// document a text document opened in the client
+type document struct {
+	uri     string
+	path    string // the path of the document in the local file system (or the URI if it is not a local file)
+	version int
+	text    string
+	lines   []string
+	// the results of the analysis of the document
+	analysis *analysis
+}
+
+func newDocument(uri string, version int, text string) *document {
+	return &document{
+		uri:     uri,
+		path:    pathOf(uri),
+		version: version,
+		text:    text,
+		lines:   splitLines(text),
+	}
+}
+
+// splitLines splits the given text into lines, without their line terminator
+func splitLines(text string) []string {
+	lines := strings.Split(text, "\n")
+	for i, l := range lines {
+		lines[i] = strings.TrimSuffix(l, "\r")
+	}
+	return lines
+}
+
+// pathOf returns the path of the local file identified by the given URI (or the URI itself if it is not a `file:` URI)
+func pathOf(uri string) string {
+	u, err := url.Parse(uri)
+	if err != nil || u.Scheme != "file" {
+		return uri
+	}
+	return filepath.FromSlash(u.Path)
+}
+
+// uriOf returns the URI of the given path of the local file system
+func uriOf(path string) string {
+	if strings.Contains(path, "://") || strings.HasPrefix(path, "untitled:") {
+		return path // not a local file
+	}
+	if abs, err := filepath.Abs(path); err == nil {
+		path = abs
+	}
+	u := url.URL{
+		Scheme: "file",
+		Path:   filepath.ToSlash(path),
+	}
+	return u.String()
+}
+
+// lspPosition converts the given location in the source (with a line and a column in characters, starting at 1)
+// into a position of LSP (with a line and a character offset in UTF-16 code units, starting at 0)
+func lspPosition(lines []string, l types.SourceLocation) Position {
+	line := l.Line - 1
+	if line < 0 {
+		return Position{}
+	}
+	if line >= len(lines) || l.Column < 1 {
+		return Position{
+			Line: line,
+		}
+	}
+	character := 0
+	column := 1
+	for _, r := range lines[line] {
+		if column == l.Column {
+			break
+		}
+		character += len(utf16.Encode([]rune{r}))
+		column++
+	}
+	return Position{
+		Line:      line,
+		Character: character,
+	}
+}
+
+// lspRange converts the given position in the source into a range of LSP
+func lspRange(lines []string, p types.Position) Range {
+	return Range{
+		Start: lspPosition(lines, p.Start),
+		End:   lspPosition(lines, p.End),
+	}
+}
+
+// sourceLocation converts the given position of LSP into a location in the source,
+// with a line and a column in characters (starting at 1)
+func sourceLocation(lines []string, filename string, p Position) types.SourceLocation {
+	column := 1
+	if p.Line >= 0 && p.Line < len(lines) {
+		character := 0
+		for _, r := range lines[p.Line] {
+			if character >= p.Character {
+				break
+			}
+			character += len(utf16.Encode([]rune{r}))
+			column++
+		}
+	}
+	return types.SourceLocation{
+		Filename: filename,
+		Line:     p.Line + 1,
+		Column:   column,
+	}
+}
+
+// contains returns true if the given position in the source contains the given location
+// (including its end, so that an element is found when the cursor is right after it)
+func contains(p types.Position, l types.SourceLocation) bool {
+	if p.Start.Line == 0 || l.Line < p.Start.Line || l.Line > p.End.Line {
+		return false
+	}
+	if l.Line == p.Start.Line && l.Column < p.Start.Column {
+		return false
+	}
+	if l.Line == p.End.Line && l.Column > p.End.Column {
+		return false
+	}
+	return true
+}
+
+// overlayFS the file system of the documents which are analyzed, in which the documents opened in the client
+// are read with their current content (which may not be saved yet), and all other files are read in the local file system
+type overlayFS struct {
+	documents map[string]*document // the opened documents, indexed by path
+}
+
+var _ configuration.FS = overlayFS{}
+
+// Open opens the file with the given name (see `configuration.FS`)
+func (fsys overlayFS) Open(name string) (configuration.File, error) {
+	path := filepath.Clean(filepath.FromSlash(name))
+	if d, found := fsys.documents[path]; found {
+		return &documentFile{
+			Reader: strings.NewReader(d.text),
+			name:   filepath.Base(path),
+			size:   int64(len(d.text)),
+		}, nil
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err // avoid returning a non-nil `File` with a nil `*os.File`
+	}
+	return f, nil
+}
+
+// documentFile the content of an opened document, read as a file
+type documentFile struct {
+	*strings.Reader
+	name string
+	size int64
+}
+
+var _ configuration.File = &documentFile{}
+
+func (f *documentFile) Stat() (os.FileInfo, error) {
+	return documentFileInfo{
+		name: f.name,
+		size: f.size,
+	}, nil
+}
+
+func (f *documentFile) Close() error {
+	return nil
+}
+
+type documentFileInfo struct {
+	name string
+	size int64
+}
+
+func (i documentFileInfo) Name() string       { return i.name }
+func (i documentFileInfo) Size() int64        { return i.size }
+func (i documentFileInfo) Mode() os.FileMode  { return 0444 }
+func (i documentFileInfo) ModTime() time.Time { return time.Now() }
+func (i documentFileInfo) IsDir() bool        { return false }
+func (i documentFileInfo) Sys() interface{}   { return nil }
+
+// runeCount returns the number of characters in the given string
+func runeCount(s string) int {
+	return utf8.RuneCountInString(s)
+}
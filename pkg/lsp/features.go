@@ -0,0 +1,366 @@
+package lsp
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/parser"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+)
+
+// documentSymbols returns the sections of the given document, as nested symbols
+func documentSymbols(d *document) []DocumentSymbol {
+	return sectionSymbols(d, d.analysis.lossless.Elements)
+}
+
+func sectionSymbols(d *document, elements []interface{}) []DocumentSymbol {
+	result := []DocumentSymbol{}
+	for _, element := range elements {
+		s, ok := element.(types.Section)
+		if !ok {
+			continue
+		}
+		symbol := DocumentSymbol{
+			Name:     plainText(s.Title),
+			Kind:     SymbolKindNamespace,
+			Range:    lspRange(d.lines, trimBlankLines(d.lines, s.Position)),
+			Children: sectionSymbols(d, s.Elements),
+		}
+		if s.Level == 0 {
+			symbol.Kind = SymbolKindModule // the title of the document
+		}
+		symbol.SelectionRange = symbol.Range
+		if title := types.Span(s.Title...); !title.Start.IsZero() {
+			symbol.SelectionRange = lspRange(d.lines, title)
+		}
+		result = append(result, symbol)
+	}
+	return result
+}
+
+// foldingRanges returns the sections, the delimited blocks, the tables and the lists
+// on more than one line of the given document
+func foldingRanges(d *document) []FoldingRange {
+	result := []FoldingRange{}
+	_ = types.Walk(d.analysis.lossless.Document, types.VisitorFuncs{
+		EnterFunc: func(element interface{}) (bool, error) {
+			kind := ""
+			switch e := element.(type) {
+			case types.Section:
+				// fold from the title of the section
+				if title := types.Span(e.Title...); !title.Start.IsZero() {
+					p := e.Position
+					p.Start = title.Start
+					element = p
+				}
+			case types.DelimitedBlock:
+				if e.Kind == types.Comment {
+					kind = "comment"
+				}
+			case types.Table, types.OrderedList, types.UnorderedList, types.LabeledList, types.CalloutList:
+			default:
+				return true, nil
+			}
+			p, _ := types.PositionOf(element)
+			if p = trimBlankLines(d.lines, p); p.End.Line > p.Start.Line {
+				result = append(result, FoldingRange{
+					StartLine: p.Start.Line - 1,
+					EndLine:   p.End.Line - 1,
+					Kind:      kind,
+				})
+			}
+			return true, nil
+		},
+	})
+	return result
+}
+
+// trimBlankLines returns the given position without the blank lines at its end (eg: at the end of a section)
+func trimBlankLines(lines []string, p types.Position) types.Position {
+	for p.End.Line > p.Start.Line && p.End.Line <= len(lines) && strings.TrimSpace(lines[p.End.Line-1]) == "" {
+		p.End.Line--
+		p.End.Column = runeCount(lines[p.End.Line-1]) + 1
+	}
+	return p
+}
+
+// elementAt returns the innermost element of the given document at the given position in the source,
+// among the elements accepted by the given function
+func elementAt(d *document, l types.SourceLocation, accept func(element interface{}) bool) (interface{}, bool) {
+	var result interface{}
+	_ = types.Walk(d.analysis.lossless.Document, types.VisitorFuncs{
+		EnterFunc: func(element interface{}) (bool, error) {
+			p, ok := types.PositionOf(element)
+			if !ok || !contains(p, l) {
+				return !ok || p.Start.IsZero(), nil // skip the nested elements of the elements which are not at this position
+			}
+			if accept(element) {
+				result = element
+			}
+			return true, nil
+		},
+	})
+	return result, result != nil
+}
+
+// definition returns the location of the element referred by the cross reference at the given position
+// of the document, or of the file included by the `include::` directive at the given position
+func (s *Server) definition(d *document, p Position) []Location {
+	l := sourceLocation(d.lines, d.path, p)
+	element, found := elementAt(d, l, func(element interface{}) bool {
+		switch element.(type) {
+		case types.InternalCrossReference, types.ExternalCrossReference, types.FileInclusion:
+			return true
+		default:
+			return false
+		}
+	})
+	if !found {
+		return []Location{}
+	}
+	switch e := element.(type) {
+	case types.InternalCrossReference:
+		if file, id, ok := splitInterDocumentReference(e.ID); ok {
+			return s.interDocumentDefinition(d, file, id)
+		}
+		if a, found := d.analysis.anchors[e.ID]; found {
+			return []Location{s.location(d, a.position)}
+		}
+	case types.ExternalCrossReference:
+		if file, id, ok := splitInterDocumentReference(e.Location.String()); ok {
+			return s.interDocumentDefinition(d, file, id)
+		}
+	case types.FileInclusion:
+		attrs := types.NewAttributesWithOverrides(s.configuration(d.path, nil).AttributeOverrides)
+		attrs.Add(d.analysis.lossless.Attributes)
+		return []Location{
+			{
+				URI: uriOf(includePath(e, attrs, d.path)),
+			},
+		}
+	}
+	return []Location{}
+}
+
+// splitInterDocumentReference splits the given reference to another document (eg: `other.adoc#id`)
+// into the path of the document and the ID of the element in this document
+func splitInterDocumentReference(ref string) (string, string, bool) {
+	file, id := ref, ""
+	if i := strings.Index(ref, "#"); i >= 0 {
+		file, id = ref[:i], ref[i+1:]
+	}
+	switch filepath.Ext(file) {
+	case ".adoc", ".asciidoc", ".asc", ".ad":
+		return file, id, true
+	default:
+		return "", "", false
+	}
+}
+
+// interDocumentDefinition returns the location of the element with the given ID in the given file
+// (relative to the given document), or the location of the start of the file if the element is not found
+func (s *Server) interDocumentDefinition(d *document, file, id string) []Location {
+	path := filepath.Join(filepath.Dir(d.path), filepath.FromSlash(file))
+	if id != "" {
+		f, err := s.configuration(path, nil).Open(path)
+		if err == nil {
+			defer f.Close()
+			if doc, err := parser.ParseDocument(f, s.configuration(path, nil)); err == nil {
+				if a, found := anchorsOf(doc)[id]; found {
+					return []Location{s.location(d, a.position)}
+				}
+			}
+		}
+	}
+	return []Location{
+		{
+			URI: uriOf(path),
+		},
+	}
+}
+
+// location returns the location of the given position in the source, which may be in another file
+// than the given document (eg: in an included file)
+func (s *Server) location(d *document, p types.Position) Location {
+	filename := p.Start.Filename
+	if filename == "" || filename == d.path {
+		return Location{
+			URI:   d.uri,
+			Range: lspRange(d.lines, p),
+		}
+	}
+	return Location{
+		URI:   uriOf(filename),
+		Range: lspRange(s.linesOf(filename), p),
+	}
+}
+
+// linesOf returns the lines of the given file (which may be opened in the client)
+func (s *Server) linesOf(path string) []string {
+	if d, found := s.documentsByPath()[path]; found {
+		return d.lines
+	}
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil
+	}
+	return splitLines(string(content))
+}
+
+// inclusionOf returns the `include::` directive of the given document which includes the given file,
+// either directly or via other included files
+func (s *Server) inclusionOf(d *document, filename string) (types.FileInclusion, bool) {
+	attrs := types.NewAttributesWithOverrides(s.configuration(d.path, nil).AttributeOverrides)
+	attrs.Add(d.analysis.lossless.Attributes)
+	inclusions := []types.FileInclusion{}
+	_ = types.Walk(d.analysis.lossless.Document, types.VisitorFuncs{
+		EnterFunc: func(element interface{}) (bool, error) {
+			if incl, ok := element.(types.FileInclusion); ok {
+				if includePath(incl, attrs, d.path) == filename {
+					inclusions = append([]types.FileInclusion{incl}, inclusions...)
+				} else {
+					inclusions = append(inclusions, incl)
+				}
+			}
+			return true, nil
+		},
+	})
+	if len(inclusions) == 0 {
+		return types.FileInclusion{}, false
+	}
+	// the file is not directly included, so the first directive is used if it is not found
+	return inclusions[0], true
+}
+
+var (
+	// the name of an attribute being written in a reference (eg: `{na`)
+	attributeReferenceRegexp = regexp.MustCompile(`\{([\p{L}\p{N}_-]*)$`)
+	// the ID being written in a cross reference (eg: `<<id` or `xref:id`)
+	crossReferenceRegexp = regexp.MustCompile(`(?:<<|xref:)([\w\-.:/#]*)$`)
+	// the references to attributes (eg: `{name}`)
+	attributeSubstitutionRegexp = regexp.MustCompile(`\{([\p{L}\p{N}_][\p{L}\p{N}_-]*)\}`)
+)
+
+// completion returns the names of the attributes after a `{`, or the IDs of the elements after a `<<` or `xref:`
+func (s *Server) completion(d *document, p Position) CompletionList {
+	result := CompletionList{
+		Items: []CompletionItem{},
+	}
+	l := sourceLocation(d.lines, d.path, p)
+	if l.Line > len(d.lines) {
+		return result
+	}
+	prefix := string([]rune(d.lines[l.Line-1])[:l.Column-1])
+	switch {
+	case attributeReferenceRegexp.MatchString(prefix):
+		attrs := s.attributes(d, l.Line)
+		for name, value := range attrs {
+			result.Items = append(result.Items, CompletionItem{
+				Label:  name,
+				Kind:   CompletionItemKindVariable,
+				Detail: value,
+			})
+		}
+	case crossReferenceRegexp.MatchString(prefix):
+		for id, a := range d.analysis.anchors {
+			result.Items = append(result.Items, CompletionItem{
+				Label:  id,
+				Kind:   CompletionItemKindReference,
+				Detail: a.label,
+			})
+		}
+	}
+	sort.Slice(result.Items, func(i, j int) bool {
+		return result.Items[i].Label < result.Items[j].Label
+	})
+	return result
+}
+
+// hover returns the value of the attribute referenced at the given position of the document, if any
+func (s *Server) hover(d *document, p Position) *Hover {
+	l := sourceLocation(d.lines, d.path, p)
+	if l.Line > len(d.lines) {
+		return nil
+	}
+	line := []rune(d.lines[l.Line-1])
+	offset := len(string(line[:l.Column-1]))
+	for _, m := range attributeSubstitutionRegexp.FindAllStringSubmatchIndex(d.lines[l.Line-1], -1) {
+		if offset < m[0] || offset >= m[1] {
+			continue
+		}
+		name := d.lines[l.Line-1][m[2]:m[3]]
+		content := fmt.Sprintf("attribute '%s' is not set", name)
+		if value, found := s.attributes(d, l.Line)[name]; found {
+			content = value
+		}
+		r := lspRange(d.lines, types.Position{
+			Start: types.SourceLocation{
+				Line:   l.Line,
+				Column: runeCount(d.lines[l.Line-1][:m[0]]) + 1,
+			},
+			End: types.SourceLocation{
+				Line:   l.Line,
+				Column: runeCount(d.lines[l.Line-1][:m[1]]) + 1,
+			},
+		})
+		return &Hover{
+			Contents: MarkupContent{
+				Kind:  "plaintext",
+				Value: content,
+			},
+			Range: &r,
+		}
+	}
+	return nil
+}
+
+// attributes returns the values of the attributes which are set at the given line of the document:
+// the predefined attributes, the attributes of the configuration, the attributes of the header of the document
+// (including the ones in the included files), and the attributes declared or reset in the document before the line
+func (s *Server) attributes(d *document, line int) map[string]string {
+	result := map[string]string{}
+	for name, value := range types.Predefined {
+		result[name] = value
+	}
+	overrides := s.configuration(d.path, nil).AttributeOverrides
+	for name, value := range overrides {
+		if o := types.NewAttributeOverride(name, value); o.Soft {
+			result[o.Name] = o.Value
+		}
+	}
+	for name, value := range d.analysis.doc.Attributes {
+		result[name] = fmt.Sprintf("%v", value)
+	}
+	_ = types.Walk(d.analysis.lossless.Document, types.VisitorFuncs{
+		EnterFunc: func(element interface{}) (bool, error) {
+			switch e := element.(type) {
+			case types.AttributeDeclaration:
+				if e.Position.Start.Line < line {
+					result[e.Name] = e.Value
+				}
+			case types.AttributeReset:
+				if e.Position.Start.Line < line {
+					delete(result, e.Name)
+				}
+			}
+			return true, nil
+		},
+	})
+	// the attributes of the configuration cannot be overridden in the document
+	for name, value := range overrides {
+		o := types.NewAttributeOverride(name, value)
+		if o.Soft {
+			continue
+		}
+		if o.Unset {
+			delete(result, o.Name)
+		} else {
+			result[o.Name] = o.Value
+		}
+	}
+	return result
+}
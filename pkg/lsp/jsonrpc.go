@@ -0,0 +1,171 @@
+package lsp
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// message a JSON-RPC 2.0 message: a request (with an ID), a notification (without ID) or a response
+type message struct {
+	JSONRPC string           `json:"jsonrpc"`
+	ID      *json.RawMessage `json:"id,omitempty"`
+	Method  string           `json:"method,omitempty"`
+	Params  json.RawMessage  `json:"params,omitempty"`
+	Result  json.RawMessage  `json:"result,omitempty"`
+	Error   *ResponseError   `json:"error,omitempty"`
+}
+
+// Codes of the JSON-RPC errors
+const (
+	ParseError           = -32700
+	InvalidParams        = -32602
+	MethodNotFound       = -32601
+	InternalError        = -32603
+	ServerNotInitialized = -32002
+)
+
+// MaxContentLength the maximum length of the content of the messages read by the server (64MiB)
+const MaxContentLength = 64 * 1024 * 1024
+
+// ResponseError the error of a request
+type ResponseError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *ResponseError) Error() string {
+	return fmt.Sprintf("%s (code %d)", e.Message, e.Code)
+}
+
+// conn a connection which reads and writes JSON-RPC messages with the `Content-Length` header
+// of the base protocol of LSP
+type conn struct {
+	r  *bufio.Reader
+	mu sync.Mutex // the messages may be written concurrently
+	w  io.Writer
+}
+
+func newConn(r io.Reader, w io.Writer) *conn {
+	return &conn{
+		r: bufio.NewReader(r),
+		w: w,
+	}
+}
+
+// read reads the next message. Returns `io.EOF` if the input was closed before a new message.
+func (c *conn) read() (message, error) {
+	length := -1
+	for {
+		line, err := c.r.ReadString('\n')
+		if err != nil {
+			if err == io.EOF && line == "" && length == -1 {
+				return message{}, io.EOF
+			}
+			return message{}, errors.Wrap(err, "unable to read the header of the message")
+		}
+		line = strings.TrimRight(line, "\r\n")
+		if line == "" {
+			break // end of the header
+		}
+		i := strings.Index(line, ":")
+		if i < 0 {
+			return message{}, errors.Errorf("invalid header: '%s'", line)
+		}
+		if strings.EqualFold(strings.TrimSpace(line[:i]), "Content-Length") {
+			if length, err = strconv.Atoi(strings.TrimSpace(line[i+1:])); err != nil {
+				return message{}, errors.Wrapf(err, "invalid header: '%s'", line)
+			}
+		}
+	}
+	if length < 0 {
+		return message{}, errors.New("missing 'Content-Length' header")
+	}
+	if length > MaxContentLength {
+		// skip the content, so the next message can still be read
+		if _, err := io.CopyN(ioutil.Discard, c.r, int64(length)); err != nil {
+			return message{}, errors.Wrap(err, "unable to read the content of the message")
+		}
+		return message{}, &ResponseError{
+			Code:    ParseError,
+			Message: fmt.Sprintf("content exceeds the maximum length of %d bytes", MaxContentLength),
+		}
+	}
+	content := make([]byte, length)
+	if _, err := io.ReadFull(c.r, content); err != nil {
+		return message{}, errors.Wrap(err, "unable to read the content of the message")
+	}
+	m := message{}
+	if err := json.Unmarshal(content, &m); err != nil {
+		return message{}, &ResponseError{
+			Code:    ParseError,
+			Message: err.Error(),
+		}
+	}
+	return m, nil
+}
+
+// write writes the given message
+func (c *conn) write(m message) error {
+	m.JSONRPC = "2.0"
+	content, err := json.Marshal(m)
+	if err != nil {
+		return errors.Wrap(err, "unable to write message")
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, err := fmt.Fprintf(c.w, "Content-Length: %d\r\n\r\n", len(content)); err != nil {
+		return errors.Wrap(err, "unable to write message")
+	}
+	if _, err := c.w.Write(content); err != nil {
+		return errors.Wrap(err, "unable to write message")
+	}
+	return nil
+}
+
+// reply writes the response to the request with the given ID
+func (c *conn) reply(id *json.RawMessage, result interface{}, err error) error {
+	m := message{
+		ID: id,
+	}
+	if id == nil {
+		null := json.RawMessage("null") // the ID could not be read
+		m.ID = &null
+	}
+	if err != nil {
+		if e, ok := err.(*ResponseError); ok {
+			m.Error = e
+		} else {
+			m.Error = &ResponseError{
+				Code:    InternalError,
+				Message: err.Error(),
+			}
+		}
+		return c.write(m)
+	}
+	content, err := json.Marshal(result)
+	if err != nil {
+		return errors.Wrap(err, "unable to write response")
+	}
+	m.Result = content // `null` if there is no result
+	return c.write(m)
+}
+
+// notify writes a notification with the given method and parameters
+func (c *conn) notify(method string, params interface{}) error {
+	content, err := json.Marshal(params)
+	if err != nil {
+		return errors.Wrapf(err, "unable to write '%s' notification", method)
+	}
+	return c.write(message{
+		Method: method,
+		Params: content,
+	})
+}
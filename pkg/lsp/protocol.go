@@ -0,0 +1,198 @@
+package lsp
+
+// The subset of the Language Server Protocol which is supported by the server
+// (see https://microsoft.github.io/language-server-protocol/specifications/specification-3-15/)
+
+// Position a position in a text document, as a zero-based line and a zero-based character offset
+// in UTF-16 code units
+type Position struct {
+	Line      int `json:"line"`
+	Character int `json:"character"`
+}
+
+// Range a range in a text document, whose end position is exclusive
+type Range struct {
+	Start Position `json:"start"`
+	End   Position `json:"end"`
+}
+
+// Location a range in a text document identified by its URI
+type Location struct {
+	URI   string `json:"uri"`
+	Range Range  `json:"range"`
+}
+
+// TextDocumentIdentifier the identifier of a text document
+type TextDocumentIdentifier struct {
+	URI string `json:"uri"`
+}
+
+// TextDocumentItem a text document transferred from the client to the server
+type TextDocumentItem struct {
+	URI        string `json:"uri"`
+	LanguageID string `json:"languageId"`
+	Version    int    `json:"version"`
+	Text       string `json:"text"`
+}
+
+// VersionedTextDocumentIdentifier the identifier of a specific version of a text document
+type VersionedTextDocumentIdentifier struct {
+	URI     string `json:"uri"`
+	Version int    `json:"version"`
+}
+
+// TextDocumentPositionParams the parameters of the requests on a position in a text document
+type TextDocumentPositionParams struct {
+	TextDocument TextDocumentIdentifier `json:"textDocument"`
+	Position     Position               `json:"position"`
+}
+
+// InitializeParams the parameters of the `initialize` request (the client capabilities are ignored)
+type InitializeParams struct {
+	ProcessID int    `json:"processId"`
+	RootURI   string `json:"rootUri"`
+}
+
+// InitializeResult the result of the `initialize` request
+type InitializeResult struct {
+	Capabilities ServerCapabilities `json:"capabilities"`
+	ServerInfo   ServerInfo         `json:"serverInfo"`
+}
+
+// ServerInfo the name of the server
+type ServerInfo struct {
+	Name string `json:"name"`
+}
+
+// TextDocumentSyncKindFull the documents are synced by sending their full content
+const TextDocumentSyncKindFull = 1
+
+// ServerCapabilities the features provided by the server
+type ServerCapabilities struct {
+	TextDocumentSync       int               `json:"textDocumentSync"`
+	CompletionProvider     CompletionOptions `json:"completionProvider"`
+	HoverProvider          bool              `json:"hoverProvider"`
+	DefinitionProvider     bool              `json:"definitionProvider"`
+	DocumentSymbolProvider bool              `json:"documentSymbolProvider"`
+	FoldingRangeProvider   bool              `json:"foldingRangeProvider"`
+}
+
+// CompletionOptions the options of the completion
+type CompletionOptions struct {
+	TriggerCharacters []string `json:"triggerCharacters"`
+}
+
+// DidOpenTextDocumentParams the parameters of the `textDocument/didOpen` notification
+type DidOpenTextDocumentParams struct {
+	TextDocument TextDocumentItem `json:"textDocument"`
+}
+
+// DidChangeTextDocumentParams the parameters of the `textDocument/didChange` notification
+type DidChangeTextDocumentParams struct {
+	TextDocument   VersionedTextDocumentIdentifier  `json:"textDocument"`
+	ContentChanges []TextDocumentContentChangeEvent `json:"contentChanges"`
+}
+
+// TextDocumentContentChangeEvent the new content of a document (the documents are synced in full)
+type TextDocumentContentChangeEvent struct {
+	Text string `json:"text"`
+}
+
+// DidCloseTextDocumentParams the parameters of the `textDocument/didClose` notification
+type DidCloseTextDocumentParams struct {
+	TextDocument TextDocumentIdentifier `json:"textDocument"`
+}
+
+// PublishDiagnosticsParams the parameters of the `textDocument/publishDiagnostics` notification
+type PublishDiagnosticsParams struct {
+	URI         string       `json:"uri"`
+	Version     int          `json:"version,omitempty"`
+	Diagnostics []Diagnostic `json:"diagnostics"`
+}
+
+// DiagnosticSeverity the severity of a diagnostic
+type DiagnosticSeverity int
+
+// Severities of the diagnostics
+const (
+	SeverityError   DiagnosticSeverity = 1
+	SeverityWarning DiagnosticSeverity = 2
+)
+
+// Diagnostic a problem in a text document
+type Diagnostic struct {
+	Range    Range              `json:"range"`
+	Severity DiagnosticSeverity `json:"severity"`
+	Code     string             `json:"code,omitempty"`
+	Source   string             `json:"source"`
+	Message  string             `json:"message"`
+}
+
+// DocumentSymbolParams the parameters of the `textDocument/documentSymbol` request
+type DocumentSymbolParams struct {
+	TextDocument TextDocumentIdentifier `json:"textDocument"`
+}
+
+// SymbolKind the kind of a symbol
+type SymbolKind int
+
+// Kinds of symbols
+const (
+	SymbolKindModule    SymbolKind = 2
+	SymbolKindNamespace SymbolKind = 3
+)
+
+// DocumentSymbol a symbol of a document, with its nested symbols
+type DocumentSymbol struct {
+	Name           string           `json:"name"`
+	Kind           SymbolKind       `json:"kind"`
+	Range          Range            `json:"range"`
+	SelectionRange Range            `json:"selectionRange"`
+	Children       []DocumentSymbol `json:"children,omitempty"`
+}
+
+// CompletionItemKind the kind of a completion item
+type CompletionItemKind int
+
+// Kinds of completion items
+const (
+	CompletionItemKindVariable  CompletionItemKind = 6
+	CompletionItemKindReference CompletionItemKind = 18
+)
+
+// CompletionItem a completion item
+type CompletionItem struct {
+	Label  string             `json:"label"`
+	Kind   CompletionItemKind `json:"kind"`
+	Detail string             `json:"detail,omitempty"`
+}
+
+// CompletionList the result of the `textDocument/completion` request
+type CompletionList struct {
+	IsIncomplete bool             `json:"isIncomplete"`
+	Items        []CompletionItem `json:"items"`
+}
+
+// MarkupContent a content in plain text or in markdown
+type MarkupContent struct {
+	Kind  string `json:"kind"`
+	Value string `json:"value"`
+}
+
+// Hover the result of the `textDocument/hover` request
+type Hover struct {
+	Contents MarkupContent `json:"contents"`
+	Range    *Range        `json:"range,omitempty"`
+}
+
+// FoldingRangeParams the parameters of the `textDocument/foldingRange` request
+type FoldingRangeParams struct {
+	TextDocument TextDocumentIdentifier `json:"textDocument"`
+}
+
+// FoldingRange a range of lines which can be folded
+type FoldingRange struct {
+	StartLine int    `json:"startLine"`
+	EndLine   int    `json:"endLine"`
+	Kind      string `json:"kind,omitempty"`
+}
@@ -0,0 +1,280 @@
+package lsp
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// Server a Language Server Protocol server for the AsciiDoc documents, which communicates with its client
+// over a single connection (eg: the standard input and output of the process).
+// The server publishes the problems found in the opened documents and in their included files,
+// and provides the outline of the documents (their sections), the definition of the cross references and
+// of the included files, the completion of the attribute names and of the cross references, the values
+// of the attributes when hovering their references, and the folding of the sections and blocks.
+type Server struct {
+	settings    []configuration.Setting
+	conn        *conn
+	documents   map[string]*document // the opened documents, indexed by URI
+	initialized bool
+	shutdown    bool
+}
+
+// NewServer returns a new server, which parses the documents with the given settings
+// (eg: `configuration.WithAttributes()` or `configuration.WithSafeMode()`)
+func NewServer(settings ...configuration.Setting) *Server {
+	return &Server{
+		settings:  settings,
+		documents: map[string]*document{},
+	}
+}
+
+// Serve reads the messages of the client in the given reader and writes the responses and notifications
+// in the given writer, until the client sends the `exit` notification or closes the input.
+// Returns an error if the client exits without requesting the server to shut down, or if the connection failed.
+func (s *Server) Serve(r io.Reader, w io.Writer) error {
+	s.conn = newConn(r, w)
+	for {
+		m, err := s.conn.read()
+		if err == io.EOF {
+			return nil
+		} else if e, ok := err.(*ResponseError); ok {
+			// the content of the message is not valid, but the next message can still be read
+			if err := s.conn.reply(nil, nil, e); err != nil {
+				return err
+			}
+			continue
+		} else if err != nil {
+			return err
+		}
+		if m.ID == nil {
+			if m.Method == "exit" {
+				if !s.shutdown {
+					return errors.New("exit notification received before the shutdown request")
+				}
+				return nil
+			}
+			if err := s.handleNotification(m.Method, m.Params); err != nil {
+				log.WithError(err).Errorf("failed to handle '%s' notification", m.Method)
+			}
+			continue
+		}
+		result, err := s.handleRequest(m.Method, m.Params)
+		if err != nil {
+			log.WithError(err).Debugf("failed to handle '%s' request", m.Method)
+		}
+		if err := s.conn.reply(m.ID, result, err); err != nil {
+			return err
+		}
+	}
+}
+
+// handleRequest returns the result of the request with the given method and parameters
+func (s *Server) handleRequest(method string, params json.RawMessage) (interface{}, error) {
+	if !s.initialized && method != "initialize" {
+		return nil, &ResponseError{
+			Code:    ServerNotInitialized,
+			Message: fmt.Sprintf("cannot handle '%s' request before initialization", method),
+		}
+	}
+	switch method {
+	case "initialize":
+		s.initialized = true
+		return InitializeResult{
+			Capabilities: ServerCapabilities{
+				TextDocumentSync: TextDocumentSyncKindFull,
+				CompletionProvider: CompletionOptions{
+					TriggerCharacters: []string{"{", "<", ":"},
+				},
+				HoverProvider:          true,
+				DefinitionProvider:     true,
+				DocumentSymbolProvider: true,
+				FoldingRangeProvider:   true,
+			},
+			ServerInfo: ServerInfo{
+				Name: "libasciidoc",
+			},
+		}, nil
+	case "shutdown":
+		s.shutdown = true
+		return nil, nil
+	case "textDocument/documentSymbol":
+		p := DocumentSymbolParams{}
+		d, err := s.document(params, &p, &p.TextDocument)
+		if err != nil {
+			return nil, err
+		}
+		return documentSymbols(d), nil
+	case "textDocument/foldingRange":
+		p := FoldingRangeParams{}
+		d, err := s.document(params, &p, &p.TextDocument)
+		if err != nil {
+			return nil, err
+		}
+		return foldingRanges(d), nil
+	case "textDocument/definition":
+		p := TextDocumentPositionParams{}
+		d, err := s.document(params, &p, &p.TextDocument)
+		if err != nil {
+			return nil, err
+		}
+		return s.definition(d, p.Position), nil
+	case "textDocument/completion":
+		p := TextDocumentPositionParams{}
+		d, err := s.document(params, &p, &p.TextDocument)
+		if err != nil {
+			return nil, err
+		}
+		return s.completion(d, p.Position), nil
+	case "textDocument/hover":
+		p := TextDocumentPositionParams{}
+		d, err := s.document(params, &p, &p.TextDocument)
+		if err != nil {
+			return nil, err
+		}
+		return s.hover(d, p.Position), nil
+	default:
+		return nil, &ResponseError{
+			Code:    MethodNotFound,
+			Message: fmt.Sprintf("unsupported method: '%s'", method),
+		}
+	}
+}
+
+// handleNotification handles the notification with the given method and parameters
+func (s *Server) handleNotification(method string, params json.RawMessage) error {
+	if !s.initialized {
+		log.Debugf("ignoring '%s' notification before initialization", method)
+		return nil
+	}
+	switch method {
+	case "textDocument/didOpen":
+		p := DidOpenTextDocumentParams{}
+		if err := json.Unmarshal(params, &p); err != nil {
+			return err
+		}
+		d := newDocument(p.TextDocument.URI, p.TextDocument.Version, p.TextDocument.Text)
+		s.documents[d.uri] = d
+		return s.publishDiagnostics(d.path)
+	case "textDocument/didChange":
+		p := DidChangeTextDocumentParams{}
+		if err := json.Unmarshal(params, &p); err != nil {
+			return err
+		}
+		if len(p.ContentChanges) == 0 {
+			return nil
+		}
+		// the documents are synced in full, so the last change contains the whole content
+		d := newDocument(p.TextDocument.URI, p.TextDocument.Version, p.ContentChanges[len(p.ContentChanges)-1].Text)
+		s.documents[d.uri] = d
+		return s.publishDiagnostics(d.path)
+	case "textDocument/didClose":
+		p := DidCloseTextDocumentParams{}
+		if err := json.Unmarshal(params, &p); err != nil {
+			return err
+		}
+		delete(s.documents, p.TextDocument.URI)
+		// clear the diagnostics of the closed document
+		if err := s.conn.notify("textDocument/publishDiagnostics", PublishDiagnosticsParams{
+			URI:         p.TextDocument.URI,
+			Diagnostics: []Diagnostic{},
+		}); err != nil {
+			return err
+		}
+		return s.publishDiagnostics(pathOf(p.TextDocument.URI))
+	default:
+		// other notifications (eg: `initialized`, `textDocument/didSave` or `$/cancelRequest`) are ignored
+		return nil
+	}
+}
+
+// document decodes the given parameters and returns the opened document identified in these parameters
+func (s *Server) document(params json.RawMessage, p interface{}, id *TextDocumentIdentifier) (*document, error) {
+	if err := json.Unmarshal(params, p); err != nil {
+		return nil, &ResponseError{
+			Code:    InvalidParams,
+			Message: err.Error(),
+		}
+	}
+	d, found := s.documents[id.URI]
+	if !found {
+		return nil, &ResponseError{
+			Code:    InvalidParams,
+			Message: fmt.Sprintf("document is not opened: '%s'", id.URI),
+		}
+	}
+	return d, nil
+}
+
+// documentsByPath returns the opened documents, indexed by path
+func (s *Server) documentsByPath() map[string]*document {
+	result := make(map[string]*document, len(s.documents))
+	for _, d := range s.documents {
+		result[d.path] = d
+	}
+	return result
+}
+
+// publishDiagnostics analyzes the document at the given path and the opened documents which include it
+// (since a change in a document may have an impact on these documents), and publishes their diagnostics
+func (s *Server) publishDiagnostics(path string) error {
+	uris := make([]string, 0, len(s.documents))
+	for uri, d := range s.documents {
+		if d.path == path || d.analysis == nil {
+			uris = append(uris, uri)
+		} else if _, found := s.inclusionOf(d, path); found {
+			// the file may be included via other files, so all the documents with an `include::` directive are retained
+			uris = append(uris, uri)
+		}
+	}
+	sort.Strings(uris)
+	for _, uri := range uris {
+		d := s.documents[uri]
+		d.analysis = s.analyze(d)
+		if err := s.conn.notify("textDocument/publishDiagnostics", PublishDiagnosticsParams{
+			URI:         d.uri,
+			Version:     d.version,
+			Diagnostics: s.diagnostics(d),
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// diagnostics returns the diagnostics of the given document. The problems found in the included files
+// are reported on the `include::` directive of the document
+func (s *Server) diagnostics(d *document) []Diagnostic {
+	result := []Diagnostic{}
+	for _, problem := range d.analysis.diagnostics {
+		diagnostic := Diagnostic{
+			Severity: SeverityWarning,
+			Code:     string(problem.Code),
+			Source:   "libasciidoc",
+			Message:  problem.Message,
+		}
+		if problem.Severity == types.DiagnosticError {
+			diagnostic.Severity = SeverityError
+		}
+		switch filename := problem.Position.Start.Filename; {
+		case problem.Position.Start.IsZero():
+			// unknown position: the problem is reported at the start of the document
+		case filename == d.path:
+			diagnostic.Range = lspRange(d.lines, problem.Position)
+		default:
+			diagnostic.Message = fmt.Sprintf("%s: %s", problem.Position.Start, problem.Message)
+			if incl, found := s.inclusionOf(d, filename); found {
+				diagnostic.Range = lspRange(d.lines, incl.Position)
+			}
+		}
+		result = append(result, diagnostic)
+	}
+	return result
+}
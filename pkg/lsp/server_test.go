@@ -0,0 +1,443 @@
+package lsp_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+	"github.com/bytesparadise/libasciidoc/pkg/lsp"
+
+	. "github.com/onsi/ginkgo"                  //nolint golint
+	. "github.com/onsi/ginkgo/extensions/table" //nolint golint
+	. "github.com/onsi/gomega"                  //nolint golint
+)
+
+var _ = Describe("language server", func() {
+
+	var dir string
+	var client *fakeClient
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "libasciidoc-lsp")
+		Expect(err).NotTo(HaveOccurred())
+		// resolve the symbolic links (eg: on macOS), since the paths are compared
+		dir, err = filepath.EvalSymlinks(dir)
+		Expect(err).NotTo(HaveOccurred())
+		client = newFakeClient(lsp.NewServer(configuration.WithAttributes(map[string]string{
+			"product": "libasciidoc",
+		})))
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	// uriOf returns the URI of the file with the given name in the temporary directory
+	uriOf := func(name string) string {
+		u := url.URL{
+			Scheme: "file",
+			Path:   filepath.ToSlash(filepath.Join(dir, name)),
+		}
+		return u.String()
+	}
+
+	writeFile := func(name, content string) {
+		Expect(ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0644)).To(Succeed())
+	}
+
+	position := func(line, character int) lsp.Position {
+		return lsp.Position{
+			Line:      line,
+			Character: character,
+		}
+	}
+
+	lspRange := func(startLine, startCharacter, endLine, endCharacter int) lsp.Range {
+		return lsp.Range{
+			Start: position(startLine, startCharacter),
+			End:   position(endLine, endCharacter),
+		}
+	}
+
+	at := func(uri string, line, character int) lsp.TextDocumentPositionParams {
+		return lsp.TextDocumentPositionParams{
+			TextDocument: lsp.TextDocumentIdentifier{
+				URI: uri,
+			},
+			Position: position(line, character),
+		}
+	}
+
+	Context("lifecycle", func() {
+
+		It("should initialize and shut down", func() {
+			result := client.initialize()
+			Expect(result.ServerInfo.Name).To(Equal("libasciidoc"))
+			Expect(result.Capabilities).To(Equal(lsp.ServerCapabilities{
+				TextDocumentSync: lsp.TextDocumentSyncKindFull,
+				CompletionProvider: lsp.CompletionOptions{
+					TriggerCharacters: []string{"{", "<", ":"},
+				},
+				HoverProvider:          true,
+				DefinitionProvider:     true,
+				DocumentSymbolProvider: true,
+				FoldingRangeProvider:   true,
+			}))
+			Expect(client.stop()).To(Succeed())
+		})
+
+		It("should reject requests before initialization", func() {
+			m := client.request("textDocument/hover", at(uriOf("doc.adoc"), 0, 0))
+			Expect(m.Error).To(Equal(&lsp.ResponseError{
+				Code:    lsp.ServerNotInitialized,
+				Message: "cannot handle 'textDocument/hover' request before initialization",
+			}))
+			client.initialize()
+			Expect(client.stop()).To(Succeed())
+		})
+
+		It("should reject unknown requests", func() {
+			client.initialize()
+			m := client.request("workspace/symbol", struct{}{})
+			Expect(m.Error.Code).To(Equal(lsp.MethodNotFound))
+			Expect(client.stop()).To(Succeed())
+		})
+
+		It("should reject too large message", func() {
+			_, err := fmt.Fprintf(client.in, "Content-Length: %d\r\n\r\n", lsp.MaxContentLength+1)
+			Expect(err).NotTo(HaveOccurred())
+			// the content is skipped by the server
+			_, err = io.CopyN(client.in, strings.NewReader(strings.Repeat(" ", lsp.MaxContentLength+1)), lsp.MaxContentLength+1)
+			Expect(err).NotTo(HaveOccurred())
+			m := client.receive()
+			Expect(m.ID).To(BeNil())
+			Expect(m.Error).To(Equal(&lsp.ResponseError{
+				Code:    lsp.ParseError,
+				Message: fmt.Sprintf("content exceeds the maximum length of %d bytes", lsp.MaxContentLength),
+			}))
+			// the next messages can still be read
+			client.initialize()
+			Expect(client.stop()).To(Succeed())
+		})
+
+		It("should fail on exit without shutdown", func() {
+			client.initialize()
+			client.notify("exit", nil)
+			Expect(<-client.done).To(MatchError("exit notification received before the shutdown request"))
+		})
+	})
+
+	Context("diagnostics", func() {
+
+		BeforeEach(func() {
+			client.initialize()
+		})
+
+		AfterEach(func() {
+			Expect(client.stop()).To(Succeed())
+		})
+
+		It("should publish no diagnostics", func() {
+			Expect(client.open(uriOf("doc.adoc"), "= Title\n\nA paragraph.\n")).To(BeEmpty())
+		})
+
+		It("should publish unresolved include and cross reference", func() {
+			diagnostics := client.open(uriOf("doc.adoc"), "= Title\n\ninclude::unknown.adoc[]\n\nSee <<unknown>>.\n")
+			Expect(diagnostics).To(HaveLen(2))
+			Expect(diagnostics[0].Range).To(Equal(lspRange(2, 0, 2, 23)))
+			Expect(diagnostics[0].Severity).To(Equal(lsp.SeverityError))
+			Expect(diagnostics[0].Code).To(Equal("unresolved-include"))
+			Expect(diagnostics[0].Source).To(Equal("libasciidoc"))
+			Expect(diagnostics[1]).To(Equal(lsp.Diagnostic{
+				Range:    lspRange(4, 4, 4, 15),
+				Severity: lsp.SeverityWarning,
+				Code:     "unresolved-xref",
+				Source:   "libasciidoc",
+				Message:  "possible invalid reference: 'unknown'",
+			}))
+		})
+
+		It("should publish validation problems", func() {
+			diagnostics := client.open(uriOf("doc.adoc"), ":doctype: manpage\n\nA paragraph.\n")
+			Expect(diagnostics).To(ContainElement(lsp.Diagnostic{
+				Range:    lspRange(2, 0, 2, 12),
+				Severity: lsp.SeverityError,
+				Code:     "invalid-document",
+				Source:   "libasciidoc",
+				Message:  "manpage document is missing a header",
+			}))
+		})
+
+		It("should report problems of included file on include directive", func() {
+			writeFile("chapter.adoc", "== Chapter\n\ninclude::missing.adoc[]\n")
+			diagnostics := client.open(uriOf("doc.adoc"), "= Title\n\ninclude::chapter.adoc[]\n")
+			Expect(diagnostics).To(HaveLen(1))
+			Expect(diagnostics[0].Range).To(Equal(lspRange(2, 0, 2, 23)))
+			Expect(diagnostics[0].Message).To(HavePrefix(filepath.Join(dir, "chapter.adoc") + ":3:1: "))
+		})
+
+		It("should publish diagnostics of changed and closed documents", func() {
+			uri := uriOf("doc.adoc")
+			Expect(client.open(uri, "See <<unknown>>.\n")).To(HaveLen(1))
+			client.notify("textDocument/didChange", lsp.DidChangeTextDocumentParams{
+				TextDocument: lsp.VersionedTextDocumentIdentifier{
+					URI:     uri,
+					Version: 2,
+				},
+				ContentChanges: []lsp.TextDocumentContentChangeEvent{
+					{Text: "[[unknown]]\nSee <<unknown>>.\n"},
+				},
+			})
+			Expect(client.diagnostics(uri)).To(BeEmpty())
+			client.notify("textDocument/didClose", lsp.DidCloseTextDocumentParams{
+				TextDocument: lsp.TextDocumentIdentifier{
+					URI: uri,
+				},
+			})
+			Expect(client.diagnostics(uri)).To(BeEmpty())
+		})
+
+		It("should publish diagnostics of changed document and of documents which include it", func() {
+			chapter := uriOf("chapter.adoc")
+			Expect(client.open(chapter, "== Chapter\n")).To(BeEmpty())
+			Expect(client.open(uriOf("appendix.adoc"), "A paragraph.\n")).To(BeEmpty())
+			Expect(client.open(uriOf("doc.adoc"), "include::chapter.adoc[]\n")).To(BeEmpty())
+			client.notify("textDocument/didChange", lsp.DidChangeTextDocumentParams{
+				TextDocument: lsp.VersionedTextDocumentIdentifier{
+					URI:     chapter,
+					Version: 2,
+				},
+				ContentChanges: []lsp.TextDocumentContentChangeEvent{
+					{Text: "== Chapter\n\nSee <<unknown>>.\n"},
+				},
+			})
+			// the diagnostics are published in the order of the URIs, so an unrelated document would be first
+			uris := []string{}
+			for i := 0; i < 2; i++ {
+				m := client.receive()
+				p := lsp.PublishDiagnosticsParams{}
+				Expect(json.Unmarshal(m.Params, &p)).To(Succeed())
+				uris = append(uris, p.URI)
+			}
+			Expect(uris).To(Equal([]string{chapter, uriOf("doc.adoc")}))
+			Expect(client.request("textDocument/documentSymbol", lsp.DocumentSymbolParams{
+				TextDocument: lsp.TextDocumentIdentifier{
+					URI: chapter,
+				},
+			}).Error).To(BeNil())
+			Expect(client.notifications).To(BeEmpty())
+		})
+
+		It("should read included documents opened in client", func() {
+			writeFile("chapter.adoc", "== Chapter\n")
+			doc := uriOf("doc.adoc")
+			Expect(client.open(doc, "include::chapter.adoc[]\n\nSee <<anchor>>.\n")).To(HaveLen(1))
+			// the unsaved content of the included document is read
+			client.notify("textDocument/didOpen", lsp.DidOpenTextDocumentParams{
+				TextDocument: lsp.TextDocumentItem{
+					URI:     uriOf("chapter.adoc"),
+					Version: 1,
+					Text:    "== Chapter\n\n[[anchor]]a paragraph\n",
+				},
+			})
+			Expect(client.diagnostics(doc)).To(BeEmpty())
+		})
+	})
+
+	Context("features", func() {
+
+		var uri string
+
+		BeforeEach(func() {
+			client.initialize()
+			writeFile("chapter.adoc", "[#included]\n== Included\n\ncontent\n")
+			writeFile("other.adoc", "= Other\n\n[#target]\n== Target\n")
+			uri = uriOf("doc.adoc")
+			client.open(uri, `= Title
+:name: value
+:ünïcode: 😀 value
+
+== Section A
+
+[#custom]
+=== Subsection
+
+See <<custom>> and <<included,the included section>> and xref:other.adoc#target[].
+
+include::chapter.adoc[]
+
+[source]
+----
+{name}
+----
+
+== Section B
+
+* item {name} and {ünïcode}
+* {product} item
+
+// {missing}
+`)
+		})
+
+		AfterEach(func() {
+			Expect(client.stop()).To(Succeed())
+		})
+
+		It("should return document symbols", func() {
+			symbols := []lsp.DocumentSymbol{}
+			client.call("textDocument/documentSymbol", lsp.DocumentSymbolParams{
+				TextDocument: lsp.TextDocumentIdentifier{URI: uri},
+			}, &symbols)
+			Expect(symbols).To(Equal([]lsp.DocumentSymbol{
+				{
+					Name:           "Title",
+					Kind:           lsp.SymbolKindModule,
+					Range:          lspRange(0, 0, 23, 12),
+					SelectionRange: lspRange(0, 2, 0, 7),
+					Children: []lsp.DocumentSymbol{
+						{
+							Name:           "Section A",
+							Kind:           lsp.SymbolKindNamespace,
+							Range:          lspRange(4, 0, 16, 4),
+							SelectionRange: lspRange(4, 3, 4, 12),
+							Children: []lsp.DocumentSymbol{
+								{
+									Name:           "Subsection",
+									Kind:           lsp.SymbolKindNamespace,
+									Range:          lspRange(6, 0, 16, 4),
+									SelectionRange: lspRange(7, 4, 7, 14),
+								},
+							},
+						},
+						{
+							Name:           "Section B",
+							Kind:           lsp.SymbolKindNamespace,
+							Range:          lspRange(18, 0, 23, 12),
+							SelectionRange: lspRange(18, 3, 18, 12),
+						},
+					},
+				},
+			}))
+		})
+
+		It("should return folding ranges", func() {
+			ranges := []lsp.FoldingRange{}
+			client.call("textDocument/foldingRange", lsp.FoldingRangeParams{
+				TextDocument: lsp.TextDocumentIdentifier{URI: uri},
+			}, &ranges)
+			Expect(ranges).To(Equal([]lsp.FoldingRange{
+				{StartLine: 0, EndLine: 23},  // title
+				{StartLine: 4, EndLine: 16},  // section A
+				{StartLine: 7, EndLine: 16},  // subsection
+				{StartLine: 13, EndLine: 16}, // source block
+				{StartLine: 18, EndLine: 23}, // section B
+				{StartLine: 20, EndLine: 21}, // list
+			}))
+		})
+
+		DescribeTable("should return definition",
+			func(line, character int, filename string, expected lsp.Range) {
+				locations := []lsp.Location{}
+				client.call("textDocument/definition", at(uri, line, character), &locations)
+				Expect(locations).To(Equal([]lsp.Location{
+					{
+						URI:   uriOf(filename),
+						Range: expected,
+					},
+				}))
+			},
+			Entry("cross reference", 9, 6, "doc.adoc", lspRange(6, 0, 9, 82)), // the subsection ends with the included section
+			Entry("cross reference to included section", 9, 22, "chapter.adoc", lspRange(0, 0, 3, 7)),
+			Entry("cross reference to other document", 9, 65, "other.adoc", lspRange(2, 0, 3, 9)),
+			Entry("include", 11, 12, "chapter.adoc", lspRange(0, 0, 0, 0)),
+		)
+
+		It("should not return definition", func() {
+			locations := []lsp.Location{}
+			client.call("textDocument/definition", at(uri, 9, 1), &locations)
+			Expect(locations).To(BeEmpty())
+		})
+
+		It("should complete attribute names", func() {
+			result := lsp.CompletionList{}
+			client.call("textDocument/completion", at(uri, 20, 8), &result)
+			Expect(result.Items).To(ContainElement(lsp.CompletionItem{
+				Label:  "name",
+				Kind:   lsp.CompletionItemKindVariable,
+				Detail: "value",
+			}))
+			Expect(result.Items).To(ContainElement(lsp.CompletionItem{
+				Label:  "product",
+				Kind:   lsp.CompletionItemKindVariable,
+				Detail: "libasciidoc",
+			}))
+			Expect(result.Items).To(ContainElement(lsp.CompletionItem{
+				Label:  "nbsp",
+				Kind:   lsp.CompletionItemKindVariable,
+				Detail: "&#160;",
+			}))
+		})
+
+		It("should complete cross references", func() {
+			result := lsp.CompletionList{}
+			client.call("textDocument/completion", at(uri, 9, 6), &result)
+			Expect(result.Items).To(ContainElement(lsp.CompletionItem{
+				Label:  "custom",
+				Kind:   lsp.CompletionItemKindReference,
+				Detail: "Subsection",
+			}))
+			Expect(result.Items).To(ContainElement(lsp.CompletionItem{
+				Label:  "included",
+				Kind:   lsp.CompletionItemKindReference,
+				Detail: "Included",
+			}))
+			client.call("textDocument/completion", at(uri, 9, 0), &result)
+			Expect(result.Items).To(BeEmpty())
+		})
+
+		DescribeTable("should return attribute value on hover",
+			func(line, character int, expected lsp.Hover) {
+				result := &lsp.Hover{}
+				client.call("textDocument/hover", at(uri, line, character), result)
+				Expect(*result).To(Equal(expected))
+			},
+			Entry("in list item", 20, 10, hover("value", lspRange(20, 7, 20, 13))),
+			Entry("in listing block", 15, 0, hover("value", lspRange(15, 0, 15, 6))),
+			Entry("with multibyte characters", 20, 20, hover("😀 value", lspRange(20, 18, 20, 27))),
+			Entry("from configuration", 21, 3, hover("libasciidoc", lspRange(21, 2, 21, 11))),
+			Entry("missing", 23, 4, hover("attribute 'missing' is not set", lspRange(23, 3, 23, 12))),
+		)
+
+		It("should not return hover outside of attribute reference", func() {
+			m := client.request("textDocument/hover", at(uri, 20, 2))
+			Expect(m.Error).To(BeNil())
+			Expect(string(m.Result)).To(Equal("null"))
+		})
+
+		It("should reject request on unknown document", func() {
+			m := client.request("textDocument/hover", at(uriOf("unknown.adoc"), 0, 0))
+			Expect(m.Error).To(Equal(&lsp.ResponseError{
+				Code:    lsp.InvalidParams,
+				Message: "document is not opened: '" + uriOf("unknown.adoc") + "'",
+			}))
+		})
+	})
+})
+
+func hover(value string, r lsp.Range) lsp.Hover {
+	return lsp.Hover{
+		Contents: lsp.MarkupContent{
+			Kind:  "plaintext",
+			Value: value,
+		},
+		Range: &r,
+	}
+}
@@ -280,6 +280,175 @@ with some content linked to <<thetitle,a label to the title>>!`
 			}
 			Expect(ParseDocument(source)).To(MatchDocument(expected))
 		})
+
+		It("cross reference to inline anchors", func() {
+			source := `a paragraph with an [[first,First Anchor]]anchor and an anchor:second[Second Anchor].
+
+see <<first>> and <<second>>`
+			expected := types.Document{
+				ElementReferences: types.ElementReferences{
+					"first": types.ElementReference{
+						Kind:    types.AnchorReference,
+						RefText: "First Anchor",
+					},
+					"second": types.ElementReference{
+						Kind:    types.AnchorReference,
+						RefText: "Second Anchor",
+					},
+				},
+				Elements: []interface{}{
+					types.Paragraph{
+						Lines: [][]interface{}{
+							{
+								types.StringElement{
+									Content: "a paragraph with an ",
+								},
+								types.InlineAnchor{
+									ID:      "first",
+									RefText: "First Anchor",
+								},
+								types.StringElement{
+									Content: "anchor and an ",
+								},
+								types.InlineAnchor{
+									ID:      "second",
+									RefText: "Second Anchor",
+								},
+								types.StringElement{
+									Content: ".",
+								},
+							},
+						},
+					},
+					types.Paragraph{
+						Lines: [][]interface{}{
+							{
+								types.StringElement{
+									Content: "see ",
+								},
+								types.InternalCrossReference{
+									ID: "first",
+								},
+								types.StringElement{
+									Content: " and ",
+								},
+								types.InternalCrossReference{
+									ID: "second",
+								},
+							},
+						},
+					},
+				},
+			}
+			Expect(ParseDocument(source)).To(MatchDocument(expected))
+		})
+
+		It("cross reference to section with reftext", func() {
+			source := `[[thetitle,The Title]]
+== a title`
+			expected := types.Document{
+				ElementReferences: types.ElementReferences{
+					"thetitle": types.ElementReference{
+						Kind:    types.SectionReference,
+						RefText: "The Title",
+					},
+				},
+				Elements: []interface{}{
+					types.Section{
+						Level: 1,
+						Attributes: types.Attributes{
+							types.AttrID:       "thetitle",
+							types.AttrCustomID: true,
+							types.AttrRefText:  "The Title",
+						},
+						Title: []interface{}{
+							types.StringElement{
+								Content: "a title",
+							},
+						},
+						Elements: []interface{}{},
+					},
+				},
+			}
+			Expect(ParseDocument(source)).To(MatchDocument(expected))
+		})
+
+		It("cross reference to blocks with titles", func() {
+			source := `.a figure
+image::foo.png[]
+
+[#second-figure,reftext=The Second Figure]
+.another figure
+image::bar.png[]
+
+[#table]
+.a table
+|===
+| cell
+|===`
+			expected := types.Document{
+				ElementReferences: types.ElementReferences{
+					"second-figure": types.ElementReference{
+						Kind:    types.FigureReference,
+						Caption: "Figure 2. ",
+						Title:   "another figure",
+						RefText: "The Second Figure",
+					},
+					"table": types.ElementReference{
+						Kind:    types.TableReference,
+						Caption: "Table 1. ",
+						Title:   "a table",
+					},
+				},
+				Elements: []interface{}{
+					types.ImageBlock{
+						Location: types.Location{
+							Path: []interface{}{
+								types.StringElement{Content: "foo.png"},
+							},
+						},
+						Attributes: types.Attributes{
+							types.AttrTitle:    "a figure",
+							types.AttrCaption:  "Figure 1. ",
+							types.AttrImageAlt: "foo",
+						},
+					},
+					types.ImageBlock{
+						Location: types.Location{
+							Path: []interface{}{
+								types.StringElement{Content: "bar.png"},
+							},
+						},
+						Attributes: types.Attributes{
+							types.AttrID:       "second-figure",
+							types.AttrCustomID: true,
+							types.AttrRefText:  "The Second Figure",
+							types.AttrTitle:    "another figure",
+							types.AttrCaption:  "Figure 2. ",
+							types.AttrImageAlt: "bar",
+						},
+					},
+					types.Table{
+						Attributes: types.Attributes{
+							types.AttrID:       "table",
+							types.AttrCustomID: true,
+							types.AttrTitle:    "a table",
+							types.AttrCaption:  "Table 1. ",
+						},
+						Lines: []types.TableLine{
+							{
+								Cells: [][]interface{}{
+									{
+										types.StringElement{Content: "cell"},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+			Expect(ParseDocument(source)).To(MatchDocument(expected))
+		})
 	})
 
 	Context("external references", func() {
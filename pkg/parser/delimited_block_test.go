@@ -1,6 +1,7 @@
 package parser_test
 
 import (
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
 	"github.com/bytesparadise/libasciidoc/pkg/types"
 	. "github.com/bytesparadise/libasciidoc/testsupport"
 
@@ -2740,7 +2741,8 @@ foo
 					Elements: []interface{}{
 						types.DelimitedBlock{
 							Attributes: types.Attributes{
-								types.AttrTitle: "example block title",
+								types.AttrTitle:   "example block title",
+								types.AttrCaption: "Example 1. ",
 							},
 							Kind: types.Example,
 							Elements: []interface{}{
@@ -2774,6 +2776,79 @@ foo
 			})
 		})
 
+		Context("open blocks", func() {
+
+			It("with paragraph and listing block", func() {
+				source := `--
+some *content*
+
+----
+some code
+----
+--`
+				expected := types.Document{
+					Elements: []interface{}{
+						types.DelimitedBlock{
+							Kind: types.Open,
+							Elements: []interface{}{
+								types.Paragraph{
+									Lines: [][]interface{}{
+										{
+											types.StringElement{
+												Content: "some ",
+											},
+											types.QuotedText{
+												Kind: types.Bold,
+												Elements: []interface{}{
+													types.StringElement{
+														Content: "content",
+													},
+												},
+											},
+										},
+									},
+								},
+								types.BlankLine{},
+								types.DelimitedBlock{
+									Kind: types.Listing,
+									Elements: []interface{}{
+										types.VerbatimLine{
+											Content: "some code",
+										},
+									},
+								},
+							},
+						},
+					},
+				}
+				Expect(ParseDocument(source)).To(MatchDocument(expected))
+			})
+
+			It("with block processor", func() {
+				source := `[chart, bar]
+--
+a: 1
+*b*: 2
+--`
+				var lines []string
+				var kind types.BlockKind
+				chart := func(ctx configuration.BlockContext, l []string, attributes types.Attributes) ([]interface{}, error) {
+					kind, lines = ctx.Kind, l
+					return []interface{}{
+						types.NewPassthroughBlock("<canvas></canvas>"),
+					}, nil
+				}
+				expected := types.Document{
+					Elements: []interface{}{
+						types.NewPassthroughBlock("<canvas></canvas>"),
+					},
+				}
+				Expect(ParseDocument(source, configuration.WithBlockProcessor("chart", chart))).To(MatchDocument(expected))
+				Expect(kind).To(Equal(types.Open))
+				Expect(lines).To(Equal([]string{"a: 1", "*b*: 2"}))
+			})
+		})
+
 		Context("admonition blocks", func() {
 
 			It("example block as admonition", func() {
@@ -3579,6 +3654,12 @@ end
 							},
 						},
 					},
+					ElementReferences: types.ElementReferences{
+						"id-for-source-block": types.ElementReference{
+							Kind:  types.ListingReference,
+							Title: "app.rb",
+						},
+					},
 				}
 				Expect(ParseDocument(source)).To(MatchDocument(expected))
 			})
@@ -0,0 +1,88 @@
+package parser
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+)
+
+// the key of the diagnostics reporter in the global store of the parser
+const diagnosticsKey = "diagnostics"
+
+// diagnosticsReporter reports the problems found during the parsing
+type diagnosticsReporter struct {
+	diagnostics *types.Diagnostics
+	// the problems which were already reported, by offset in the parsed content, since the parser may
+	// backtrack and match the same element more than once
+	reported map[int]map[types.DiagnosticCode]bool
+}
+
+// withDiagnostics returns the option to report the problems found during the parsing in the given diagnostics
+func withDiagnostics(diagnostics *types.Diagnostics) Option {
+	return GlobalStore(diagnosticsKey, &diagnosticsReporter{
+		diagnostics: diagnostics,
+		reported:    map[int]map[types.DiagnosticCode]bool{},
+	})
+}
+
+// warnf reports a problem with the `warning` severity on the text matched by the current rule.
+// The problem is reported with the position of the text if the source positions are recorded.
+func (c *current) warnf(code types.DiagnosticCode, format string, args ...interface{}) {
+	r, ok := c.globalStore[diagnosticsKey].(*diagnosticsReporter)
+	if !ok || r.reported[c.pos.offset][code] {
+		return
+	}
+	if r.reported[c.pos.offset] == nil {
+		r.reported[c.pos.offset] = map[types.DiagnosticCode]bool{}
+	}
+	r.reported[c.pos.offset][code] = true
+	var position types.Position
+	if m, ok := c.globalStore[positionMapperKey].(*positionMapper); ok {
+		position = m.position(c.pos, c.text)
+	}
+	r.diagnostics.Warnf(code, position, format, args...)
+}
+
+// checkTable reports the tables whose last row is incomplete, since the cells of this row are ignored
+func (c *current) checkTable(header interface{}, lines []interface{}) {
+	columns := -1
+	if header, ok := header.(types.TableLine); ok {
+		columns = len(header.Cells)
+	}
+	cells := 0
+	for _, l := range lines {
+		if l, ok := l.(types.TableLine); ok {
+			if columns == -1 {
+				columns = len(l.Cells)
+			}
+			cells += len(l.Cells)
+		}
+	}
+	if columns > 0 && cells%columns != 0 {
+		c.warnf(types.InvalidTable, "dropping %d cell(s) in the last row of the table, which has %d column(s)", cells%columns, columns)
+	}
+}
+
+// checkCrossReferences reports the cross references to the elements which do not exist in the given document
+func checkCrossReferences(doc types.Document, diagnostics *types.Diagnostics) {
+	_ = types.Walk(doc, types.VisitorFuncs{
+		EnterFunc: func(element interface{}) (bool, error) {
+			if x, ok := element.(types.InternalCrossReference); ok {
+				if _, found := doc.ElementReferences[x.ID]; !found && !isInterDocumentReference(x.ID) {
+					diagnostics.Warnf(types.UnresolvedCrossReference, x.Position, "possible invalid reference: '%s'", x.ID)
+				}
+			}
+			return true, nil
+		},
+	})
+}
+
+// isInterDocumentReference returns true if the given ID refers to another document (eg: `other.adoc#id`)
+func isInterDocumentReference(id string) bool {
+	path := id
+	if i := strings.Index(id, "#"); i >= 0 {
+		path = id[:i]
+	}
+	return filepath.Ext(path) == ".adoc"
+}
@@ -0,0 +1,187 @@
+package parser_test
+
+import (
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+	"github.com/bytesparadise/libasciidoc/pkg/parser"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	. "github.com/onsi/ginkgo" //nolint golint
+	. "github.com/onsi/gomega" //nolint golint
+)
+
+var _ = Describe("diagnostics", func() {
+
+	parseDocument := func(source string, settings ...configuration.Setting) []types.Diagnostic {
+		diagnostics := types.NewDiagnostics()
+		settings = append([]configuration.Setting{
+			configuration.WithFilename("test.adoc"),
+			configuration.WithSourcePositions(true),
+			configuration.WithDiagnostics(diagnostics),
+		}, settings...)
+		_, err := parser.ParseDocument(strings.NewReader(source), configuration.NewConfiguration(settings...))
+		Expect(err).NotTo(HaveOccurred())
+		return diagnostics.All()
+	}
+
+	position := func(filename string, startLine, startCol, endLine, endCol int) types.Position {
+		return types.Position{
+			Start: types.SourceLocation{Filename: filename, Line: startLine, Column: startCol},
+			End:   types.SourceLocation{Filename: filename, Line: endLine, Column: endCol},
+		}
+	}
+
+	It("should not report anything on a valid document", func() {
+		source := `:foo: bar
+
+[[ref]]
+a paragraph with {foo} and a <<ref>> and <<other.adoc#ref>>
+
+|===
+| a | b
+| c | d
+|===`
+		Expect(parseDocument(source)).To(BeEmpty())
+	})
+
+	It("should not fail without diagnostics", func() {
+		source := `a <<unknown>> reference to a {missing} attribute`
+		_, err := parser.ParseDocument(strings.NewReader(source), configuration.NewConfiguration())
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("should record the positions when collecting the diagnostics", func() {
+		diagnostics := types.NewDiagnostics()
+		_, err := parser.ParseDocument(strings.NewReader(`a {missing} attribute`), configuration.NewConfiguration(
+			configuration.WithFilename("test.adoc"),
+			configuration.WithDiagnostics(diagnostics)))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(diagnostics.All()).To(HaveLen(1))
+		Expect(diagnostics.All()[0].Position).To(Equal(position("test.adoc", 1, 3, 1, 12)))
+	})
+
+	Context("file inclusions", func() {
+
+		It("should report unresolved file inclusion", func() {
+			source := `include::../../test/includes/unknown.adoc[]`
+			diagnostics := parseDocument(source)
+			Expect(diagnostics).To(HaveLen(1))
+			Expect(diagnostics[0].Severity).To(Equal(types.DiagnosticError))
+			Expect(diagnostics[0].Code).To(Equal(types.UnresolvedInclude))
+			Expect(diagnostics[0].Message).To(HavePrefix("unable to read file to include '../../test/includes/unknown.adoc': "))
+			Expect(diagnostics[0].Position).To(Equal(position("test.adoc", 1, 1, 1, 44)))
+		})
+
+		It("should report unresolved file inclusion with attribute in path", func() {
+			// the missing attribute is reported when the error message is substituted
+			source := `include::{includedir}/unknown.adoc[]`
+			diagnostics := parseDocument(source)
+			Expect(diagnostics).To(HaveLen(2))
+			Expect(diagnostics[0].Code).To(Equal(types.UnresolvedInclude))
+			Expect(diagnostics[1].Code).To(Equal(types.MissingAttribute))
+		})
+
+		It("should report include cycle", func() {
+			source := `include::../../test/includes/self-include.adoc[]`
+			diagnostics := parseDocument(source)
+			Expect(diagnostics).To(HaveLen(1))
+			Expect(diagnostics[0].Code).To(Equal(types.UnresolvedInclude))
+			Expect(diagnostics[0].Message).To(Equal("cannot include 'self-include.adoc': include cycle detected"))
+			// the position is in the included file
+			Expect(diagnostics[0].Position.Start.Filename).To(HaveSuffix("self-include.adoc"))
+		})
+
+		It("should report file inclusion in secure mode", func() {
+			source := `include::../../test/includes/chapter-a.adoc[]`
+			Expect(parseDocument(source, configuration.WithSafeMode(configuration.Secure))).To(Equal([]types.Diagnostic{
+				{
+					Severity: types.DiagnosticWarning,
+					Code:     types.IncludeNotAllowed,
+					Message:  "cannot include '../../test/includes/chapter-a.adoc' in secure mode",
+					Position: position("test.adoc", 1, 1, 1, 46),
+				},
+			}))
+		})
+
+		It("should report missing tag", func() {
+			source := `include::../../test/includes/tag-include.adoc[tag=unknown]`
+			diagnostics := parseDocument(source)
+			Expect(diagnostics).To(HaveLen(1))
+			Expect(diagnostics[0].Severity).To(Equal(types.DiagnosticWarning))
+			Expect(diagnostics[0].Code).To(Equal(types.UnresolvedIncludeTag))
+			Expect(diagnostics[0].Message).To(Equal("tag 'unknown' not found in include file '../../test/includes/tag-include.adoc'"))
+		})
+	})
+
+	It("should report missing attributes", func() {
+		source := `a {missing} attribute`
+		Expect(parseDocument(source)).To(Equal([]types.Diagnostic{
+			{
+				Severity: types.DiagnosticWarning,
+				Code:     types.MissingAttribute,
+				Message:  "unable to find attribute 'missing'",
+				Position: position("test.adoc", 1, 3, 1, 12),
+			},
+		}))
+	})
+
+	It("should report unresolved cross references", func() {
+		source := `== Section A
+
+a <<unknown>> reference
+
+* an item with a <<_section_a>> reference
+* and an <<other,unknown>> reference`
+		diagnostics := parseDocument(source)
+		Expect(diagnostics).To(Equal([]types.Diagnostic{
+			{
+				Severity: types.DiagnosticWarning,
+				Code:     types.UnresolvedCrossReference,
+				Message:  "possible invalid reference: 'unknown'",
+				Position: position("test.adoc", 3, 3, 3, 14),
+			},
+			{
+				Severity: types.DiagnosticWarning,
+				Code:     types.UnresolvedCrossReference,
+				Message:  "possible invalid reference: 'other'",
+				Position: position("test.adoc", 6, 10, 6, 27),
+			},
+		}))
+	})
+
+	It("should report duplicate IDs", func() {
+		source := `[[foo]]
+== Section A
+
+[#foo]
+a paragraph
+
+== Section A`
+		Expect(parseDocument(source)).To(Equal([]types.Diagnostic{
+			{
+				Severity: types.DiagnosticWarning,
+				Code:     types.DuplicateID,
+				Message:  "id assigned to block already in use: 'foo'",
+				Position: position("test.adoc", 4, 1, 5, 12),
+			},
+		}))
+	})
+
+	It("should report incomplete table rows", func() {
+		source := `====
+|===
+| a | b
+| c
+|===
+====`
+		Expect(parseDocument(source)).To(Equal([]types.Diagnostic{
+			{
+				Severity: types.DiagnosticWarning,
+				Code:     types.InvalidTable,
+				Message:  "dropping 1 cell(s) in the last row of the table, which has 2 column(s)",
+				Position: position("test.adoc", 2, 1, 5, 5),
+			},
+		}))
+	})
+})
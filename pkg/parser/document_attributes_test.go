@@ -1,7 +1,10 @@
 package parser_test
 
 import (
+	"strings"
+
 	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+	"github.com/bytesparadise/libasciidoc/pkg/parser"
 	"github.com/bytesparadise/libasciidoc/pkg/types"
 	. "github.com/bytesparadise/libasciidoc/testsupport"
 
@@ -1389,5 +1392,178 @@ a paragraph written by {author}.`
 			}
 			Expect(ParseDocument(source, configuration.WithAttributes(attrs))).To(Equal(expected))
 		})
+
+		It("hard-set attribute cannot be changed by the document", func() {
+			source := `:author: Xavier
+
+{author}`
+			expected := types.Document{
+				Attributes: types.Attributes{
+					"author": "John",
+				},
+				Elements: []interface{}{
+					types.Paragraph{
+						Lines: [][]interface{}{
+							{
+								types.StringElement{Content: "John"},
+							},
+						},
+					},
+				},
+			}
+			Expect(ParseDocument(source, configuration.WithAttribute("author", "John"))).To(Equal(expected))
+		})
+
+		It("soft-set attribute can be changed by the document", func() {
+			source := `{author}
+
+:author: Xavier
+
+{author}`
+			expected := types.Document{
+				Attributes: types.Attributes{
+					"author": "Xavier",
+				},
+				Elements: []interface{}{
+					types.Paragraph{
+						Lines: [][]interface{}{
+							{
+								types.StringElement{Content: "John"},
+							},
+						},
+					},
+					types.Paragraph{
+						Lines: [][]interface{}{
+							{
+								types.StringElement{Content: "Xavier"},
+							},
+						},
+					},
+				},
+			}
+			Expect(ParseDocument(source, configuration.WithSoftAttribute("author", "John"))).To(Equal(expected))
+		})
+
+		It("soft-set attribute with value suffix can be reset by the document", func() {
+			source := `:!author:
+
+{author}`
+			expected := types.Document{
+				Elements: []interface{}{
+					types.Paragraph{
+						Lines: [][]interface{}{
+							{
+								types.StringElement{Content: "{author}"},
+							},
+						},
+					},
+				},
+			}
+			Expect(ParseDocument(source, configuration.WithAttributes(map[string]string{
+				"author": "John@",
+			}))).To(Equal(expected))
+		})
+
+		It("hard-unset attribute cannot be set by the document", func() {
+			source := `:author: Xavier
+
+{author}`
+			expected := types.Document{
+				Elements: []interface{}{
+					types.Paragraph{
+						Lines: [][]interface{}{
+							{
+								types.StringElement{Content: "{author}"},
+							},
+						},
+					},
+				},
+			}
+			Expect(ParseDocument(source, configuration.WithAttributeUnset("author"))).To(Equal(expected))
+		})
+
+		It("soft-unset attribute can be set by the document", func() {
+			source := `:author: Xavier
+
+{author}`
+			expected := types.Document{
+				Attributes: types.Attributes{
+					"author": "Xavier",
+				},
+				Elements: []interface{}{
+					types.Paragraph{
+						Lines: [][]interface{}{
+							{
+								types.StringElement{Content: "Xavier"},
+							},
+						},
+					},
+				},
+			}
+			Expect(ParseDocument(source, configuration.WithAttributeSoftUnset("author"))).To(Equal(expected))
+		})
+	})
+
+	Context("document with safe mode", func() {
+
+		It("document cannot set source highlighter in server mode", func() {
+			source := `:source-highlighter: pygments
+:icons: font`
+			expected := types.Document{
+				Attributes: types.Attributes{
+					"icons": "font",
+				},
+				Elements: []interface{}{},
+			}
+			Expect(ParseDocument(source, configuration.WithSafeMode(configuration.Server))).To(Equal(expected))
+		})
+
+		It("document cannot set icons in secure mode", func() {
+			source := `:source-highlighter: pygments
+:icons: font`
+			expected := types.Document{
+				Elements: []interface{}{},
+			}
+			Expect(ParseDocument(source, configuration.WithSafeMode(configuration.Secure))).To(Equal(expected))
+		})
+
+		It("source highlighter and icons can be set by the API in secure mode", func() {
+			source := `:source-highlighter: chroma`
+			expected := types.Document{
+				Attributes: types.Attributes{
+					"icons":              "font",
+					"source-highlighter": "chroma", // soft-set via the API, so the document can change it
+				},
+				Elements: []interface{}{},
+			}
+			Expect(ParseDocument(source,
+				configuration.WithSafeMode(configuration.Secure),
+				configuration.WithAttribute("icons", "font"),
+				configuration.WithSoftAttribute("source-highlighter", "pygments"),
+			)).To(Equal(expected))
+		})
+
+		It("document cannot set icons when the secure mode is set after the configuration was created", func() {
+			source := `:icons: font`
+			config := configuration.NewConfiguration()
+			config.SafeMode = configuration.Secure
+			expected := types.Document{
+				Elements: []interface{}{},
+			}
+			Expect(parser.ParseDocument(strings.NewReader(source), config)).To(Equal(expected))
+		})
+
+		It("document can set icons when the unsafe mode is set after the configuration was created", func() {
+			source := `:icons: font`
+			config := configuration.NewConfiguration(configuration.WithSafeMode(configuration.Secure))
+			config.SafeMode = configuration.Unsafe
+			expected := types.Document{
+				Attributes: types.Attributes{
+					"icons": "font",
+				},
+				Elements: []interface{}{},
+			}
+			Expect(parser.ParseDocument(strings.NewReader(source), config)).To(Equal(expected))
+		})
 	})
 })
@@ -8,6 +8,7 @@ import (
 	"github.com/bytesparadise/libasciidoc/pkg/configuration"
 	"github.com/bytesparadise/libasciidoc/pkg/types"
 	"github.com/davecgh/go-spew/spew"
+	"github.com/pkg/errors"
 
 	log "github.com/sirupsen/logrus"
 )
@@ -20,36 +21,48 @@ const LevelOffset ContextKey = "leveloffset"
 
 // ParseDraftDocument parses a document's content and applies the preprocessing directives (file inclusions)
 func ParseDraftDocument(r io.Reader, config configuration.Configuration, options ...Option) (types.DraftDocument, error) {
-	options = append(options, Entrypoint("AsciidocDocument"))
-	return parseDraftDocument(r, []levelOffset{}, config, options...)
+	config = config.WithLockedAttributes()
+	lines, err := Preprocess(r, config)
+	if err != nil {
+		return types.DraftDocument{}, err
+	}
+	return parseDraftDocument(lines, config, false, options...)
 }
 
-func parseDraftDocument(r io.Reader, levelOffsets []levelOffset, config configuration.Configuration, options ...Option) (types.DraftDocument, error) {
+// ParseRawDraftDocument parses a document's content without applying the preprocessing directives, i.e.,
+// the `include::` directives are retained as `types.FileInclusion` elements (or as verbatim lines in the delimited blocks).
+// This is meant for the tools which write the document back (eg: a formatter)
+func ParseRawDraftDocument(r io.Reader, config configuration.Configuration, options ...Option) (types.DraftDocument, error) {
+	config = config.WithLockedAttributes()
+	lines, err := readLines(r, config.Filename)
+	if err != nil {
+		return types.DraftDocument{}, errors.Wrapf(err, "unable to read '%s'", config.Filename)
+	}
+	return parseDraftDocument(lines, config, true, options...)
+}
+
+// parseDraftDocument parses the given lines, in which the `include::` directives are kept
+// as `types.FileInclusion` elements if `raw` is true (or converted to text otherwise)
+func parseDraftDocument(lines []PreprocessedLine, config configuration.Configuration, raw bool, options ...Option) (types.DraftDocument, error) {
 	log.Debugf("parsing draft document '%s'", config.Filename)
-	d, err := ParseReader(config.Filename, r, options...)
+	if config.Diagnostics != nil {
+		config.SourcePositions = true // the problems are reported with their position
+	}
+	parseOptions := append([]Option{Entrypoint("AsciidocDocument")}, options...)
+	if config.SourcePositions {
+		parseOptions = append(parseOptions, sourcePositionsOf(lines))
+	}
+	if config.Diagnostics != nil {
+		parseOptions = append(parseOptions, withDiagnostics(config.Diagnostics))
+	}
+	d, err := ParseReader(config.Filename, joinLines(lines), parseOptions...)
 	if err != nil {
 		return types.DraftDocument{}, err
 	}
 	doc := d.(types.DraftDocument)
-	attrs := types.AttributesWithOverrides{
-		Content:   map[string]interface{}{},
-		Overrides: map[string]string{},
-	}
-	doc.Blocks, err = processFileInclusions(doc.Blocks, attrs, levelOffsets, config, options...)
+	doc.Blocks, err = processDraftBlocks(doc.Blocks, config, raw, options...)
 	if err != nil {
-		return types.DraftDocument{
-			Blocks: []interface{}{
-				types.Paragraph{
-					Lines: [][]interface{}{
-						{
-							types.StringElement{
-								Content: err.Error(),
-							},
-						},
-					},
-				},
-			},
-		}, nil
+		return types.DraftDocument{}, err
 	}
 	if log.IsLevelEnabled(log.DebugLevel) {
 		log.Debug("draft document:")
@@ -58,65 +71,67 @@ func parseDraftDocument(r io.Reader, levelOffsets []levelOffset, config configur
 	return doc, nil
 }
 
-// processFileInclusions resolves the file inclusions if any is found in the given elements
-// and applies level offset on sections when needed
-func processFileInclusions(elements []interface{}, attrs types.AttributesWithOverrides, levelOffsets []levelOffset, config configuration.Configuration, options ...Option) ([]interface{}, error) {
-	result := []interface{}{}
-	log.Debugf("processing file inclusions found in %d element(s)", len(elements))
+// processDraftBlocks parses the content of the delimited blocks found in the given elements, depending on their kind.
+// Also, the `include::` directives which were not processed during the preprocessing (ie, in non-asciidoc files)
+// are retained as text (unless the document is parsed without preprocessing).
+func processDraftBlocks(elements []interface{}, config configuration.Configuration, raw bool, options ...Option) ([]interface{}, error) {
+	result := make([]interface{}, 0, len(elements))
 	for _, e := range elements {
 		switch e := e.(type) {
-		case types.AttributeDeclaration: // may be needed if there's an attribute substitution in the path of the file to include
-			attrs.Set(e.Name, e.Value)
-			result = append(result, e)
 		case types.FileInclusion:
-			// read the file and include its content
-			embedded, err := parseFileToInclude(e, attrs, levelOffsets, config, options...)
-			if errr, ok := err.(FileInclusionError); ok {
-				log.Errorf("failed to include content of '%s' in '%s'", e.Location, errr.Filename)
-				return nil, err
-			} else if err != nil {
-				return nil, err
+			if raw {
+				result = append(result, e)
+				continue
 			}
-			result = append(result, embedded.Blocks...)
+			result = append(result, includedParagraph(e))
 		case types.DelimitedBlock:
-			elmts, err := processFileInclusions(e.Elements, attrs, levelOffsets, config,
-				// use a new var to avoid overridding the current one which needs to stay as-is for the rest of the doc parsing
-				append(options, Entrypoint("VerbatimDocument"))...)
-			if err != nil {
-				// do not fail but retain the error message
-				elmts = []interface{}{
-					types.VerbatimLine{
-						Content: err.Error(),
-					},
+			// the raw lines of a block which has a processor are processed once the whole document is parsed
+			_, processed := blockProcessor(config, e.Kind, e.Attributes)
+			elmts := make([]interface{}, len(e.Elements))
+			for i, elmt := range e.Elements {
+				if incl, ok := elmt.(types.FileInclusion); ok {
+					elmt = types.VerbatimLine{
+						Content:  incl.RawText,
+						Position: incl.Position,
+					}
 				}
+				elmts[i] = elmt
 			}
-			// next, parse the elements with the grammar rule that corresponds to the delimited block substitutions (based on its type)
-			extraAttrs, elmts, err := parseDelimitedBlockContent(config.Filename, e.Kind, elmts, options...)
-			if err != nil {
-				return nil, err
+			blockOptions := append([]Option{}, options...)
+			if config.SourcePositions {
+				// the positions of the elements of the block are based on the positions of their (verbatim) lines
+				if opt, ok := sourcePositionsOfElements(elmts); ok {
+					blockOptions = append(blockOptions, opt)
+				}
 			}
-			if e.Attributes == nil && len(extraAttrs) > 0 {
-				e.Attributes = types.Attributes{}
+			if config.Diagnostics != nil {
+				blockOptions = append(blockOptions, withDiagnostics(config.Diagnostics))
+			}
+			if !processed {
+				// parse the elements with the grammar rule that corresponds to the delimited block substitutions (based on its type)
+				extraAttrs, elements, err := parseDelimitedBlockContent(config.Filename, e.Kind, elmts, blockOptions...)
+				if err != nil {
+					return nil, err
+				}
+				if e.Attributes == nil && len(extraAttrs) > 0 {
+					e.Attributes = types.Attributes{}
+				}
+				e.Attributes.Add(extraAttrs)
+				elmts = elements
+				if !raw {
+					for i, elmt := range elmts {
+						if incl, ok := elmt.(types.FileInclusion); ok {
+							elmts[i] = includedParagraph(incl)
+						}
+					}
+				}
 			}
-			e.Attributes.Add(extraAttrs)
 			result = append(result, types.DelimitedBlock{
 				Attributes: e.Attributes,
 				Kind:       e.Kind,
 				Elements:   elmts,
+				Position:   e.Position,
 			})
-		case types.Section:
-			for _, offset := range levelOffsets {
-				oldLevel := e.Level
-				offset.apply(&e)
-				// replace the absolute when the first section is processed with a relative offset
-				// which is based on the actual level offset that resulted in the application of the absolute offset
-				if offset.absolute {
-					levelOffsets = []levelOffset{
-						relativeOffset(e.Level - oldLevel),
-					}
-				}
-			}
-			result = append(result, e)
 		default:
 			result = append(result, e)
 		}
@@ -124,14 +139,41 @@ func processFileInclusions(elements []interface{}, attrs types.AttributesWithOve
 	return result, nil
 }
 
+// includedParagraph returns the paragraph which retains the given `include::` directive as text
+// (eg: in a non-asciidoc file)
+func includedParagraph(incl types.FileInclusion) types.Paragraph {
+	return types.Paragraph{
+		Lines: [][]interface{}{
+			{
+				types.StringElement{
+					Content:  incl.RawText,
+					Position: incl.Position,
+				},
+			},
+		},
+		Position: incl.Position,
+	}
+}
+
+// isVerbatim returns true if the content of the delimited blocks of the given kind is not parsed
+func isVerbatim(kind types.BlockKind) bool {
+	switch kind {
+	case types.Fenced, types.Listing, types.Literal, types.Source, types.Comment, types.Passthrough:
+		return true
+	default:
+		return false
+	}
+}
+
 // parseDelimitedBlockContent parses the given verbatim elements, depending on the given delimited block kind.
 // May return the elements unchanged, or convert the elements to a source doc and parse with a custom entrypoint
 func parseDelimitedBlockContent(filename string, kind types.BlockKind, elements []interface{}, options ...Option) (types.Attributes, []interface{}, error) {
-	switch kind {
-	case types.Fenced, types.Listing, types.Literal, types.Source, types.Comment, types.Passthrough:
+	if isVerbatim(kind) {
 		// return the verbatim elements
 		return types.Attributes{}, elements, nil
-	case types.Example, types.Quote, types.Sidebar:
+	}
+	switch kind {
+	case types.Example, types.Quote, types.Sidebar, types.Open:
 		return parseDelimitedBlockElements(filename, elements, append(options, Entrypoint("NormalBlockContent"))...)
 	case types.MarkdownQuote:
 		return parseMarkdownQuoteBlockElements(filename, elements, append(options, Entrypoint("NormalBlockContent"))...)
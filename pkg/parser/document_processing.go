@@ -6,32 +6,35 @@ import (
 	"github.com/bytesparadise/libasciidoc/pkg/configuration"
 	"github.com/bytesparadise/libasciidoc/pkg/types"
 	"github.com/davecgh/go-spew/spew"
+	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
 )
 
-// ParseDocument parses the content of the reader identitied by the filename
+// ParseDocument parses the content of the reader identified by the filename
 func ParseDocument(r io.Reader, config configuration.Configuration) (types.Document, error) {
+	// the attributes are locked according to the current safe mode
+	config = config.WithLockedAttributes()
 	draftDoc, err := ParseDraftDocument(r, config)
 	if err != nil {
 		return types.Document{}, err
 	}
-	attrs := types.AttributesWithOverrides{
-		Content:   types.Attributes{},
-		Overrides: config.AttributeOverrides,
-	}
+	attrs := types.NewAttributesWithOverrides(config.AttributeOverrides)
 	// also, add all front-matter key/values
 	attrs.Add(draftDoc.FrontMatter.Content)
 	// also, add all AttributeDeclaration at the top of the document
 	attrs.Add(draftDoc.Attributes())
 
+	// assign the captions of the figures, tables, etc.
+	assignCaptions(draftDoc.Blocks, attrs, config)
+
 	// apply document attribute substitutions and re-parse paragraphs that were affected
-	blocks, _, err := applyAttributeSubstitutions(draftDoc.Blocks, attrs)
+	blocks, _, err := applyAttributeSubstitutions(draftDoc.Blocks, attrs, config.Diagnostics)
 	if err != nil {
 		return types.Document{}, err
 	}
 
 	// now, merge list items into proper lists
-	blocks, err = rearrangeListItems(blocks.([]interface{}), false)
+	blocks, err = rearrangeListItems(blocks.([]interface{}), false, false)
 	if err != nil {
 		return types.Document{}, err
 	}
@@ -40,9 +43,13 @@ func ParseDocument(r io.Reader, config configuration.Configuration) (types.Docum
 
 	blocks, footnotes := processFootnotes(blocks.([]interface{}))
 	// now, rearrange elements in a hierarchical manner
-	doc := rearrangeSections(blocks.([]interface{}))
+	doc := rearrangeSections(blocks.([]interface{}), config.Diagnostics)
 	// also, set the footnotes
 	doc.Footnotes = footnotes
+	// report the cross references to unknown elements
+	if config.Diagnostics != nil {
+		checkCrossReferences(doc, config.Diagnostics)
+	}
 	// insert the preamble at the right location
 	doc = includePreamble(doc)
 	// and add all remaining attributes, too
@@ -51,6 +58,10 @@ func ParseDocument(r io.Reader, config configuration.Configuration) (types.Docum
 		doc.Attributes = types.Attributes{}
 	}
 	doc.Attributes.Add(extraAttrs)
+	// replace the delimited blocks which have a processor
+	if doc, err = processDocumentBlocks(doc, config); err != nil {
+		return types.Document{}, err
+	}
 	// also insert the table of contents
 	doc = includeTableOfContentsPlaceHolder(doc)
 	// finally
@@ -60,3 +71,47 @@ func ParseDocument(r io.Reader, config configuration.Configuration) (types.Docum
 	}
 	return doc, nil
 }
+
+// ParseLosslessDocument parses the content of the reader identified by the filename, without losing any content of
+// the source: unlike in `ParseDocument()`, the blank lines, the comments, the attribute declarations and the
+// `include::` directives (which are not processed) are retained in the resulting document, the attribute
+// substitutions and the block processors are not applied, and all elements have their position in the source.
+// This is meant for the tools which rewrite the documents (eg: refactoring, linters), not for the rendering.
+func ParseLosslessDocument(r io.Reader, config configuration.Configuration) (types.LosslessDocument, error) {
+	config = config.WithLockedAttributes()
+	lines, err := readLines(r, config.Filename)
+	if err != nil {
+		return types.LosslessDocument{}, errors.Wrapf(err, "unable to read '%s'", config.Filename)
+	}
+	config.SourcePositions = true
+	draftDoc, err := parseDraftDocument(lines, config, true)
+	if err != nil {
+		return types.LosslessDocument{}, err
+	}
+	attrs := types.NewAttributesWithOverrides(config.AttributeOverrides)
+	attrs.Add(draftDoc.FrontMatter.Content)
+	attrs.Add(draftDoc.Attributes())
+	// merge list items into proper lists, but retain the blank lines and the comments
+	blocks, err := rearrangeListItems(draftDoc.Blocks, false, true)
+	if err != nil {
+		return types.LosslessDocument{}, err
+	}
+	doc := rearrangeSections(blocks, config.Diagnostics)
+	if config.Diagnostics != nil {
+		checkCrossReferences(doc, config.Diagnostics)
+	}
+	doc = includePreamble(doc)
+	extraAttrs := attrs.All()
+	if doc.Attributes == nil && len(extraAttrs) > 0 {
+		doc.Attributes = types.Attributes{}
+	}
+	doc.Attributes.Add(extraAttrs)
+	source := make([]string, len(lines))
+	for i, l := range lines {
+		source[i] = l.Content
+	}
+	return types.LosslessDocument{
+		Document: doc,
+		Lines:    source,
+	}, nil
+}
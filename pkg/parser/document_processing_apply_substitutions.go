@@ -9,9 +9,10 @@ import (
 )
 
 // applyAttributeSubstitutions(elements applies the document attribute substitutions
-// and re-parse the paragraphs that were affected
+// and re-parse the paragraphs that were affected. The substitutions of missing attributes are reported
+// in the given diagnostics
 // nolint: gocyclo
-func applyAttributeSubstitutions(element interface{}, attrs types.AttributesWithOverrides) (interface{}, bool, error) {
+func applyAttributeSubstitutions(element interface{}, attrs types.AttributesWithOverrides, diagnostics *types.Diagnostics) (interface{}, bool, error) {
 	// the document attributes, as they are resolved while processing the blocks
 	// log.Debugf("applying document substitutions on block of type %T", element)
 	switch e := element.(type) {
@@ -19,7 +20,7 @@ func applyAttributeSubstitutions(element interface{}, attrs types.AttributesWith
 		elements := make([]interface{}, 0, len(e)) // maximum capacity cannot exceed initial input
 		applied := false
 		for _, element := range e {
-			r, a, err := applyAttributeSubstitutions(element, attrs)
+			r, a, err := applyAttributeSubstitutions(element, attrs, diagnostics)
 			if err != nil {
 				return []interface{}{}, false, err
 			}
@@ -41,12 +42,15 @@ func applyAttributeSubstitutions(element interface{}, attrs types.AttributesWith
 	case types.AttributeSubstitution:
 		if value, ok := attrs.GetAsString(e.Name); ok {
 			return types.StringElement{
-				Content: value,
+				Content:  value,
+				Position: e.Position,
 			}, true, nil
 		}
 		log.Warnf("unable to find attribute '%s'", e.Name)
+		diagnostics.Warnf(types.MissingAttribute, e.Position, "unable to find attribute '%s'", e.Name)
 		return types.StringElement{
-			Content: "{" + e.Name + "}",
+			Content:  "{" + e.Name + "}",
+			Position: e.Position,
 		}, false, nil
 	case types.ImageBlock:
 		return e.ResolveLocation(attrs), false, nil
@@ -55,7 +59,7 @@ func applyAttributeSubstitutions(element interface{}, attrs types.AttributesWith
 	case types.ExternalCrossReference:
 		return e.ResolveLocation(attrs), false, nil
 	case types.Section:
-		title, applied, err := applyAttributeSubstitutions(e.Title, attrs)
+		title, applied, err := applyAttributeSubstitutions(e.Title, attrs, diagnostics)
 		if err != nil {
 			return struct{}{}, false, err
 		}
@@ -65,42 +69,42 @@ func applyAttributeSubstitutions(element interface{}, attrs types.AttributesWith
 		e, err = e.ResolveID(attrs)
 		return e, applied, err
 	case types.OrderedListItem:
-		elements, applied, err := applyAttributeSubstitutions(e.Elements, attrs)
+		elements, applied, err := applyAttributeSubstitutions(e.Elements, attrs, diagnostics)
 		if err != nil {
 			return struct{}{}, false, err
 		}
 		e.Elements = elements.([]interface{})
 		return e, applied, nil
 	case types.UnorderedListItem:
-		elements, applied, err := applyAttributeSubstitutions(e.Elements, attrs)
+		elements, applied, err := applyAttributeSubstitutions(e.Elements, attrs, diagnostics)
 		if err != nil {
 			return struct{}{}, false, err
 		}
 		e.Elements = elements.([]interface{})
 		return e, applied, nil
 	case types.LabeledListItem:
-		elements, applied, err := applyAttributeSubstitutions(e.Elements, attrs)
+		elements, applied, err := applyAttributeSubstitutions(e.Elements, attrs, diagnostics)
 		if err != nil {
 			return struct{}{}, false, err
 		}
 		e.Elements = elements.([]interface{})
 		return e, applied, nil
 	case types.QuotedText:
-		elements, applied, err := applyAttributeSubstitutions(e.Elements, attrs)
+		elements, applied, err := applyAttributeSubstitutions(e.Elements, attrs, diagnostics)
 		if err != nil {
 			return struct{}{}, false, err
 		}
 		e.Elements = elements.([]interface{})
 		return e, applied, nil
 	case types.ContinuedListItemElement:
-		element, applied, err := applyAttributeSubstitutions(e.Element, attrs)
+		element, applied, err := applyAttributeSubstitutions(e.Element, attrs, diagnostics)
 		if err != nil {
 			return struct{}{}, false, err
 		}
 		e.Element = element
 		return e, applied, nil
 	case types.DelimitedBlock:
-		elements, applied, err := applyAttributeSubstitutions(e.Elements, attrs)
+		elements, applied, err := applyAttributeSubstitutions(e.Elements, attrs, diagnostics)
 		if err != nil {
 			return struct{}{}, false, err
 		}
@@ -109,7 +113,7 @@ func applyAttributeSubstitutions(element interface{}, attrs types.AttributesWith
 	case types.Paragraph:
 		applied := false
 		for i, line := range e.Lines {
-			line, a, err := applyAttributeSubstitutions(line, attrs)
+			line, a, err := applyAttributeSubstitutions(line, attrs, diagnostics)
 			if err != nil {
 				return struct{}{}, false, err
 			}
@@ -135,7 +139,11 @@ func parseInlineLinks(elements []interface{}) ([]interface{}, error) {
 				return []interface{}{}, errors.Wrap(err, "error while parsing content for inline links")
 			}
 			log.Debugf("  found: %+v", elements)
-			result = append(result, elements.([]interface{})...)
+			for _, e := range elements.([]interface{}) {
+				// the content may be the result of attribute substitutions, so the elements
+				// found in the string element retain its position
+				result = append(result, types.WithPosition(e, element.Position))
+			}
 		default:
 			result = append(result, element)
 		}
@@ -31,7 +31,7 @@ var _ = Describe("document attribute subsititutions", func() {
 				"foo": "bar",
 			},
 			Overrides: map[string]string{},
-		})
+		}, nil)
 		// then
 		Expect(err).To(Not(HaveOccurred()))
 		Expect(applied).To(BeTrue())
@@ -70,7 +70,7 @@ var _ = Describe("document attribute subsititutions", func() {
 				"foo": "bar",
 			},
 			Overrides: map[string]string{},
-		})
+		}, nil)
 		// then
 		Expect(err).To(Not(HaveOccurred()))
 		Expect(applied).To(BeTrue())
@@ -112,7 +112,7 @@ var _ = Describe("document attribute subsititutions", func() {
 				"foo": "bar",
 			},
 			Overrides: map[string]string{},
-		})
+		}, nil)
 		// then
 		Expect(err).To(Not(HaveOccurred()))
 		Expect(applied).To(BeTrue())
@@ -152,7 +152,7 @@ var _ = Describe("document attribute subsititutions", func() {
 		result, applied, err := applyAttributeSubstitutions(elements, types.AttributesWithOverrides{
 			Content:   map[string]interface{}{},
 			Overrides: map[string]string{},
-		})
+		}, nil)
 
 		// then
 		Expect(err).To(Not(HaveOccurred()))
@@ -193,7 +193,7 @@ var _ = Describe("document attribute subsititutions", func() {
 		result, applied, err := applyAttributeSubstitutions(elements, types.AttributesWithOverrides{
 			Content:   map[string]interface{}{},
 			Overrides: map[string]string{},
-		})
+		}, nil)
 
 		// then
 		Expect(err).To(Not(HaveOccurred()))
@@ -244,7 +244,7 @@ var _ = Describe("document attribute subsititutions", func() {
 				"host":   "foo.bar",
 			},
 			Overrides: map[string]string{},
-		})
+		}, nil)
 
 		// then
 		Expect(err).To(Not(HaveOccurred()))
@@ -303,7 +303,7 @@ var _ = Describe("document attribute subsititutions", func() {
 					"foo": "bar",
 				},
 				Overrides: map[string]string{},
-			})
+			}, nil)
 			// then
 			Expect(err).To(Not(HaveOccurred()))
 			Expect(applied).To(BeTrue())
@@ -350,7 +350,7 @@ var _ = Describe("document attribute subsititutions", func() {
 					"foo": "bar",
 				},
 				Overrides: map[string]string{},
-			})
+			}, nil)
 			// then
 			Expect(err).To(Not(HaveOccurred()))
 			Expect(applied).To(BeTrue())
@@ -397,7 +397,7 @@ var _ = Describe("document attribute subsititutions", func() {
 					"foo": "bar",
 				},
 				Overrides: map[string]string{},
-			})
+			}, nil)
 			// then
 			Expect(err).To(Not(HaveOccurred()))
 			Expect(applied).To(BeTrue())
@@ -447,7 +447,7 @@ var _ = Describe("document attribute subsititutions", func() {
 					"foo": "bar",
 				},
 				Overrides: map[string]string{},
-			})
+			}, nil)
 			// then
 			Expect(err).To(Not(HaveOccurred()))
 			Expect(applied).To(BeTrue())
@@ -505,7 +505,7 @@ var _ = Describe("document attribute subsititutions", func() {
 					"foo": "bar",
 				},
 				Overrides: map[string]string{},
-			})
+			}, nil)
 			// then
 			Expect(err).To(Not(HaveOccurred()))
 			Expect(applied).To(BeTrue())
@@ -557,7 +557,7 @@ var _ = Describe("document attribute subsititutions", func() {
 					"foo": "bar",
 				},
 				Overrides: map[string]string{},
-			})
+			}, nil)
 			// then
 			Expect(err).To(Not(HaveOccurred()))
 			Expect(applied).To(BeTrue())
@@ -606,7 +606,7 @@ var _ = Describe("document attribute subsititutions", func() {
 				Overrides: map[string]string{
 					"foo": "BAR",
 				},
-			})
+			}, nil)
 			// then
 			Expect(err).To(Not(HaveOccurred()))
 			Expect(applied).To(BeTrue())
@@ -0,0 +1,64 @@
+package parser
+
+import (
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// processDocumentBlocks replaces the delimited blocks of the given document whose style has a processor in the configuration
+func processDocumentBlocks(doc types.Document, config configuration.Configuration) (types.Document, error) {
+	return types.Rewrite(doc, func(element interface{}) ([]interface{}, bool, error) {
+		switch b := element.(type) {
+		case types.DelimitedBlock:
+			lines := make([]string, 0, len(b.Elements))
+			for _, e := range b.Elements {
+				if l, ok := e.(types.VerbatimLine); ok {
+					lines = append(lines, l.Content)
+				}
+			}
+			return processBlock(doc, config, b.Kind, lines, b.Attributes, b.Position)
+		case types.LiteralBlock:
+			return processBlock(doc, config, types.Literal, b.Lines, b.Attributes, b.Position)
+		default:
+			return nil, false, nil
+		}
+	})
+}
+
+// processBlock calls the processor of the given block, if defined in the configuration
+func processBlock(doc types.Document, config configuration.Configuration, kind types.BlockKind, lines []string, attributes types.Attributes, position types.Position) ([]interface{}, bool, error) {
+	p, found := blockProcessor(config, kind, attributes)
+	if !found {
+		return nil, false, nil
+	}
+	style, _ := attributes.GetAsString(types.AttrStyle)
+	elements, err := p(configuration.BlockContext{
+		Document: doc,
+		Config:   config,
+		Kind:     kind,
+		Position: position,
+	}, lines, attributes)
+	if err != nil {
+		return nil, false, errors.Wrapf(err, "unable to process the '%s' block", style)
+	}
+	log.Debugf("replaced the '%s' block with %d element(s)", style, len(elements))
+	if elements == nil {
+		// the block is removed
+		elements = []interface{}{}
+	}
+	return elements, true, nil
+}
+
+// blockProcessor returns the processor of the block with the given kind and attributes, if defined in the configuration.
+// Only the listing, literal, open and example blocks can have a processor, based on their style (eg: `[graphviz]`)
+func blockProcessor(config configuration.Configuration, kind types.BlockKind, attributes types.Attributes) (configuration.BlockProcessor, bool) {
+	switch kind {
+	case types.Listing, types.Literal, types.Open, types.Example:
+		if style, found := attributes[types.AttrStyle].(string); found {
+			return config.BlockProcessor(style)
+		}
+	}
+	return nil, false
+}
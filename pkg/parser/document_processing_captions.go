@@ -0,0 +1,108 @@
+package parser
+
+import (
+	"strconv"
+
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	log "github.com/sirupsen/logrus"
+)
+
+// captionAttributes the document attributes which specify the caption labels of each kind of element.
+// The default values are the built-in labels in the language of the document (there is no
+// built-in label for listings, which means that their caption is disabled by default)
+var captionAttributes = map[string]string{
+	types.FigureReference:  types.AttrFigureCaption,
+	types.TableReference:   types.AttrTableCaption,
+	types.ExampleReference: types.AttrExampleCaption,
+	types.ListingReference: types.AttrListingCaption,
+}
+
+// captionsProcessor assigns the captions of the figures, tables, example blocks and listing blocks with a title.
+// The document attributes are tracked while the blocks are processed, so that an attribute declared or reset in the middle of
+// the document (eg: `:figure-caption!:`) only applies to the subsequent blocks.
+type captionsProcessor struct {
+	config   configuration.Configuration
+	attrs    types.AttributesWithOverrides
+	unset    map[string]bool // the attributes which were reset in the document
+	counters map[string]int
+}
+
+// assignCaptions sets the `caption` attribute (eg: `Figure 1. `) on all the elements with a title which support captions,
+// unless the caption was explicitly set on the element, or the caption was disabled for the kind of element.
+// The delimited blocks which have a processor are replaced once the whole document is parsed (eg: with the image of a diagram),
+// so they are captioned as figures, and their processor receives the caption in their attributes.
+func assignCaptions(blocks []interface{}, attrs types.AttributesWithOverrides, config configuration.Configuration) {
+	p := captionsProcessor{
+		config:   config,
+		attrs:    types.NewAttributesWithOverrides(attrs.Overrides),
+		unset:    map[string]bool{},
+		counters: map[string]int{},
+	}
+	p.attrs.Add(attrs.Content)
+	p.process(blocks)
+}
+
+func (p captionsProcessor) process(elements []interface{}) {
+	for _, element := range elements {
+		switch e := element.(type) {
+		case types.AttributeDeclaration:
+			p.attrs.Set(e.Name, e.Value)
+			delete(p.unset, e.Name)
+		case types.AttributeReset:
+			p.attrs.Delete(e.Name)
+			p.unset[e.Name] = true
+		case types.ImageBlock:
+			p.assignCaption(e.Attributes, types.FigureReference)
+		case types.Table:
+			p.assignCaption(e.Attributes, types.TableReference)
+		case types.DelimitedBlock:
+			if _, processed := blockProcessor(p.config, e.Kind, e.Attributes); processed {
+				p.assignCaption(e.Attributes, types.FigureReference)
+				continue
+			}
+			switch {
+			case e.Kind == types.Example && !e.Attributes.Has(types.AttrAdmonitionKind):
+				p.assignCaption(e.Attributes, types.ExampleReference)
+			case e.Kind == types.Listing || e.Kind == types.Source:
+				p.assignCaption(e.Attributes, types.ListingReference)
+			}
+			p.process(e.Elements)
+		case types.OrderedListItem:
+			p.process(e.Elements)
+		case types.UnorderedListItem:
+			p.process(e.Elements)
+		case types.LabeledListItem:
+			p.process(e.Elements)
+		case types.ContinuedListItemElement:
+			p.process([]interface{}{e.Element})
+		}
+	}
+}
+
+func (p captionsProcessor) assignCaption(attrs types.Attributes, kind string) {
+	if !attrs.Has(types.AttrTitle) || attrs.Has(types.AttrCaption) {
+		return
+	}
+	label := p.captionLabel(kind)
+	if label == "" {
+		return
+	}
+	p.counters[kind]++
+	caption := label + " " + strconv.Itoa(p.counters[kind]) + ". "
+	log.Debugf("assigning caption '%s' to element of kind '%s'", caption, kind)
+	attrs[types.AttrCaption] = caption
+}
+
+// captionLabel returns the current caption label for the given kind of element, or an empty string
+// if the captions are disabled for this kind of element
+func (p captionsProcessor) captionLabel(kind string) string {
+	name := captionAttributes[kind]
+	if label, found := p.attrs.GetAsString(name); found {
+		return label
+	}
+	if p.unset[name] || p.attrs.IsUnset(name) {
+		return ""
+	}
+	return types.DefaultLabel(p.attrs.GetAsStringWithDefault(types.AttrLang, types.DefaultLang), name)
+}
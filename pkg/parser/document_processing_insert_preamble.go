@@ -36,6 +36,7 @@ func doInsertPreamble(blocks []interface{}) []interface{} {
 		log.Debugf("skipping preamble (%d vs %d)", len(preamble.Elements), len(blocks))
 		return blocks
 	}
+	preamble.Position = types.Span(preamble.Elements...)
 	// now, insert the preamble instead of the 'n' blocks that belong to the preamble
 	// and copy the other items
 	result := make([]interface{}, len(blocks)-len(preamble.Elements)+1)
@@ -0,0 +1,108 @@
+package parser_test
+
+import (
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+	"github.com/bytesparadise/libasciidoc/pkg/parser"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	. "github.com/onsi/ginkgo" //nolint golint
+	. "github.com/onsi/gomega" //nolint golint
+)
+
+var _ = Describe("lossless documents", func() {
+
+	parseLosslessDocument := func(source string) types.LosslessDocument {
+		doc, err := parser.ParseLosslessDocument(strings.NewReader(source), configuration.NewConfiguration(configuration.WithFilename("test.adoc")))
+		Expect(err).NotTo(HaveOccurred())
+		return doc
+	}
+
+	position := func(startLine, startCol, endLine, endCol int) types.Position {
+		return types.Position{
+			Start: types.SourceLocation{Filename: "test.adoc", Line: startLine, Column: startCol},
+			End:   types.SourceLocation{Filename: "test.adoc", Line: endLine, Column: endCol},
+		}
+	}
+
+	It("should retain the comments and the blank lines", func() {
+		doc := parseLosslessDocument("first paragraph\n\n\n// a comment\n\nsecond paragraph\n")
+		Expect(doc.Elements).To(HaveLen(6))
+		Expect(doc.Elements[1]).To(Equal(types.BlankLine{
+			Position: position(2, 1, 2, 1),
+		}))
+		Expect(doc.Elements[2]).To(Equal(types.BlankLine{
+			Position: position(3, 1, 3, 1),
+		}))
+		Expect(doc.Elements[3]).To(Equal(types.SingleLineComment{
+			Content:  " a comment",
+			Position: position(4, 1, 4, 13),
+		}))
+		Expect(doc.TextOf(doc.Elements[3])).To(Equal("// a comment"))
+		Expect(doc.TextOf(doc.Elements[5])).To(Equal("second paragraph"))
+	})
+
+	It("should retain the blank lines after a list", func() {
+		doc := parseLosslessDocument("* item 1\n* item 2\n\n\nparagraph\n")
+		Expect(doc.Elements).To(HaveLen(4))
+		Expect(doc.Elements[0]).To(BeAssignableToTypeOf(types.UnorderedList{}))
+		Expect(doc.TextOf(doc.Elements[0])).To(Equal("* item 1\n* item 2"))
+		Expect(doc.Elements[1]).To(BeAssignableToTypeOf(types.BlankLine{}))
+		Expect(doc.Elements[2]).To(BeAssignableToTypeOf(types.BlankLine{}))
+		Expect(doc.TextOf(doc.Elements[3])).To(Equal("paragraph"))
+	})
+
+	It("should retain the attribute declarations and references", func() {
+		doc := parseLosslessDocument(":name: value\n\na {name} paragraph\n")
+		Expect(doc.Attributes).To(Equal(types.Attributes{
+			"name": "value",
+		}))
+		Expect(doc.Elements).To(HaveLen(3))
+		Expect(doc.Elements[0]).To(BeAssignableToTypeOf(types.AttributeDeclaration{}))
+		Expect(doc.TextOf(doc.Elements[0])).To(Equal(":name: value"))
+		p := doc.Elements[2].(types.Paragraph)
+		Expect(p.Lines[0][1]).To(Equal(types.AttributeSubstitution{
+			Name:     "name",
+			Position: position(3, 3, 3, 9),
+		}))
+	})
+
+	It("should retain the file inclusions", func() {
+		doc := parseLosslessDocument("include::../../test/includes/chapter-a.adoc[leveloffset=+1]\n")
+		Expect(doc.Elements).To(HaveLen(1))
+		Expect(doc.Elements[0]).To(BeAssignableToTypeOf(types.FileInclusion{}))
+		Expect(doc.Elements[0].(types.FileInclusion).Position).To(Equal(position(1, 1, 1, 60)))
+		Expect(doc.TextOf(doc.Elements[0])).To(Equal("include::../../test/includes/chapter-a.adoc[leveloffset=+1]"))
+	})
+
+	It("should retain the delimiters and the attribute lists as written", func() {
+		source := "== Section\n\n[source, go]\n.Title\n----\nfunc main() {}\n----\n\n[cols = \"1,2\"]\n|===\n|a |b\n|===\n"
+		doc := parseLosslessDocument(source)
+		Expect(doc.Lines).To(Equal(strings.Split(strings.TrimSuffix(source, "\n"), "\n")))
+		section := doc.Elements[0].(types.Section)
+		Expect(section.Elements).To(HaveLen(4))
+		block := section.Elements[1]
+		Expect(doc.TextOf(block)).To(Equal("[source, go]\n.Title\n----\nfunc main() {}\n----"))
+		Expect(doc.Trivia(block)).To(Equal(types.BlockTrivia{
+			Attributes: []types.SourceText{
+				{Text: "[source, go]", Position: position(3, 1, 3, 13)},
+				{Text: ".Title", Position: position(4, 1, 4, 7)},
+			},
+			Delimiters: []types.SourceText{
+				{Text: "----", Position: position(5, 1, 5, 5)},
+				{Text: "----", Position: position(7, 1, 7, 5)},
+			},
+		}))
+		table := section.Elements[3]
+		Expect(doc.Trivia(table)).To(Equal(types.BlockTrivia{
+			Attributes: []types.SourceText{
+				{Text: `[cols = "1,2"]`, Position: position(9, 1, 9, 15)},
+			},
+			Delimiters: []types.SourceText{
+				{Text: "|===", Position: position(10, 1, 10, 5)},
+				{Text: "|===", Position: position(12, 1, 12, 5)},
+			},
+		}))
+	})
+})
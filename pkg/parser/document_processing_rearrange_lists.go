@@ -1,7 +1,6 @@
 package parser
 
 import (
-	"reflect"
 	"strings"
 
 	"github.com/bytesparadise/libasciidoc/pkg/types"
@@ -10,37 +9,33 @@ import (
 	log "github.com/sirupsen/logrus"
 )
 
-// rearrangeListItems moves the list items into lists, and nested lists if needed
-func rearrangeListItems(blocks []interface{}, withinDelimitedBlock bool) ([]interface{}, error) {
+// rearrangeListItems moves the list items into lists, and nested lists if needed.
+// In lossless mode, the blank lines are retained, except those between the items of a list
+// (which are within the position of the list)
+func rearrangeListItems(blocks []interface{}, withinDelimitedBlock, lossless bool) ([]interface{}, error) {
 	// log.Debugf("rearranging list items in %d blocks...", len(blocks))
 	result := make([]interface{}, 0, len(blocks)) // maximum capacity cannot exceed initial input
 	lists := []types.List{}                       // at each level (or depth), we have a list, whatever its type.
 	// track if the previous block was a blank line.
 	// also, count the blanklines to determine the level of parent attachment when reaching a `ContinuedListItemElement`
 	blanklineCount := 0
+	blanklines := []interface{}{} // the blank lines after the current list, which are retained in lossless mode if the list ends
 	for _, block := range blocks {
 		switch block := block.(type) {
 		case types.DelimitedBlock:
 			// process and replace the elements within this delimited block
-			elements, err := rearrangeListItems(block.Elements, true)
+			elements, err := rearrangeListItems(block.Elements, true, lossless)
 			if err != nil {
 				return nil, errors.Wrapf(err, "unable to rearrange list items in delimited block")
 			}
 			block.Elements = elements
 			if len(lists) > 0 {
-				switch list := lists[0].(type) { // just add the top-level list
-				case *types.OrderedList:
-					result = append(result, *list)
-				case *types.UnorderedList:
-					result = append(result, *list)
-				case *types.LabeledList:
-					result = append(result, *list)
-				case *types.CalloutList:
-					result = append(result, *list)
-				}
+				result = append(result, closeList(lists[0])) // just add the top-level list
 				// reset the list for further usage while processing the rest of the document
 				lists = []types.List{}
 			}
+			result = append(result, blanklines...)
+			blanklines = blanklines[:0]
 			result = append(result, block)
 		case types.OrderedListItem, types.UnorderedListItem, types.LabeledListItem, types.CalloutListItem:
 			// there's a special case: if the next list item has attributes and was preceded by a
@@ -48,10 +43,11 @@ func rearrangeListItems(blocks []interface{}, withinDelimitedBlock bool) ([]inte
 			if blanklineCount > 0 && len(block.(types.DocumentElement).GetAttributes()) > 0 {
 				if len(lists) > 0 {
 					for _, list := range pruneLists(lists, 0) {
-						result = append(result, unPtr(list))
+						result = append(result, closeList(list))
 					}
 					// reset the list for further usage while processing the rest of the document
 					lists = []types.List{}
+					result = append(result, blanklines...)
 				}
 			}
 			var err error
@@ -60,16 +56,20 @@ func rearrangeListItems(blocks []interface{}, withinDelimitedBlock bool) ([]inte
 				return nil, errors.Wrapf(err, "unable to rearrange list items in delimited block")
 			}
 			blanklineCount = 0
+			blanklines = blanklines[:0]
 		case types.ContinuedListItemElement:
 			block.Offset = blanklineCount
 			lists = appendContinuedListItemElement(lists, block)
 			blanklineCount = 0
+			blanklines = blanklines[:0]
 		case types.BlankLine:
 			// blank lines are not part of the resulting Document sections (or top-level), but they are part of the delimited blocks
 			// in some cases, they can also be used to split lists apart (when the next item has some attributes,
 			// or if the next block is a comment)
-			if withinDelimitedBlock && len(lists) == 0 { // only retain blank lines if within a delimited block, but not currently dealing with a list (or a set of nested lists)
+			if (withinDelimitedBlock || lossless) && len(lists) == 0 { // only retain blank lines if within a delimited block, but not currently dealing with a list (or a set of nested lists)
 				result = append(result, block)
+			} else if lossless {
+				blanklines = append(blanklines, block)
 			}
 			blanklineCount++
 		default:
@@ -80,11 +80,13 @@ func rearrangeListItems(blocks []interface{}, withinDelimitedBlock bool) ([]inte
 			if len(lists) > 0 {
 				log.Debugf("appending %d lists before processing element of type %T", len(lists), block)
 				for _, list := range pruneLists(lists, 0) {
-					result = append(result, unPtr(list))
+					result = append(result, closeList(list))
 				}
 				// reset the list for further usage while processing the rest of the document
 				lists = []types.List{}
 			}
+			result = append(result, blanklines...)
+			blanklines = blanklines[:0]
 			result = append(result, block)
 		}
 	}
@@ -92,19 +94,31 @@ func rearrangeListItems(blocks []interface{}, withinDelimitedBlock bool) ([]inte
 	if len(lists) > 0 {
 		log.Debugf("processing the remaining %d lists...", len(lists))
 		for _, list := range pruneLists(lists, 0) {
-			result = append(result, unPtr(list))
+			result = append(result, closeList(list))
 		}
 	}
-	return result, nil
+	return append(result, blanklines...), nil
 }
 
-func unPtr(value interface{}) interface{} {
-	v := reflect.ValueOf(value)
-	k := v.Kind()
-	if k == reflect.Ptr && v.Elem().IsValid() {
-		return v.Elem().Interface()
+// closeList returns the value of the given list, with a position which spans over all its items
+// (their position may have been extended after they were added to the list)
+func closeList(list types.List) interface{} {
+	switch list := list.(type) {
+	case *types.OrderedList:
+		list.Position = types.Span(list.Items[0], list.Items[len(list.Items)-1])
+		return *list
+	case *types.UnorderedList:
+		list.Position = types.Span(list.Items[0], list.Items[len(list.Items)-1])
+		return *list
+	case *types.LabeledList:
+		list.Position = types.Span(list.Items[0], list.Items[len(list.Items)-1])
+		return *list
+	case *types.CalloutList:
+		list.Position = types.Span(list.Items[0], list.Items[len(list.Items)-1])
+		return *list
+	default:
+		return list
 	}
-	return value
 }
 
 func appendListItem(lists []types.List, item interface{}) ([]types.List, error) {
@@ -210,7 +224,7 @@ func appendLabeledListItem(lists []types.List, item types.LabeledListItem) ([]ty
 	if len(item.Term) == 1 {
 		if term, ok := item.Term[0].(types.StringElement); ok {
 			var err error
-			item.Term, err = parseLabeledListItemTerm(term.Content)
+			item.Term, err = parseLabeledListItemTerm(term)
 			if err != nil {
 				return nil, err
 			}
@@ -242,9 +256,13 @@ func appendLabeledListItem(lists []types.List, item types.LabeledListItem) ([]ty
 }
 
 // a labeled list item term may contain links, images, quoted text, footnotes, etc.
-func parseLabeledListItemTerm(term string) ([]interface{}, error) {
+func parseLabeledListItemTerm(term types.StringElement) ([]interface{}, error) {
 	result := []interface{}{}
-	elements, err := ParseReader("", strings.NewReader(term), Entrypoint("LabeledListItemTerm"))
+	options := []Option{Entrypoint("LabeledListItemTerm")}
+	if !term.Start.IsZero() {
+		options = append(options, withSourcePositions([]types.SourceLocation{term.Start}))
+	}
+	elements, err := ParseReader("", strings.NewReader(term.Content), options...)
 	if err != nil {
 		return []interface{}{}, errors.Wrap(err, "error while parsing content for inline links")
 	}
@@ -273,12 +291,8 @@ func pruneLists(lists []types.List, level int) []types.List {
 			parentList := &(lists[i-1])
 			parentItem := (*parentList).LastItem()
 			switch childList := lists[i].(type) {
-			case *types.OrderedList:
-				parentItem.AddElement(*childList)
-			case *types.UnorderedList:
-				parentItem.AddElement(*childList)
-			case *types.LabeledList:
-				parentItem.AddElement(*childList)
+			case *types.OrderedList, *types.UnorderedList, *types.LabeledList:
+				parentItem.AddElement(closeList(childList))
 			}
 		}
 		// also, prune the pointers to the remaining sublists
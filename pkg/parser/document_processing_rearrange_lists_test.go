@@ -363,7 +363,7 @@ var _ = Describe("rearrange lists", func() {
 				},
 			},
 		}
-		Expect(rearrangeListItems(actual, false)).To(Equal(expected))
+		Expect(rearrangeListItems(actual, false, false)).To(Equal(expected))
 	})
 
 	It("labeled list with rich terms", func() {
@@ -497,7 +497,7 @@ var _ = Describe("rearrange lists", func() {
 				},
 			},
 		}
-		Expect(rearrangeListItems(actual, false)).To(Equal(expected))
+		Expect(rearrangeListItems(actual, false, false)).To(Equal(expected))
 	})
 
 	It("callout list with rich terms", func() {
@@ -559,7 +559,7 @@ var _ = Describe("rearrange lists", func() {
 				},
 			},
 		}
-		Expect(rearrangeListItems(actual, false)).To(Equal(expected))
+		Expect(rearrangeListItems(actual, false, false)).To(Equal(expected))
 	})
 
 })
@@ -7,8 +7,9 @@ import (
 	log "github.com/sirupsen/logrus"
 )
 
-// rearrangeSections moves elements into section to obtain a hierarchical document instead of a flat thing
-func rearrangeSections(blocks []interface{}) types.Document {
+// rearrangeSections moves elements into section to obtain a hierarchical document instead of a flat thing.
+// The IDs which are assigned to more than one element are reported in the given diagnostics
+func rearrangeSections(blocks []interface{}, diagnostics *types.Diagnostics) types.Document {
 
 	// use same logic as with list items:
 	// only append a child section to her parent section when
@@ -22,7 +23,7 @@ func rearrangeSections(blocks []interface{}) types.Document {
 	for _, element := range blocks {
 		if e, ok := element.(types.Section); ok {
 			// avoid duplicate IDs in sections
-			referenceSection(&e, elementRefs)
+			referenceSection(&e, elementRefs, diagnostics)
 			if previous == nil { // set first parent
 				log.Debugf("setting section with title %v as a top-level element", e.Title)
 				sections = append(sections, e)
@@ -41,6 +42,7 @@ func rearrangeSections(blocks []interface{}) types.Document {
 			}
 			previous = &e // pointer to new current parent
 		} else {
+			referenceElement(element, elementRefs, diagnostics)
 			if previous == nil {
 				// log.Debugf("adding element of type %T as a top-level element", element)
 				tle = append(tle, element)
@@ -65,11 +67,15 @@ func rearrangeSections(blocks []interface{}) types.Document {
 	}
 }
 
-func referenceSection(e *types.Section, elementRefs types.ElementReferences) {
+func referenceSection(e *types.Section, elementRefs types.ElementReferences, diagnostics *types.Diagnostics) {
 	attrID, found := e.Attributes.GetAsString(types.AttrID)
 	if !found {
 		return
 	}
+	if _, found := elementRefs[attrID]; found && e.Attributes.GetAsBool(types.AttrCustomID) {
+		// only the custom IDs are reported, since the generated ones are renamed
+		diagnostics.Warnf(types.DuplicateID, e.Position, "id assigned to section already in use: '%s'", attrID)
+	}
 	for i := 1; ; i++ {
 		var id string
 		if i == 1 {
@@ -78,13 +84,100 @@ func referenceSection(e *types.Section, elementRefs types.ElementReferences) {
 			id = attrID + "_" + strconv.Itoa(i)
 		}
 		if _, found := elementRefs[id]; !found {
-			elementRefs[id] = e.Title
+			elementRefs[id] = sectionReference(*e)
 			// override the element id
 			e.Attributes.Set(types.AttrID, id)
 			break
 		}
 	}
-	elementRefs[attrID] = e.Title
+	elementRefs[attrID] = sectionReference(*e)
+}
+
+// sectionReference returns the title of the given section, unless it has a `reftext` attribute
+func sectionReference(s types.Section) interface{} {
+	if reftext, found := s.Attributes.GetAsString(types.AttrRefText); found {
+		return types.ElementReference{
+			Kind:    types.SectionReference,
+			RefText: reftext,
+		}
+	}
+	return s.Title
+}
+
+// referenceElement registers the given element if it has an ID, as well as its nested elements
+// and the inline anchors of its content.
+func referenceElement(element interface{}, elementRefs types.ElementReferences, diagnostics *types.Diagnostics) {
+	switch e := element.(type) {
+	case types.ImageBlock:
+		addElementReference(e.Attributes, types.FigureReference, e.Position, elementRefs, diagnostics)
+	case types.Table:
+		addElementReference(e.Attributes, types.TableReference, e.Position, elementRefs, diagnostics)
+	case types.DelimitedBlock:
+		switch {
+		case e.Kind == types.Example && !e.Attributes.Has(types.AttrAdmonitionKind):
+			addElementReference(e.Attributes, types.ExampleReference, e.Position, elementRefs, diagnostics)
+		case e.Kind == types.Listing || e.Kind == types.Source:
+			addElementReference(e.Attributes, types.ListingReference, e.Position, elementRefs, diagnostics)
+		default:
+			addElementReference(e.Attributes, types.BlockReference, e.Position, elementRefs, diagnostics)
+		}
+		for _, elmt := range e.Elements {
+			referenceElement(elmt, elementRefs, diagnostics)
+		}
+	case types.Paragraph:
+		addElementReference(e.Attributes, types.BlockReference, e.Position, elementRefs, diagnostics)
+		for _, line := range e.Lines {
+			for _, elmt := range line {
+				if a, ok := elmt.(types.InlineAnchor); ok {
+					if _, found := elementRefs[a.ID]; found {
+						diagnostics.Warnf(types.DuplicateID, a.Position, "id assigned to anchor already in use: '%s'", a.ID)
+					}
+					elementRefs[a.ID] = types.ElementReference{
+						Kind:    types.AnchorReference,
+						RefText: a.RefText,
+					}
+				}
+			}
+		}
+	case types.OrderedList:
+		addElementReference(e.Attributes, types.BlockReference, e.Position, elementRefs, diagnostics)
+		for _, item := range e.Items {
+			for _, elmt := range item.Elements {
+				referenceElement(elmt, elementRefs, diagnostics)
+			}
+		}
+	case types.UnorderedList:
+		addElementReference(e.Attributes, types.BlockReference, e.Position, elementRefs, diagnostics)
+		for _, item := range e.Items {
+			for _, elmt := range item.Elements {
+				referenceElement(elmt, elementRefs, diagnostics)
+			}
+		}
+	case types.LabeledList:
+		addElementReference(e.Attributes, types.BlockReference, e.Position, elementRefs, diagnostics)
+		for _, item := range e.Items {
+			for _, elmt := range item.Elements {
+				referenceElement(elmt, elementRefs, diagnostics)
+			}
+		}
+	}
+}
+
+func addElementReference(attrs types.Attributes, kind string, position types.Position, elementRefs types.ElementReferences, diagnostics *types.Diagnostics) {
+	id, found := attrs.GetAsString(types.AttrID)
+	if !found {
+		return
+	}
+	if _, found := elementRefs[id]; found {
+		diagnostics.Warnf(types.DuplicateID, position, "id assigned to block already in use: '%s'", id)
+	}
+	ref := types.ElementReference{
+		Kind: kind,
+	}
+	ref.Caption, _ = attrs.GetAsString(types.AttrCaption)
+	ref.Title, _ = attrs.GetAsString(types.AttrTitle)
+	ref.RefText, _ = attrs.GetAsString(types.AttrRefText)
+	elementRefs[id] = ref
 }
 
 func pruneSections(sections []types.Section, level int) []types.Section {
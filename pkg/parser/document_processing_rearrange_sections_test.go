@@ -156,7 +156,7 @@ var _ = Describe("rearrange sections", func() {
 				},
 			},
 		}
-		Expect(rearrangeSections(actual)).To(Equal(expected))
+		Expect(rearrangeSections(actual, nil)).To(Equal(expected))
 	})
 
 	It("section levels 1, 2, 3, 3", func() {
@@ -307,7 +307,7 @@ var _ = Describe("rearrange sections", func() {
 				},
 			},
 		}
-		Expect(rearrangeSections(actual)).To(Equal(expected))
+		Expect(rearrangeSections(actual, nil)).To(Equal(expected))
 	})
 
 	It("section levels 1, 3, 4, 4", func() {
@@ -458,7 +458,7 @@ var _ = Describe("rearrange sections", func() {
 				},
 			},
 		}
-		Expect(rearrangeSections(actual)).To(Equal(expected))
+		Expect(rearrangeSections(actual, nil)).To(Equal(expected))
 	})
 
 })
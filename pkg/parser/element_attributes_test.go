@@ -351,6 +351,177 @@ a paragraph`
 			})
 		})
 
+		Context("attribute list", func() {
+
+			It("positional and named attributes", func() {
+				source := `[appendix, second, key1=value1, key2 = value2 , ]
+a paragraph`
+				expected := types.Paragraph{
+					Attributes: types.Attributes{
+						types.AttrStyle: "appendix",
+						"positional-1":  "appendix",
+						"positional-2":  "second",
+						"key1":          "value1",
+						"key2":          "value2",
+					},
+					Lines: [][]interface{}{
+						{
+							types.StringElement{
+								Content: "a paragraph",
+							},
+						},
+					},
+				}
+				Expect(ParseDocumentBlock(source)).To(Equal(expected))
+			})
+
+			It("empty style", func() {
+				source := `[,second]
+a paragraph`
+				expected := types.Paragraph{
+					Attributes: types.Attributes{
+						"positional-2": "second",
+					},
+					Lines: [][]interface{}{
+						{
+							types.StringElement{
+								Content: "a paragraph",
+							},
+						},
+					},
+				}
+				Expect(ParseDocumentBlock(source)).To(Equal(expected))
+			})
+
+			It("quoted values with commas", func() {
+				source := `[style,"a, \"quoted\" value", title='another, value']
+a paragraph`
+				expected := types.Paragraph{
+					Attributes: types.Attributes{
+						types.AttrStyle: "style",
+						"positional-1":  "style",
+						"positional-2":  `a, "quoted" value`,
+						types.AttrTitle: "another, value",
+					},
+					Lines: [][]interface{}{
+						{
+							types.StringElement{
+								Content: "a paragraph",
+							},
+						},
+					},
+				}
+				Expect(ParseDocumentBlock(source)).To(Equal(expected))
+			})
+
+			It("short-hand id, roles and options", func() {
+				source := `[sidebar#main.role1.role2%opt1%opt2]
+a paragraph`
+				expected := types.Paragraph{
+					Attributes: types.Attributes{
+						types.AttrStyle:    "sidebar",
+						"positional-1":     "sidebar",
+						types.AttrID:       "main",
+						types.AttrCustomID: true,
+						types.AttrRole:     []string{"role1", "role2"},
+						types.AttrOptions:  []string{"opt1", "opt2"},
+					},
+					Lines: [][]interface{}{
+						{
+							types.StringElement{
+								Content: "a paragraph",
+							},
+						},
+					},
+				}
+				Expect(ParseDocumentBlock(source)).To(Equal(expected))
+			})
+
+			It("short-hand id with role", func() {
+				source := `[#main.role]
+a paragraph`
+				expected := types.Paragraph{
+					Attributes: types.Attributes{
+						types.AttrID:       "main",
+						types.AttrCustomID: true,
+						types.AttrRole:     "role",
+					},
+					Lines: [][]interface{}{
+						{
+							types.StringElement{
+								Content: "a paragraph",
+							},
+						},
+					},
+				}
+				Expect(ParseDocumentBlock(source)).To(Equal(expected))
+			})
+
+			It("short-hand and named options", func() {
+				source := `[%header,options="footer,autowidth",opts=header]
+a paragraph`
+				expected := types.Paragraph{
+					Attributes: types.Attributes{
+						types.AttrOptions: []string{"header", "footer", "autowidth"},
+					},
+					Lines: [][]interface{}{
+						{
+							types.StringElement{
+								Content: "a paragraph",
+							},
+						},
+					},
+				}
+				Expect(ParseDocumentBlock(source)).To(Equal(expected))
+			})
+
+			It("source block with short-hand attributes", func() {
+				source := `[source#main.wide%linenums,go]
+----
+package main
+----`
+				expected := types.DelimitedBlock{
+					Attributes: types.Attributes{
+						types.AttrKind:     types.Source,
+						types.AttrLanguage: "go",
+						types.AttrID:       "main",
+						types.AttrCustomID: true,
+						types.AttrRole:     "wide",
+						types.AttrOptions:  []string{"linenums"},
+					},
+					Kind: types.Source,
+					Elements: []interface{}{
+						types.VerbatimLine{
+							Content: "package main",
+						},
+					},
+				}
+				Expect(ParseDocumentBlock(source)).To(Equal(expected))
+			})
+
+			It("quote block with short-hand attributes and quoted values", func() {
+				source := `[quote.epigraph, "Doe, John", "Chapter 1, Section 2"]
+____
+a quote
+____`
+				expected := types.DelimitedBlock{
+					Attributes: types.Attributes{
+						types.AttrKind:        types.Quote,
+						types.AttrRole:        "epigraph",
+						types.AttrQuoteAuthor: "Doe, John",
+						types.AttrQuoteTitle:  "Chapter 1, Section 2",
+					},
+					Kind: types.Quote,
+					Elements: []interface{}{
+						types.VerbatimLine{
+							Content: "a quote",
+						},
+					},
+				}
+				Expect(ParseDocumentBlock(source)).To(Equal(expected))
+			})
+		})
+
 		Context("standalone attributes", func() {
 
 			It("single standalone attribute", func() {
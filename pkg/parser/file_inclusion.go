@@ -1,10 +1,9 @@
 package parser
 
 import (
-	"bufio"
-	"bytes"
 	"fmt"
-	"os"
+	"io"
+	pathpkg "path"
 	"path/filepath"
 	"strconv"
 	"strings"
@@ -12,24 +11,26 @@ import (
 	"github.com/bytesparadise/libasciidoc/pkg/configuration"
 	"github.com/bytesparadise/libasciidoc/pkg/types"
 	"github.com/pkg/errors"
+	"golang.org/x/text/encoding/htmlindex"
+	"golang.org/x/text/transform"
 
 	log "github.com/sirupsen/logrus"
 )
 
-// levelOffset a func that applies a given offset to the sections of a child document to include in a parent doc (the caller)
+// levelOffset a func that applies a given offset to the level of the sections of a child document to include in a parent doc (the caller)
 type levelOffset struct {
 	absolute bool
 	value    int
-	apply    func(*types.Section)
+	apply    func(int) int
 }
 
 func relativeOffset(offset int) levelOffset {
 	return levelOffset{
 		absolute: false,
 		value:    offset,
-		apply: func(s *types.Section) {
-			log.Debugf("applying relative offset: %d + %d on %+v", s.Level, offset, s.Title)
-			s.Level += offset
+		apply: func(level int) int {
+			log.Debugf("applying relative offset: %d + %d", level, offset)
+			return level + offset
 		},
 	}
 }
@@ -38,138 +39,269 @@ func absoluteOffset(offset int) levelOffset {
 	return levelOffset{
 		absolute: true,
 		value:    offset,
-		apply: func(s *types.Section) {
-			log.Debugf("applying absolute offset: %d -> %d on %+v", s.Level, offset, s.Title)
-			s.Level = offset
+		apply: func(level int) int {
+			log.Debugf("applying absolute offset: %d -> %d", level, offset)
+			return offset
 		},
 	}
 }
 
-func parseFileToInclude(incl types.FileInclusion, attrs types.AttributesWithOverrides, levelOffsets []levelOffset, config configuration.Configuration, options ...Option) (types.DraftDocument, error) {
-	path := incl.Location.Resolve(attrs).String()
-	currentDir := filepath.Dir(config.Filename)
-	log.Debugf("parsing '%s' from current dir '%s' (%s)", path, currentDir, config.Filename)
-	f, absPath, done, err := open(filepath.Join(currentDir, path))
-	defer done()
-	if err != nil {
-		return types.DraftDocument{}, FileInclusionError{
-			Filename: config.Filename,
-			rawText:  incl.RawText,
-		}
+// defaultMaxIncludeDepth the maximum depth of nested file inclusions, unless the `max-include-depth` attribute is set
+const defaultMaxIncludeDepth = 64
+
+// includeChain the chain of file inclusions which led to the document being parsed
+type includeChain struct {
+	locations []types.SourceLocation // the locations of the `include::` directives, from the main document
+	files     []string               // the absolute paths (or URIs) of the documents, starting with the main document
+	maxDepth  int                    // the maximum depth of nested file inclusions (negative until resolved)
+}
+
+func newIncludeChain() includeChain {
+	return includeChain{
+		locations: []types.SourceLocation{},
+		files:     []string{},
+		maxDepth:  -1,
 	}
-	content := bytes.NewBuffer(nil)
-	scanner := bufio.NewScanner(bufio.NewReader(f))
-	if lineRanges, ok := incl.LineRanges(); ok {
-		if err := readWithinLines(scanner, content, lineRanges); err != nil {
-			return types.DraftDocument{}, FileInclusionError{
-				Filename: config.Filename,
-				rawText:  incl.RawText,
-			}
-		}
-	} else if tagRanges, ok := incl.TagRanges(); ok {
-		if err := readWithinTags(path, scanner, content, tagRanges); err != nil {
-			return types.DraftDocument{}, FileInclusionError{
-				Filename: config.Filename,
-				rawText:  incl.RawText,
-			}
-		}
-	} else {
-		if err := readAll(scanner, content); err != nil {
-			return types.DraftDocument{}, FileInclusionError{
-				Filename: config.Filename,
-				rawText:  incl.RawText,
-			}
+}
+
+// withMainDocument returns a copy of this chain starting with the main document of the given configuration
+func (c includeChain) withMainDocument(config configuration.Configuration) includeChain {
+	if config.Filename == "" {
+		return c
+	}
+	c.files = []string{absolutePath(config.Filename, config)}
+	return c
+}
+
+// push returns a copy of this chain, with the given directive location and included file
+func (c includeChain) push(location types.SourceLocation, file string) includeChain {
+	locations := make([]types.SourceLocation, len(c.locations), len(c.locations)+1)
+	copy(locations, c.locations)
+	files := make([]string, len(c.files), len(c.files)+1)
+	copy(files, c.files)
+	return includeChain{
+		locations: append(locations, location),
+		files:     append(files, file),
+		maxDepth:  c.maxDepth,
+	}
+}
+
+// includes returns `true` if the given file is already part of this chain
+func (c includeChain) includes(file string) bool {
+	for _, f := range c.files {
+		if f == file {
+			return true
 		}
 	}
-	if err := scanner.Err(); err != nil {
-		return types.DraftDocument{}, FileInclusionError{
-			Filename: config.Filename,
-			rawText:  incl.RawText,
+	return false
+}
+
+// maxIncludeDepth returns the value of the `max-include-depth` attribute, or the default value
+func maxIncludeDepth(attrs types.AttributesWithOverrides) int {
+	if d, found := attrs.GetAsString(types.AttrMaxIncludeDepth); found {
+		if depth, err := strconv.Atoi(d); err == nil && depth >= 0 {
+			return depth
 		}
+		log.Warnf("invalid value of '%s' attribute: '%s'", types.AttrMaxIncludeDepth, d)
+	}
+	return defaultMaxIncludeDepth
+}
+
+// include replaces the given `include::` directive with the lines of the file to include,
+// which are processed in turn (since they may contain other `include::` directives)
+func (p *preprocessor) include(incl types.FileInclusion, frame *includeFrame) error {
+	config := frame.config
+	location := types.SourceLocation{
+		Filename: config.Filename,
+		Line:     incl.Line,
+	}
+	path := incl.Location.Resolve(p.attrs).String()
+	// the files read by an include processor are not restricted by the safe mode
+	_, _, _, processed := includeProcessorOf(path, config)
+	if !processed && config.SafeMode >= configuration.Secure {
+		// replace with a link to the file, as Asciidoctor does
+		log.Warnf("cannot include '%s' in '%s' in %s mode", path, config.Filename, config.SafeMode)
+		config.Diagnostics.Warnf(types.IncludeNotAllowed, incl.Position, "cannot include '%s' in %s mode", path, config.SafeMode)
+		p.append(PreprocessedLine{Content: "link:" + path + "[]", Location: location}, frame)
+		return nil
+	}
+	if !processed && isRemote(path) && !allowURIRead(config) {
+		// replace with a link to the remote file, as Asciidoctor does
+		log.Warnf("cannot include '%s' in '%s' unless the '%s' attribute is set", path, config.Filename, types.AttrAllowURIRead)
+		config.Diagnostics.Warnf(types.IncludeNotAllowed, incl.Position, "cannot include '%s' unless the '%s' attribute is set", path, types.AttrAllowURIRead)
+		p.append(PreprocessedLine{Content: "link:" + path + "[]", Location: location}, frame)
+		return nil
+	}
+	chain := frame.chain
+	if chain.maxDepth < 0 {
+		// resolved with the attributes declared before the first `include::` directive
+		chain.maxDepth = maxIncludeDepth(p.attrs)
+		frame.chain.maxDepth = chain.maxDepth
+	}
+	if len(chain.locations) >= chain.maxDepth {
+		log.Errorf("cannot include '%s' in '%s': maximum include depth of %d exceeded", path, config.Filename, chain.maxDepth)
+		return newFileInclusionError(incl, chain, config, fmt.Sprintf("cannot include '%s': maximum include depth of %d exceeded", path, chain.maxDepth))
+	}
+	lines, absPath, err := readFileToInclude(incl, path, config)
+	if err != nil && incl.IsOptional() {
+		log.WithError(err).Infof("skipping optional file to include '%s' in '%s'", path, config.Filename)
+		return nil
+	} else if err != nil {
+		log.WithError(err).Errorf("unable to read file to include '%s' in '%s'", path, config.Filename)
+		return newFileInclusionError(incl, chain, config, fmt.Sprintf("unable to read file to include '%s': %v", path, err))
 	}
-	// parse the content, and returns the corresponding elements
+	if chain.includes(absPath) {
+		log.Errorf("cannot include '%s' in '%s': include cycle detected", path, config.Filename)
+		return newFileInclusionError(incl, chain, config, fmt.Sprintf("cannot include '%s': include cycle detected", path))
+	}
+	levelOffsets := frame.levelOffsets
 	if l, found := incl.Attributes.GetAsString(types.AttrLevelOffset); found {
 		offset, err := strconv.Atoi(l)
 		if err != nil {
-			return types.DraftDocument{}, errors.Wrap(err, "unable to read file to include")
+			log.WithError(err).Errorf("invalid level offset '%s' of file to include '%s' in '%s'", l, path, config.Filename)
+			return newFileInclusionError(incl, chain, config, fmt.Sprintf("invalid level offset '%s' of file to include '%s'", l, path))
 		}
 		if strings.HasPrefix(l, "+") || strings.HasPrefix(l, "-") {
-			levelOffsets = append(levelOffsets, relativeOffset(offset))
+			levelOffsets = append(levelOffsets[:len(levelOffsets):len(levelOffsets)], relativeOffset(offset))
 		} else {
 			levelOffsets = []levelOffset{absoluteOffset(offset)}
-
 		}
 	}
-	// use a simpler/different grammar for non-asciidoc files.
-	if !IsAsciidoc(absPath) {
-		options = append(options, Entrypoint("TextDocument")) // TODO: delete rule and use VerbatimDocument?
-	}
 	inclConfig := config.Clone()
 	inclConfig.Filename = absPath
-	return parseDraftDocument(content, levelOffsets, inclConfig, options...)
+	return p.process(lines, &includeFrame{
+		config:       inclConfig,
+		chain:        chain.push(location, absPath),
+		levelOffsets: levelOffsets,
+		// the sections are only expected in asciidoc files
+		asciidoc: IsAsciidoc(pathOf(absPath)),
+	})
+}
+
+// readFileToInclude reads the lines of the file to include, limited to the line or tag ranges if specified.
+// Returns the lines along with the absolute path (or URI) of the file
+func readFileToInclude(incl types.FileInclusion, path string, config configuration.Configuration) ([]PreprocessedLine, string, error) {
+	f, absPath, done, err := openFileToInclude(incl, path, config)
+	defer done()
+	if err != nil {
+		return nil, absPath, err
+	}
+	if encoding, found := incl.Attributes.GetAsString(types.AttrEncoding); found {
+		// transcode the content of the file to UTF-8
+		e, err := htmlindex.Get(encoding)
+		if err != nil {
+			return nil, absPath, errors.Wrapf(err, "unsupported encoding '%s'", encoding)
+		}
+		f = transform.NewReader(f, e.NewDecoder())
+	}
+	lines, err := readLines(f, absPath)
+	if err != nil {
+		return nil, absPath, err
+	}
+	if lineRanges, ok := incl.LineRanges(); ok {
+		lines, err = readWithinLines(lines, lineRanges)
+	} else if tagRanges, ok := incl.TagRanges(); ok {
+		lines, err = readWithinTags(path, lines, tagRanges, incl.Position, config.Diagnostics)
+	} else {
+		lines, err = readAll(lines)
+	}
+	if err != nil {
+		return nil, absPath, err
+	}
+	if i, found := incl.Attributes.GetAsString(types.AttrIndent); found {
+		if indent, err := strconv.Atoi(i); err == nil && indent >= 0 {
+			adjustIndentation(lines, indent)
+		} else {
+			log.Warnf("invalid indent '%s' of file to include '%s' in '%s'", i, path, config.Filename)
+			config.Diagnostics.Warnf(types.InvalidInclude, incl.Position, "invalid indent '%s' of file to include '%s'", i, path)
+		}
+	}
+	return lines, absPath, nil
 }
 
 // FileInclusionError an error which may happen during a file inclusion
 type FileInclusionError struct {
 	Filename string
-	rawText  string
+	Line     int
+	// Chain the locations of the `include::` directives which led to the document in which the error occurred,
+	// from the main document to the parent document
+	Chain []types.SourceLocation
+	// Reason the cause of the error
+	Reason  string
+	rawText string
+}
+
+func newFileInclusionError(incl types.FileInclusion, chain includeChain, config configuration.Configuration, reason string) FileInclusionError {
+	return FileInclusionError{
+		Filename: config.Filename,
+		Line:     incl.Line,
+		Chain:    chain.locations,
+		Reason:   reason,
+		rawText:  incl.RawText,
+	}
 }
 
 func (e FileInclusionError) Error() string {
 	return fmt.Sprintf("Unresolved directive in %s - %s", e.Filename, e.rawText)
 }
 
+// Trace returns the location of the unresolved directive, followed by the locations of the
+// `include::` directives which led to it (eg: `'child.adoc:3', included from 'test.adoc:1'`)
+func (e FileInclusionError) Trace() string {
+	result := &strings.Builder{}
+	fmt.Fprintf(result, "'%s'", types.SourceLocation{Filename: e.Filename, Line: e.Line})
+	for i := len(e.Chain) - 1; i >= 0; i-- {
+		fmt.Fprintf(result, ", included from '%s'", e.Chain[i])
+	}
+	return result.String()
+}
+
 var _ error = FileInclusionError{}
 
-func readWithinLines(scanner *bufio.Scanner, content *bytes.Buffer, lineRanges types.LineRanges) error {
+// parseIncludedFileLine parses the given line in search for the `tag::<tag>[]` or `end:<tag>[]` macros
+func parseIncludedFileLine(line PreprocessedLine) (types.IncludedFileLine, error) {
+	l, err := Parse(line.Location.Filename, []byte(line.Content), Entrypoint("IncludedFileLine"))
+	if err != nil {
+		return types.IncludedFileLine{}, err
+	}
+	fl, ok := l.(types.IncludedFileLine)
+	if !ok {
+		return types.IncludedFileLine{}, errors.Errorf("unexpected type of parsed line in file to include: %T", l)
+	}
+	return fl, nil
+}
+
+func readWithinLines(lines []PreprocessedLine, lineRanges types.LineRanges) ([]PreprocessedLine, error) {
+	// some ranges may be relative to the end of the content
+	lineRanges = lineRanges.Resolve(len(lines))
 	log.Debugf("limiting to line ranges: %v", lineRanges)
-	line := 0
-	for scanner.Scan() {
-		line++
-		log.Debugf("line %d: '%s' (matching range: %t)", line, scanner.Text(), lineRanges.Match(line))
-		// parse the line in search for the `tag::<tag>[]` or `end:<tag>[]` macros
-		l, err := Parse("", scanner.Bytes(), Entrypoint("IncludedFileLine"))
+	result := make([]PreprocessedLine, 0, len(lines))
+	for _, l := range lines {
+		fl, err := parseIncludedFileLine(l)
 		if err != nil {
-			return err
-		}
-		fl, ok := l.(types.IncludedFileLine)
-		if !ok {
-			return errors.Errorf("unexpected type of parsed line in file to include: %T", l)
+			return nil, err
 		}
+		log.Debugf("line %d: '%s' (matching range: %t)", l.Location.Line, l.Content, lineRanges.Match(l.Location.Line))
 		// skip if the line has tags
-		if fl.HasTag() {
+		if fl.HasTag() || !lineRanges.Match(l.Location.Line) {
 			continue
 		}
-		// TODO: stop reading if current line above highest range
-		if lineRanges.Match(line) {
-			_, err := content.Write(scanner.Bytes())
-			if err != nil {
-				return err
-			}
-			_, err = content.WriteString("\n")
-			if err != nil {
-				return err
-			}
-		}
+		result = append(result, l)
 	}
-	return nil
+	return result, nil
 }
 
-func readWithinTags(path string, scanner *bufio.Scanner, content *bytes.Buffer, expectedRanges types.TagRanges) error {
+// readWithinTags returns the lines within the given tag ranges, and reports the tags which were not found or not closed
+// at the given position of the `include::` directive
+func readWithinTags(path string, lines []PreprocessedLine, expectedRanges types.TagRanges, position types.Position, diagnostics *types.Diagnostics) ([]PreprocessedLine, error) {
 	log.Debugf("limiting to tag ranges: %v", expectedRanges)
 	currentRanges := make(map[string]*types.CurrentTagRange, len(expectedRanges)) // ensure capacity
-	lineNumber := 0
-	for scanner.Scan() {
-		lineNumber++
-		line := scanner.Bytes()
-		// parse the line in search for the `tag::<tag>[]` or `end:<tag>[]` macros
-		l, err := Parse("", line, Entrypoint("IncludedFileLine"))
+	result := make([]PreprocessedLine, 0, len(lines))
+	for _, l := range lines {
+		lineNumber := l.Location.Line
+		fl, err := parseIncludedFileLine(l)
 		if err != nil {
-			return err
-		}
-		fl, ok := l.(types.IncludedFileLine)
-		if !ok {
-			return errors.Errorf("unexpected type of parsed line in file to include: %T", l)
+			return nil, err
 		}
 		// check if a start or end tag was found in the line
 		if startTag, ok := fl.GetStartTag(); ok {
@@ -182,14 +314,7 @@ func readWithinTags(path string, scanner *bufio.Scanner, content *bytes.Buffer,
 			currentRanges[endTag.Value].EndLine = lineNumber
 		}
 		if expectedRanges.Match(lineNumber, currentRanges) && !fl.HasTag() {
-			_, err := content.Write(scanner.Bytes())
-			if err != nil {
-				return err
-			}
-			_, err = content.WriteString("\n")
-			if err != nil {
-				return err
-			}
+			result = append(result, l)
 		}
 	}
 	// after the file has been processed, let's check if all tags were "found"
@@ -201,81 +326,208 @@ func readWithinTags(path string, scanner *bufio.Scanner, content *bytes.Buffer,
 		default:
 			if tr, found := currentRanges[tag.Name]; !found {
 				log.Errorf("tag '%s' not found in include file: %s", tag.Name, path)
+				diagnostics.Warnf(types.UnresolvedIncludeTag, position, "tag '%s' not found in include file '%s'", tag.Name, path)
 			} else if tr.EndLine == -1 {
 				log.Errorf("detected unclosed tag '%s' starting at line %d of include file: %s", tag.Name, tr.StartLine, path)
+				diagnostics.Warnf(types.UnresolvedIncludeTag, position, "unclosed tag '%s' starting at line %d of include file '%s'", tag.Name, tr.StartLine, path)
 			}
 		}
 	}
-	return nil
+	return result, nil
 }
 
-func readAll(scanner *bufio.Scanner, content *bytes.Buffer) error {
-	for scanner.Scan() {
-		// parse the line in search for the `tag::<tag>[]` or `end:<tag>[]` macros
-		l, err := Parse("", scanner.Bytes(), Entrypoint("IncludedFileLine"))
+func readAll(lines []PreprocessedLine) ([]PreprocessedLine, error) {
+	result := make([]PreprocessedLine, 0, len(lines))
+	for _, l := range lines {
+		fl, err := parseIncludedFileLine(l)
 		if err != nil {
-			return err
-		}
-		fl, ok := l.(types.IncludedFileLine)
-		if !ok {
-			return errors.Errorf("unexpected type of parsed line in file to include: %T", l)
+			return nil, err
 		}
 		// skip if the line has tags
 		if fl.HasTag() {
 			continue
 		}
-		_, err = content.Write(scanner.Bytes())
+		result = append(result, l)
+	}
+	return result, nil
+}
+
+// openFileToInclude opens the file at the given path, which is relative to the current file
+// (`config.Filename`), unless it is absolute, a remote location or a location read by an include processor.
+// Returns the reader of the file, its absolute path (or URI) and a function to call once the file has been read.
+func openFileToInclude(incl types.FileInclusion, path string, config configuration.Configuration) (io.Reader, string, func(), error) {
+	if p, location, target, found := includeProcessorOf(path, config); found {
+		log.Debugf("reading '%s' with include processor", location)
+		r, err := p(configuration.IncludeContext{
+			Config:   config,
+			Position: incl.Position,
+		}, target, incl.Attributes)
 		if err != nil {
-			return err
+			return nil, location, func() {}, err
 		}
-		_, err = content.WriteString("\n")
+		if r == nil {
+			return strings.NewReader(""), location, func() {}, nil
+		}
+		return r, location, func() {
+			if c, ok := r.(io.Closer); ok {
+				if err := c.Close(); err != nil {
+					log.WithError(err).Errorf("failed to close '%s'", location)
+				}
+			}
+		}, nil
+	}
+	switch {
+	case isRemote(path):
+		r, err := openRemote(path, config)
+		return r, path, func() {}, err
+	case isRemote(config.Filename):
+		// relative location in a remote file
+		if !allowURIRead(config) {
+			return nil, path, func() {}, errors.Errorf("cannot read '%s' relatively to '%s' unless the '%s' attribute is set", path, config.Filename, types.AttrAllowURIRead)
+		}
+		uri, err := resolveRemoteLocation(config.Filename, path)
 		if err != nil {
-			return err
+			return nil, path, func() {}, err
+		}
+		r, err := openRemote(uri, config)
+		return r, uri, func() {}, err
+	case config.FS != nil:
+		currentDir := pathpkg.Dir(filepath.ToSlash(config.Filename))
+		log.Debugf("parsing '%s' from current dir '%s' (%s)", path, currentDir, config.Filename)
+		path = pathpkg.Join(currentDir, filepath.ToSlash(path))
+		if config.SafeMode >= configuration.Safe {
+			if err := checkWithinBaseDir(path, config); err != nil {
+				return nil, path, func() {}, err
+			}
+		}
+		return open(path, config)
+	default:
+		currentDir := filepath.Dir(config.Filename)
+		log.Debugf("parsing '%s' from current dir '%s' (%s)", path, currentDir, config.Filename)
+		path = filepath.Join(currentDir, path)
+		if config.SafeMode >= configuration.Safe {
+			if err := checkWithinBaseDir(path, config); err != nil {
+				return nil, path, func() {}, err
+			}
 		}
+		return open(path, config)
 	}
-	return nil
 }
 
-func open(path string) (*os.File, string, func(), error) {
-	wd, err := os.Getwd()
+// includeProcessorOf returns the include processor of the given location if its scheme has a processor
+// (eg: `git:main:README.adoc`), along with the location and the target to pass to the processor (eg: `main:README.adoc`).
+// Also, the relative locations in a file read by an include processor are read by the same processor,
+// with a target which is relative to the target of this file (eg: `main:docs/guide.adoc` for `docs/guide.adoc`
+// in the file read with the `main:README.adoc` target).
+func includeProcessorOf(location string, config configuration.Configuration) (configuration.IncludeProcessor, string, string, bool) {
+	if p, _, target, found := splitIncludeProcessorLocation(location, config); found {
+		return p, location, target, true
+	}
+	if isRemote(location) || filepath.IsAbs(location) || strings.HasPrefix(location, "/") {
+		return nil, location, "", false
+	}
+	if p, scheme, target, found := splitIncludeProcessorLocation(config.Filename, config); found {
+		// replace the last segment of the target (eg: `README.adoc` in `main:README.adoc`)
+		target = target[:strings.LastIndexAny(target, "/:")+1] + location
+		return p, scheme + ":" + target, target, true
+	}
+	return nil, location, "", false
+}
+
+// splitIncludeProcessorLocation returns the include processor of the given location, along with its scheme and target
+func splitIncludeProcessorLocation(location string, config configuration.Configuration) (configuration.IncludeProcessor, string, string, bool) {
+	if i := strings.Index(location, ":"); i > 0 {
+		if p, found := config.IncludeProcessor(location[:i]); found {
+			return p, location[:i], location[i+1:], true
+		}
+	}
+	return nil, "", "", false
+}
+
+// absolutePath returns the absolute path of the given file in the local file system, or its cleaned path in the
+// file system of the configuration (which is not made absolute). The URIs and the locations read by an include processor
+// are returned unchanged.
+func absolutePath(path string, config configuration.Configuration) string {
+	if _, _, _, found := splitIncludeProcessorLocation(path, config); found || isRemote(path) {
+		return path
+	}
+	if config.FS != nil {
+		return pathpkg.Clean(filepath.ToSlash(path))
+	}
+	if absPath, err := filepath.Abs(path); err == nil {
+		return absPath
+	}
+	return path
+}
+
+// checkWithinBaseDir verifies that the given path is located in the base directory of the given configuration
+// (after the symlinks were evaluated, in the local file system)
+func checkWithinBaseDir(path string, config configuration.Configuration) error {
+	baseDir := config.BaseDir
+	if config.FS != nil {
+		// no symlinks to evaluate
+		if rel, err := filepath.Rel(pathpkg.Clean(filepath.ToSlash(baseDir)), path); err != nil || rel == ".." || strings.HasPrefix(rel, "../") {
+			return errors.Errorf("'%s' is outside of the base directory '%s'", path, baseDir)
+		}
+		return nil
+	}
+	absBaseDir, err := realPath(baseDir)
 	if err != nil {
-		return nil, "", func() {}, err
+		return err
 	}
-	absPath, err := filepath.Abs(path)
+	absPath, err := realPath(path)
 	if err != nil {
-		return nil, "", func() {
-			log.Debugf("restoring current working dir to: %s", wd)
-			if err := os.Chdir(wd); err != nil { // restore the previous working directory
-				log.WithError(err).Error("failed to restore previous working directory")
-			}
-		}, err
+		return err
+	}
+	if rel, err := filepath.Rel(absBaseDir, absPath); err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return errors.Errorf("'%s' is outside of the base directory '%s'", path, baseDir)
 	}
-	dir := filepath.Dir(absPath)
-	err = os.Chdir(dir)
+	return nil
+}
+
+// realPath returns the absolute path of the given file, in which the symlinks have been evaluated (if the file exists)
+func realPath(path string) (string, error) {
+	absPath, err := filepath.Abs(path)
 	if err != nil {
-		return nil, "", func() {
-			log.Debugf("restoring current working dir to: %s", wd)
-			if err := os.Chdir(wd); err != nil { // restore the previous working directory
-				log.WithError(err).Error("failed to restore previous working directory")
-			}
-		}, err
+		return "", err
+	}
+	if p, err := filepath.EvalSymlinks(absPath); err == nil {
+		return p, nil
+	}
+	return absPath, nil
+}
+
+// adjustIndentation removes the common leading indentation of the non-blank lines,
+// then indents them with the given number of spaces
+func adjustIndentation(lines []PreprocessedLine, indent int) {
+	common := -1
+	for _, l := range lines {
+		if strings.TrimSpace(l.Content) == "" {
+			continue
+		}
+		if n := len(l.Content) - len(strings.TrimLeft(l.Content, " \t")); common == -1 || n < common {
+			common = n
+		}
 	}
-	// read the file per-se
+	for i, l := range lines {
+		if strings.TrimSpace(l.Content) == "" {
+			lines[i].Content = ""
+			continue
+		}
+		lines[i].Content = strings.Repeat(" ", indent) + l.Content[common:]
+	}
+}
+
+// open opens the file at the given path in the file system of the given configuration
+// (or in the local file system)
+func open(path string, config configuration.Configuration) (io.Reader, string, func(), error) {
+	absPath := absolutePath(path, config)
 	log.Debugf("opening '%s'", absPath)
-	f, err := os.Open(absPath)
+	f, err := config.Open(absPath)
 	if err != nil {
-		return nil, absPath, func() {
-			log.Debugf("restoring current working dir to: %s", wd)
-			if err := os.Chdir(wd); err != nil { // restore the previous working directory
-				log.WithError(err).Error("failed to restore previous working directory")
-			}
-		}, err
+		return nil, absPath, func() {}, err
 	}
 	return f, absPath, func() {
-		log.Debugf("restoring current working dir to: %s", wd)
-		if err := os.Chdir(wd); err != nil { // restore the previous working directory
-			log.WithError(err).Error("failed to restore previous working directory")
-		}
 		if err := f.Close(); err != nil {
 			log.WithError(err).Errorf("failed to close file '%s'", absPath)
 		}
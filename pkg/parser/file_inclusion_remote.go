@@ -0,0 +1,150 @@
+package parser
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// isRemote returns true if the given path is an `http://` or `https://` URI
+func isRemote(path string) bool {
+	return strings.HasPrefix(path, "http://") || strings.HasPrefix(path, "https://")
+}
+
+// allowURIRead returns true if the `allow-uri-read` attribute was set via the API or the CLI.
+// As in Asciidoctor, this attribute cannot be set in the document itself.
+func allowURIRead(config configuration.Configuration) bool {
+	_, found := types.NewAttributesWithOverrides(config.AttributeOverrides).GetAsString(types.AttrAllowURIRead)
+	return found
+}
+
+// resolveRemoteLocation resolves the given path relatively to the given base URI
+func resolveRemoteLocation(base, path string) (string, error) {
+	b, err := url.Parse(base)
+	if err != nil {
+		return "", errors.Wrapf(err, "invalid URI '%s'", base)
+	}
+	p, err := url.Parse(path)
+	if err != nil {
+		return "", errors.Wrapf(err, "invalid path '%s'", path)
+	}
+	return b.ResolveReference(p).String(), nil
+}
+
+// pathOf returns the path of the given location, without the query and the fragment if it is a URI
+func pathOf(location string) string {
+	if !isRemote(location) {
+		return location
+	}
+	if u, err := url.Parse(location); err == nil {
+		return u.Path
+	}
+	return location
+}
+
+// openRemote reads the content at the given URI with the HTTP client of the given configuration,
+// or from the cache directory (if configured) when the content was already read and has not expired yet
+func openRemote(uri string, config configuration.Configuration) (io.Reader, error) {
+	if config.URICacheDir != "" {
+		if content, found := readURICache(config.URICacheDir, uri, config.URICacheTTL); found {
+			log.Debugf("reading '%s' from cache", uri)
+			return bytes.NewReader(content), nil
+		}
+	}
+	log.Debugf("reading '%s'", uri)
+	ctx := context.Background()
+	if config.HTTPTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, config.HTTPTimeout)
+		defer cancel()
+	}
+	req, err := http.NewRequest(http.MethodGet, uri, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to read '%s'", uri)
+	}
+	client := config.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to read '%s'", uri)
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			log.WithError(err).Errorf("failed to close response body of '%s'", uri)
+		}
+	}()
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("unable to read '%s': %s", uri, resp.Status)
+	}
+	limit := config.MaxRemoteSize
+	if limit <= 0 {
+		limit = configuration.DefaultMaxRemoteSize
+	}
+	// read one more byte than the limit, to detect the content which exceeds it
+	content, err := ioutil.ReadAll(io.LimitReader(resp.Body, limit+1))
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to read '%s'", uri)
+	}
+	if int64(len(content)) > limit {
+		return nil, errors.Errorf("unable to read '%s': content exceeds the maximum size of %d bytes", uri, limit)
+	}
+	if config.URICacheDir != "" {
+		if err := writeURICache(config.URICacheDir, uri, content); err != nil {
+			// not a blocker
+			log.WithError(err).Warnf("unable to cache content of '%s'", uri)
+		}
+	}
+	return bytes.NewReader(content), nil
+}
+
+// uriCacheFile returns the path to the file in which the content at the given URI is cached
+func uriCacheFile(dir, uri string) string {
+	sum := sha256.Sum256([]byte(uri))
+	return filepath.Join(dir, hex.EncodeToString(sum[:]))
+}
+
+// readURICache returns the cached content at the given URI, unless it was cached for longer than the given duration
+// (or `configuration.DefaultURICacheTTL` if zero)
+func readURICache(dir, uri string, ttl time.Duration) ([]byte, bool) {
+	if ttl == 0 {
+		ttl = configuration.DefaultURICacheTTL
+	}
+	f := uriCacheFile(dir, uri)
+	info, err := os.Stat(f)
+	if err != nil {
+		return nil, false
+	}
+	if time.Since(info.ModTime()) > ttl {
+		log.Debugf("cached content of '%s' has expired", uri)
+		return nil, false
+	}
+	content, err := ioutil.ReadFile(f)
+	if err != nil {
+		return nil, false
+	}
+	return content, true
+}
+
+func writeURICache(dir, uri string, content []byte) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(uriCacheFile(dir, uri), content, 0644)
+}
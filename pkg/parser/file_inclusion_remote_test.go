@@ -0,0 +1,211 @@
+package parser_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+	"github.com/bytesparadise/libasciidoc/pkg/parser"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo" //nolint golint
+	. "github.com/onsi/gomega" //nolint golint
+	log "github.com/sirupsen/logrus"
+)
+
+var _ = Describe("remote file inclusions", func() {
+
+	var server *httptest.Server
+	var requests int
+
+	BeforeEach(func() {
+		requests = 0
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			switch r.URL.Path {
+			case "/chapter.adoc":
+				fmt.Fprint(w, "== Chapter\n\nsome *content*")
+			case "/docs/index.adoc":
+				fmt.Fprint(w, "include::sections/section.adoc[]")
+			case "/docs/sections/section.adoc":
+				fmt.Fprint(w, "a section")
+			case "/version.adoc":
+				fmt.Fprintf(w, "version %d", requests)
+			case "/hello.txt":
+				fmt.Fprint(w, "package main")
+			case "/large.adoc":
+				fmt.Fprint(w, strings.Repeat("a", 100))
+			case "/slow.adoc":
+				time.Sleep(200 * time.Millisecond)
+				fmt.Fprint(w, "too late")
+			default:
+				http.NotFound(w, r)
+			}
+		}))
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	parseDraftDocument := func(source string, settings ...configuration.Setting) (types.DraftDocument, error) {
+		settings = append([]configuration.Setting{
+			configuration.WithFilename("test.adoc"),
+			configuration.WithHTTPClient(server.Client()),
+		}, settings...)
+		return parser.ParseDraftDocument(strings.NewReader(source), configuration.NewConfiguration(settings...))
+	}
+
+	It("should include remote asciidoc file", func() {
+		source := `include::` + server.URL + `/chapter.adoc[]`
+		expected, err := ParseDraftDocument("== Chapter\n\nsome *content*")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(parseDraftDocument(source, configuration.WithAttribute(types.AttrAllowURIRead, ""))).
+			To(MatchDraftDocument(expected.(types.DraftDocument)))
+	})
+
+	It("should include remote file with attribute in location", func() {
+		source := `:host: ` + server.URL + `
+
+include::{host}/chapter.adoc[leveloffset=+1]`
+		expected, err := ParseDraftDocument(`:host: ` + server.URL + `
+
+=== Chapter
+
+some *content*`)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(parseDraftDocument(source, configuration.WithAttribute(types.AttrAllowURIRead, ""))).
+			To(MatchDraftDocument(expected.(types.DraftDocument)))
+	})
+
+	It("should include remote file in listing block", func() {
+		source := `----
+include::` + server.URL + `/hello.txt[]
+----`
+		expected, err := ParseDraftDocument("----\npackage main\n----")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(parseDraftDocument(source, configuration.WithAttribute(types.AttrAllowURIRead, ""))).
+			To(MatchDraftDocument(expected.(types.DraftDocument)))
+	})
+
+	It("should include files relatively to remote file", func() {
+		source := `include::` + server.URL + `/docs/index.adoc[]`
+		expected, err := ParseDraftDocument("a section")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(parseDraftDocument(source, configuration.WithAttribute(types.AttrAllowURIRead, ""))).
+			To(MatchDraftDocument(expected.(types.DraftDocument)))
+	})
+
+	It("should replace with link when allow-uri-read is not set", func() {
+		console, reset := ConfigureLogger()
+		defer reset()
+		source := `include::` + server.URL + `/chapter.adoc[]`
+		expected, err := ParseDraftDocument(`link:` + server.URL + `/chapter.adoc[]`)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(parseDraftDocument(source)).To(MatchDraftDocument(expected.(types.DraftDocument)))
+		Expect(requests).To(Equal(0))
+		Expect(console).To(ContainMessageWithLevel(log.WarnLevel, "cannot include '"+server.URL+"/chapter.adoc' in 'test.adoc' unless the 'allow-uri-read' attribute is set"))
+	})
+
+	It("should ignore allow-uri-read when set in document", func() {
+		source := `:allow-uri-read:
+
+include::` + server.URL + `/chapter.adoc[]`
+		expected, err := ParseDraftDocument(`:allow-uri-read:
+
+link:` + server.URL + `/chapter.adoc[]`)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(parseDraftDocument(source)).To(MatchDraftDocument(expected.(types.DraftDocument)))
+		Expect(requests).To(Equal(0))
+	})
+
+	It("should replace with error message when remote file is missing", func() {
+		console, reset := ConfigureLogger()
+		defer reset()
+		source := `include::` + server.URL + `/unknown.adoc[]`
+		// the error message replaces the directive, so the URL becomes a link
+		expected, err := ParseDraftDocument("Unresolved directive in test.adoc - include::" + server.URL + "/unknown.adoc[]")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(parseDraftDocument(source, configuration.WithAttribute(types.AttrAllowURIRead, ""))).
+			To(MatchDraftDocument(expected.(types.DraftDocument)))
+		Expect(console).To(ContainMessageWithLevel(log.ErrorLevel, "unable to read file to include '"+server.URL+"/unknown.adoc' in 'test.adoc'"))
+	})
+
+	It("should replace with error message when remote server is too slow", func() {
+		source := `include::` + server.URL + `/slow.adoc[]`
+		// the error message replaces the directive, so the URL becomes a link
+		expected, err := ParseDraftDocument("Unresolved directive in test.adoc - include::" + server.URL + "/slow.adoc[]")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(parseDraftDocument(source,
+			configuration.WithAttribute(types.AttrAllowURIRead, ""),
+			configuration.WithHTTPTimeout(50*time.Millisecond))).
+			To(MatchDraftDocument(expected.(types.DraftDocument)))
+	})
+
+	It("should replace with error message when remote file is too large", func() {
+		source := `include::` + server.URL + `/large.adoc[]`
+		diagnostics := types.NewDiagnostics()
+		_, err := parseDraftDocument(source,
+			configuration.WithAttribute(types.AttrAllowURIRead, ""),
+			configuration.WithMaxRemoteSize(10),
+			configuration.WithDiagnostics(diagnostics))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(diagnostics.All()).To(HaveLen(1))
+		Expect(diagnostics.All()[0].Code).To(Equal(types.UnresolvedInclude))
+		Expect(diagnostics.All()[0].Message).To(HaveSuffix("content exceeds the maximum size of 10 bytes"))
+	})
+
+	It("should read remote file from cache", func() {
+		dir, err := ioutil.TempDir("", "libasciidoc-cache")
+		Expect(err).NotTo(HaveOccurred())
+		defer os.RemoveAll(dir)
+		source := `include::` + server.URL + `/chapter.adoc[]`
+		expected, err := ParseDraftDocument("== Chapter\n\nsome *content*")
+		Expect(err).NotTo(HaveOccurred())
+		for i := 0; i < 2; i++ {
+			Expect(parseDraftDocument(source,
+				configuration.WithAttribute(types.AttrAllowURIRead, ""),
+				configuration.WithURICacheDir(dir))).
+				To(MatchDraftDocument(expected.(types.DraftDocument)))
+		}
+		Expect(requests).To(Equal(1))
+	})
+
+	It("should read remote file again when its cached content has expired", func() {
+		dir, err := ioutil.TempDir("", "libasciidoc-cache")
+		Expect(err).NotTo(HaveOccurred())
+		defer os.RemoveAll(dir)
+		source := `include::` + server.URL + `/version.adoc[]`
+		settings := []configuration.Setting{
+			configuration.WithAttribute(types.AttrAllowURIRead, ""),
+			configuration.WithURICacheDir(dir),
+			configuration.WithURICacheTTL(time.Minute),
+		}
+		expected, err := ParseDraftDocument("version 1")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(parseDraftDocument(source, settings...)).To(MatchDraftDocument(expected.(types.DraftDocument)))
+		// not expired yet
+		Expect(parseDraftDocument(source, settings...)).To(MatchDraftDocument(expected.(types.DraftDocument)))
+		Expect(requests).To(Equal(1))
+		// make the cached content older than its TTL
+		files, err := ioutil.ReadDir(dir)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(files).To(HaveLen(1))
+		past := time.Now().Add(-2 * time.Minute)
+		Expect(os.Chtimes(filepath.Join(dir, files[0].Name()), past, past)).To(Succeed())
+		expected, err = ParseDraftDocument("version 2")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(parseDraftDocument(source, settings...)).To(MatchDraftDocument(expected.(types.DraftDocument)))
+		Expect(requests).To(Equal(2))
+		// the refreshed content is cached again
+		Expect(parseDraftDocument(source, settings...)).To(MatchDraftDocument(expected.(types.DraftDocument)))
+		Expect(requests).To(Equal(2))
+	})
+})
@@ -1,8 +1,15 @@
 package parser_test
 
 import (
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
 	"strings"
+	"time"
 
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
 	"github.com/bytesparadise/libasciidoc/pkg/parser"
 	"github.com/bytesparadise/libasciidoc/pkg/types"
 	. "github.com/bytesparadise/libasciidoc/testsupport"
@@ -11,6 +18,7 @@ import (
 	. "github.com/onsi/ginkgo" //nolint golint
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega" //nolint golint
+	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
 )
 
@@ -119,6 +127,64 @@ var _ = Describe("file inclusions", func() {
 
 	Context("draft document", func() {
 
+		Context("raw draft document", func() {
+
+			It("should retain the file inclusions", func() {
+				source := `include::chapter-a.adoc[]
+
+====
+include::chapter-b.adoc[]
+====
+
+----
+include::code.go[]
+----`
+				expected := types.DraftDocument{
+					Blocks: []interface{}{
+						types.FileInclusion{
+							Location: types.Location{
+								Path: []interface{}{
+									types.StringElement{
+										Content: "chapter-a.adoc",
+									},
+								},
+							},
+							RawText: "include::chapter-a.adoc[]",
+							Line:    1,
+						},
+						types.BlankLine{},
+						types.DelimitedBlock{
+							Kind: types.Example,
+							Elements: []interface{}{
+								types.FileInclusion{
+									Location: types.Location{
+										Path: []interface{}{
+											types.StringElement{
+												Content: "chapter-b.adoc",
+											},
+										},
+									},
+									RawText: "include::chapter-b.adoc[]",
+									Line:    1,
+								},
+							},
+						},
+						types.BlankLine{},
+						types.DelimitedBlock{
+							Kind: types.Listing,
+							Elements: []interface{}{
+								types.VerbatimLine{
+									Content: "include::code.go[]",
+								},
+							},
+						},
+					},
+				}
+				Expect(parser.ParseRawDraftDocument(strings.NewReader(source), configuration.NewConfiguration(configuration.WithFilename("test.adoc")))).
+					To(Equal(expected))
+			})
+		})
+
 		Context("without preprocessing", func() {
 
 			It("should include adoc file without leveloffset in local dir", func() {
@@ -136,6 +202,7 @@ var _ = Describe("file inclusions", func() {
 								},
 							},
 							RawText: source,
+							Line:    1,
 						},
 					},
 				}
@@ -160,6 +227,7 @@ var _ = Describe("file inclusions", func() {
 								},
 							},
 							RawText: source,
+							Line:    1,
 						},
 					},
 				}
@@ -185,6 +253,7 @@ var _ = Describe("file inclusions", func() {
 								},
 							},
 							RawText: source,
+							Line:    1,
 						},
 					},
 				}
@@ -212,6 +281,7 @@ var _ = Describe("file inclusions", func() {
 											},
 										},
 										RawText: `include::../../test/includes/chapter-a.adoc[]`,
+										Line:    2,
 									},
 								},
 							},
@@ -238,6 +308,7 @@ var _ = Describe("file inclusions", func() {
 											},
 										},
 										RawText: `include::../../test/includes/chapter-a.adoc[]`,
+										Line:    2,
 									},
 								},
 							},
@@ -264,6 +335,7 @@ var _ = Describe("file inclusions", func() {
 											},
 										},
 										RawText: `include::../../test/includes/chapter-a.adoc[]`,
+										Line:    2,
 									},
 								},
 							},
@@ -290,6 +362,7 @@ var _ = Describe("file inclusions", func() {
 											},
 										},
 										RawText: `include::../../test/includes/chapter-a.adoc[]`,
+										Line:    2,
 									},
 								},
 							},
@@ -320,6 +393,7 @@ var _ = Describe("file inclusions", func() {
 											},
 										},
 										RawText: `include::../../test/includes/chapter-a.adoc[]`,
+										Line:    3,
 									},
 								},
 							},
@@ -346,6 +420,7 @@ var _ = Describe("file inclusions", func() {
 											},
 										},
 										RawText: `include::../../test/includes/chapter-a.adoc[]`,
+										Line:    2,
 									},
 								},
 							},
@@ -373,6 +448,7 @@ var _ = Describe("file inclusions", func() {
 											},
 										},
 										RawText: `include::../../test/includes/chapter-a.adoc[]`,
+										Line:    2,
 									},
 								},
 							},
@@ -404,6 +480,7 @@ var _ = Describe("file inclusions", func() {
 										},
 									},
 									RawText: `include::../../test/includes/chapter-a.adoc[lines=1]`,
+									Line:    1,
 								},
 							},
 						}
@@ -429,6 +506,7 @@ var _ = Describe("file inclusions", func() {
 										},
 									},
 									RawText: `include::../../test/includes/chapter-a.adoc[lines=1..2]`,
+									Line:    1,
 								},
 							},
 						}
@@ -456,6 +534,7 @@ var _ = Describe("file inclusions", func() {
 										},
 									},
 									RawText: `include::../../test/includes/chapter-a.adoc[lines=1;3..4;6..-1]`,
+									Line:    1,
 								},
 							},
 						}
@@ -479,6 +558,7 @@ var _ = Describe("file inclusions", func() {
 										},
 									},
 									RawText: `include::../../test/includes/chapter-a.adoc[lines=1;3..4;6..foo]`,
+									Line:    1,
 								},
 							},
 						}
@@ -506,6 +586,7 @@ var _ = Describe("file inclusions", func() {
 										},
 									},
 									RawText: `include::../../test/includes/chapter-a.adoc[lines=1,3..4,6..-1]`,
+									Line:    1,
 								},
 							},
 						}
@@ -529,6 +610,7 @@ var _ = Describe("file inclusions", func() {
 										},
 									},
 									RawText: `include::../../test/includes/chapter-a.adoc[lines=foo]`,
+									Line:    1,
 								},
 							},
 						}
@@ -557,6 +639,7 @@ var _ = Describe("file inclusions", func() {
 										},
 									},
 									RawText: `include::../../test/includes/chapter-a.adoc[lines="1"]`,
+									Line:    1,
 								},
 							},
 						}
@@ -582,6 +665,7 @@ var _ = Describe("file inclusions", func() {
 										},
 									},
 									RawText: `include::../../test/includes/chapter-a.adoc[lines="1..2"]`,
+									Line:    1,
 								},
 							},
 						}
@@ -609,6 +693,7 @@ var _ = Describe("file inclusions", func() {
 										},
 									},
 									RawText: `include::../../test/includes/chapter-a.adoc[lines="1,3..4,6..-1"]`,
+									Line:    1,
 								},
 							},
 						}
@@ -634,6 +719,7 @@ var _ = Describe("file inclusions", func() {
 										},
 									},
 									RawText: `include::../../test/includes/chapter-a.adoc[lines="1,3..4,6..foo"]`,
+									Line:    1,
 								},
 							},
 						}
@@ -641,13 +727,17 @@ var _ = Describe("file inclusions", func() {
 							WithoutPreprocessing())).To(Equal(expected))
 					})
 
-					It("file inclusion with invalid quoted range - case 2", func() {
-						source := `include::../../test/includes/chapter-a.adoc[lines="1;3..4;6..10"]` // using semi-colons instead of commas
+					It("file inclusion with multiple quoted ranges separated by semicolons", func() {
+						source := `include::../../test/includes/chapter-a.adoc[lines="1;3..4;6..10"]`
 						expected := types.DraftDocument{
 							Blocks: []interface{}{
 								types.FileInclusion{
 									Attributes: types.Attributes{
-										types.AttrLineRanges: `"1;3..4;6..10"`,
+										types.AttrLineRanges: types.LineRanges{
+											{StartLine: 1, EndLine: 1},
+											{StartLine: 3, EndLine: 4},
+											{StartLine: 6, EndLine: 10},
+										},
 									},
 									Location: types.Location{
 										Path: []interface{}{
@@ -657,6 +747,7 @@ var _ = Describe("file inclusions", func() {
 										},
 									},
 									RawText: source,
+									Line:    1,
 								},
 							},
 						}
@@ -688,6 +779,7 @@ var _ = Describe("file inclusions", func() {
 										},
 									},
 									RawText: source,
+									Line:    1,
 								},
 							},
 						}
@@ -720,6 +812,7 @@ var _ = Describe("file inclusions", func() {
 										},
 									},
 									RawText: source,
+									Line:    1,
 								},
 							},
 						}
@@ -1768,12 +1861,20 @@ include::../../test/includes/chapter-a.adoc[]
 						Expect(ParseDraftDocument(source)).To(MatchDraftDocument(expected))
 					})
 
-					It("file inclusion with invalid quoted range - case 2", func() {
-						source := `include::../../test/includes/chapter-a.adoc[lines="1;3..4;6..10"]` // using semi-colons instead of commas
+					It("file inclusion with multiple quoted ranges separated by semicolons", func() {
+						// here, the `content` paragraph gets attached to the header and becomes the author
+						source := `include::../../test/includes/chapter-a.adoc[lines="1;3..4;6..10"]`
 						expected := types.DraftDocument{
 							Blocks: []interface{}{
 								types.Section{
 									Level: 0,
+									Attributes: types.Attributes{
+										types.AttrAuthors: []types.DocumentAuthor{
+											{
+												FullName: "content",
+											},
+										},
+									},
 									Title: []interface{}{
 										types.StringElement{
 											Content: "Chapter A",
@@ -1781,16 +1882,6 @@ include::../../test/includes/chapter-a.adoc[]
 									},
 									Elements: []interface{}{},
 								},
-								types.BlankLine{},
-								types.Paragraph{
-									Lines: [][]interface{}{
-										{
-											types.StringElement{
-												Content: "content",
-											},
-										},
-									},
-								},
 							},
 						}
 						Expect(ParseDraftDocument(source)).To(MatchDraftDocument(expected))
@@ -2177,7 +2268,13 @@ include::../../test/includes/chapter-a.adoc[]
 								Lines: [][]interface{}{
 									{
 										types.StringElement{
-											Content: "Unresolved directive in test.adoc - include::{unknown}/unknown.adoc[leveloffset=+1]",
+											Content: "Unresolved directive in test.adoc - include::",
+										},
+										types.AttributeSubstitution{
+											Name: "unknown",
+										},
+										types.StringElement{
+											Content: "/unknown.adoc[leveloffset=+1]",
 										},
 									},
 								},
@@ -2186,7 +2283,7 @@ include::../../test/includes/chapter-a.adoc[]
 					}
 					Expect(ParseDraftDocument(source)).To(MatchDraftDocument(expected))
 					// verify error in logs
-					Expect(console).To(ContainMessageWithLevel(log.ErrorLevel, "failed to include content of '{unknown}/unknown.adoc' in 'test.adoc'"))
+					Expect(console).To(ContainMessageWithLevel(log.ErrorLevel, "failed to include content of '{unknown}/unknown.adoc' in 'test.adoc:1'"))
 				})
 
 				It("should replace with error message if file is missing in standalone block", func() {
@@ -2210,7 +2307,7 @@ include::../../test/includes/chapter-a.adoc[]
 					}
 					Expect(ParseDraftDocument(source)).To(MatchDraftDocument(expected))
 					// verify error in logs
-					Expect(console).To(ContainMessageWithLevel(log.ErrorLevel, "failed to include content of '../../test/includes/unknown.adoc' in 'test.adoc'"))
+					Expect(console).To(ContainMessageWithLevel(log.ErrorLevel, "failed to include content of '../../test/includes/unknown.adoc' in 'test.adoc:1'"))
 				})
 
 				It("should replace with error message if file with attribute in path is not resolved", func() {
@@ -2225,7 +2322,13 @@ include::../../test/includes/chapter-a.adoc[]
 								Lines: [][]interface{}{
 									{
 										types.StringElement{
-											Content: "Unresolved directive in test.adoc - include::{includedir}/unknown.adoc[leveloffset=+1]",
+											Content: "Unresolved directive in test.adoc - include::",
+										},
+										types.AttributeSubstitution{
+											Name: "includedir",
+										},
+										types.StringElement{
+											Content: "/unknown.adoc[leveloffset=+1]",
 										},
 									},
 								},
@@ -2234,7 +2337,7 @@ include::../../test/includes/chapter-a.adoc[]
 					}
 					Expect(ParseDraftDocument(source)).To(MatchDraftDocument(expected))
 					// verify error in logs
-					Expect(console).To(ContainMessageWithLevel(log.ErrorLevel, "failed to include content of '{includedir}/unknown.adoc' in 'test.adoc'"))
+					Expect(console).To(ContainMessageWithLevel(log.ErrorLevel, "failed to include content of '{includedir}/unknown.adoc' in 'test.adoc:1'"))
 				})
 
 				It("should replace with error message if file is missing in delimited block", func() {
@@ -2259,7 +2362,7 @@ include::../../test/includes/unknown.adoc[leveloffset=+1]
 					}
 					Expect(ParseDraftDocument(source)).To(MatchDraftDocument(expected))
 					// verify error in logs
-					Expect(console).To(ContainMessageWithLevel(log.ErrorLevel, "failed to include content of '../../test/includes/unknown.adoc' in 'test.adoc'"))
+					Expect(console).To(ContainMessageWithLevel(log.ErrorLevel, "failed to include content of '../../test/includes/unknown.adoc' in 'test.adoc:2'"))
 				})
 
 				It("should replace with error message if file with attribute in path is not resolved", func() {
@@ -2284,7 +2387,68 @@ include::{includedir}/unknown.adoc[leveloffset=+1]
 					}
 					Expect(ParseDraftDocument(source)).To(MatchDraftDocument(expected))
 					// verify error in logs
-					Expect(console).To(ContainMessageWithLevel(log.ErrorLevel, "failed to include content of '{includedir}/unknown.adoc' in 'test.adoc'"))
+					Expect(console).To(ContainMessageWithLevel(log.ErrorLevel, "failed to include content of '{includedir}/unknown.adoc' in 'test.adoc:2'"))
+				})
+
+				It("should replace with error message if file is missing in paragraph", func() {
+					source := `some text
+include::../../test/includes/unknown.adoc[]
+more text`
+					expected := types.DraftDocument{
+						Blocks: []interface{}{
+							types.Paragraph{
+								Lines: [][]interface{}{
+									{
+										types.StringElement{
+											Content: "some text",
+										},
+									},
+									{
+										types.StringElement{
+											Content: "Unresolved directive in test.adoc - include::../../test/includes/unknown.adoc[]",
+										},
+									},
+									{
+										types.StringElement{
+											Content: "more text",
+										},
+									},
+								},
+							},
+						},
+					}
+					Expect(ParseDraftDocument(source)).To(MatchDraftDocument(expected))
+				})
+
+				It("should replace with error message if file is missing in example block after included lines", func() {
+					source := `====
+include::../../test/includes/paragraph.adoc[]
+include::../../test/includes/unknown.adoc[]
+====`
+					expected := types.DraftDocument{
+						Blocks: []interface{}{
+							types.DelimitedBlock{
+								Kind: types.Example,
+								Elements: []interface{}{
+									types.Paragraph{
+										Lines: [][]interface{}{
+											{
+												types.StringElement{
+													Content: "a paragraph",
+												},
+											},
+											{
+												types.StringElement{
+													Content: "Unresolved directive in test.adoc - include::../../test/includes/unknown.adoc[]",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					}
+					Expect(ParseDraftDocument(source)).To(MatchDraftDocument(expected))
 				})
 			})
 
@@ -2416,6 +2580,243 @@ include::{includedir}/grandchild-include.adoc[]
 				})
 			})
 
+			Context("with include options", func() {
+
+				It("should remove indentation", func() {
+					source := `----
+include::../../test/includes/indented_snippet.txt[indent=0]
+----`
+					expected := types.DraftDocument{
+						Blocks: []interface{}{
+							types.DelimitedBlock{
+								Kind: types.Listing,
+								Elements: []interface{}{
+									types.VerbatimLine{
+										Content: `func hello() {`,
+									},
+									types.VerbatimLine{
+										Content: `    fmt.Println("hello")`,
+									},
+									types.VerbatimLine{
+										Content: ``,
+									},
+									types.VerbatimLine{
+										Content: `}`,
+									},
+								},
+							},
+						},
+					}
+					Expect(ParseDraftDocument(source)).To(MatchDraftDocument(expected))
+				})
+
+				It("should adjust indentation", func() {
+					source := `----
+include::../../test/includes/indented_snippet.txt[indent=2]
+----`
+					expected := types.DraftDocument{
+						Blocks: []interface{}{
+							types.DelimitedBlock{
+								Kind: types.Listing,
+								Elements: []interface{}{
+									types.VerbatimLine{
+										Content: `  func hello() {`,
+									},
+									types.VerbatimLine{
+										Content: `      fmt.Println("hello")`,
+									},
+									types.VerbatimLine{
+										Content: ``,
+									},
+									types.VerbatimLine{
+										Content: `  }`,
+									},
+								},
+							},
+						},
+					}
+					Expect(ParseDraftDocument(source)).To(MatchDraftDocument(expected))
+				})
+
+				It("should include lines until the end with open-ended range", func() {
+					source := `----
+include::../../test/includes/lines.txt[lines=5..]
+----`
+					expected := types.DraftDocument{
+						Blocks: []interface{}{
+							types.DelimitedBlock{
+								Kind: types.Listing,
+								Elements: []interface{}{
+									types.VerbatimLine{
+										Content: `line 5`,
+									},
+									types.VerbatimLine{
+										Content: `line 6`,
+									},
+								},
+							},
+						},
+					}
+					Expect(ParseDraftDocument(source)).To(MatchDraftDocument(expected))
+				})
+
+				It("should include lines until the end with negative range", func() {
+					source := `----
+include::../../test/includes/lines.txt[lines=5..-1]
+----`
+					expected := types.DraftDocument{
+						Blocks: []interface{}{
+							types.DelimitedBlock{
+								Kind: types.Listing,
+								Elements: []interface{}{
+									types.VerbatimLine{
+										Content: `line 5`,
+									},
+									types.VerbatimLine{
+										Content: `line 6`,
+									},
+								},
+							},
+						},
+					}
+					Expect(ParseDraftDocument(source)).To(MatchDraftDocument(expected))
+				})
+
+				It("should include lines counting from the end", func() {
+					source := `----
+include::../../test/includes/lines.txt[lines=-3..-2]
+----`
+					expected := types.DraftDocument{
+						Blocks: []interface{}{
+							types.DelimitedBlock{
+								Kind: types.Listing,
+								Elements: []interface{}{
+									types.VerbatimLine{
+										Content: `line 4`,
+									},
+									types.VerbatimLine{
+										Content: `line 5`,
+									},
+								},
+							},
+						},
+					}
+					Expect(ParseDraftDocument(source)).To(MatchDraftDocument(expected))
+				})
+
+				It("should include multiple ranges separated by semicolons", func() {
+					source := `----
+include::../../test/includes/lines.txt[lines=1;5..]
+----`
+					expected := types.DraftDocument{
+						Blocks: []interface{}{
+							types.DelimitedBlock{
+								Kind: types.Listing,
+								Elements: []interface{}{
+									types.VerbatimLine{
+										Content: `line 1`,
+									},
+									types.VerbatimLine{
+										Content: `line 5`,
+									},
+									types.VerbatimLine{
+										Content: `line 6`,
+									},
+								},
+							},
+						},
+					}
+					Expect(ParseDraftDocument(source)).To(MatchDraftDocument(expected))
+				})
+
+				It("should include multiple quoted ranges separated by semicolons", func() {
+					source := `----
+include::../../test/includes/lines.txt[lines="2..3;6"]
+----`
+					expected := types.DraftDocument{
+						Blocks: []interface{}{
+							types.DelimitedBlock{
+								Kind: types.Listing,
+								Elements: []interface{}{
+									types.VerbatimLine{
+										Content: `line 2`,
+									},
+									types.VerbatimLine{
+										Content: `line 3`,
+									},
+									types.VerbatimLine{
+										Content: `line 6`,
+									},
+								},
+							},
+						},
+					}
+					Expect(ParseDraftDocument(source)).To(MatchDraftDocument(expected))
+				})
+
+				It("should transcode file with custom encoding", func() {
+					source := `----
+include::../../test/includes/latin1.txt[encoding=iso-8859-1]
+----`
+					expected := types.DraftDocument{
+						Blocks: []interface{}{
+							types.DelimitedBlock{
+								Kind: types.Listing,
+								Elements: []interface{}{
+									types.VerbatimLine{
+										Content: `café crème`,
+									},
+								},
+							},
+						},
+					}
+					Expect(ParseDraftDocument(source)).To(MatchDraftDocument(expected))
+				})
+
+				It("should skip missing optional file", func() {
+					// setup logger to write in a buffer so we can check the output
+					console, reset := ConfigureLogger()
+					defer reset()
+					source := `include::../../test/includes/unknown.adoc[opts=optional]
+
+some content`
+					expected := types.DraftDocument{
+						Blocks: []interface{}{
+							types.BlankLine{},
+							types.Paragraph{
+								Lines: [][]interface{}{
+									{
+										types.StringElement{
+											Content: "some content",
+										},
+									},
+								},
+							},
+						},
+					}
+					Expect(ParseDraftDocument(source)).To(MatchDraftDocument(expected))
+					Expect(console).ToNot(ContainAnyMessageWithLevels(log.ErrorLevel, log.WarnLevel))
+				})
+
+				It("should replace with error message with unknown encoding", func() {
+					source := `include::../../test/includes/latin1.txt[encoding=unknown]`
+					expected := types.DraftDocument{
+						Blocks: []interface{}{
+							types.Paragraph{
+								Lines: [][]interface{}{
+									{
+										types.StringElement{
+											Content: "Unresolved directive in test.adoc - include::../../test/includes/latin1.txt[encoding=unknown]",
+										},
+									},
+								},
+							},
+						},
+					}
+					Expect(ParseDraftDocument(source)).To(MatchDraftDocument(expected))
+				})
+			})
+
 			Context("inclusion of non-asciidoc file", func() {
 
 				It("include go file without any range", func() {
@@ -2775,3 +3176,339 @@ include::../../test/includes/hello_world.go.txt[lines=1]
 		})
 	})
 })
+
+var _ = Describe("file inclusions with safe mode", func() {
+
+	parseDraftDocument := func(source string, settings ...configuration.Setting) (types.DraftDocument, error) {
+		settings = append([]configuration.Setting{configuration.WithFilename("test.adoc")}, settings...)
+		return parser.ParseDraftDocument(strings.NewReader(source), configuration.NewConfiguration(settings...))
+	}
+
+	unresolved := func(source string) types.DraftDocument {
+		return types.DraftDocument{
+			Blocks: []interface{}{
+				types.Paragraph{
+					Lines: [][]interface{}{
+						{
+							types.StringElement{
+								Content: "Unresolved directive in test.adoc - " + source,
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	It("should include file within base directory in safe mode", func() {
+		source := `include::../../test/includes/chapter-a.adoc[]`
+		expected, err := ParseDraftDocument(source)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(parseDraftDocument(source,
+			configuration.WithSafeMode(configuration.Safe),
+			configuration.WithBaseDir("../../test"))).
+			To(MatchDraftDocument(expected.(types.DraftDocument)))
+	})
+
+	It("should include nested files within base directory in server mode", func() {
+		source := `include::../../test/includes/parent-include.adoc[]`
+		expected, err := ParseDraftDocument(source)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(parseDraftDocument(source,
+			configuration.WithSafeMode(configuration.Server),
+			configuration.WithBaseDir("../../test/includes"))).
+			To(MatchDraftDocument(expected.(types.DraftDocument)))
+	})
+
+	It("should not include file outside of document directory in safe mode", func() {
+		// setup logger to write in a buffer so we can check the output
+		console, reset := ConfigureLogger()
+		defer reset()
+		source := `include::../../test/includes/chapter-a.adoc[]`
+		Expect(parseDraftDocument(source, configuration.WithSafeMode(configuration.Safe))).
+			To(MatchDraftDocument(unresolved(source)))
+		Expect(console).To(ContainMessageWithLevel(log.ErrorLevel, "unable to read file to include '../../test/includes/chapter-a.adoc' in 'test.adoc'"))
+	})
+
+	It("should not include file outside of base directory in server mode", func() {
+		source := `include::../../test/includes/chapter-a.adoc[]`
+		Expect(parseDraftDocument(source,
+			configuration.WithSafeMode(configuration.Server),
+			configuration.WithBaseDir("../../test/includes/../../pkg"))).
+			To(MatchDraftDocument(unresolved(source)))
+	})
+
+	It("should include file outside of document directory in unsafe mode", func() {
+		source := `include::../../test/includes/chapter-a.adoc[]`
+		expected, err := ParseDraftDocument(source)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(parseDraftDocument(source, configuration.WithSafeMode(configuration.Unsafe))).
+			To(MatchDraftDocument(expected.(types.DraftDocument)))
+	})
+
+	It("should replace with link in secure mode", func() {
+		source := `include::../../test/includes/chapter-a.adoc[]`
+		expected, err := ParseDraftDocument(`link:../../test/includes/chapter-a.adoc[]`)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(parseDraftDocument(source, configuration.WithSafeMode(configuration.Secure))).
+			To(MatchDraftDocument(expected.(types.DraftDocument)))
+	})
+})
+
+var _ = Describe("file inclusions with cycles and depth limit", func() {
+
+	parseDraftDocument := func(source string, settings ...configuration.Setting) (types.DraftDocument, error) {
+		settings = append([]configuration.Setting{configuration.WithFilename("test.adoc")}, settings...)
+		return parser.ParseDraftDocument(strings.NewReader(source), configuration.NewConfiguration(settings...))
+	}
+
+	absPath := func(filename string) string {
+		p, err := filepath.Abs(filename)
+		Expect(err).NotTo(HaveOccurred())
+		return p
+	}
+
+	It("should detect file including itself", func() {
+		// setup logger to write in a buffer so we can check the output
+		console, reset := ConfigureLogger()
+		defer reset()
+		source := `include::../../test/includes/self-include.adoc[]`
+		selfInclude := absPath("../../test/includes/self-include.adoc")
+		expected, err := ParseDraftDocument(`first line of self
+
+Unresolved directive in ` + selfInclude + ` - include::self-include.adoc[]`)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(parseDraftDocument(source)).To(MatchDraftDocument(expected.(types.DraftDocument)))
+		Expect(console).To(ContainMessageWithLevel(log.ErrorLevel, "cannot include 'self-include.adoc' in '"+selfInclude+"': include cycle detected"))
+	})
+
+	It("should detect files including each other", func() {
+		// setup logger to write in a buffer so we can check the output
+		console, reset := ConfigureLogger()
+		defer reset()
+		source := `a paragraph
+
+include::../../test/includes/cycle-a.adoc[]`
+		cycleA := absPath("../../test/includes/cycle-a.adoc")
+		cycleB := absPath("../../test/includes/cycle-b.adoc")
+		expected, err := ParseDraftDocument(`a paragraph
+
+first line of cycle A
+
+first line of cycle B
+
+Unresolved directive in ` + cycleB + ` - include::cycle-a.adoc[]`)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(parseDraftDocument(source)).To(MatchDraftDocument(expected.(types.DraftDocument)))
+		Expect(console).To(ContainMessageWithLevel(log.ErrorLevel, "failed to include content of 'cycle-a.adoc' in '"+cycleB+":3', included from '"+cycleA+":3', included from 'test.adoc:3'"))
+	})
+
+	It("should include the same file several times", func() {
+		source := `include::../../test/includes/grandchild-include.adoc[]
+
+include::../../test/includes/grandchild-include.adoc[]`
+		expected, err := ParseDraftDocument(`== grandchild title
+
+first line of grandchild
+
+last line of grandchild
+
+== grandchild title
+
+first line of grandchild
+
+last line of grandchild`)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(parseDraftDocument(source)).To(MatchDraftDocument(expected.(types.DraftDocument)))
+	})
+
+	It("should not include files beyond the maximum depth", func() {
+		// setup logger to write in a buffer so we can check the output
+		console, reset := ConfigureLogger()
+		defer reset()
+		source := `include::../../test/includes/parent-include.adoc[]`
+		childInclude := absPath("../../test/includes/child-include.adoc")
+		expected, err := ParseDraftDocument(`= parent title
+
+first line of parent
+
+= child title
+
+first line of child
+
+Unresolved directive in ` + childInclude + ` - include::grandchild-include.adoc[]
+
+last line of child
+
+last line of parent`)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(parseDraftDocument(source, configuration.WithAttribute(types.AttrMaxIncludeDepth, "2"))).
+			To(MatchDraftDocument(expected.(types.DraftDocument)))
+		Expect(console).To(ContainMessageWithLevel(log.ErrorLevel, "cannot include 'grandchild-include.adoc' in '"+childInclude+"': maximum include depth of 2 exceeded"))
+	})
+
+	It("should not include any file with maximum depth set in document", func() {
+		source := `:max-include-depth: 0
+
+include::../../test/includes/chapter-a.adoc[]`
+		expected, err := ParseDraftDocument(`:max-include-depth: 0
+
+Unresolved directive in test.adoc - include::../../test/includes/chapter-a.adoc[]`)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(parseDraftDocument(source)).To(MatchDraftDocument(expected.(types.DraftDocument)))
+	})
+})
+
+var _ = Describe("file inclusions with a file system and include processors", func() {
+
+	fsys := mapFS{
+		"docs/chapter.adoc":          "first line of chapter\n\ninclude::sections/section.adoc[]",
+		"docs/sections/section.adoc": "first line of section",
+		"outside.adoc":               "outside content",
+	}
+
+	parseDraftDocument := func(source string, settings ...configuration.Setting) (types.DraftDocument, error) {
+		settings = append([]configuration.Setting{
+			configuration.WithFilename("docs/test.adoc"),
+			configuration.WithFS(fsys),
+		}, settings...)
+		return parser.ParseDraftDocument(strings.NewReader(source), configuration.NewConfiguration(settings...))
+	}
+
+	It("should include nested files from the file system", func() {
+		source := `include::chapter.adoc[]`
+		expected, err := ParseDraftDocument(`first line of chapter
+
+first line of section`)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(parseDraftDocument(source)).To(MatchDraftDocument(expected.(types.DraftDocument)))
+	})
+
+	It("should include file within base directory in safe mode", func() {
+		source := `include::chapter.adoc[]`
+		expected, err := ParseDraftDocument(`first line of chapter
+
+first line of section`)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(parseDraftDocument(source, configuration.WithSafeMode(configuration.Safe))).
+			To(MatchDraftDocument(expected.(types.DraftDocument)))
+	})
+
+	It("should not include file outside of base directory in safe mode", func() {
+		source := `include::../outside.adoc[]`
+		expected, err := ParseDraftDocument(`Unresolved directive in docs/test.adoc - include::../outside.adoc[]`)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(parseDraftDocument(source, configuration.WithSafeMode(configuration.Safe))).
+			To(MatchDraftDocument(expected.(types.DraftDocument)))
+	})
+
+	It("should not include missing file", func() {
+		source := `include::missing.adoc[]`
+		expected, err := ParseDraftDocument(`Unresolved directive in docs/test.adoc - include::missing.adoc[]`)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(parseDraftDocument(source)).To(MatchDraftDocument(expected.(types.DraftDocument)))
+	})
+
+	Context("include processors", func() {
+
+		var targets []string
+
+		git := func(ctx configuration.IncludeContext, target string, attributes types.Attributes) (io.Reader, error) {
+			targets = append(targets, target)
+			switch target {
+			case "main:README.adoc":
+				return strings.NewReader("first line of readme\n\ninclude::docs/guide.adoc[]"), nil
+			case "main:docs/guide.adoc":
+				return ioutil.NopCloser(strings.NewReader("first line of guide")), nil
+			default:
+				return nil, errors.Errorf("unknown revision or path: '%s'", target)
+			}
+		}
+
+		BeforeEach(func() {
+			targets = nil
+		})
+
+		It("should include content read by processor with nested relative file", func() {
+			source := `include::git:main:README.adoc[]`
+			expected, err := ParseDraftDocument(`first line of readme
+
+first line of guide`)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(parseDraftDocument(source, configuration.WithIncludeProcessor("git", git))).
+				To(MatchDraftDocument(expected.(types.DraftDocument)))
+			Expect(targets).To(Equal([]string{"main:README.adoc", "main:docs/guide.adoc"}))
+		})
+
+		It("should include content read by processor in secure mode", func() {
+			source := `include::git:main:docs/guide.adoc[]`
+			expected, err := ParseDraftDocument(`first line of guide`)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(parseDraftDocument(source,
+				configuration.WithIncludeProcessor("git", git),
+				configuration.WithSafeMode(configuration.Secure))).
+				To(MatchDraftDocument(expected.(types.DraftDocument)))
+		})
+
+		It("should not include content when processor failed", func() {
+			// setup logger to write in a buffer so we can check the output
+			console, reset := ConfigureLogger()
+			defer reset()
+			source := `include::git:main:unknown.adoc[]`
+			expected, err := ParseDraftDocument(`Unresolved directive in docs/test.adoc - include::git:main:unknown.adoc[]`)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(parseDraftDocument(source, configuration.WithIncludeProcessor("git", git))).
+				To(MatchDraftDocument(expected.(types.DraftDocument)))
+			Expect(console).To(ContainMessageWithLevel(log.ErrorLevel, "unable to read file to include 'git:main:unknown.adoc' in 'docs/test.adoc'"))
+		})
+
+		It("should not use processor of another scheme", func() {
+			source := `include::svn:trunk/README.adoc[]`
+			expected, err := ParseDraftDocument(`Unresolved directive in docs/test.adoc - include::svn:trunk/README.adoc[]`)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(parseDraftDocument(source, configuration.WithIncludeProcessor("git", git))).
+				To(MatchDraftDocument(expected.(types.DraftDocument)))
+		})
+	})
+})
+
+// mapFS a file system in memory, with the content of the files indexed by their name
+type mapFS map[string]string
+
+func (fsys mapFS) Open(name string) (configuration.File, error) {
+	content, found := fsys[name]
+	if !found {
+		return nil, &os.PathError{Op: "open", Path: name, Err: os.ErrNotExist}
+	}
+	return &mapFile{
+		Reader: strings.NewReader(content),
+		name:   name,
+		size:   int64(len(content)),
+	}, nil
+}
+
+type mapFile struct {
+	*strings.Reader
+	name string
+	size int64
+}
+
+func (f *mapFile) Stat() (os.FileInfo, error) {
+	return mapFileInfo{name: path.Base(f.name), size: f.size}, nil
+}
+
+func (f *mapFile) Close() error {
+	return nil
+}
+
+type mapFileInfo struct {
+	name string
+	size int64
+}
+
+func (i mapFileInfo) Name() string       { return i.name }
+func (i mapFileInfo) Size() int64        { return i.size }
+func (i mapFileInfo) Mode() os.FileMode  { return 0444 }
+func (i mapFileInfo) ModTime() time.Time { return time.Time{} }
+func (i mapFileInfo) IsDir() bool        { return false }
+func (i mapFileInfo) Sys() interface{}   { return nil }
@@ -115,7 +115,8 @@ var _ = Describe("ordered lists - draft", func() {
 				Blocks: []interface{}{
 					types.OrderedListItem{
 						Attributes: types.Attributes{
-							"lowerroman": nil,
+							types.AttrStyle: "lowerroman",
+							"positional-1":  "lowerroman",
 						},
 						Level:          1,
 						NumberingStyle: types.Arabic,
@@ -158,8 +159,9 @@ var _ = Describe("ordered lists - draft", func() {
 						Level:          1,
 						NumberingStyle: types.Arabic,
 						Attributes: types.Attributes{
-							"lowerroman": nil,
-							"start":      "5",
+							types.AttrStyle: "lowerroman",
+							"positional-1":  "lowerroman",
+							"start":         "5",
 						},
 						Elements: elements,
 					},
@@ -504,7 +506,8 @@ var _ = Describe("ordered lists - draft", func() {
 						Level:          3,
 						NumberingStyle: types.LowerRoman,
 						Attributes: types.Attributes{
-							"upperroman": nil,
+							types.AttrStyle: "upperroman",
+							"positional-1":  "upperroman",
 						},
 						Elements: []interface{}{
 							types.Paragraph{
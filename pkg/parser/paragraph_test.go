@@ -189,7 +189,7 @@ baz`
 					Blocks: []interface{}{
 						types.Paragraph{
 							Attributes: types.Attributes{
-								types.AttrHardBreaks: nil,
+								types.AttrOptions: []string{types.AttrHardBreaks},
 							},
 							Lines: [][]interface{}{
 								{
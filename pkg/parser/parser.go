@@ -13,6 +13,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"unicode"
 	"unicode/utf8"
 
@@ -23,34 +24,34 @@ var g = &grammar{
 	rules: []*rule{
 		{
 			name: "AsciidocDocument",
-			pos:  position{line: 17, col: 1, offset: 348},
+			pos:  position{line: 17, col: 1, offset: 332},
 			expr: &actionExpr{
-				pos: position{line: 17, col: 21, offset: 368},
+				pos: position{line: 17, col: 21, offset: 352},
 				run: (*parser).callonAsciidocDocument1,
 				expr: &seqExpr{
-					pos: position{line: 17, col: 21, offset: 368},
+					pos: position{line: 17, col: 21, offset: 352},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 17, col: 21, offset: 368},
+							pos:   position{line: 17, col: 21, offset: 352},
 							label: "frontmatter",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 17, col: 34, offset: 381},
+								pos: position{line: 17, col: 34, offset: 365},
 								expr: &ruleRefExpr{
-									pos:  position{line: 17, col: 34, offset: 381},
+									pos:  position{line: 17, col: 34, offset: 365},
 									name: "FrontMatter",
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 17, col: 48, offset: 395},
+							pos:   position{line: 17, col: 48, offset: 379},
 							label: "blocks",
 							expr: &ruleRefExpr{
-								pos:  position{line: 17, col: 56, offset: 403},
+								pos:  position{line: 17, col: 56, offset: 387},
 								name: "AsciidocDocumentBlocks",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 17, col: 80, offset: 427},
+							pos:  position{line: 17, col: 80, offset: 411},
 							name: "EOF",
 						},
 					},
@@ -59,23 +60,23 @@ var g = &grammar{
 		},
 		{
 			name: "VerbatimDocument",
-			pos:  position{line: 21, col: 1, offset: 511},
+			pos:  position{line: 21, col: 1, offset: 491},
 			expr: &actionExpr{
-				pos: position{line: 21, col: 21, offset: 531},
+				pos: position{line: 21, col: 21, offset: 511},
 				run: (*parser).callonVerbatimDocument1,
 				expr: &seqExpr{
-					pos: position{line: 21, col: 21, offset: 531},
+					pos: position{line: 21, col: 21, offset: 511},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 21, col: 21, offset: 531},
+							pos:   position{line: 21, col: 21, offset: 511},
 							label: "blocks",
 							expr: &ruleRefExpr{
-								pos:  position{line: 21, col: 29, offset: 539},
+								pos:  position{line: 21, col: 29, offset: 519},
 								name: "VerbatimFileContent",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 21, col: 50, offset: 560},
+							pos:  position{line: 21, col: 50, offset: 540},
 							name: "EOF",
 						},
 					},
@@ -84,23 +85,23 @@ var g = &grammar{
 		},
 		{
 			name: "TextDocument",
-			pos:  position{line: 26, col: 1, offset: 665},
+			pos:  position{line: 26, col: 1, offset: 640},
 			expr: &actionExpr{
-				pos: position{line: 26, col: 17, offset: 681},
+				pos: position{line: 26, col: 17, offset: 656},
 				run: (*parser).callonTextDocument1,
 				expr: &seqExpr{
-					pos: position{line: 26, col: 17, offset: 681},
+					pos: position{line: 26, col: 17, offset: 656},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 26, col: 17, offset: 681},
+							pos:   position{line: 26, col: 17, offset: 656},
 							label: "blocks",
 							expr: &ruleRefExpr{
-								pos:  position{line: 26, col: 25, offset: 689},
+								pos:  position{line: 26, col: 25, offset: 664},
 								name: "TextDocumentBlocks",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 26, col: 45, offset: 709},
+							pos:  position{line: 26, col: 45, offset: 684},
 							name: "EOF",
 						},
 					},
@@ -109,31 +110,31 @@ var g = &grammar{
 		},
 		{
 			name: "AsciidocDocumentBlocks",
-			pos:  position{line: 33, col: 1, offset: 899},
+			pos:  position{line: 33, col: 1, offset: 867},
 			expr: &actionExpr{
-				pos: position{line: 33, col: 27, offset: 925},
+				pos: position{line: 33, col: 27, offset: 893},
 				run: (*parser).callonAsciidocDocumentBlocks1,
 				expr: &seqExpr{
-					pos: position{line: 33, col: 27, offset: 925},
+					pos: position{line: 33, col: 27, offset: 893},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 33, col: 27, offset: 925},
+							pos:   position{line: 33, col: 27, offset: 893},
 							label: "header",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 33, col: 35, offset: 933},
+								pos: position{line: 33, col: 35, offset: 901},
 								expr: &ruleRefExpr{
-									pos:  position{line: 33, col: 35, offset: 933},
+									pos:  position{line: 33, col: 35, offset: 901},
 									name: "DocumentHeader",
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 33, col: 52, offset: 950},
+							pos:   position{line: 33, col: 52, offset: 918},
 							label: "others",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 33, col: 60, offset: 958},
+								pos: position{line: 33, col: 60, offset: 926},
 								expr: &ruleRefExpr{
-									pos:  position{line: 33, col: 60, offset: 958},
+									pos:  position{line: 33, col: 60, offset: 926},
 									name: "DocumentBlock",
 								},
 							},
@@ -144,84 +145,84 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentBlock",
-			pos:  position{line: 42, col: 1, offset: 1216},
+			pos:  position{line: 42, col: 1, offset: 1175},
 			expr: &choiceExpr{
-				pos: position{line: 43, col: 9, offset: 1243},
+				pos: position{line: 43, col: 9, offset: 1201},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 43, col: 9, offset: 1243},
+						pos:  position{line: 43, col: 9, offset: 1201},
 						name: "LabeledListItem",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 44, col: 11, offset: 1309},
+						pos:  position{line: 44, col: 11, offset: 1266},
 						name: "SimpleParagraph",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 45, col: 11, offset: 1336},
+						pos:  position{line: 45, col: 11, offset: 1292},
 						name: "BlankLine",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 46, col: 11, offset: 1389},
+						pos:  position{line: 46, col: 11, offset: 1344},
 						name: "Section",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 47, col: 11, offset: 1408},
+						pos:  position{line: 47, col: 11, offset: 1362},
 						name: "DelimitedBlock",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 48, col: 11, offset: 1434},
+						pos:  position{line: 48, col: 11, offset: 1387},
 						name: "FileInclusion",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 49, col: 11, offset: 1459},
+						pos:  position{line: 49, col: 11, offset: 1411},
 						name: "VerseParagraph",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 50, col: 11, offset: 1514},
+						pos:  position{line: 50, col: 11, offset: 1465},
 						name: "ImageBlock",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 51, col: 11, offset: 1537},
+						pos:  position{line: 51, col: 11, offset: 1487},
 						name: "OrderedListItem",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 52, col: 11, offset: 1565},
+						pos:  position{line: 52, col: 11, offset: 1514},
 						name: "UnorderedListItem",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 53, col: 11, offset: 1595},
+						pos:  position{line: 53, col: 11, offset: 1543},
 						name: "CalloutListItem",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 54, col: 11, offset: 1622},
+						pos:  position{line: 54, col: 11, offset: 1569},
 						name: "ContinuedListItemElement",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 55, col: 11, offset: 1658},
+						pos:  position{line: 55, col: 11, offset: 1604},
 						name: "LiteralBlock",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 56, col: 11, offset: 1683},
+						pos:  position{line: 56, col: 11, offset: 1628},
 						name: "AttributeDeclaration",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 57, col: 11, offset: 1716},
+						pos:  position{line: 57, col: 11, offset: 1660},
 						name: "AttributeReset",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 58, col: 11, offset: 1743},
+						pos:  position{line: 58, col: 11, offset: 1686},
 						name: "TableOfContentsPlaceHolder",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 59, col: 11, offset: 1781},
+						pos:  position{line: 59, col: 11, offset: 1723},
 						name: "UserMacroBlock",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 60, col: 11, offset: 1807},
+						pos:  position{line: 60, col: 11, offset: 1748},
 						name: "StandaloneAttributes",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 61, col: 11, offset: 1839},
+						pos:  position{line: 61, col: 11, offset: 1779},
 						name: "Paragraph",
 					},
 				},
@@ -229,14 +230,14 @@ var g = &grammar{
 		},
 		{
 			name: "AsciidocDocumentBlocksWithinDelimitedBlock",
-			pos:  position{line: 63, col: 1, offset: 1852},
+			pos:  position{line: 63, col: 1, offset: 1790},
 			expr: &labeledExpr{
-				pos:   position{line: 63, col: 47, offset: 1898},
+				pos:   position{line: 63, col: 47, offset: 1836},
 				label: "blocks",
 				expr: &zeroOrMoreExpr{
-					pos: position{line: 63, col: 54, offset: 1905},
+					pos: position{line: 63, col: 54, offset: 1843},
 					expr: &ruleRefExpr{
-						pos:  position{line: 63, col: 55, offset: 1906},
+						pos:  position{line: 63, col: 55, offset: 1844},
 						name: "DocumentBlockWithinDelimitedBlock",
 					},
 				},
@@ -244,84 +245,84 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentBlockWithinDelimitedBlock",
-			pos:  position{line: 65, col: 1, offset: 1945},
+			pos:  position{line: 65, col: 1, offset: 1881},
 			expr: &actionExpr{
-				pos: position{line: 65, col: 38, offset: 1982},
+				pos: position{line: 65, col: 38, offset: 1918},
 				run: (*parser).callonDocumentBlockWithinDelimitedBlock1,
 				expr: &seqExpr{
-					pos: position{line: 65, col: 38, offset: 1982},
+					pos: position{line: 65, col: 38, offset: 1918},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 65, col: 38, offset: 1982},
+							pos: position{line: 65, col: 38, offset: 1918},
 							expr: &ruleRefExpr{
-								pos:  position{line: 65, col: 39, offset: 1983},
+								pos:  position{line: 65, col: 39, offset: 1919},
 								name: "EOF",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 66, col: 5, offset: 1993},
+							pos:   position{line: 66, col: 5, offset: 1928},
 							label: "block",
 							expr: &choiceExpr{
-								pos: position{line: 66, col: 12, offset: 2000},
+								pos: position{line: 66, col: 12, offset: 1935},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 66, col: 12, offset: 2000},
+										pos:  position{line: 66, col: 12, offset: 1935},
 										name: "DelimitedBlock",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 67, col: 11, offset: 2026},
+										pos:  position{line: 67, col: 11, offset: 1960},
 										name: "BlankLine",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 68, col: 11, offset: 2079},
+										pos:  position{line: 68, col: 11, offset: 2012},
 										name: "FileInclusion",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 69, col: 11, offset: 2104},
+										pos:  position{line: 69, col: 11, offset: 2036},
 										name: "VerseParagraph",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 70, col: 11, offset: 2130},
+										pos:  position{line: 70, col: 11, offset: 2061},
 										name: "ImageBlock",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 71, col: 11, offset: 2153},
+										pos:  position{line: 71, col: 11, offset: 2083},
 										name: "OrderedListItem",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 72, col: 11, offset: 2181},
+										pos:  position{line: 72, col: 11, offset: 2110},
 										name: "UnorderedListItem",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 73, col: 11, offset: 2211},
+										pos:  position{line: 73, col: 11, offset: 2139},
 										name: "LabeledListItem",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 74, col: 11, offset: 2239},
+										pos:  position{line: 74, col: 11, offset: 2166},
 										name: "ContinuedListItemElement",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 75, col: 11, offset: 2275},
+										pos:  position{line: 75, col: 11, offset: 2201},
 										name: "LiteralBlock",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 76, col: 11, offset: 2300},
+										pos:  position{line: 76, col: 11, offset: 2225},
 										name: "AttributeDeclaration",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 77, col: 11, offset: 2333},
+										pos:  position{line: 77, col: 11, offset: 2257},
 										name: "AttributeReset",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 78, col: 11, offset: 2360},
+										pos:  position{line: 78, col: 11, offset: 2283},
 										name: "TableOfContentsPlaceHolder",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 79, col: 11, offset: 2398},
+										pos:  position{line: 79, col: 11, offset: 2320},
 										name: "UserMacroBlock",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 80, col: 11, offset: 2424},
+										pos:  position{line: 80, col: 11, offset: 2345},
 										name: "Paragraph",
 									},
 								},
@@ -333,14 +334,14 @@ var g = &grammar{
 		},
 		{
 			name: "TextDocumentBlocks",
-			pos:  position{line: 84, col: 1, offset: 2466},
+			pos:  position{line: 84, col: 1, offset: 2383},
 			expr: &labeledExpr{
-				pos:   position{line: 84, col: 23, offset: 2488},
+				pos:   position{line: 84, col: 23, offset: 2405},
 				label: "blocks",
 				expr: &zeroOrMoreExpr{
-					pos: position{line: 84, col: 30, offset: 2495},
+					pos: position{line: 84, col: 30, offset: 2412},
 					expr: &ruleRefExpr{
-						pos:  position{line: 84, col: 31, offset: 2496},
+						pos:  position{line: 84, col: 31, offset: 2413},
 						name: "TextDocumentBlock",
 					},
 				},
@@ -348,32 +349,32 @@ var g = &grammar{
 		},
 		{
 			name: "TextDocumentBlock",
-			pos:  position{line: 86, col: 1, offset: 2519},
+			pos:  position{line: 86, col: 1, offset: 2434},
 			expr: &actionExpr{
-				pos: position{line: 86, col: 22, offset: 2540},
+				pos: position{line: 86, col: 22, offset: 2455},
 				run: (*parser).callonTextDocumentBlock1,
 				expr: &seqExpr{
-					pos: position{line: 86, col: 22, offset: 2540},
+					pos: position{line: 86, col: 22, offset: 2455},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 86, col: 22, offset: 2540},
+							pos: position{line: 86, col: 22, offset: 2455},
 							expr: &ruleRefExpr{
-								pos:  position{line: 86, col: 23, offset: 2541},
+								pos:  position{line: 86, col: 23, offset: 2456},
 								name: "EOF",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 87, col: 5, offset: 2551},
+							pos:   position{line: 87, col: 5, offset: 2465},
 							label: "block",
 							expr: &choiceExpr{
-								pos: position{line: 87, col: 12, offset: 2558},
+								pos: position{line: 87, col: 12, offset: 2472},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 87, col: 12, offset: 2558},
+										pos:  position{line: 87, col: 12, offset: 2472},
 										name: "BlankLine",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 87, col: 24, offset: 2570},
+										pos:  position{line: 87, col: 24, offset: 2484},
 										name: "Paragraph",
 									},
 								},
@@ -385,38 +386,38 @@ var g = &grammar{
 		},
 		{
 			name: "FrontMatter",
-			pos:  position{line: 94, col: 1, offset: 2723},
+			pos:  position{line: 94, col: 1, offset: 2630},
 			expr: &ruleRefExpr{
-				pos:  position{line: 94, col: 16, offset: 2738},
+				pos:  position{line: 94, col: 16, offset: 2645},
 				name: "YamlFrontMatter",
 			},
 		},
 		{
 			name: "YamlFrontMatter",
-			pos:  position{line: 96, col: 1, offset: 2758},
+			pos:  position{line: 96, col: 1, offset: 2663},
 			expr: &actionExpr{
-				pos: position{line: 96, col: 20, offset: 2777},
+				pos: position{line: 96, col: 20, offset: 2682},
 				run: (*parser).callonYamlFrontMatter1,
 				expr: &seqExpr{
-					pos: position{line: 96, col: 20, offset: 2777},
+					pos: position{line: 96, col: 20, offset: 2682},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 96, col: 20, offset: 2777},
+							pos:  position{line: 96, col: 20, offset: 2682},
 							name: "YamlFrontMatterToken",
 						},
 						&labeledExpr{
-							pos:   position{line: 96, col: 41, offset: 2798},
+							pos:   position{line: 96, col: 41, offset: 2703},
 							label: "content",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 96, col: 49, offset: 2806},
+								pos: position{line: 96, col: 49, offset: 2711},
 								expr: &ruleRefExpr{
-									pos:  position{line: 96, col: 50, offset: 2807},
+									pos:  position{line: 96, col: 50, offset: 2712},
 									name: "YamlFrontMatterContent",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 96, col: 75, offset: 2832},
+							pos:  position{line: 96, col: 75, offset: 2737},
 							name: "YamlFrontMatterToken",
 						},
 					},
@@ -425,25 +426,25 @@ var g = &grammar{
 		},
 		{
 			name: "YamlFrontMatterToken",
-			pos:  position{line: 100, col: 1, offset: 2916},
+			pos:  position{line: 100, col: 1, offset: 2833},
 			expr: &seqExpr{
-				pos: position{line: 100, col: 26, offset: 2941},
+				pos: position{line: 100, col: 26, offset: 2858},
 				exprs: []interface{}{
 					&litMatcher{
-						pos:        position{line: 100, col: 26, offset: 2941},
+						pos:        position{line: 100, col: 26, offset: 2858},
 						val:        "---",
 						ignoreCase: false,
 						want:       "\"---\"",
 					},
 					&zeroOrMoreExpr{
-						pos: position{line: 100, col: 32, offset: 2947},
+						pos: position{line: 100, col: 32, offset: 2864},
 						expr: &ruleRefExpr{
-							pos:  position{line: 100, col: 32, offset: 2947},
+							pos:  position{line: 100, col: 32, offset: 2864},
 							name: "Space",
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 100, col: 39, offset: 2954},
+						pos:  position{line: 100, col: 39, offset: 2871},
 						name: "EOL",
 					},
 				},
@@ -451,26 +452,26 @@ var g = &grammar{
 		},
 		{
 			name: "YamlFrontMatterContent",
-			pos:  position{line: 102, col: 1, offset: 2961},
+			pos:  position{line: 102, col: 1, offset: 2876},
 			expr: &actionExpr{
-				pos: position{line: 102, col: 27, offset: 2987},
+				pos: position{line: 102, col: 27, offset: 2902},
 				run: (*parser).callonYamlFrontMatterContent1,
 				expr: &zeroOrMoreExpr{
-					pos: position{line: 102, col: 27, offset: 2987},
+					pos: position{line: 102, col: 27, offset: 2902},
 					expr: &oneOrMoreExpr{
-						pos: position{line: 102, col: 28, offset: 2988},
+						pos: position{line: 102, col: 28, offset: 2903},
 						expr: &seqExpr{
-							pos: position{line: 102, col: 29, offset: 2989},
+							pos: position{line: 102, col: 29, offset: 2904},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 102, col: 29, offset: 2989},
+									pos: position{line: 102, col: 29, offset: 2904},
 									expr: &ruleRefExpr{
-										pos:  position{line: 102, col: 30, offset: 2990},
+										pos:  position{line: 102, col: 30, offset: 2905},
 										name: "YamlFrontMatterToken",
 									},
 								},
 								&anyMatcher{
-									line: 102, col: 51, offset: 3011,
+									line: 102, col: 51, offset: 2926,
 								},
 							},
 						},
@@ -480,99 +481,99 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentHeader",
-			pos:  position{line: 109, col: 1, offset: 3184},
+			pos:  position{line: 109, col: 1, offset: 3092},
 			expr: &actionExpr{
-				pos: position{line: 109, col: 19, offset: 3202},
+				pos: position{line: 109, col: 19, offset: 3110},
 				run: (*parser).callonDocumentHeader1,
 				expr: &seqExpr{
-					pos: position{line: 109, col: 19, offset: 3202},
+					pos: position{line: 109, col: 19, offset: 3110},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 109, col: 19, offset: 3202},
+							pos:        position{line: 109, col: 19, offset: 3110},
 							val:        "=",
 							ignoreCase: false,
 							want:       "\"=\"",
 						},
 						&oneOrMoreExpr{
-							pos: position{line: 109, col: 23, offset: 3206},
+							pos: position{line: 109, col: 23, offset: 3114},
 							expr: &ruleRefExpr{
-								pos:  position{line: 109, col: 23, offset: 3206},
+								pos:  position{line: 109, col: 23, offset: 3114},
 								name: "Space",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 109, col: 30, offset: 3213},
+							pos:   position{line: 109, col: 30, offset: 3121},
 							label: "title",
 							expr: &ruleRefExpr{
-								pos:  position{line: 109, col: 37, offset: 3220},
+								pos:  position{line: 109, col: 37, offset: 3128},
 								name: "TitleElements",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 109, col: 52, offset: 3235},
+							pos:   position{line: 109, col: 52, offset: 3143},
 							label: "id",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 109, col: 56, offset: 3239},
+								pos: position{line: 109, col: 56, offset: 3147},
 								expr: &ruleRefExpr{
-									pos:  position{line: 109, col: 56, offset: 3239},
+									pos:  position{line: 109, col: 56, offset: 3147},
 									name: "InlineElementID",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 109, col: 74, offset: 3257},
+							pos:  position{line: 109, col: 74, offset: 3165},
 							name: "EOL",
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 110, col: 9, offset: 3270},
+							pos: position{line: 110, col: 9, offset: 3177},
 							expr: &choiceExpr{
-								pos: position{line: 110, col: 10, offset: 3271},
+								pos: position{line: 110, col: 10, offset: 3178},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 110, col: 10, offset: 3271},
+										pos:  position{line: 110, col: 10, offset: 3178},
 										name: "SingleLineComment",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 110, col: 30, offset: 3291},
+										pos:  position{line: 110, col: 30, offset: 3198},
 										name: "CommentBlock",
 									},
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 111, col: 9, offset: 3315},
+							pos:   position{line: 111, col: 9, offset: 3221},
 							label: "authors",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 111, col: 18, offset: 3324},
+								pos: position{line: 111, col: 18, offset: 3230},
 								expr: &ruleRefExpr{
-									pos:  position{line: 111, col: 18, offset: 3324},
+									pos:  position{line: 111, col: 18, offset: 3230},
 									name: "DocumentAuthors",
 								},
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 112, col: 9, offset: 3352},
+							pos: position{line: 112, col: 9, offset: 3257},
 							expr: &choiceExpr{
-								pos: position{line: 112, col: 10, offset: 3353},
+								pos: position{line: 112, col: 10, offset: 3258},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 112, col: 10, offset: 3353},
+										pos:  position{line: 112, col: 10, offset: 3258},
 										name: "SingleLineComment",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 112, col: 30, offset: 3373},
+										pos:  position{line: 112, col: 30, offset: 3278},
 										name: "CommentBlock",
 									},
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 113, col: 9, offset: 3397},
+							pos:   position{line: 113, col: 9, offset: 3301},
 							label: "revision",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 113, col: 19, offset: 3407},
+								pos: position{line: 113, col: 19, offset: 3311},
 								expr: &ruleRefExpr{
-									pos:  position{line: 113, col: 19, offset: 3407},
+									pos:  position{line: 113, col: 19, offset: 3311},
 									name: "DocumentRevision",
 								},
 							},
@@ -583,16 +584,16 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentAuthors",
-			pos:  position{line: 117, col: 1, offset: 3512},
+			pos:  position{line: 117, col: 1, offset: 3428},
 			expr: &choiceExpr{
-				pos: position{line: 117, col: 20, offset: 3531},
+				pos: position{line: 117, col: 20, offset: 3447},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 117, col: 20, offset: 3531},
+						pos:  position{line: 117, col: 20, offset: 3447},
 						name: "DocumentAuthorsInlineForm",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 117, col: 48, offset: 3559},
+						pos:  position{line: 117, col: 48, offset: 3475},
 						name: "DocumentAuthorsAttributeForm",
 					},
 				},
@@ -600,42 +601,42 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentAuthorsInlineForm",
-			pos:  position{line: 119, col: 1, offset: 3591},
+			pos:  position{line: 119, col: 1, offset: 3505},
 			expr: &actionExpr{
-				pos: position{line: 119, col: 30, offset: 3620},
+				pos: position{line: 119, col: 30, offset: 3534},
 				run: (*parser).callonDocumentAuthorsInlineForm1,
 				expr: &seqExpr{
-					pos: position{line: 119, col: 30, offset: 3620},
+					pos: position{line: 119, col: 30, offset: 3534},
 					exprs: []interface{}{
 						&zeroOrMoreExpr{
-							pos: position{line: 119, col: 30, offset: 3620},
+							pos: position{line: 119, col: 30, offset: 3534},
 							expr: &ruleRefExpr{
-								pos:  position{line: 119, col: 30, offset: 3620},
+								pos:  position{line: 119, col: 30, offset: 3534},
 								name: "Space",
 							},
 						},
 						&notExpr{
-							pos: position{line: 119, col: 37, offset: 3627},
+							pos: position{line: 119, col: 37, offset: 3541},
 							expr: &litMatcher{
-								pos:        position{line: 119, col: 38, offset: 3628},
+								pos:        position{line: 119, col: 38, offset: 3542},
 								val:        ":",
 								ignoreCase: false,
 								want:       "\":\"",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 119, col: 42, offset: 3632},
+							pos:   position{line: 119, col: 42, offset: 3546},
 							label: "authors",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 119, col: 51, offset: 3641},
+								pos: position{line: 119, col: 51, offset: 3555},
 								expr: &ruleRefExpr{
-									pos:  position{line: 119, col: 51, offset: 3641},
+									pos:  position{line: 119, col: 51, offset: 3555},
 									name: "DocumentAuthor",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 119, col: 68, offset: 3658},
+							pos:  position{line: 119, col: 68, offset: 3572},
 							name: "EOL",
 						},
 					},
@@ -644,36 +645,36 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentAuthorsAttributeForm",
-			pos:  position{line: 123, col: 1, offset: 3732},
+			pos:  position{line: 123, col: 1, offset: 3642},
 			expr: &actionExpr{
-				pos: position{line: 123, col: 33, offset: 3764},
+				pos: position{line: 123, col: 33, offset: 3674},
 				run: (*parser).callonDocumentAuthorsAttributeForm1,
 				expr: &seqExpr{
-					pos: position{line: 123, col: 33, offset: 3764},
+					pos: position{line: 123, col: 33, offset: 3674},
 					exprs: []interface{}{
 						&zeroOrMoreExpr{
-							pos: position{line: 123, col: 33, offset: 3764},
+							pos: position{line: 123, col: 33, offset: 3674},
 							expr: &ruleRefExpr{
-								pos:  position{line: 123, col: 33, offset: 3764},
+								pos:  position{line: 123, col: 33, offset: 3674},
 								name: "Space",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 123, col: 40, offset: 3771},
+							pos:        position{line: 123, col: 40, offset: 3681},
 							val:        ":author:",
 							ignoreCase: false,
 							want:       "\":author:\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 123, col: 51, offset: 3782},
+							pos:   position{line: 123, col: 51, offset: 3692},
 							label: "author",
 							expr: &ruleRefExpr{
-								pos:  position{line: 123, col: 59, offset: 3790},
+								pos:  position{line: 123, col: 59, offset: 3700},
 								name: "DocumentAuthor",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 123, col: 75, offset: 3806},
+							pos:  position{line: 123, col: 75, offset: 3716},
 							name: "EOL",
 						},
 					},
@@ -682,59 +683,59 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentAuthor",
-			pos:  position{line: 127, col: 1, offset: 3889},
+			pos:  position{line: 127, col: 1, offset: 3795},
 			expr: &actionExpr{
-				pos: position{line: 127, col: 19, offset: 3907},
+				pos: position{line: 127, col: 19, offset: 3813},
 				run: (*parser).callonDocumentAuthor1,
 				expr: &seqExpr{
-					pos: position{line: 127, col: 19, offset: 3907},
+					pos: position{line: 127, col: 19, offset: 3813},
 					exprs: []interface{}{
 						&zeroOrMoreExpr{
-							pos: position{line: 127, col: 19, offset: 3907},
+							pos: position{line: 127, col: 19, offset: 3813},
 							expr: &ruleRefExpr{
-								pos:  position{line: 127, col: 19, offset: 3907},
+								pos:  position{line: 127, col: 19, offset: 3813},
 								name: "Space",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 127, col: 26, offset: 3914},
+							pos:   position{line: 127, col: 26, offset: 3820},
 							label: "fullname",
 							expr: &ruleRefExpr{
-								pos:  position{line: 127, col: 36, offset: 3924},
+								pos:  position{line: 127, col: 36, offset: 3830},
 								name: "DocumentAuthorName",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 127, col: 56, offset: 3944},
+							pos:   position{line: 127, col: 56, offset: 3850},
 							label: "email",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 127, col: 62, offset: 3950},
+								pos: position{line: 127, col: 62, offset: 3856},
 								expr: &ruleRefExpr{
-									pos:  position{line: 127, col: 63, offset: 3951},
+									pos:  position{line: 127, col: 63, offset: 3857},
 									name: "DocumentAuthorEmail",
 								},
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 127, col: 85, offset: 3973},
+							pos: position{line: 127, col: 85, offset: 3879},
 							expr: &ruleRefExpr{
-								pos:  position{line: 127, col: 85, offset: 3973},
+								pos:  position{line: 127, col: 85, offset: 3879},
 								name: "Space",
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 127, col: 92, offset: 3980},
+							pos: position{line: 127, col: 92, offset: 3886},
 							expr: &litMatcher{
-								pos:        position{line: 127, col: 92, offset: 3980},
+								pos:        position{line: 127, col: 92, offset: 3886},
 								val:        ";",
 								ignoreCase: false,
 								want:       "\";\"",
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 127, col: 97, offset: 3985},
+							pos: position{line: 127, col: 97, offset: 3891},
 							expr: &ruleRefExpr{
-								pos:  position{line: 127, col: 97, offset: 3985},
+								pos:  position{line: 127, col: 97, offset: 3891},
 								name: "Space",
 							},
 						},
@@ -744,14 +745,14 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentAuthorName",
-			pos:  position{line: 132, col: 1, offset: 4135},
+			pos:  position{line: 132, col: 1, offset: 4036},
 			expr: &actionExpr{
-				pos: position{line: 132, col: 23, offset: 4157},
+				pos: position{line: 132, col: 23, offset: 4058},
 				run: (*parser).callonDocumentAuthorName1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 132, col: 23, offset: 4157},
+					pos: position{line: 132, col: 23, offset: 4058},
 					expr: &charClassMatcher{
-						pos:        position{line: 132, col: 23, offset: 4157},
+						pos:        position{line: 132, col: 23, offset: 4058},
 						val:        "[^<;\\r\\n]",
 						chars:      []rune{'<', ';', '\r', '\n'},
 						ignoreCase: false,
@@ -762,29 +763,29 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentAuthorEmail",
-			pos:  position{line: 136, col: 1, offset: 4208},
+			pos:  position{line: 136, col: 1, offset: 4105},
 			expr: &actionExpr{
-				pos: position{line: 136, col: 24, offset: 4231},
+				pos: position{line: 136, col: 24, offset: 4128},
 				run: (*parser).callonDocumentAuthorEmail1,
 				expr: &seqExpr{
-					pos: position{line: 136, col: 24, offset: 4231},
+					pos: position{line: 136, col: 24, offset: 4128},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 136, col: 24, offset: 4231},
+							pos:        position{line: 136, col: 24, offset: 4128},
 							val:        "<",
 							ignoreCase: false,
 							want:       "\"<\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 136, col: 28, offset: 4235},
+							pos:   position{line: 136, col: 28, offset: 4132},
 							label: "email",
 							expr: &actionExpr{
-								pos: position{line: 136, col: 35, offset: 4242},
+								pos: position{line: 136, col: 35, offset: 4139},
 								run: (*parser).callonDocumentAuthorEmail5,
 								expr: &oneOrMoreExpr{
-									pos: position{line: 136, col: 36, offset: 4243},
+									pos: position{line: 136, col: 36, offset: 4140},
 									expr: &charClassMatcher{
-										pos:        position{line: 136, col: 36, offset: 4243},
+										pos:        position{line: 136, col: 36, offset: 4140},
 										val:        "[^>\\r\\n]",
 										chars:      []rune{'>', '\r', '\n'},
 										ignoreCase: false,
@@ -794,7 +795,7 @@ var g = &grammar{
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 138, col: 4, offset: 4292},
+							pos:        position{line: 138, col: 4, offset: 4187},
 							val:        ">",
 							ignoreCase: false,
 							want:       "\">\"",
@@ -805,85 +806,85 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentRevision",
-			pos:  position{line: 144, col: 1, offset: 4459},
+			pos:  position{line: 144, col: 1, offset: 4348},
 			expr: &actionExpr{
-				pos: position{line: 144, col: 21, offset: 4479},
+				pos: position{line: 144, col: 21, offset: 4368},
 				run: (*parser).callonDocumentRevision1,
 				expr: &seqExpr{
-					pos: position{line: 144, col: 21, offset: 4479},
+					pos: position{line: 144, col: 21, offset: 4368},
 					exprs: []interface{}{
 						&zeroOrMoreExpr{
-							pos: position{line: 144, col: 21, offset: 4479},
+							pos: position{line: 144, col: 21, offset: 4368},
 							expr: &ruleRefExpr{
-								pos:  position{line: 144, col: 21, offset: 4479},
+								pos:  position{line: 144, col: 21, offset: 4368},
 								name: "Space",
 							},
 						},
 						&notExpr{
-							pos: position{line: 144, col: 28, offset: 4486},
+							pos: position{line: 144, col: 28, offset: 4375},
 							expr: &litMatcher{
-								pos:        position{line: 144, col: 29, offset: 4487},
+								pos:        position{line: 144, col: 29, offset: 4376},
 								val:        ":",
 								ignoreCase: false,
 								want:       "\":\"",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 144, col: 33, offset: 4491},
+							pos:   position{line: 144, col: 33, offset: 4380},
 							label: "revision",
 							expr: &choiceExpr{
-								pos: position{line: 145, col: 9, offset: 4511},
+								pos: position{line: 145, col: 9, offset: 4399},
 								alternatives: []interface{}{
 									&actionExpr{
-										pos: position{line: 145, col: 10, offset: 4512},
+										pos: position{line: 145, col: 10, offset: 4400},
 										run: (*parser).callonDocumentRevision9,
 										expr: &seqExpr{
-											pos: position{line: 145, col: 10, offset: 4512},
+											pos: position{line: 145, col: 10, offset: 4400},
 											exprs: []interface{}{
 												&labeledExpr{
-													pos:   position{line: 145, col: 10, offset: 4512},
+													pos:   position{line: 145, col: 10, offset: 4400},
 													label: "revnumber",
 													expr: &ruleRefExpr{
-														pos:  position{line: 145, col: 21, offset: 4523},
+														pos:  position{line: 145, col: 21, offset: 4411},
 														name: "DocumentRevisionNumber",
 													},
 												},
 												&zeroOrOneExpr{
-													pos: position{line: 145, col: 45, offset: 4547},
+													pos: position{line: 145, col: 45, offset: 4435},
 													expr: &litMatcher{
-														pos:        position{line: 145, col: 45, offset: 4547},
+														pos:        position{line: 145, col: 45, offset: 4435},
 														val:        ",",
 														ignoreCase: false,
 														want:       "\",\"",
 													},
 												},
 												&labeledExpr{
-													pos:   position{line: 145, col: 50, offset: 4552},
+													pos:   position{line: 145, col: 50, offset: 4440},
 													label: "revdate",
 													expr: &zeroOrOneExpr{
-														pos: position{line: 145, col: 58, offset: 4560},
+														pos: position{line: 145, col: 58, offset: 4448},
 														expr: &ruleRefExpr{
-															pos:  position{line: 145, col: 59, offset: 4561},
+															pos:  position{line: 145, col: 59, offset: 4449},
 															name: "DocumentRevisionDate",
 														},
 													},
 												},
 												&zeroOrOneExpr{
-													pos: position{line: 145, col: 82, offset: 4584},
+													pos: position{line: 145, col: 82, offset: 4472},
 													expr: &litMatcher{
-														pos:        position{line: 145, col: 82, offset: 4584},
+														pos:        position{line: 145, col: 82, offset: 4472},
 														val:        ":",
 														ignoreCase: false,
 														want:       "\":\"",
 													},
 												},
 												&labeledExpr{
-													pos:   position{line: 145, col: 87, offset: 4589},
+													pos:   position{line: 145, col: 87, offset: 4477},
 													label: "revremark",
 													expr: &zeroOrOneExpr{
-														pos: position{line: 145, col: 97, offset: 4599},
+														pos: position{line: 145, col: 97, offset: 4487},
 														expr: &ruleRefExpr{
-															pos:  position{line: 145, col: 98, offset: 4600},
+															pos:  position{line: 145, col: 98, offset: 4488},
 															name: "DocumentRevisionRemark",
 														},
 													},
@@ -892,35 +893,35 @@ var g = &grammar{
 										},
 									},
 									&actionExpr{
-										pos: position{line: 147, col: 15, offset: 4719},
+										pos: position{line: 147, col: 15, offset: 4605},
 										run: (*parser).callonDocumentRevision23,
 										expr: &seqExpr{
-											pos: position{line: 147, col: 15, offset: 4719},
+											pos: position{line: 147, col: 15, offset: 4605},
 											exprs: []interface{}{
 												&labeledExpr{
-													pos:   position{line: 147, col: 15, offset: 4719},
+													pos:   position{line: 147, col: 15, offset: 4605},
 													label: "revdate",
 													expr: &ruleRefExpr{
-														pos:  position{line: 147, col: 24, offset: 4728},
+														pos:  position{line: 147, col: 24, offset: 4614},
 														name: "DocumentRevisionDate",
 													},
 												},
 												&zeroOrOneExpr{
-													pos: position{line: 147, col: 46, offset: 4750},
+													pos: position{line: 147, col: 46, offset: 4636},
 													expr: &litMatcher{
-														pos:        position{line: 147, col: 46, offset: 4750},
+														pos:        position{line: 147, col: 46, offset: 4636},
 														val:        ":",
 														ignoreCase: false,
 														want:       "\":\"",
 													},
 												},
 												&labeledExpr{
-													pos:   position{line: 147, col: 51, offset: 4755},
+													pos:   position{line: 147, col: 51, offset: 4641},
 													label: "revremark",
 													expr: &zeroOrOneExpr{
-														pos: position{line: 147, col: 61, offset: 4765},
+														pos: position{line: 147, col: 61, offset: 4651},
 														expr: &ruleRefExpr{
-															pos:  position{line: 147, col: 62, offset: 4766},
+															pos:  position{line: 147, col: 62, offset: 4652},
 															name: "DocumentRevisionRemark",
 														},
 													},
@@ -932,7 +933,7 @@ var g = &grammar{
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 149, col: 13, offset: 4877},
+							pos:  position{line: 149, col: 13, offset: 4761},
 							name: "EOL",
 						},
 					},
@@ -941,30 +942,30 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentRevisionNumber",
-			pos:  position{line: 154, col: 1, offset: 5012},
+			pos:  position{line: 154, col: 1, offset: 4891},
 			expr: &choiceExpr{
-				pos: position{line: 154, col: 27, offset: 5038},
+				pos: position{line: 154, col: 27, offset: 4917},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 154, col: 27, offset: 5038},
+						pos: position{line: 154, col: 27, offset: 4917},
 						run: (*parser).callonDocumentRevisionNumber2,
 						expr: &seqExpr{
-							pos: position{line: 154, col: 27, offset: 5038},
+							pos: position{line: 154, col: 27, offset: 4917},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 154, col: 27, offset: 5038},
+									pos:        position{line: 154, col: 27, offset: 4917},
 									val:        "v",
 									ignoreCase: true,
 									want:       "\"v\"i",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 154, col: 32, offset: 5043},
+									pos:  position{line: 154, col: 32, offset: 4922},
 									name: "DIGIT",
 								},
 								&oneOrMoreExpr{
-									pos: position{line: 154, col: 39, offset: 5050},
+									pos: position{line: 154, col: 39, offset: 4929},
 									expr: &charClassMatcher{
-										pos:        position{line: 154, col: 39, offset: 5050},
+										pos:        position{line: 154, col: 39, offset: 4929},
 										val:        "[^:,\\r\\n]",
 										chars:      []rune{':', ',', '\r', '\n'},
 										ignoreCase: false,
@@ -975,28 +976,28 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 156, col: 5, offset: 5100},
+						pos: position{line: 156, col: 5, offset: 4977},
 						run: (*parser).callonDocumentRevisionNumber8,
 						expr: &seqExpr{
-							pos: position{line: 156, col: 5, offset: 5100},
+							pos: position{line: 156, col: 5, offset: 4977},
 							exprs: []interface{}{
 								&zeroOrOneExpr{
-									pos: position{line: 156, col: 5, offset: 5100},
+									pos: position{line: 156, col: 5, offset: 4977},
 									expr: &litMatcher{
-										pos:        position{line: 156, col: 5, offset: 5100},
+										pos:        position{line: 156, col: 5, offset: 4977},
 										val:        "v",
 										ignoreCase: true,
 										want:       "\"v\"i",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 156, col: 11, offset: 5106},
+									pos:  position{line: 156, col: 11, offset: 4983},
 									name: "DIGIT",
 								},
 								&oneOrMoreExpr{
-									pos: position{line: 156, col: 18, offset: 5113},
+									pos: position{line: 156, col: 18, offset: 4990},
 									expr: &charClassMatcher{
-										pos:        position{line: 156, col: 18, offset: 5113},
+										pos:        position{line: 156, col: 18, offset: 4990},
 										val:        "[^:,\\r\\n]",
 										chars:      []rune{':', ',', '\r', '\n'},
 										ignoreCase: false,
@@ -1004,16 +1005,16 @@ var g = &grammar{
 									},
 								},
 								&zeroOrMoreExpr{
-									pos: position{line: 156, col: 29, offset: 5124},
+									pos: position{line: 156, col: 29, offset: 5001},
 									expr: &ruleRefExpr{
-										pos:  position{line: 156, col: 29, offset: 5124},
+										pos:  position{line: 156, col: 29, offset: 5001},
 										name: "Space",
 									},
 								},
 								&andExpr{
-									pos: position{line: 156, col: 36, offset: 5131},
+									pos: position{line: 156, col: 36, offset: 5008},
 									expr: &litMatcher{
-										pos:        position{line: 156, col: 37, offset: 5132},
+										pos:        position{line: 156, col: 37, offset: 5009},
 										val:        ",",
 										ignoreCase: false,
 										want:       "\",\"",
@@ -1027,14 +1028,14 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentRevisionDate",
-			pos:  position{line: 160, col: 1, offset: 5176},
+			pos:  position{line: 160, col: 1, offset: 5049},
 			expr: &actionExpr{
-				pos: position{line: 160, col: 25, offset: 5200},
+				pos: position{line: 160, col: 25, offset: 5073},
 				run: (*parser).callonDocumentRevisionDate1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 160, col: 25, offset: 5200},
+					pos: position{line: 160, col: 25, offset: 5073},
 					expr: &charClassMatcher{
-						pos:        position{line: 160, col: 25, offset: 5200},
+						pos:        position{line: 160, col: 25, offset: 5073},
 						val:        "[^:\\r\\n]",
 						chars:      []rune{':', '\r', '\n'},
 						ignoreCase: false,
@@ -1045,14 +1046,14 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentRevisionRemark",
-			pos:  position{line: 164, col: 1, offset: 5250},
+			pos:  position{line: 164, col: 1, offset: 5119},
 			expr: &actionExpr{
-				pos: position{line: 164, col: 27, offset: 5276},
+				pos: position{line: 164, col: 27, offset: 5145},
 				run: (*parser).callonDocumentRevisionRemark1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 164, col: 27, offset: 5276},
+					pos: position{line: 164, col: 27, offset: 5145},
 					expr: &charClassMatcher{
-						pos:        position{line: 164, col: 27, offset: 5276},
+						pos:        position{line: 164, col: 27, offset: 5145},
 						val:        "[^\\r\\r\\n]",
 						chars:      []rune{'\r', '\r', '\n'},
 						ignoreCase: false,
@@ -1063,56 +1064,56 @@ var g = &grammar{
 		},
 		{
 			name: "AttributeDeclaration",
-			pos:  position{line: 171, col: 1, offset: 5436},
+			pos:  position{line: 171, col: 1, offset: 5298},
 			expr: &actionExpr{
-				pos: position{line: 171, col: 25, offset: 5460},
+				pos: position{line: 171, col: 25, offset: 5322},
 				run: (*parser).callonAttributeDeclaration1,
 				expr: &seqExpr{
-					pos: position{line: 171, col: 25, offset: 5460},
+					pos: position{line: 171, col: 25, offset: 5322},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 171, col: 25, offset: 5460},
+							pos:        position{line: 171, col: 25, offset: 5322},
 							val:        ":",
 							ignoreCase: false,
 							want:       "\":\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 171, col: 29, offset: 5464},
+							pos:   position{line: 171, col: 29, offset: 5326},
 							label: "name",
 							expr: &ruleRefExpr{
-								pos:  position{line: 171, col: 35, offset: 5470},
+								pos:  position{line: 171, col: 35, offset: 5332},
 								name: "AttributeName",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 171, col: 50, offset: 5485},
+							pos:        position{line: 171, col: 50, offset: 5347},
 							val:        ":",
 							ignoreCase: false,
 							want:       "\":\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 172, col: 9, offset: 5499},
+							pos:   position{line: 172, col: 9, offset: 5360},
 							label: "value",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 172, col: 15, offset: 5505},
+								pos: position{line: 172, col: 15, offset: 5366},
 								expr: &actionExpr{
-									pos: position{line: 172, col: 16, offset: 5506},
+									pos: position{line: 172, col: 16, offset: 5367},
 									run: (*parser).callonAttributeDeclaration9,
 									expr: &seqExpr{
-										pos: position{line: 172, col: 17, offset: 5507},
+										pos: position{line: 172, col: 17, offset: 5368},
 										exprs: []interface{}{
 											&oneOrMoreExpr{
-												pos: position{line: 172, col: 17, offset: 5507},
+												pos: position{line: 172, col: 17, offset: 5368},
 												expr: &ruleRefExpr{
-													pos:  position{line: 172, col: 17, offset: 5507},
+													pos:  position{line: 172, col: 17, offset: 5368},
 													name: "Space",
 												},
 											},
 											&labeledExpr{
-												pos:   position{line: 172, col: 24, offset: 5514},
+												pos:   position{line: 172, col: 24, offset: 5375},
 												label: "value",
 												expr: &ruleRefExpr{
-													pos:  position{line: 172, col: 31, offset: 5521},
+													pos:  position{line: 172, col: 31, offset: 5382},
 													name: "AttributeDeclarationValue",
 												},
 											},
@@ -1122,14 +1123,14 @@ var g = &grammar{
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 174, col: 13, offset: 5597},
+							pos: position{line: 174, col: 13, offset: 5456},
 							expr: &ruleRefExpr{
-								pos:  position{line: 174, col: 13, offset: 5597},
+								pos:  position{line: 174, col: 13, offset: 5456},
 								name: "Space",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 174, col: 20, offset: 5604},
+							pos:  position{line: 174, col: 20, offset: 5463},
 							name: "EOL",
 						},
 					},
@@ -1138,15 +1139,15 @@ var g = &grammar{
 		},
 		{
 			name: "AttributeName",
-			pos:  position{line: 181, col: 1, offset: 5851},
+			pos:  position{line: 181, col: 1, offset: 5719},
 			expr: &actionExpr{
-				pos: position{line: 181, col: 18, offset: 5868},
+				pos: position{line: 181, col: 18, offset: 5736},
 				run: (*parser).callonAttributeName1,
 				expr: &seqExpr{
-					pos: position{line: 181, col: 18, offset: 5868},
+					pos: position{line: 181, col: 18, offset: 5736},
 					exprs: []interface{}{
 						&charClassMatcher{
-							pos:        position{line: 181, col: 18, offset: 5868},
+							pos:        position{line: 181, col: 18, offset: 5736},
 							val:        "[\\pL0-9_]",
 							chars:      []rune{'_'},
 							ranges:     []rune{'0', '9'},
@@ -1155,9 +1156,9 @@ var g = &grammar{
 							inverted:   false,
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 181, col: 28, offset: 5878},
+							pos: position{line: 181, col: 28, offset: 5746},
 							expr: &charClassMatcher{
-								pos:        position{line: 181, col: 29, offset: 5879},
+								pos:        position{line: 181, col: 29, offset: 5747},
 								val:        "[\\pL0-9-]",
 								chars:      []rune{'-'},
 								ranges:     []rune{'0', '9'},
@@ -1172,14 +1173,14 @@ var g = &grammar{
 		},
 		{
 			name: "AttributeDeclarationValue",
-			pos:  position{line: 185, col: 1, offset: 5931},
+			pos:  position{line: 185, col: 1, offset: 5795},
 			expr: &actionExpr{
-				pos: position{line: 185, col: 30, offset: 5960},
+				pos: position{line: 185, col: 30, offset: 5824},
 				run: (*parser).callonAttributeDeclarationValue1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 185, col: 30, offset: 5960},
+					pos: position{line: 185, col: 30, offset: 5824},
 					expr: &charClassMatcher{
-						pos:        position{line: 185, col: 30, offset: 5960},
+						pos:        position{line: 185, col: 30, offset: 5824},
 						val:        "[^\\r\\n]",
 						chars:      []rune{'\r', '\n'},
 						ignoreCase: false,
@@ -1190,85 +1191,85 @@ var g = &grammar{
 		},
 		{
 			name: "AttributeReset",
-			pos:  position{line: 189, col: 1, offset: 6009},
+			pos:  position{line: 189, col: 1, offset: 5869},
 			expr: &choiceExpr{
-				pos: position{line: 189, col: 19, offset: 6027},
+				pos: position{line: 189, col: 19, offset: 5887},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 189, col: 19, offset: 6027},
+						pos: position{line: 189, col: 19, offset: 5887},
 						run: (*parser).callonAttributeReset2,
 						expr: &seqExpr{
-							pos: position{line: 189, col: 19, offset: 6027},
+							pos: position{line: 189, col: 19, offset: 5887},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 189, col: 19, offset: 6027},
+									pos:        position{line: 189, col: 19, offset: 5887},
 									val:        ":!",
 									ignoreCase: false,
 									want:       "\":!\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 189, col: 24, offset: 6032},
+									pos:   position{line: 189, col: 24, offset: 5892},
 									label: "name",
 									expr: &ruleRefExpr{
-										pos:  position{line: 189, col: 30, offset: 6038},
+										pos:  position{line: 189, col: 30, offset: 5898},
 										name: "AttributeName",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 189, col: 45, offset: 6053},
+									pos:        position{line: 189, col: 45, offset: 5913},
 									val:        ":",
 									ignoreCase: false,
 									want:       "\":\"",
 								},
 								&zeroOrMoreExpr{
-									pos: position{line: 189, col: 49, offset: 6057},
+									pos: position{line: 189, col: 49, offset: 5917},
 									expr: &ruleRefExpr{
-										pos:  position{line: 189, col: 49, offset: 6057},
+										pos:  position{line: 189, col: 49, offset: 5917},
 										name: "Space",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 189, col: 56, offset: 6064},
+									pos:  position{line: 189, col: 56, offset: 5924},
 									name: "EOL",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 191, col: 5, offset: 6126},
+						pos: position{line: 191, col: 5, offset: 6000},
 						run: (*parser).callonAttributeReset11,
 						expr: &seqExpr{
-							pos: position{line: 191, col: 5, offset: 6126},
+							pos: position{line: 191, col: 5, offset: 6000},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 191, col: 5, offset: 6126},
+									pos:        position{line: 191, col: 5, offset: 6000},
 									val:        ":",
 									ignoreCase: false,
 									want:       "\":\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 191, col: 9, offset: 6130},
+									pos:   position{line: 191, col: 9, offset: 6004},
 									label: "name",
 									expr: &ruleRefExpr{
-										pos:  position{line: 191, col: 15, offset: 6136},
+										pos:  position{line: 191, col: 15, offset: 6010},
 										name: "AttributeName",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 191, col: 30, offset: 6151},
+									pos:        position{line: 191, col: 30, offset: 6025},
 									val:        "!:",
 									ignoreCase: false,
 									want:       "\"!:\"",
 								},
 								&zeroOrMoreExpr{
-									pos: position{line: 191, col: 35, offset: 6156},
+									pos: position{line: 191, col: 35, offset: 6030},
 									expr: &ruleRefExpr{
-										pos:  position{line: 191, col: 35, offset: 6156},
+										pos:  position{line: 191, col: 35, offset: 6030},
 										name: "Space",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 191, col: 42, offset: 6163},
+									pos:  position{line: 191, col: 42, offset: 6037},
 									name: "EOL",
 								},
 							},
@@ -1279,29 +1280,29 @@ var g = &grammar{
 		},
 		{
 			name: "AttributeSubstitution",
-			pos:  position{line: 195, col: 1, offset: 6226},
+			pos:  position{line: 195, col: 1, offset: 6112},
 			expr: &actionExpr{
-				pos: position{line: 195, col: 26, offset: 6251},
+				pos: position{line: 195, col: 26, offset: 6137},
 				run: (*parser).callonAttributeSubstitution1,
 				expr: &seqExpr{
-					pos: position{line: 195, col: 26, offset: 6251},
+					pos: position{line: 195, col: 26, offset: 6137},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 195, col: 26, offset: 6251},
+							pos:        position{line: 195, col: 26, offset: 6137},
 							val:        "{",
 							ignoreCase: false,
 							want:       "\"{\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 195, col: 30, offset: 6255},
+							pos:   position{line: 195, col: 30, offset: 6141},
 							label: "name",
 							expr: &ruleRefExpr{
-								pos:  position{line: 195, col: 36, offset: 6261},
+								pos:  position{line: 195, col: 36, offset: 6147},
 								name: "AttributeName",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 195, col: 51, offset: 6276},
+							pos:        position{line: 195, col: 51, offset: 6162},
 							val:        "}",
 							ignoreCase: false,
 							want:       "\"}\"",
@@ -1312,28 +1313,28 @@ var g = &grammar{
 		},
 		{
 			name: "Attributes",
-			pos:  position{line: 199, col: 1, offset: 6346},
+			pos:  position{line: 199, col: 1, offset: 6244},
 			expr: &actionExpr{
-				pos: position{line: 199, col: 15, offset: 6360},
+				pos: position{line: 199, col: 15, offset: 6258},
 				run: (*parser).callonAttributes1,
 				expr: &seqExpr{
-					pos: position{line: 199, col: 15, offset: 6360},
+					pos: position{line: 199, col: 15, offset: 6258},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 199, col: 15, offset: 6360},
+							pos:   position{line: 199, col: 15, offset: 6258},
 							label: "attrs",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 199, col: 21, offset: 6366},
+								pos: position{line: 199, col: 21, offset: 6264},
 								expr: &ruleRefExpr{
-									pos:  position{line: 199, col: 22, offset: 6367},
+									pos:  position{line: 199, col: 22, offset: 6265},
 									name: "ElementAttribute",
 								},
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 199, col: 41, offset: 6386},
+							pos: position{line: 199, col: 41, offset: 6284},
 							expr: &ruleRefExpr{
-								pos:  position{line: 199, col: 41, offset: 6386},
+								pos:  position{line: 199, col: 41, offset: 6284},
 								name: "BlankLine",
 							},
 						},
@@ -1343,32 +1344,32 @@ var g = &grammar{
 		},
 		{
 			name: "ElementAttribute",
-			pos:  position{line: 203, col: 1, offset: 6460},
+			pos:  position{line: 203, col: 1, offset: 6354},
 			expr: &actionExpr{
-				pos: position{line: 203, col: 21, offset: 6480},
+				pos: position{line: 203, col: 21, offset: 6374},
 				run: (*parser).callonElementAttribute1,
 				expr: &seqExpr{
-					pos: position{line: 203, col: 21, offset: 6480},
+					pos: position{line: 203, col: 21, offset: 6374},
 					exprs: []interface{}{
 						&andExpr{
-							pos: position{line: 203, col: 21, offset: 6480},
+							pos: position{line: 203, col: 21, offset: 6374},
 							expr: &choiceExpr{
-								pos: position{line: 203, col: 23, offset: 6482},
+								pos: position{line: 203, col: 23, offset: 6376},
 								alternatives: []interface{}{
 									&litMatcher{
-										pos:        position{line: 203, col: 23, offset: 6482},
+										pos:        position{line: 203, col: 23, offset: 6376},
 										val:        "[",
 										ignoreCase: false,
 										want:       "\"[\"",
 									},
 									&litMatcher{
-										pos:        position{line: 203, col: 29, offset: 6488},
+										pos:        position{line: 203, col: 29, offset: 6382},
 										val:        ".",
 										ignoreCase: false,
 										want:       "\".\"",
 									},
 									&litMatcher{
-										pos:        position{line: 203, col: 35, offset: 6494},
+										pos:        position{line: 203, col: 35, offset: 6388},
 										val:        "#",
 										ignoreCase: false,
 										want:       "\"#\"",
@@ -1377,53 +1378,49 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 204, col: 5, offset: 6571},
+							pos:   position{line: 204, col: 5, offset: 6464},
 							label: "attr",
 							expr: &choiceExpr{
-								pos: position{line: 204, col: 11, offset: 6577},
+								pos: position{line: 204, col: 11, offset: 6470},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 204, col: 11, offset: 6577},
+										pos:  position{line: 204, col: 11, offset: 6470},
 										name: "ElementID",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 205, col: 9, offset: 6599},
+										pos:  position{line: 205, col: 9, offset: 6491},
 										name: "ElementTitle",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 206, col: 9, offset: 6624},
-										name: "ElementRole",
-									},
-									&ruleRefExpr{
-										pos:  position{line: 207, col: 9, offset: 6648},
+										pos:  position{line: 206, col: 9, offset: 6515},
 										name: "LiteralAttribute",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 208, col: 9, offset: 6677},
+										pos:  position{line: 207, col: 9, offset: 6543},
 										name: "SourceAttributes",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 209, col: 9, offset: 6706},
+										pos:  position{line: 208, col: 9, offset: 6571},
 										name: "QuoteAttributes",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 210, col: 9, offset: 6734},
+										pos:  position{line: 209, col: 9, offset: 6598},
 										name: "VerseAttributes",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 211, col: 9, offset: 6762},
+										pos:  position{line: 210, col: 9, offset: 6625},
 										name: "AdmonitionMarkerAttribute",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 212, col: 9, offset: 6800},
+										pos:  position{line: 211, col: 9, offset: 6662},
 										name: "HorizontalLayout",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 213, col: 9, offset: 6829},
+										pos:  position{line: 212, col: 9, offset: 6690},
 										name: "PassthroughBlockAttribute",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 214, col: 9, offset: 6867},
+										pos:  position{line: 213, col: 9, offset: 6727},
 										name: "AttributeGroup",
 									},
 								},
@@ -1435,16 +1432,16 @@ var g = &grammar{
 		},
 		{
 			name: "MasqueradeAttribute",
-			pos:  position{line: 219, col: 1, offset: 7055},
+			pos:  position{line: 218, col: 1, offset: 6910},
 			expr: &choiceExpr{
-				pos: position{line: 219, col: 24, offset: 7078},
+				pos: position{line: 218, col: 24, offset: 6933},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 219, col: 24, offset: 7078},
+						pos:  position{line: 218, col: 24, offset: 6933},
 						name: "QuoteAttributes",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 219, col: 42, offset: 7096},
+						pos:  position{line: 218, col: 42, offset: 6951},
 						name: "VerseAttributes",
 					},
 				},
@@ -1452,86 +1449,205 @@ var g = &grammar{
 		},
 		{
 			name: "ElementID",
-			pos:  position{line: 221, col: 1, offset: 7115},
+			pos:  position{line: 221, col: 1, offset: 7075},
+			expr: &actionExpr{
+				pos: position{line: 221, col: 14, offset: 7088},
+				run: (*parser).callonElementID1,
+				expr: &seqExpr{
+					pos: position{line: 221, col: 14, offset: 7088},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 221, col: 14, offset: 7088},
+							val:        "[[",
+							ignoreCase: false,
+							want:       "\"[[\"",
+						},
+						&labeledExpr{
+							pos:   position{line: 221, col: 19, offset: 7093},
+							label: "id",
+							expr: &ruleRefExpr{
+								pos:  position{line: 221, col: 23, offset: 7097},
+								name: "ID",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 221, col: 27, offset: 7101},
+							label: "reftext",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 221, col: 35, offset: 7109},
+								expr: &ruleRefExpr{
+									pos:  position{line: 221, col: 36, offset: 7110},
+									name: "AnchorRefText",
+								},
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 221, col: 52, offset: 7126},
+							val:        "]]",
+							ignoreCase: false,
+							want:       "\"]]\"",
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 221, col: 57, offset: 7131},
+							expr: &ruleRefExpr{
+								pos:  position{line: 221, col: 57, offset: 7131},
+								name: "Space",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 221, col: 64, offset: 7138},
+							name: "EOL",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "InlineElementID",
+			pos:  position{line: 225, col: 1, offset: 7199},
+			expr: &actionExpr{
+				pos: position{line: 225, col: 20, offset: 7218},
+				run: (*parser).callonInlineElementID1,
+				expr: &seqExpr{
+					pos: position{line: 225, col: 20, offset: 7218},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 225, col: 20, offset: 7218},
+							val:        "[[",
+							ignoreCase: false,
+							want:       "\"[[\"",
+						},
+						&labeledExpr{
+							pos:   position{line: 225, col: 25, offset: 7223},
+							label: "id",
+							expr: &ruleRefExpr{
+								pos:  position{line: 225, col: 29, offset: 7227},
+								name: "ID",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 225, col: 33, offset: 7231},
+							label: "reftext",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 225, col: 41, offset: 7239},
+								expr: &ruleRefExpr{
+									pos:  position{line: 225, col: 42, offset: 7240},
+									name: "AnchorRefText",
+								},
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 225, col: 58, offset: 7256},
+							val:        "]]",
+							ignoreCase: false,
+							want:       "\"]]\"",
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 225, col: 63, offset: 7261},
+							expr: &ruleRefExpr{
+								pos:  position{line: 225, col: 63, offset: 7261},
+								name: "Space",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "InlineAnchor",
+			pos:  position{line: 230, col: 1, offset: 7498},
 			expr: &choiceExpr{
-				pos: position{line: 221, col: 14, offset: 7128},
+				pos: position{line: 230, col: 17, offset: 7514},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 221, col: 14, offset: 7128},
-						run: (*parser).callonElementID2,
+						pos: position{line: 230, col: 17, offset: 7514},
+						run: (*parser).callonInlineAnchor2,
 						expr: &seqExpr{
-							pos: position{line: 221, col: 14, offset: 7128},
+							pos: position{line: 230, col: 17, offset: 7514},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 221, col: 14, offset: 7128},
+									pos:        position{line: 230, col: 17, offset: 7514},
 									val:        "[[",
 									ignoreCase: false,
 									want:       "\"[[\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 221, col: 19, offset: 7133},
+									pos:   position{line: 230, col: 22, offset: 7519},
 									label: "id",
 									expr: &ruleRefExpr{
-										pos:  position{line: 221, col: 23, offset: 7137},
+										pos:  position{line: 230, col: 26, offset: 7523},
 										name: "ID",
 									},
 								},
+								&labeledExpr{
+									pos:   position{line: 230, col: 30, offset: 7527},
+									label: "reftext",
+									expr: &zeroOrOneExpr{
+										pos: position{line: 230, col: 38, offset: 7535},
+										expr: &ruleRefExpr{
+											pos:  position{line: 230, col: 39, offset: 7536},
+											name: "AnchorRefText",
+										},
+									},
+								},
 								&litMatcher{
-									pos:        position{line: 221, col: 27, offset: 7141},
+									pos:        position{line: 230, col: 55, offset: 7552},
 									val:        "]]",
 									ignoreCase: false,
 									want:       "\"]]\"",
 								},
-								&zeroOrMoreExpr{
-									pos: position{line: 221, col: 32, offset: 7146},
-									expr: &ruleRefExpr{
-										pos:  position{line: 221, col: 32, offset: 7146},
-										name: "Space",
-									},
-								},
-								&ruleRefExpr{
-									pos:  position{line: 221, col: 39, offset: 7153},
-									name: "EOL",
-								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 223, col: 5, offset: 7208},
-						run: (*parser).callonElementID11,
+						pos: position{line: 232, col: 5, offset: 7634},
+						run: (*parser).callonInlineAnchor11,
 						expr: &seqExpr{
-							pos: position{line: 223, col: 5, offset: 7208},
+							pos: position{line: 232, col: 5, offset: 7634},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 223, col: 5, offset: 7208},
-									val:        "[#",
+									pos:        position{line: 232, col: 5, offset: 7634},
+									val:        "anchor:",
 									ignoreCase: false,
-									want:       "\"[#\"",
+									want:       "\"anchor:\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 223, col: 10, offset: 7213},
+									pos:   position{line: 232, col: 15, offset: 7644},
 									label: "id",
 									expr: &ruleRefExpr{
-										pos:  position{line: 223, col: 14, offset: 7217},
+										pos:  position{line: 232, col: 19, offset: 7648},
 										name: "ID",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 223, col: 18, offset: 7221},
-									val:        "]",
+									pos:        position{line: 232, col: 23, offset: 7652},
+									val:        "[",
 									ignoreCase: false,
-									want:       "\"]\"",
+									want:       "\"[\"",
 								},
-								&zeroOrMoreExpr{
-									pos: position{line: 223, col: 23, offset: 7226},
-									expr: &ruleRefExpr{
-										pos:  position{line: 223, col: 23, offset: 7226},
-										name: "Space",
+								&labeledExpr{
+									pos:   position{line: 232, col: 27, offset: 7656},
+									label: "reftext",
+									expr: &actionExpr{
+										pos: position{line: 232, col: 36, offset: 7665},
+										run: (*parser).callonInlineAnchor18,
+										expr: &zeroOrMoreExpr{
+											pos: position{line: 232, col: 36, offset: 7665},
+											expr: &charClassMatcher{
+												pos:        position{line: 232, col: 36, offset: 7665},
+												val:        "[^\\r\\n\\]]",
+												chars:      []rune{'\r', '\n', ']'},
+												ignoreCase: false,
+												inverted:   true,
+											},
+										},
 									},
 								},
-								&ruleRefExpr{
-									pos:  position{line: 223, col: 30, offset: 7233},
-									name: "EOL",
+								&litMatcher{
+									pos:        position{line: 232, col: 79, offset: 7708},
+									val:        "]",
+									ignoreCase: false,
+									want:       "\"]\"",
 								},
 							},
 						},
@@ -1540,70 +1656,91 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "InlineElementID",
-			pos:  position{line: 227, col: 1, offset: 7289},
+			name: "AnchorRefText",
+			pos:  position{line: 236, col: 1, offset: 7788},
 			expr: &actionExpr{
-				pos: position{line: 227, col: 20, offset: 7308},
-				run: (*parser).callonInlineElementID1,
+				pos: position{line: 236, col: 18, offset: 7805},
+				run: (*parser).callonAnchorRefText1,
 				expr: &seqExpr{
-					pos: position{line: 227, col: 20, offset: 7308},
+					pos: position{line: 236, col: 18, offset: 7805},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 227, col: 20, offset: 7308},
-							val:        "[[",
-							ignoreCase: false,
-							want:       "\"[[\"",
-						},
-						&labeledExpr{
-							pos:   position{line: 227, col: 25, offset: 7313},
-							label: "id",
-							expr: &ruleRefExpr{
-								pos:  position{line: 227, col: 29, offset: 7317},
-								name: "ID",
-							},
-						},
-						&litMatcher{
-							pos:        position{line: 227, col: 33, offset: 7321},
-							val:        "]]",
+							pos:        position{line: 236, col: 18, offset: 7805},
+							val:        ",",
 							ignoreCase: false,
-							want:       "\"]]\"",
+							want:       "\",\"",
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 227, col: 38, offset: 7326},
+							pos: position{line: 236, col: 22, offset: 7809},
 							expr: &ruleRefExpr{
-								pos:  position{line: 227, col: 38, offset: 7326},
+								pos:  position{line: 236, col: 22, offset: 7809},
 								name: "Space",
 							},
 						},
+						&labeledExpr{
+							pos:   position{line: 236, col: 29, offset: 7816},
+							label: "reftext",
+							expr: &actionExpr{
+								pos: position{line: 236, col: 38, offset: 7825},
+								run: (*parser).callonAnchorRefText7,
+								expr: &oneOrMoreExpr{
+									pos: position{line: 236, col: 38, offset: 7825},
+									expr: &seqExpr{
+										pos: position{line: 236, col: 39, offset: 7826},
+										exprs: []interface{}{
+											&notExpr{
+												pos: position{line: 236, col: 39, offset: 7826},
+												expr: &litMatcher{
+													pos:        position{line: 236, col: 40, offset: 7827},
+													val:        "]]",
+													ignoreCase: false,
+													want:       "\"]]\"",
+												},
+											},
+											&notExpr{
+												pos: position{line: 236, col: 45, offset: 7832},
+												expr: &ruleRefExpr{
+													pos:  position{line: 236, col: 46, offset: 7833},
+													name: "Newline",
+												},
+											},
+											&anyMatcher{
+												line: 236, col: 54, offset: 7841,
+											},
+										},
+									},
+								},
+							},
+						},
 					},
 				},
 			},
 		},
 		{
 			name: "ElementTitle",
-			pos:  position{line: 233, col: 1, offset: 7609},
+			pos:  position{line: 242, col: 1, offset: 8046},
 			expr: &actionExpr{
-				pos: position{line: 233, col: 17, offset: 7625},
+				pos: position{line: 242, col: 17, offset: 8062},
 				run: (*parser).callonElementTitle1,
 				expr: &seqExpr{
-					pos: position{line: 233, col: 17, offset: 7625},
+					pos: position{line: 242, col: 17, offset: 8062},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 233, col: 17, offset: 7625},
+							pos:        position{line: 242, col: 17, offset: 8062},
 							val:        ".",
 							ignoreCase: false,
 							want:       "\".\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 233, col: 21, offset: 7629},
+							pos:   position{line: 242, col: 21, offset: 8066},
 							label: "title",
 							expr: &ruleRefExpr{
-								pos:  position{line: 233, col: 28, offset: 7636},
+								pos:  position{line: 242, col: 28, offset: 8073},
 								name: "ElementTitleContent",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 233, col: 49, offset: 7657},
+							pos:  position{line: 242, col: 49, offset: 8094},
 							name: "EOL",
 						},
 					},
@@ -1612,15 +1749,15 @@ var g = &grammar{
 		},
 		{
 			name: "ElementTitleContent",
-			pos:  position{line: 237, col: 1, offset: 7719},
+			pos:  position{line: 246, col: 1, offset: 8152},
 			expr: &actionExpr{
-				pos: position{line: 237, col: 24, offset: 7742},
+				pos: position{line: 246, col: 24, offset: 8175},
 				run: (*parser).callonElementTitleContent1,
 				expr: &seqExpr{
-					pos: position{line: 237, col: 24, offset: 7742},
+					pos: position{line: 246, col: 24, offset: 8175},
 					exprs: []interface{}{
 						&charClassMatcher{
-							pos:        position{line: 237, col: 24, offset: 7742},
+							pos:        position{line: 246, col: 24, offset: 8175},
 							val:        "[\\pL0-9]",
 							ranges:     []rune{'0', '9'},
 							classes:    []*unicode.RangeTable{rangeTable("L")},
@@ -1628,9 +1765,9 @@ var g = &grammar{
 							inverted:   false,
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 237, col: 32, offset: 7750},
+							pos: position{line: 246, col: 32, offset: 8183},
 							expr: &charClassMatcher{
-								pos:        position{line: 237, col: 32, offset: 7750},
+								pos:        position{line: 246, col: 32, offset: 8183},
 								val:        "[^\\r\\n<>]",
 								chars:      []rune{'\r', '\n', '<', '>'},
 								ignoreCase: false,
@@ -1641,97 +1778,30 @@ var g = &grammar{
 				},
 			},
 		},
-		{
-			name: "ElementRole",
-			pos:  position{line: 243, col: 1, offset: 7983},
-			expr: &actionExpr{
-				pos: position{line: 243, col: 16, offset: 7998},
-				run: (*parser).callonElementRole1,
-				expr: &seqExpr{
-					pos: position{line: 243, col: 16, offset: 7998},
-					exprs: []interface{}{
-						&litMatcher{
-							pos:        position{line: 243, col: 16, offset: 7998},
-							val:        "[.",
-							ignoreCase: false,
-							want:       "\"[.\"",
-						},
-						&labeledExpr{
-							pos:   position{line: 243, col: 21, offset: 8003},
-							label: "role",
-							expr: &actionExpr{
-								pos: position{line: 243, col: 27, offset: 8009},
-								run: (*parser).callonElementRole5,
-								expr: &seqExpr{
-									pos: position{line: 243, col: 27, offset: 8009},
-									exprs: []interface{}{
-										&charClassMatcher{
-											pos:        position{line: 243, col: 27, offset: 8009},
-											val:        "[\\pL0-9]",
-											ranges:     []rune{'0', '9'},
-											classes:    []*unicode.RangeTable{rangeTable("L")},
-											ignoreCase: false,
-											inverted:   false,
-										},
-										&zeroOrMoreExpr{
-											pos: position{line: 243, col: 36, offset: 8018},
-											expr: &charClassMatcher{
-												pos:        position{line: 243, col: 36, offset: 8018},
-												val:        "[^\\]\\r\\n]",
-												chars:      []rune{']', '\r', '\n'},
-												ignoreCase: false,
-												inverted:   true,
-											},
-										},
-									},
-								},
-							},
-						},
-						&litMatcher{
-							pos:        position{line: 245, col: 4, offset: 8067},
-							val:        "]",
-							ignoreCase: false,
-							want:       "\"]\"",
-						},
-						&zeroOrMoreExpr{
-							pos: position{line: 245, col: 8, offset: 8071},
-							expr: &ruleRefExpr{
-								pos:  position{line: 245, col: 8, offset: 8071},
-								name: "Space",
-							},
-						},
-						&ruleRefExpr{
-							pos:  position{line: 245, col: 15, offset: 8078},
-							name: "EOL",
-						},
-					},
-				},
-			},
-		},
 		{
 			name: "LiteralAttribute",
-			pos:  position{line: 249, col: 1, offset: 8138},
+			pos:  position{line: 250, col: 1, offset: 8316},
 			expr: &actionExpr{
-				pos: position{line: 249, col: 21, offset: 8158},
+				pos: position{line: 250, col: 21, offset: 8336},
 				run: (*parser).callonLiteralAttribute1,
 				expr: &seqExpr{
-					pos: position{line: 249, col: 21, offset: 8158},
+					pos: position{line: 250, col: 21, offset: 8336},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 249, col: 21, offset: 8158},
+							pos:        position{line: 250, col: 21, offset: 8336},
 							val:        "[literal]",
 							ignoreCase: false,
 							want:       "\"[literal]\"",
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 249, col: 33, offset: 8170},
+							pos: position{line: 250, col: 33, offset: 8348},
 							expr: &ruleRefExpr{
-								pos:  position{line: 249, col: 33, offset: 8170},
+								pos:  position{line: 250, col: 33, offset: 8348},
 								name: "Space",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 249, col: 40, offset: 8177},
+							pos:  position{line: 250, col: 40, offset: 8355},
 							name: "Newline",
 						},
 					},
@@ -1740,28 +1810,28 @@ var g = &grammar{
 		},
 		{
 			name: "PassthroughBlockAttribute",
-			pos:  position{line: 253, col: 1, offset: 8233},
+			pos:  position{line: 254, col: 1, offset: 8407},
 			expr: &actionExpr{
-				pos: position{line: 253, col: 30, offset: 8262},
+				pos: position{line: 254, col: 30, offset: 8436},
 				run: (*parser).callonPassthroughBlockAttribute1,
 				expr: &seqExpr{
-					pos: position{line: 253, col: 30, offset: 8262},
+					pos: position{line: 254, col: 30, offset: 8436},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 253, col: 30, offset: 8262},
+							pos:        position{line: 254, col: 30, offset: 8436},
 							val:        "[pass]",
 							ignoreCase: false,
 							want:       "\"[pass]\"",
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 253, col: 39, offset: 8271},
+							pos: position{line: 254, col: 39, offset: 8445},
 							expr: &ruleRefExpr{
-								pos:  position{line: 253, col: 39, offset: 8271},
+								pos:  position{line: 254, col: 39, offset: 8445},
 								name: "Space",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 253, col: 46, offset: 8278},
+							pos:  position{line: 254, col: 46, offset: 8452},
 							name: "Newline",
 						},
 					},
@@ -1770,42 +1840,42 @@ var g = &grammar{
 		},
 		{
 			name: "AdmonitionMarkerAttribute",
-			pos:  position{line: 258, col: 1, offset: 8424},
+			pos:  position{line: 259, col: 1, offset: 8593},
 			expr: &actionExpr{
-				pos: position{line: 258, col: 30, offset: 8453},
+				pos: position{line: 259, col: 30, offset: 8622},
 				run: (*parser).callonAdmonitionMarkerAttribute1,
 				expr: &seqExpr{
-					pos: position{line: 258, col: 30, offset: 8453},
+					pos: position{line: 259, col: 30, offset: 8622},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 258, col: 30, offset: 8453},
+							pos:        position{line: 259, col: 30, offset: 8622},
 							val:        "[",
 							ignoreCase: false,
 							want:       "\"[\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 258, col: 34, offset: 8457},
+							pos:   position{line: 259, col: 34, offset: 8626},
 							label: "k",
 							expr: &ruleRefExpr{
-								pos:  position{line: 258, col: 37, offset: 8460},
+								pos:  position{line: 259, col: 37, offset: 8629},
 								name: "AdmonitionKind",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 258, col: 53, offset: 8476},
+							pos:        position{line: 259, col: 53, offset: 8645},
 							val:        "]",
 							ignoreCase: false,
 							want:       "\"]\"",
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 258, col: 57, offset: 8480},
+							pos: position{line: 259, col: 57, offset: 8649},
 							expr: &ruleRefExpr{
-								pos:  position{line: 258, col: 57, offset: 8480},
+								pos:  position{line: 259, col: 57, offset: 8649},
 								name: "Space",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 258, col: 64, offset: 8487},
+							pos:  position{line: 259, col: 64, offset: 8656},
 							name: "EOL",
 						},
 					},
@@ -1814,165 +1884,561 @@ var g = &grammar{
 		},
 		{
 			name: "SourceAttributes",
-			pos:  position{line: 263, col: 1, offset: 8647},
+			pos:  position{line: 264, col: 1, offset: 8811},
 			expr: &actionExpr{
-				pos: position{line: 263, col: 21, offset: 8667},
+				pos: position{line: 264, col: 21, offset: 8831},
 				run: (*parser).callonSourceAttributes1,
 				expr: &seqExpr{
-					pos: position{line: 263, col: 21, offset: 8667},
+					pos: position{line: 264, col: 21, offset: 8831},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 263, col: 21, offset: 8667},
+							pos:        position{line: 264, col: 21, offset: 8831},
 							val:        "[source",
 							ignoreCase: false,
 							want:       "\"[source\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 264, col: 5, offset: 8683},
+							pos:   position{line: 265, col: 5, offset: 8846},
 							label: "language",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 264, col: 14, offset: 8692},
+								pos: position{line: 265, col: 14, offset: 8855},
 								expr: &actionExpr{
-									pos: position{line: 264, col: 15, offset: 8693},
+									pos: position{line: 265, col: 15, offset: 8856},
 									run: (*parser).callonSourceAttributes6,
 									expr: &seqExpr{
-										pos: position{line: 264, col: 15, offset: 8693},
+										pos: position{line: 265, col: 15, offset: 8856},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 264, col: 15, offset: 8693},
+												pos:        position{line: 265, col: 15, offset: 8856},
 												val:        ",",
 												ignoreCase: false,
 												want:       "\",\"",
 											},
 											&labeledExpr{
-												pos:   position{line: 264, col: 19, offset: 8697},
+												pos:   position{line: 265, col: 19, offset: 8860},
 												label: "attr",
 												expr: &zeroOrOneExpr{
-													pos: position{line: 264, col: 24, offset: 8702},
+													pos: position{line: 265, col: 24, offset: 8865},
 													expr: &ruleRefExpr{
-														pos:  position{line: 264, col: 25, offset: 8703},
+														pos:  position{line: 265, col: 25, offset: 8866},
 														name: "StandaloneAttributeValue",
 													},
 												},
 											},
 										},
 									},
-								},
-							},
-						},
-						&labeledExpr{
-							pos:   position{line: 265, col: 5, offset: 8759},
-							label: "others",
-							expr: &zeroOrMoreExpr{
-								pos: position{line: 265, col: 12, offset: 8766},
-								expr: &actionExpr{
-									pos: position{line: 265, col: 13, offset: 8767},
-									run: (*parser).callonSourceAttributes14,
-									expr: &seqExpr{
-										pos: position{line: 265, col: 13, offset: 8767},
-										exprs: []interface{}{
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 266, col: 5, offset: 8921},
+							label: "others",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 266, col: 12, offset: 8928},
+								expr: &actionExpr{
+									pos: position{line: 266, col: 13, offset: 8929},
+									run: (*parser).callonSourceAttributes14,
+									expr: &seqExpr{
+										pos: position{line: 266, col: 13, offset: 8929},
+										exprs: []interface{}{
+											&litMatcher{
+												pos:        position{line: 266, col: 13, offset: 8929},
+												val:        ",",
+												ignoreCase: false,
+												want:       "\",\"",
+											},
+											&labeledExpr{
+												pos:   position{line: 266, col: 17, offset: 8933},
+												label: "attr",
+												expr: &zeroOrOneExpr{
+													pos: position{line: 266, col: 22, offset: 8938},
+													expr: &ruleRefExpr{
+														pos:  position{line: 266, col: 23, offset: 8939},
+														name: "GenericAttribute",
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 267, col: 5, offset: 8986},
+							val:        "]",
+							ignoreCase: false,
+							want:       "\"]\"",
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 267, col: 9, offset: 8990},
+							expr: &ruleRefExpr{
+								pos:  position{line: 267, col: 9, offset: 8990},
+								name: "Space",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 267, col: 16, offset: 8997},
+							name: "EOL",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "AttributeGroup",
+			pos:  position{line: 274, col: 1, offset: 9288},
+			expr: &actionExpr{
+				pos: position{line: 274, col: 19, offset: 9306},
+				run: (*parser).callonAttributeGroup1,
+				expr: &seqExpr{
+					pos: position{line: 274, col: 19, offset: 9306},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 274, col: 19, offset: 9306},
+							val:        "[",
+							ignoreCase: false,
+							want:       "\"[\"",
+						},
+						&notExpr{
+							pos: position{line: 274, col: 23, offset: 9310},
+							expr: &ruleRefExpr{
+								pos:  position{line: 274, col: 24, offset: 9311},
+								name: "Space",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 274, col: 30, offset: 9317},
+							label: "attributes",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 274, col: 41, offset: 9328},
+								expr: &ruleRefExpr{
+									pos:  position{line: 274, col: 42, offset: 9329},
+									name: "AttributeGroupEntry",
+								},
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 274, col: 64, offset: 9351},
+							val:        "]",
+							ignoreCase: false,
+							want:       "\"]\"",
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 274, col: 68, offset: 9355},
+							expr: &ruleRefExpr{
+								pos:  position{line: 274, col: 68, offset: 9355},
+								name: "Space",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 274, col: 75, offset: 9362},
+							name: "EOL",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "AttributeGroupEntry",
+			pos:  position{line: 278, col: 1, offset: 9434},
+			expr: &actionExpr{
+				pos: position{line: 278, col: 24, offset: 9457},
+				run: (*parser).callonAttributeGroupEntry1,
+				expr: &seqExpr{
+					pos: position{line: 278, col: 24, offset: 9457},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 278, col: 24, offset: 9457},
+							label: "attr",
+							expr: &choiceExpr{
+								pos: position{line: 278, col: 30, offset: 9463},
+								alternatives: []interface{}{
+									&ruleRefExpr{
+										pos:  position{line: 278, col: 30, offset: 9463},
+										name: "NamedAttribute",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 278, col: 47, offset: 9480},
+										name: "PositionalAttribute",
+									},
+								},
+							},
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 278, col: 68, offset: 9501},
+							expr: &ruleRefExpr{
+								pos:  position{line: 278, col: 68, offset: 9501},
+								name: "Space",
+							},
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 278, col: 75, offset: 9508},
+							expr: &seqExpr{
+								pos: position{line: 278, col: 76, offset: 9509},
+								exprs: []interface{}{
+									&litMatcher{
+										pos:        position{line: 278, col: 76, offset: 9509},
+										val:        ",",
+										ignoreCase: false,
+										want:       "\",\"",
+									},
+									&zeroOrMoreExpr{
+										pos: position{line: 278, col: 80, offset: 9513},
+										expr: &ruleRefExpr{
+											pos:  position{line: 278, col: 80, offset: 9513},
+											name: "Space",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "NamedAttribute",
+			pos:  position{line: 282, col: 1, offset: 9548},
+			expr: &actionExpr{
+				pos: position{line: 282, col: 19, offset: 9566},
+				run: (*parser).callonNamedAttribute1,
+				expr: &seqExpr{
+					pos: position{line: 282, col: 19, offset: 9566},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 282, col: 19, offset: 9566},
+							label: "key",
+							expr: &ruleRefExpr{
+								pos:  position{line: 282, col: 24, offset: 9571},
+								name: "NamedAttributeKey",
+							},
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 282, col: 43, offset: 9590},
+							expr: &ruleRefExpr{
+								pos:  position{line: 282, col: 43, offset: 9590},
+								name: "Space",
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 282, col: 50, offset: 9597},
+							val:        "=",
+							ignoreCase: false,
+							want:       "\"=\"",
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 282, col: 54, offset: 9601},
+							expr: &ruleRefExpr{
+								pos:  position{line: 282, col: 54, offset: 9601},
+								name: "Space",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 282, col: 61, offset: 9608},
+							label: "value",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 282, col: 67, offset: 9614},
+								expr: &ruleRefExpr{
+									pos:  position{line: 282, col: 68, offset: 9615},
+									name: "AttributeGroupValue",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "NamedAttributeKey",
+			pos:  position{line: 286, col: 1, offset: 9698},
+			expr: &actionExpr{
+				pos: position{line: 286, col: 22, offset: 9719},
+				run: (*parser).callonNamedAttributeKey1,
+				expr: &seqExpr{
+					pos: position{line: 286, col: 22, offset: 9719},
+					exprs: []interface{}{
+						&charClassMatcher{
+							pos:        position{line: 286, col: 22, offset: 9719},
+							val:        "[\\pL0-9_]",
+							chars:      []rune{'_'},
+							ranges:     []rune{'0', '9'},
+							classes:    []*unicode.RangeTable{rangeTable("L")},
+							ignoreCase: false,
+							inverted:   false,
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 286, col: 32, offset: 9729},
+							expr: &charClassMatcher{
+								pos:        position{line: 286, col: 32, offset: 9729},
+								val:        "[\\pL0-9_-]",
+								chars:      []rune{'_', '-'},
+								ranges:     []rune{'0', '9'},
+								classes:    []*unicode.RangeTable{rangeTable("L")},
+								ignoreCase: false,
+								inverted:   false,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "PositionalAttribute",
+			pos:  position{line: 290, col: 1, offset: 9777},
+			expr: &choiceExpr{
+				pos: position{line: 290, col: 24, offset: 9800},
+				alternatives: []interface{}{
+					&actionExpr{
+						pos: position{line: 290, col: 24, offset: 9800},
+						run: (*parser).callonPositionalAttribute2,
+						expr: &labeledExpr{
+							pos:   position{line: 290, col: 24, offset: 9800},
+							label: "value",
+							expr: &ruleRefExpr{
+								pos:  position{line: 290, col: 31, offset: 9807},
+								name: "AttributeGroupValue",
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 292, col: 5, offset: 9881},
+						run: (*parser).callonPositionalAttribute5,
+						expr: &andExpr{
+							pos: position{line: 292, col: 5, offset: 9881},
+							expr: &litMatcher{
+								pos:        position{line: 292, col: 6, offset: 9882},
+								val:        ",",
+								ignoreCase: false,
+								want:       "\",\"",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "AttributeGroupValue",
+			pos:  position{line: 296, col: 1, offset: 9992},
+			expr: &choiceExpr{
+				pos: position{line: 296, col: 24, offset: 10015},
+				alternatives: []interface{}{
+					&ruleRefExpr{
+						pos:  position{line: 296, col: 24, offset: 10015},
+						name: "DoubleQuotedAttributeGroupValue",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 296, col: 58, offset: 10049},
+						name: "SingleQuotedAttributeGroupValue",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 296, col: 92, offset: 10083},
+						name: "UnquotedAttributeGroupValue",
+					},
+				},
+			},
+		},
+		{
+			name: "DoubleQuotedAttributeGroupValue",
+			pos:  position{line: 298, col: 1, offset: 10112},
+			expr: &actionExpr{
+				pos: position{line: 298, col: 36, offset: 10147},
+				run: (*parser).callonDoubleQuotedAttributeGroupValue1,
+				expr: &seqExpr{
+					pos: position{line: 298, col: 36, offset: 10147},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 298, col: 36, offset: 10147},
+							val:        "\"",
+							ignoreCase: false,
+							want:       "\"\\\"\"",
+						},
+						&labeledExpr{
+							pos:   position{line: 298, col: 41, offset: 10152},
+							label: "value",
+							expr: &actionExpr{
+								pos: position{line: 298, col: 48, offset: 10159},
+								run: (*parser).callonDoubleQuotedAttributeGroupValue5,
+								expr: &zeroOrMoreExpr{
+									pos: position{line: 298, col: 48, offset: 10159},
+									expr: &choiceExpr{
+										pos: position{line: 298, col: 49, offset: 10160},
+										alternatives: []interface{}{
+											&litMatcher{
+												pos:        position{line: 298, col: 49, offset: 10160},
+												val:        "\\\"",
+												ignoreCase: false,
+												want:       "\"\\\\\\\"\"",
+											},
+											&charClassMatcher{
+												pos:        position{line: 298, col: 56, offset: 10167},
+												val:        "[^\\r\\n\"]",
+												chars:      []rune{'\r', '\n', '"'},
+												ignoreCase: false,
+												inverted:   true,
+											},
+										},
+									},
+								},
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 300, col: 4, offset: 10214},
+							val:        "\"",
+							ignoreCase: false,
+							want:       "\"\\\"\"",
+						},
+						&andExpr{
+							pos: position{line: 300, col: 9, offset: 10219},
+							expr: &seqExpr{
+								pos: position{line: 300, col: 11, offset: 10221},
+								exprs: []interface{}{
+									&zeroOrMoreExpr{
+										pos: position{line: 300, col: 11, offset: 10221},
+										expr: &ruleRefExpr{
+											pos:  position{line: 300, col: 11, offset: 10221},
+											name: "Space",
+										},
+									},
+									&choiceExpr{
+										pos: position{line: 300, col: 19, offset: 10229},
+										alternatives: []interface{}{
 											&litMatcher{
-												pos:        position{line: 265, col: 13, offset: 8767},
+												pos:        position{line: 300, col: 19, offset: 10229},
 												val:        ",",
 												ignoreCase: false,
 												want:       "\",\"",
 											},
-											&labeledExpr{
-												pos:   position{line: 265, col: 17, offset: 8771},
-												label: "attr",
-												expr: &zeroOrOneExpr{
-													pos: position{line: 265, col: 22, offset: 8776},
-													expr: &ruleRefExpr{
-														pos:  position{line: 265, col: 23, offset: 8777},
-														name: "GenericAttribute",
-													},
-												},
+											&litMatcher{
+												pos:        position{line: 300, col: 25, offset: 10235},
+												val:        "]",
+												ignoreCase: false,
+												want:       "\"]\"",
 											},
 										},
 									},
 								},
 							},
 						},
-						&litMatcher{
-							pos:        position{line: 266, col: 5, offset: 8825},
-							val:        "]",
-							ignoreCase: false,
-							want:       "\"]\"",
-						},
-						&zeroOrMoreExpr{
-							pos: position{line: 266, col: 9, offset: 8829},
-							expr: &ruleRefExpr{
-								pos:  position{line: 266, col: 9, offset: 8829},
-								name: "Space",
-							},
-						},
-						&ruleRefExpr{
-							pos:  position{line: 266, col: 16, offset: 8836},
-							name: "EOL",
-						},
 					},
 				},
 			},
 		},
 		{
-			name: "AttributeGroup",
-			pos:  position{line: 271, col: 1, offset: 8992},
+			name: "SingleQuotedAttributeGroupValue",
+			pos:  position{line: 304, col: 1, offset: 10308},
 			expr: &actionExpr{
-				pos: position{line: 271, col: 19, offset: 9010},
-				run: (*parser).callonAttributeGroup1,
+				pos: position{line: 304, col: 36, offset: 10343},
+				run: (*parser).callonSingleQuotedAttributeGroupValue1,
 				expr: &seqExpr{
-					pos: position{line: 271, col: 19, offset: 9010},
+					pos: position{line: 304, col: 36, offset: 10343},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 271, col: 19, offset: 9010},
-							val:        "[",
+							pos:        position{line: 304, col: 36, offset: 10343},
+							val:        "'",
 							ignoreCase: false,
-							want:       "\"[\"",
+							want:       "\"'\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 271, col: 23, offset: 9014},
-							label: "attributes",
-							expr: &zeroOrMoreExpr{
-								pos: position{line: 271, col: 34, offset: 9025},
-								expr: &ruleRefExpr{
-									pos:  position{line: 271, col: 35, offset: 9026},
-									name: "GenericAttribute",
+							pos:   position{line: 304, col: 40, offset: 10347},
+							label: "value",
+							expr: &actionExpr{
+								pos: position{line: 304, col: 47, offset: 10354},
+								run: (*parser).callonSingleQuotedAttributeGroupValue5,
+								expr: &zeroOrMoreExpr{
+									pos: position{line: 304, col: 47, offset: 10354},
+									expr: &choiceExpr{
+										pos: position{line: 304, col: 48, offset: 10355},
+										alternatives: []interface{}{
+											&litMatcher{
+												pos:        position{line: 304, col: 48, offset: 10355},
+												val:        "\\'",
+												ignoreCase: false,
+												want:       "\"\\\\'\"",
+											},
+											&charClassMatcher{
+												pos:        position{line: 304, col: 55, offset: 10362},
+												val:        "[^\\r\\n']",
+												chars:      []rune{'\r', '\n', '\''},
+												ignoreCase: false,
+												inverted:   true,
+											},
+										},
+									},
 								},
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 271, col: 54, offset: 9045},
-							val:        "]",
+							pos:        position{line: 306, col: 4, offset: 10409},
+							val:        "'",
 							ignoreCase: false,
-							want:       "\"]\"",
+							want:       "\"'\"",
 						},
-						&zeroOrMoreExpr{
-							pos: position{line: 271, col: 58, offset: 9049},
-							expr: &ruleRefExpr{
-								pos:  position{line: 271, col: 58, offset: 9049},
-								name: "Space",
+						&andExpr{
+							pos: position{line: 306, col: 8, offset: 10413},
+							expr: &seqExpr{
+								pos: position{line: 306, col: 10, offset: 10415},
+								exprs: []interface{}{
+									&zeroOrMoreExpr{
+										pos: position{line: 306, col: 10, offset: 10415},
+										expr: &ruleRefExpr{
+											pos:  position{line: 306, col: 10, offset: 10415},
+											name: "Space",
+										},
+									},
+									&choiceExpr{
+										pos: position{line: 306, col: 18, offset: 10423},
+										alternatives: []interface{}{
+											&litMatcher{
+												pos:        position{line: 306, col: 18, offset: 10423},
+												val:        ",",
+												ignoreCase: false,
+												want:       "\",\"",
+											},
+											&litMatcher{
+												pos:        position{line: 306, col: 24, offset: 10429},
+												val:        "]",
+												ignoreCase: false,
+												want:       "\"]\"",
+											},
+										},
+									},
+								},
 							},
 						},
-						&ruleRefExpr{
-							pos:  position{line: 271, col: 65, offset: 9056},
-							name: "EOL",
-						},
+					},
+				},
+			},
+		},
+		{
+			name: "UnquotedAttributeGroupValue",
+			pos:  position{line: 310, col: 1, offset: 10502},
+			expr: &actionExpr{
+				pos: position{line: 310, col: 32, offset: 10533},
+				run: (*parser).callonUnquotedAttributeGroupValue1,
+				expr: &oneOrMoreExpr{
+					pos: position{line: 310, col: 32, offset: 10533},
+					expr: &charClassMatcher{
+						pos:        position{line: 310, col: 32, offset: 10533},
+						val:        "[^\\r\\n,\\]]",
+						chars:      []rune{'\r', '\n', ',', ']'},
+						ignoreCase: false,
+						inverted:   true,
 					},
 				},
 			},
 		},
 		{
 			name: "GenericAttribute",
-			pos:  position{line: 275, col: 1, offset: 9132},
+			pos:  position{line: 314, col: 1, offset: 10600},
 			expr: &choiceExpr{
-				pos: position{line: 275, col: 21, offset: 9152},
+				pos: position{line: 314, col: 21, offset: 10620},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 275, col: 21, offset: 9152},
+						pos:  position{line: 314, col: 21, offset: 10620},
 						name: "GenericAttributeWithValue",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 275, col: 49, offset: 9180},
+						pos:  position{line: 314, col: 49, offset: 10648},
 						name: "GenericAttributeWithoutValue",
 					},
 				},
@@ -1980,51 +2446,51 @@ var g = &grammar{
 		},
 		{
 			name: "GenericAttributeWithValue",
-			pos:  position{line: 277, col: 1, offset: 9212},
+			pos:  position{line: 316, col: 1, offset: 10678},
 			expr: &actionExpr{
-				pos: position{line: 277, col: 30, offset: 9241},
+				pos: position{line: 316, col: 30, offset: 10707},
 				run: (*parser).callonGenericAttributeWithValue1,
 				expr: &seqExpr{
-					pos: position{line: 277, col: 30, offset: 9241},
+					pos: position{line: 316, col: 30, offset: 10707},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 277, col: 30, offset: 9241},
+							pos:   position{line: 316, col: 30, offset: 10707},
 							label: "key",
 							expr: &ruleRefExpr{
-								pos:  position{line: 277, col: 35, offset: 9246},
+								pos:  position{line: 316, col: 35, offset: 10712},
 								name: "AttributeKey",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 277, col: 49, offset: 9260},
+							pos:        position{line: 316, col: 49, offset: 10726},
 							val:        "=",
 							ignoreCase: false,
 							want:       "\"=\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 277, col: 53, offset: 9264},
+							pos:   position{line: 316, col: 53, offset: 10730},
 							label: "value",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 277, col: 59, offset: 9270},
+								pos: position{line: 316, col: 59, offset: 10736},
 								expr: &ruleRefExpr{
-									pos:  position{line: 277, col: 60, offset: 9271},
+									pos:  position{line: 316, col: 60, offset: 10737},
 									name: "AttributeValue",
 								},
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 277, col: 77, offset: 9288},
+							pos: position{line: 316, col: 77, offset: 10754},
 							expr: &litMatcher{
-								pos:        position{line: 277, col: 77, offset: 9288},
+								pos:        position{line: 316, col: 77, offset: 10754},
 								val:        ",",
 								ignoreCase: false,
 								want:       "\",\"",
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 277, col: 82, offset: 9293},
+							pos: position{line: 316, col: 82, offset: 10759},
 							expr: &ruleRefExpr{
-								pos:  position{line: 277, col: 82, offset: 9293},
+								pos:  position{line: 316, col: 82, offset: 10759},
 								name: "Space",
 							},
 						},
@@ -2034,34 +2500,34 @@ var g = &grammar{
 		},
 		{
 			name: "GenericAttributeWithoutValue",
-			pos:  position{line: 281, col: 1, offset: 9396},
+			pos:  position{line: 320, col: 1, offset: 10858},
 			expr: &actionExpr{
-				pos: position{line: 281, col: 33, offset: 9428},
+				pos: position{line: 320, col: 33, offset: 10890},
 				run: (*parser).callonGenericAttributeWithoutValue1,
 				expr: &seqExpr{
-					pos: position{line: 281, col: 33, offset: 9428},
+					pos: position{line: 320, col: 33, offset: 10890},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 281, col: 33, offset: 9428},
+							pos:   position{line: 320, col: 33, offset: 10890},
 							label: "key",
 							expr: &ruleRefExpr{
-								pos:  position{line: 281, col: 38, offset: 9433},
+								pos:  position{line: 320, col: 38, offset: 10895},
 								name: "AttributeKey",
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 281, col: 52, offset: 9447},
+							pos: position{line: 320, col: 52, offset: 10909},
 							expr: &litMatcher{
-								pos:        position{line: 281, col: 52, offset: 9447},
+								pos:        position{line: 320, col: 52, offset: 10909},
 								val:        ",",
 								ignoreCase: false,
 								want:       "\",\"",
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 281, col: 57, offset: 9452},
+							pos: position{line: 320, col: 57, offset: 10914},
 							expr: &ruleRefExpr{
-								pos:  position{line: 281, col: 57, offset: 9452},
+								pos:  position{line: 320, col: 57, offset: 10914},
 								name: "Space",
 							},
 						},
@@ -2071,57 +2537,57 @@ var g = &grammar{
 		},
 		{
 			name: "AttributeKey",
-			pos:  position{line: 285, col: 1, offset: 9544},
+			pos:  position{line: 324, col: 1, offset: 11002},
 			expr: &actionExpr{
-				pos: position{line: 285, col: 17, offset: 9560},
+				pos: position{line: 324, col: 17, offset: 11018},
 				run: (*parser).callonAttributeKey1,
 				expr: &seqExpr{
-					pos: position{line: 285, col: 17, offset: 9560},
+					pos: position{line: 324, col: 17, offset: 11018},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 285, col: 17, offset: 9560},
+							pos: position{line: 324, col: 17, offset: 11018},
 							expr: &litMatcher{
-								pos:        position{line: 285, col: 18, offset: 9561},
+								pos:        position{line: 324, col: 18, offset: 11019},
 								val:        "quote",
 								ignoreCase: false,
 								want:       "\"quote\"",
 							},
 						},
 						&notExpr{
-							pos: position{line: 285, col: 26, offset: 9569},
+							pos: position{line: 324, col: 26, offset: 11027},
 							expr: &litMatcher{
-								pos:        position{line: 285, col: 27, offset: 9570},
+								pos:        position{line: 324, col: 27, offset: 11028},
 								val:        "verse",
 								ignoreCase: false,
 								want:       "\"verse\"",
 							},
 						},
 						&notExpr{
-							pos: position{line: 285, col: 35, offset: 9578},
+							pos: position{line: 324, col: 35, offset: 11036},
 							expr: &litMatcher{
-								pos:        position{line: 285, col: 36, offset: 9579},
+								pos:        position{line: 324, col: 36, offset: 11037},
 								val:        "literal",
 								ignoreCase: false,
 								want:       "\"literal\"",
 							},
 						},
 						&notExpr{
-							pos: position{line: 285, col: 46, offset: 9589},
+							pos: position{line: 324, col: 46, offset: 11047},
 							expr: &oneOrMoreExpr{
-								pos: position{line: 285, col: 48, offset: 9591},
+								pos: position{line: 324, col: 48, offset: 11049},
 								expr: &ruleRefExpr{
-									pos:  position{line: 285, col: 48, offset: 9591},
+									pos:  position{line: 324, col: 48, offset: 11049},
 									name: "Space",
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 285, col: 56, offset: 9599},
+							pos:   position{line: 324, col: 56, offset: 11057},
 							label: "key",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 285, col: 61, offset: 9604},
+								pos: position{line: 324, col: 61, offset: 11062},
 								expr: &charClassMatcher{
-									pos:        position{line: 285, col: 61, offset: 9604},
+									pos:        position{line: 324, col: 61, offset: 11062},
 									val:        "[^\\r\\n=,\\]]",
 									chars:      []rune{'\r', '\n', '=', ',', ']'},
 									ignoreCase: false,
@@ -2130,9 +2596,9 @@ var g = &grammar{
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 285, col: 75, offset: 9618},
+							pos: position{line: 324, col: 75, offset: 11076},
 							expr: &ruleRefExpr{
-								pos:  position{line: 285, col: 75, offset: 9618},
+								pos:  position{line: 324, col: 75, offset: 11076},
 								name: "Space",
 							},
 						},
@@ -2142,17 +2608,17 @@ var g = &grammar{
 		},
 		{
 			name: "AttributeValue",
-			pos:  position{line: 289, col: 1, offset: 9665},
+			pos:  position{line: 328, col: 1, offset: 11119},
 			expr: &actionExpr{
-				pos: position{line: 289, col: 19, offset: 9683},
+				pos: position{line: 328, col: 19, offset: 11137},
 				run: (*parser).callonAttributeValue1,
 				expr: &labeledExpr{
-					pos:   position{line: 289, col: 19, offset: 9683},
+					pos:   position{line: 328, col: 19, offset: 11137},
 					label: "value",
 					expr: &oneOrMoreExpr{
-						pos: position{line: 289, col: 26, offset: 9690},
+						pos: position{line: 328, col: 26, offset: 11144},
 						expr: &charClassMatcher{
-							pos:        position{line: 289, col: 26, offset: 9690},
+							pos:        position{line: 328, col: 26, offset: 11144},
 							val:        "[^\\r\\n=,\\]]",
 							chars:      []rune{'\r', '\n', '=', ',', ']'},
 							ignoreCase: false,
@@ -2164,20 +2630,20 @@ var g = &grammar{
 		},
 		{
 			name: "StandaloneAttributeValue",
-			pos:  position{line: 293, col: 1, offset: 9745},
+			pos:  position{line: 332, col: 1, offset: 11195},
 			expr: &actionExpr{
-				pos: position{line: 293, col: 29, offset: 9773},
+				pos: position{line: 332, col: 29, offset: 11223},
 				run: (*parser).callonStandaloneAttributeValue1,
 				expr: &seqExpr{
-					pos: position{line: 293, col: 29, offset: 9773},
+					pos: position{line: 332, col: 29, offset: 11223},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 293, col: 29, offset: 9773},
+							pos:   position{line: 332, col: 29, offset: 11223},
 							label: "value",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 293, col: 36, offset: 9780},
+								pos: position{line: 332, col: 36, offset: 11230},
 								expr: &charClassMatcher{
-									pos:        position{line: 293, col: 36, offset: 9780},
+									pos:        position{line: 332, col: 36, offset: 11230},
 									val:        "[^\\r\\n=,\\]]",
 									chars:      []rune{'\r', '\n', '=', ',', ']'},
 									ignoreCase: false,
@@ -2186,9 +2652,9 @@ var g = &grammar{
 							},
 						},
 						&notExpr{
-							pos: position{line: 293, col: 50, offset: 9794},
+							pos: position{line: 332, col: 50, offset: 11244},
 							expr: &litMatcher{
-								pos:        position{line: 293, col: 51, offset: 9795},
+								pos:        position{line: 332, col: 51, offset: 11245},
 								val:        "=",
 								ignoreCase: false,
 								want:       "\"=\"",
@@ -2200,28 +2666,28 @@ var g = &grammar{
 		},
 		{
 			name: "HorizontalLayout",
-			pos:  position{line: 297, col: 1, offset: 9965},
+			pos:  position{line: 336, col: 1, offset: 11411},
 			expr: &actionExpr{
-				pos: position{line: 297, col: 21, offset: 9985},
+				pos: position{line: 336, col: 21, offset: 11431},
 				run: (*parser).callonHorizontalLayout1,
 				expr: &seqExpr{
-					pos: position{line: 297, col: 21, offset: 9985},
+					pos: position{line: 336, col: 21, offset: 11431},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 297, col: 21, offset: 9985},
+							pos:        position{line: 336, col: 21, offset: 11431},
 							val:        "[horizontal]",
 							ignoreCase: false,
 							want:       "\"[horizontal]\"",
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 297, col: 36, offset: 10000},
+							pos: position{line: 336, col: 36, offset: 11446},
 							expr: &ruleRefExpr{
-								pos:  position{line: 297, col: 36, offset: 10000},
+								pos:  position{line: 336, col: 36, offset: 11446},
 								name: "Space",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 297, col: 43, offset: 10007},
+							pos:  position{line: 336, col: 43, offset: 11453},
 							name: "EOL",
 						},
 					},
@@ -2230,81 +2696,81 @@ var g = &grammar{
 		},
 		{
 			name: "QuoteAttributes",
-			pos:  position{line: 301, col: 1, offset: 10077},
+			pos:  position{line: 340, col: 1, offset: 11519},
 			expr: &actionExpr{
-				pos: position{line: 301, col: 20, offset: 10096},
+				pos: position{line: 340, col: 20, offset: 11538},
 				run: (*parser).callonQuoteAttributes1,
 				expr: &seqExpr{
-					pos: position{line: 301, col: 20, offset: 10096},
+					pos: position{line: 340, col: 20, offset: 11538},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 301, col: 20, offset: 10096},
+							pos:        position{line: 340, col: 20, offset: 11538},
 							val:        "[quote",
 							ignoreCase: false,
 							want:       "\"[quote\"",
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 301, col: 29, offset: 10105},
+							pos: position{line: 340, col: 29, offset: 11547},
 							expr: &ruleRefExpr{
-								pos:  position{line: 301, col: 29, offset: 10105},
+								pos:  position{line: 340, col: 29, offset: 11547},
 								name: "Space",
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 301, col: 36, offset: 10112},
+							pos: position{line: 340, col: 36, offset: 11554},
 							expr: &litMatcher{
-								pos:        position{line: 301, col: 36, offset: 10112},
+								pos:        position{line: 340, col: 36, offset: 11554},
 								val:        ",",
 								ignoreCase: false,
 								want:       "\",\"",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 301, col: 41, offset: 10117},
+							pos:   position{line: 340, col: 41, offset: 11559},
 							label: "author",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 301, col: 48, offset: 10124},
+								pos: position{line: 340, col: 48, offset: 11566},
 								expr: &ruleRefExpr{
-									pos:  position{line: 301, col: 49, offset: 10125},
+									pos:  position{line: 340, col: 49, offset: 11567},
 									name: "QuoteAttribute",
 								},
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 301, col: 66, offset: 10142},
+							pos: position{line: 340, col: 66, offset: 11584},
 							expr: &litMatcher{
-								pos:        position{line: 301, col: 66, offset: 10142},
+								pos:        position{line: 340, col: 66, offset: 11584},
 								val:        ",",
 								ignoreCase: false,
 								want:       "\",\"",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 301, col: 71, offset: 10147},
+							pos:   position{line: 340, col: 71, offset: 11589},
 							label: "title",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 301, col: 77, offset: 10153},
+								pos: position{line: 340, col: 77, offset: 11595},
 								expr: &ruleRefExpr{
-									pos:  position{line: 301, col: 78, offset: 10154},
+									pos:  position{line: 340, col: 78, offset: 11596},
 									name: "QuoteAttribute",
 								},
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 301, col: 95, offset: 10171},
+							pos:        position{line: 340, col: 95, offset: 11613},
 							val:        "]",
 							ignoreCase: false,
 							want:       "\"]\"",
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 301, col: 99, offset: 10175},
+							pos: position{line: 340, col: 99, offset: 11617},
 							expr: &ruleRefExpr{
-								pos:  position{line: 301, col: 99, offset: 10175},
+								pos:  position{line: 340, col: 99, offset: 11617},
 								name: "Space",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 301, col: 106, offset: 10182},
+							pos:  position{line: 340, col: 106, offset: 11624},
 							name: "EOL",
 						},
 					},
@@ -2313,81 +2779,81 @@ var g = &grammar{
 		},
 		{
 			name: "VerseAttributes",
-			pos:  position{line: 305, col: 1, offset: 10255},
+			pos:  position{line: 344, col: 1, offset: 11693},
 			expr: &actionExpr{
-				pos: position{line: 305, col: 20, offset: 10274},
+				pos: position{line: 344, col: 20, offset: 11712},
 				run: (*parser).callonVerseAttributes1,
 				expr: &seqExpr{
-					pos: position{line: 305, col: 20, offset: 10274},
+					pos: position{line: 344, col: 20, offset: 11712},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 305, col: 20, offset: 10274},
+							pos:        position{line: 344, col: 20, offset: 11712},
 							val:        "[verse",
 							ignoreCase: false,
 							want:       "\"[verse\"",
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 305, col: 29, offset: 10283},
+							pos: position{line: 344, col: 29, offset: 11721},
 							expr: &ruleRefExpr{
-								pos:  position{line: 305, col: 29, offset: 10283},
+								pos:  position{line: 344, col: 29, offset: 11721},
 								name: "Space",
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 305, col: 36, offset: 10290},
+							pos: position{line: 344, col: 36, offset: 11728},
 							expr: &litMatcher{
-								pos:        position{line: 305, col: 36, offset: 10290},
+								pos:        position{line: 344, col: 36, offset: 11728},
 								val:        ",",
 								ignoreCase: false,
 								want:       "\",\"",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 305, col: 41, offset: 10295},
+							pos:   position{line: 344, col: 41, offset: 11733},
 							label: "author",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 305, col: 48, offset: 10302},
+								pos: position{line: 344, col: 48, offset: 11740},
 								expr: &ruleRefExpr{
-									pos:  position{line: 305, col: 49, offset: 10303},
+									pos:  position{line: 344, col: 49, offset: 11741},
 									name: "QuoteAttribute",
 								},
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 305, col: 66, offset: 10320},
+							pos: position{line: 344, col: 66, offset: 11758},
 							expr: &litMatcher{
-								pos:        position{line: 305, col: 66, offset: 10320},
+								pos:        position{line: 344, col: 66, offset: 11758},
 								val:        ",",
 								ignoreCase: false,
 								want:       "\",\"",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 305, col: 71, offset: 10325},
+							pos:   position{line: 344, col: 71, offset: 11763},
 							label: "title",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 305, col: 77, offset: 10331},
+								pos: position{line: 344, col: 77, offset: 11769},
 								expr: &ruleRefExpr{
-									pos:  position{line: 305, col: 78, offset: 10332},
+									pos:  position{line: 344, col: 78, offset: 11770},
 									name: "QuoteAttribute",
 								},
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 305, col: 95, offset: 10349},
+							pos:        position{line: 344, col: 95, offset: 11787},
 							val:        "]",
 							ignoreCase: false,
 							want:       "\"]\"",
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 305, col: 99, offset: 10353},
+							pos: position{line: 344, col: 99, offset: 11791},
 							expr: &ruleRefExpr{
-								pos:  position{line: 305, col: 99, offset: 10353},
+								pos:  position{line: 344, col: 99, offset: 11791},
 								name: "Space",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 305, col: 106, offset: 10360},
+							pos:  position{line: 344, col: 106, offset: 11798},
 							name: "EOL",
 						},
 					},
@@ -2396,14 +2862,14 @@ var g = &grammar{
 		},
 		{
 			name: "QuoteAttribute",
-			pos:  position{line: 309, col: 1, offset: 10451},
+			pos:  position{line: 348, col: 1, offset: 11885},
 			expr: &actionExpr{
-				pos: position{line: 309, col: 19, offset: 10469},
+				pos: position{line: 348, col: 19, offset: 11903},
 				run: (*parser).callonQuoteAttribute1,
 				expr: &zeroOrMoreExpr{
-					pos: position{line: 309, col: 20, offset: 10470},
+					pos: position{line: 348, col: 20, offset: 11904},
 					expr: &charClassMatcher{
-						pos:        position{line: 309, col: 20, offset: 10470},
+						pos:        position{line: 348, col: 20, offset: 11904},
 						val:        "[^\\r\\n,\\]]",
 						chars:      []rune{'\r', '\n', ',', ']'},
 						ignoreCase: false,
@@ -2414,32 +2880,32 @@ var g = &grammar{
 		},
 		{
 			name: "InlineAttributes",
-			pos:  position{line: 313, col: 1, offset: 10523},
+			pos:  position{line: 352, col: 1, offset: 11953},
 			expr: &actionExpr{
-				pos: position{line: 313, col: 21, offset: 10543},
+				pos: position{line: 352, col: 21, offset: 11973},
 				run: (*parser).callonInlineAttributes1,
 				expr: &seqExpr{
-					pos: position{line: 313, col: 21, offset: 10543},
+					pos: position{line: 352, col: 21, offset: 11973},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 313, col: 21, offset: 10543},
+							pos:        position{line: 352, col: 21, offset: 11973},
 							val:        "[",
 							ignoreCase: false,
 							want:       "\"[\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 313, col: 25, offset: 10547},
+							pos:   position{line: 352, col: 25, offset: 11977},
 							label: "attrs",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 313, col: 31, offset: 10553},
+								pos: position{line: 352, col: 31, offset: 11983},
 								expr: &ruleRefExpr{
-									pos:  position{line: 313, col: 32, offset: 10554},
+									pos:  position{line: 352, col: 32, offset: 11984},
 									name: "GenericAttribute",
 								},
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 313, col: 51, offset: 10573},
+							pos:        position{line: 352, col: 51, offset: 12003},
 							val:        "]",
 							ignoreCase: false,
 							want:       "\"]\"",
@@ -2450,22 +2916,22 @@ var g = &grammar{
 		},
 		{
 			name: "QuotedTextAttrs",
-			pos:  position{line: 326, col: 1, offset: 11054},
+			pos:  position{line: 365, col: 1, offset: 12471},
 			expr: &actionExpr{
-				pos: position{line: 326, col: 20, offset: 11073},
+				pos: position{line: 365, col: 20, offset: 12490},
 				run: (*parser).callonQuotedTextAttrs1,
 				expr: &labeledExpr{
-					pos:   position{line: 326, col: 20, offset: 11073},
+					pos:   position{line: 365, col: 20, offset: 12490},
 					label: "attrs",
 					expr: &choiceExpr{
-						pos: position{line: 326, col: 27, offset: 11080},
+						pos: position{line: 365, col: 27, offset: 12497},
 						alternatives: []interface{}{
 							&ruleRefExpr{
-								pos:  position{line: 326, col: 27, offset: 11080},
+								pos:  position{line: 365, col: 27, offset: 12497},
 								name: "QuotedTextRole",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 326, col: 44, offset: 11097},
+								pos:  position{line: 365, col: 44, offset: 12514},
 								name: "QuotedTextShortHand",
 							},
 						},
@@ -2475,32 +2941,32 @@ var g = &grammar{
 		},
 		{
 			name: "QuotedTextRole",
-			pos:  position{line: 333, col: 1, offset: 11366},
+			pos:  position{line: 372, col: 1, offset: 12776},
 			expr: &actionExpr{
-				pos: position{line: 333, col: 19, offset: 11384},
+				pos: position{line: 372, col: 19, offset: 12794},
 				run: (*parser).callonQuotedTextRole1,
 				expr: &seqExpr{
-					pos: position{line: 333, col: 19, offset: 11384},
+					pos: position{line: 372, col: 19, offset: 12794},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 333, col: 19, offset: 11384},
+							pos:        position{line: 372, col: 19, offset: 12794},
 							val:        "[",
 							ignoreCase: false,
 							want:       "\"[\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 333, col: 23, offset: 11388},
+							pos:   position{line: 372, col: 23, offset: 12798},
 							label: "role",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 333, col: 28, offset: 11393},
+								pos: position{line: 372, col: 28, offset: 12803},
 								expr: &ruleRefExpr{
-									pos:  position{line: 333, col: 28, offset: 11393},
+									pos:  position{line: 372, col: 28, offset: 12803},
 									name: "QuotedTextRoleWord",
 								},
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 333, col: 48, offset: 11413},
+							pos:        position{line: 372, col: 48, offset: 12823},
 							val:        "]",
 							ignoreCase: false,
 							want:       "\"]\"",
@@ -2511,17 +2977,17 @@ var g = &grammar{
 		},
 		{
 			name: "QuotedTextRoleWord",
-			pos:  position{line: 337, col: 1, offset: 11473},
+			pos:  position{line: 376, col: 1, offset: 12879},
 			expr: &actionExpr{
-				pos: position{line: 337, col: 23, offset: 11495},
+				pos: position{line: 376, col: 23, offset: 12901},
 				run: (*parser).callonQuotedTextRoleWord1,
 				expr: &seqExpr{
-					pos: position{line: 337, col: 23, offset: 11495},
+					pos: position{line: 376, col: 23, offset: 12901},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 337, col: 23, offset: 11495},
+							pos: position{line: 376, col: 23, offset: 12901},
 							expr: &charClassMatcher{
-								pos:        position{line: 337, col: 24, offset: 11496},
+								pos:        position{line: 376, col: 24, offset: 12902},
 								val:        "[#.]",
 								chars:      []rune{'#', '.'},
 								ignoreCase: false,
@@ -2529,15 +2995,15 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 337, col: 29, offset: 11501},
+							pos:   position{line: 376, col: 29, offset: 12907},
 							label: "role",
 							expr: &actionExpr{
-								pos: position{line: 337, col: 35, offset: 11507},
+								pos: position{line: 376, col: 35, offset: 12913},
 								run: (*parser).callonQuotedTextRoleWord6,
 								expr: &zeroOrMoreExpr{
-									pos: position{line: 337, col: 35, offset: 11507},
+									pos: position{line: 376, col: 35, offset: 12913},
 									expr: &charClassMatcher{
-										pos:        position{line: 337, col: 35, offset: 11507},
+										pos:        position{line: 376, col: 35, offset: 12913},
 										val:        "[^\\]]",
 										chars:      []rune{']'},
 										ignoreCase: false,
@@ -2552,33 +3018,33 @@ var g = &grammar{
 		},
 		{
 			name: "QuotedTextShortHand",
-			pos:  position{line: 346, col: 1, offset: 11823},
+			pos:  position{line: 385, col: 1, offset: 13220},
 			expr: &actionExpr{
-				pos: position{line: 346, col: 24, offset: 11846},
+				pos: position{line: 385, col: 24, offset: 13243},
 				run: (*parser).callonQuotedTextShortHand1,
 				expr: &seqExpr{
-					pos: position{line: 346, col: 24, offset: 11846},
+					pos: position{line: 385, col: 24, offset: 13243},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 346, col: 24, offset: 11846},
+							pos:        position{line: 385, col: 24, offset: 13243},
 							val:        "[",
 							ignoreCase: false,
 							want:       "\"[\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 346, col: 28, offset: 11850},
+							pos:   position{line: 385, col: 28, offset: 13247},
 							label: "attrs",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 346, col: 34, offset: 11856},
+								pos: position{line: 385, col: 34, offset: 13253},
 								expr: &choiceExpr{
-									pos: position{line: 346, col: 36, offset: 11858},
+									pos: position{line: 385, col: 36, offset: 13255},
 									alternatives: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 346, col: 36, offset: 11858},
+											pos:  position{line: 385, col: 36, offset: 13255},
 											name: "QuotedTextShortRole",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 346, col: 58, offset: 11880},
+											pos:  position{line: 385, col: 58, offset: 13277},
 											name: "QuotedTextShortID",
 										},
 									},
@@ -2586,7 +3052,7 @@ var g = &grammar{
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 346, col: 79, offset: 11901},
+							pos:        position{line: 385, col: 79, offset: 13298},
 							val:        "]",
 							ignoreCase: false,
 							want:       "\"]\"",
@@ -2597,29 +3063,29 @@ var g = &grammar{
 		},
 		{
 			name: "QuotedTextShortRole",
-			pos:  position{line: 350, col: 1, offset: 11936},
+			pos:  position{line: 389, col: 1, offset: 13329},
 			expr: &actionExpr{
-				pos: position{line: 350, col: 24, offset: 11959},
+				pos: position{line: 389, col: 24, offset: 13352},
 				run: (*parser).callonQuotedTextShortRole1,
 				expr: &seqExpr{
-					pos: position{line: 350, col: 24, offset: 11959},
+					pos: position{line: 389, col: 24, offset: 13352},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 350, col: 24, offset: 11959},
+							pos:        position{line: 389, col: 24, offset: 13352},
 							val:        ".",
 							ignoreCase: false,
 							want:       "\".\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 350, col: 28, offset: 11963},
+							pos:   position{line: 389, col: 28, offset: 13356},
 							label: "role",
 							expr: &actionExpr{
-								pos: position{line: 350, col: 34, offset: 11969},
+								pos: position{line: 389, col: 34, offset: 13362},
 								run: (*parser).callonQuotedTextShortRole5,
 								expr: &oneOrMoreExpr{
-									pos: position{line: 350, col: 34, offset: 11969},
+									pos: position{line: 389, col: 34, offset: 13362},
 									expr: &charClassMatcher{
-										pos:        position{line: 350, col: 34, offset: 11969},
+										pos:        position{line: 389, col: 34, offset: 13362},
 										val:        "[^.#\\]]",
 										chars:      []rune{'.', '#', ']'},
 										ignoreCase: false,
@@ -2634,29 +3100,29 @@ var g = &grammar{
 		},
 		{
 			name: "QuotedTextShortID",
-			pos:  position{line: 356, col: 1, offset: 12082},
+			pos:  position{line: 395, col: 1, offset: 13469},
 			expr: &actionExpr{
-				pos: position{line: 356, col: 22, offset: 12103},
+				pos: position{line: 395, col: 22, offset: 13490},
 				run: (*parser).callonQuotedTextShortID1,
 				expr: &seqExpr{
-					pos: position{line: 356, col: 22, offset: 12103},
+					pos: position{line: 395, col: 22, offset: 13490},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 356, col: 22, offset: 12103},
+							pos:        position{line: 395, col: 22, offset: 13490},
 							val:        "#",
 							ignoreCase: false,
 							want:       "\"#\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 356, col: 26, offset: 12107},
+							pos:   position{line: 395, col: 26, offset: 13494},
 							label: "id",
 							expr: &actionExpr{
-								pos: position{line: 356, col: 30, offset: 12111},
+								pos: position{line: 395, col: 30, offset: 13498},
 								run: (*parser).callonQuotedTextShortID5,
 								expr: &oneOrMoreExpr{
-									pos: position{line: 356, col: 30, offset: 12111},
+									pos: position{line: 395, col: 30, offset: 13498},
 									expr: &charClassMatcher{
-										pos:        position{line: 356, col: 30, offset: 12111},
+										pos:        position{line: 395, col: 30, offset: 13498},
 										val:        "[^.#\\]]",
 										chars:      []rune{'.', '#', ']'},
 										ignoreCase: false,
@@ -2671,33 +3137,33 @@ var g = &grammar{
 		},
 		{
 			name: "StandaloneAttributes",
-			pos:  position{line: 362, col: 1, offset: 12218},
+			pos:  position{line: 401, col: 1, offset: 13604},
 			expr: &actionExpr{
-				pos: position{line: 362, col: 25, offset: 12242},
+				pos: position{line: 401, col: 25, offset: 13628},
 				run: (*parser).callonStandaloneAttributes1,
 				expr: &seqExpr{
-					pos: position{line: 362, col: 25, offset: 12242},
+					pos: position{line: 401, col: 25, offset: 13628},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 362, col: 25, offset: 12242},
+							pos:   position{line: 401, col: 25, offset: 13628},
 							label: "attributes",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 362, col: 36, offset: 12253},
+								pos: position{line: 401, col: 36, offset: 13639},
 								expr: &ruleRefExpr{
-									pos:  position{line: 362, col: 37, offset: 12254},
+									pos:  position{line: 401, col: 37, offset: 13640},
 									name: "ElementAttribute",
 								},
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 362, col: 56, offset: 12273},
+							pos: position{line: 401, col: 56, offset: 13659},
 							expr: &ruleRefExpr{
-								pos:  position{line: 362, col: 56, offset: 12273},
+								pos:  position{line: 401, col: 56, offset: 13659},
 								name: "BlankLine",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 362, col: 67, offset: 12284},
+							pos:  position{line: 401, col: 67, offset: 13670},
 							name: "EOF",
 						},
 					},
@@ -2706,16 +3172,16 @@ var g = &grammar{
 		},
 		{
 			name: "QuotedString",
-			pos:  position{line: 370, col: 1, offset: 12551},
+			pos:  position{line: 409, col: 1, offset: 13929},
 			expr: &choiceExpr{
-				pos: position{line: 370, col: 17, offset: 12567},
+				pos: position{line: 409, col: 17, offset: 13945},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 370, col: 17, offset: 12567},
+						pos:  position{line: 409, col: 17, offset: 13945},
 						name: "SingleQuotedString",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 370, col: 38, offset: 12588},
+						pos:  position{line: 409, col: 38, offset: 13966},
 						name: "DoubleQuotedString",
 					},
 				},
@@ -2723,29 +3189,29 @@ var g = &grammar{
 		},
 		{
 			name: "SingleQuotedString",
-			pos:  position{line: 372, col: 1, offset: 12610},
+			pos:  position{line: 411, col: 1, offset: 13986},
 			expr: &actionExpr{
-				pos: position{line: 372, col: 23, offset: 12632},
+				pos: position{line: 411, col: 23, offset: 14008},
 				run: (*parser).callonSingleQuotedString1,
 				expr: &seqExpr{
-					pos: position{line: 372, col: 23, offset: 12632},
+					pos: position{line: 411, col: 23, offset: 14008},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 372, col: 23, offset: 12632},
+							pos:        position{line: 411, col: 23, offset: 14008},
 							val:        "'`",
 							ignoreCase: false,
 							want:       "\"'`\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 372, col: 28, offset: 12637},
+							pos:   position{line: 411, col: 28, offset: 14013},
 							label: "elements",
 							expr: &ruleRefExpr{
-								pos:  position{line: 372, col: 37, offset: 12646},
+								pos:  position{line: 411, col: 37, offset: 14022},
 								name: "SingleQuotedStringElements",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 372, col: 64, offset: 12673},
+							pos:        position{line: 411, col: 64, offset: 14049},
 							val:        "`'",
 							ignoreCase: false,
 							want:       "\"`'\"",
@@ -2756,17 +3222,17 @@ var g = &grammar{
 		},
 		{
 			name: "SingleQuotedStringElements",
-			pos:  position{line: 376, col: 1, offset: 12765},
+			pos:  position{line: 415, col: 1, offset: 14153},
 			expr: &actionExpr{
-				pos: position{line: 376, col: 31, offset: 12795},
+				pos: position{line: 415, col: 31, offset: 14183},
 				run: (*parser).callonSingleQuotedStringElements1,
 				expr: &labeledExpr{
-					pos:   position{line: 376, col: 31, offset: 12795},
+					pos:   position{line: 415, col: 31, offset: 14183},
 					label: "elements",
 					expr: &oneOrMoreExpr{
-						pos: position{line: 376, col: 41, offset: 12805},
+						pos: position{line: 415, col: 41, offset: 14193},
 						expr: &ruleRefExpr{
-							pos:  position{line: 376, col: 41, offset: 12805},
+							pos:  position{line: 415, col: 41, offset: 14193},
 							name: "SingleQuotedStringElement",
 						},
 					},
@@ -2775,95 +3241,95 @@ var g = &grammar{
 		},
 		{
 			name: "SingleQuotedStringElement",
-			pos:  position{line: 381, col: 1, offset: 12970},
+			pos:  position{line: 420, col: 1, offset: 14370},
 			expr: &actionExpr{
-				pos: position{line: 381, col: 30, offset: 12999},
+				pos: position{line: 420, col: 30, offset: 14399},
 				run: (*parser).callonSingleQuotedStringElement1,
 				expr: &labeledExpr{
-					pos:   position{line: 381, col: 30, offset: 12999},
+					pos:   position{line: 420, col: 30, offset: 14399},
 					label: "element",
 					expr: &choiceExpr{
-						pos: position{line: 382, col: 9, offset: 13018},
+						pos: position{line: 421, col: 9, offset: 14417},
 						alternatives: []interface{}{
 							&ruleRefExpr{
-								pos:  position{line: 382, col: 9, offset: 13018},
+								pos:  position{line: 421, col: 9, offset: 14417},
 								name: "LineBreak",
 							},
 							&oneOrMoreExpr{
-								pos: position{line: 383, col: 11, offset: 13064},
+								pos: position{line: 422, col: 11, offset: 14462},
 								expr: &ruleRefExpr{
-									pos:  position{line: 383, col: 11, offset: 13064},
+									pos:  position{line: 422, col: 11, offset: 14462},
 									name: "Space",
 								},
 							},
 							&ruleRefExpr{
-								pos:  position{line: 384, col: 11, offset: 13082},
+								pos:  position{line: 423, col: 11, offset: 14479},
 								name: "InlineIcon",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 385, col: 11, offset: 13104},
+								pos:  position{line: 424, col: 11, offset: 14500},
 								name: "InlineImage",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 386, col: 11, offset: 13127},
+								pos:  position{line: 425, col: 11, offset: 14522},
 								name: "InlineFootnote",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 387, col: 11, offset: 13153},
+								pos:  position{line: 426, col: 11, offset: 14547},
 								name: "InlinePassthrough",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 388, col: 11, offset: 13182},
+								pos:  position{line: 427, col: 11, offset: 14575},
 								name: "Link",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 389, col: 11, offset: 13198},
+								pos:  position{line: 428, col: 11, offset: 14590},
 								name: "AttributeSubstitution",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 390, col: 11, offset: 13231},
+								pos:  position{line: 429, col: 11, offset: 14622},
 								name: "BoldText",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 391, col: 11, offset: 13251},
+								pos:  position{line: 430, col: 11, offset: 14641},
 								name: "ItalicText",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 392, col: 11, offset: 13273},
+								pos:  position{line: 431, col: 11, offset: 14662},
 								name: "MarkedText",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 393, col: 11, offset: 13295},
+								pos:  position{line: 432, col: 11, offset: 14683},
 								name: "SubscriptText",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 394, col: 11, offset: 13320},
+								pos:  position{line: 433, col: 11, offset: 14707},
 								name: "SuperscriptText",
 							},
 							&seqExpr{
-								pos: position{line: 395, col: 11, offset: 13347},
+								pos: position{line: 434, col: 11, offset: 14733},
 								exprs: []interface{}{
 									&notExpr{
-										pos: position{line: 395, col: 11, offset: 13347},
+										pos: position{line: 434, col: 11, offset: 14733},
 										expr: &litMatcher{
-											pos:        position{line: 395, col: 12, offset: 13348},
+											pos:        position{line: 434, col: 12, offset: 14734},
 											val:        "`'",
 											ignoreCase: false,
 											want:       "\"`'\"",
 										},
 									},
 									&ruleRefExpr{
-										pos:  position{line: 395, col: 17, offset: 13353},
+										pos:  position{line: 434, col: 17, offset: 14739},
 										name: "MonospaceText",
 									},
 								},
 							},
 							&ruleRefExpr{
-								pos:  position{line: 396, col: 11, offset: 13378},
+								pos:  position{line: 435, col: 11, offset: 14763},
 								name: "DoubleQuotedString",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 397, col: 11, offset: 13408},
+								pos:  position{line: 436, col: 11, offset: 14792},
 								name: "SingleQuotedStringFallbackCharacter",
 							},
 						},
@@ -2873,33 +3339,33 @@ var g = &grammar{
 		},
 		{
 			name: "SingleQuotedStringFallbackCharacter",
-			pos:  position{line: 401, col: 1, offset: 13478},
+			pos:  position{line: 440, col: 1, offset: 14858},
 			expr: &choiceExpr{
-				pos: position{line: 401, col: 41, offset: 13518},
+				pos: position{line: 440, col: 41, offset: 14898},
 				alternatives: []interface{}{
 					&charClassMatcher{
-						pos:        position{line: 401, col: 41, offset: 13518},
+						pos:        position{line: 440, col: 41, offset: 14898},
 						val:        "[^\\r\\n`]",
 						chars:      []rune{'\r', '\n', '`'},
 						ignoreCase: false,
 						inverted:   true,
 					},
 					&actionExpr{
-						pos: position{line: 401, col: 52, offset: 13529},
+						pos: position{line: 440, col: 52, offset: 14909},
 						run: (*parser).callonSingleQuotedStringFallbackCharacter3,
 						expr: &seqExpr{
-							pos: position{line: 401, col: 52, offset: 13529},
+							pos: position{line: 440, col: 52, offset: 14909},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 401, col: 52, offset: 13529},
+									pos:        position{line: 440, col: 52, offset: 14909},
 									val:        "`",
 									ignoreCase: false,
 									want:       "\"`\"",
 								},
 								&notExpr{
-									pos: position{line: 401, col: 56, offset: 13533},
+									pos: position{line: 440, col: 56, offset: 14913},
 									expr: &litMatcher{
-										pos:        position{line: 401, col: 57, offset: 13534},
+										pos:        position{line: 440, col: 57, offset: 14914},
 										val:        "'",
 										ignoreCase: false,
 										want:       "\"'\"",
@@ -2913,29 +3379,29 @@ var g = &grammar{
 		},
 		{
 			name: "DoubleQuotedString",
-			pos:  position{line: 405, col: 1, offset: 13597},
+			pos:  position{line: 444, col: 1, offset: 14989},
 			expr: &actionExpr{
-				pos: position{line: 405, col: 23, offset: 13619},
+				pos: position{line: 444, col: 23, offset: 15011},
 				run: (*parser).callonDoubleQuotedString1,
 				expr: &seqExpr{
-					pos: position{line: 405, col: 23, offset: 13619},
+					pos: position{line: 444, col: 23, offset: 15011},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 405, col: 23, offset: 13619},
+							pos:        position{line: 444, col: 23, offset: 15011},
 							val:        "\"`",
 							ignoreCase: false,
 							want:       "\"\\\"`\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 405, col: 29, offset: 13625},
+							pos:   position{line: 444, col: 29, offset: 15017},
 							label: "elements",
 							expr: &ruleRefExpr{
-								pos:  position{line: 405, col: 38, offset: 13634},
+								pos:  position{line: 444, col: 38, offset: 15026},
 								name: "DoubleQuotedStringElements",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 405, col: 65, offset: 13661},
+							pos:        position{line: 444, col: 65, offset: 15053},
 							val:        "`\"",
 							ignoreCase: false,
 							want:       "\"`\\\"\"",
@@ -2946,17 +3412,17 @@ var g = &grammar{
 		},
 		{
 			name: "DoubleQuotedStringElements",
-			pos:  position{line: 409, col: 1, offset: 13754},
+			pos:  position{line: 448, col: 1, offset: 15158},
 			expr: &actionExpr{
-				pos: position{line: 409, col: 31, offset: 13784},
+				pos: position{line: 448, col: 31, offset: 15188},
 				run: (*parser).callonDoubleQuotedStringElements1,
 				expr: &labeledExpr{
-					pos:   position{line: 409, col: 31, offset: 13784},
+					pos:   position{line: 448, col: 31, offset: 15188},
 					label: "elements",
 					expr: &oneOrMoreExpr{
-						pos: position{line: 409, col: 41, offset: 13794},
+						pos: position{line: 448, col: 41, offset: 15198},
 						expr: &ruleRefExpr{
-							pos:  position{line: 409, col: 41, offset: 13794},
+							pos:  position{line: 448, col: 41, offset: 15198},
 							name: "DoubleQuotedStringElement",
 						},
 					},
@@ -2965,88 +3431,88 @@ var g = &grammar{
 		},
 		{
 			name: "DoubleQuotedStringElement",
-			pos:  position{line: 414, col: 1, offset: 13959},
+			pos:  position{line: 453, col: 1, offset: 15375},
 			expr: &actionExpr{
-				pos: position{line: 414, col: 30, offset: 13988},
+				pos: position{line: 453, col: 30, offset: 15404},
 				run: (*parser).callonDoubleQuotedStringElement1,
 				expr: &labeledExpr{
-					pos:   position{line: 414, col: 30, offset: 13988},
+					pos:   position{line: 453, col: 30, offset: 15404},
 					label: "element",
 					expr: &choiceExpr{
-						pos: position{line: 415, col: 9, offset: 14007},
+						pos: position{line: 454, col: 9, offset: 15422},
 						alternatives: []interface{}{
 							&ruleRefExpr{
-								pos:  position{line: 415, col: 9, offset: 14007},
+								pos:  position{line: 454, col: 9, offset: 15422},
 								name: "LineBreak",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 417, col: 11, offset: 14072},
+								pos:  position{line: 456, col: 11, offset: 15485},
 								name: "InlineIcon",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 418, col: 11, offset: 14094},
+								pos:  position{line: 457, col: 11, offset: 15506},
 								name: "InlineImage",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 419, col: 11, offset: 14117},
+								pos:  position{line: 458, col: 11, offset: 15528},
 								name: "InlineFootnote",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 420, col: 11, offset: 14143},
+								pos:  position{line: 459, col: 11, offset: 15553},
 								name: "InlinePassthrough",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 421, col: 11, offset: 14172},
+								pos:  position{line: 460, col: 11, offset: 15581},
 								name: "Link",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 422, col: 11, offset: 14188},
+								pos:  position{line: 461, col: 11, offset: 15596},
 								name: "AttributeSubstitution",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 423, col: 11, offset: 14221},
+								pos:  position{line: 462, col: 11, offset: 15628},
 								name: "BoldText",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 424, col: 11, offset: 14241},
+								pos:  position{line: 463, col: 11, offset: 15647},
 								name: "ItalicText",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 425, col: 11, offset: 14263},
+								pos:  position{line: 464, col: 11, offset: 15668},
 								name: "MarkedText",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 426, col: 11, offset: 14285},
+								pos:  position{line: 465, col: 11, offset: 15689},
 								name: "SubscriptText",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 427, col: 11, offset: 14310},
+								pos:  position{line: 466, col: 11, offset: 15713},
 								name: "SuperscriptText",
 							},
 							&seqExpr{
-								pos: position{line: 428, col: 11, offset: 14337},
+								pos: position{line: 467, col: 11, offset: 15739},
 								exprs: []interface{}{
 									&notExpr{
-										pos: position{line: 428, col: 11, offset: 14337},
+										pos: position{line: 467, col: 11, offset: 15739},
 										expr: &litMatcher{
-											pos:        position{line: 428, col: 12, offset: 14338},
+											pos:        position{line: 467, col: 12, offset: 15740},
 											val:        "`\"",
 											ignoreCase: false,
 											want:       "\"`\\\"\"",
 										},
 									},
 									&ruleRefExpr{
-										pos:  position{line: 428, col: 18, offset: 14344},
+										pos:  position{line: 467, col: 18, offset: 15746},
 										name: "MonospaceText",
 									},
 								},
 							},
 							&ruleRefExpr{
-								pos:  position{line: 429, col: 11, offset: 14369},
+								pos:  position{line: 468, col: 11, offset: 15770},
 								name: "SingleQuotedString",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 430, col: 11, offset: 14399},
+								pos:  position{line: 469, col: 11, offset: 15799},
 								name: "DoubleQuotedStringFallbackCharacter",
 							},
 						},
@@ -3056,33 +3522,33 @@ var g = &grammar{
 		},
 		{
 			name: "DoubleQuotedStringFallbackCharacter",
-			pos:  position{line: 434, col: 1, offset: 14477},
+			pos:  position{line: 473, col: 1, offset: 15873},
 			expr: &actionExpr{
-				pos: position{line: 434, col: 41, offset: 14517},
+				pos: position{line: 473, col: 41, offset: 15913},
 				run: (*parser).callonDoubleQuotedStringFallbackCharacter1,
 				expr: &choiceExpr{
-					pos: position{line: 434, col: 42, offset: 14518},
+					pos: position{line: 473, col: 42, offset: 15914},
 					alternatives: []interface{}{
 						&charClassMatcher{
-							pos:        position{line: 434, col: 42, offset: 14518},
+							pos:        position{line: 473, col: 42, offset: 15914},
 							val:        "[^\\r\\n`]",
 							chars:      []rune{'\r', '\n', '`'},
 							ignoreCase: false,
 							inverted:   true,
 						},
 						&seqExpr{
-							pos: position{line: 434, col: 53, offset: 14529},
+							pos: position{line: 473, col: 53, offset: 15925},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 434, col: 53, offset: 14529},
+									pos:        position{line: 473, col: 53, offset: 15925},
 									val:        "`",
 									ignoreCase: false,
 									want:       "\"`\"",
 								},
 								&notExpr{
-									pos: position{line: 434, col: 57, offset: 14533},
+									pos: position{line: 473, col: 57, offset: 15929},
 									expr: &litMatcher{
-										pos:        position{line: 434, col: 58, offset: 14534},
+										pos:        position{line: 473, col: 58, offset: 15930},
 										val:        "\"",
 										ignoreCase: false,
 										want:       "\"\\\"\"",
@@ -3096,34 +3562,34 @@ var g = &grammar{
 		},
 		{
 			name: "Section",
-			pos:  position{line: 441, col: 1, offset: 14706},
+			pos:  position{line: 480, col: 1, offset: 16111},
 			expr: &actionExpr{
-				pos: position{line: 441, col: 12, offset: 14717},
+				pos: position{line: 480, col: 12, offset: 16122},
 				run: (*parser).callonSection1,
 				expr: &seqExpr{
-					pos: position{line: 441, col: 12, offset: 14717},
+					pos: position{line: 480, col: 12, offset: 16122},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 441, col: 12, offset: 14717},
+							pos:   position{line: 480, col: 12, offset: 16122},
 							label: "attributes",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 441, col: 23, offset: 14728},
+								pos: position{line: 480, col: 23, offset: 16133},
 								expr: &ruleRefExpr{
-									pos:  position{line: 441, col: 24, offset: 14729},
+									pos:  position{line: 480, col: 24, offset: 16134},
 									name: "Attributes",
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 442, col: 5, offset: 14747},
+							pos:   position{line: 481, col: 5, offset: 16151},
 							label: "level",
 							expr: &actionExpr{
-								pos: position{line: 442, col: 12, offset: 14754},
+								pos: position{line: 481, col: 12, offset: 16158},
 								run: (*parser).callonSection7,
 								expr: &oneOrMoreExpr{
-									pos: position{line: 442, col: 12, offset: 14754},
+									pos: position{line: 481, col: 12, offset: 16158},
 									expr: &litMatcher{
-										pos:        position{line: 442, col: 13, offset: 14755},
+										pos:        position{line: 481, col: 13, offset: 16159},
 										val:        "=",
 										ignoreCase: false,
 										want:       "\"=\"",
@@ -3132,37 +3598,37 @@ var g = &grammar{
 							},
 						},
 						&andCodeExpr{
-							pos: position{line: 446, col: 5, offset: 14850},
+							pos: position{line: 485, col: 5, offset: 16250},
 							run: (*parser).callonSection10,
 						},
 						&oneOrMoreExpr{
-							pos: position{line: 450, col: 5, offset: 15006},
+							pos: position{line: 489, col: 5, offset: 16402},
 							expr: &ruleRefExpr{
-								pos:  position{line: 450, col: 5, offset: 15006},
+								pos:  position{line: 489, col: 5, offset: 16402},
 								name: "Space",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 450, col: 12, offset: 15013},
+							pos:   position{line: 489, col: 12, offset: 16409},
 							label: "title",
 							expr: &ruleRefExpr{
-								pos:  position{line: 450, col: 19, offset: 15020},
+								pos:  position{line: 489, col: 19, offset: 16416},
 								name: "TitleElements",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 450, col: 34, offset: 15035},
+							pos:   position{line: 489, col: 34, offset: 16431},
 							label: "id",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 450, col: 38, offset: 15039},
+								pos: position{line: 489, col: 38, offset: 16435},
 								expr: &ruleRefExpr{
-									pos:  position{line: 450, col: 38, offset: 15039},
+									pos:  position{line: 489, col: 38, offset: 16435},
 									name: "InlineElementID",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 450, col: 56, offset: 15057},
+							pos:  position{line: 489, col: 56, offset: 16453},
 							name: "EOL",
 						},
 					},
@@ -3171,34 +3637,34 @@ var g = &grammar{
 		},
 		{
 			name: "TitleElements",
-			pos:  position{line: 454, col: 1, offset: 15167},
+			pos:  position{line: 493, col: 1, offset: 16575},
 			expr: &actionExpr{
-				pos: position{line: 454, col: 18, offset: 15184},
+				pos: position{line: 493, col: 18, offset: 16592},
 				run: (*parser).callonTitleElements1,
 				expr: &labeledExpr{
-					pos:   position{line: 454, col: 18, offset: 15184},
+					pos:   position{line: 493, col: 18, offset: 16592},
 					label: "elements",
 					expr: &oneOrMoreExpr{
-						pos: position{line: 454, col: 27, offset: 15193},
+						pos: position{line: 493, col: 27, offset: 16601},
 						expr: &seqExpr{
-							pos: position{line: 454, col: 28, offset: 15194},
+							pos: position{line: 493, col: 28, offset: 16602},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 454, col: 28, offset: 15194},
+									pos: position{line: 493, col: 28, offset: 16602},
 									expr: &ruleRefExpr{
-										pos:  position{line: 454, col: 29, offset: 15195},
+										pos:  position{line: 493, col: 29, offset: 16603},
 										name: "Newline",
 									},
 								},
 								&notExpr{
-									pos: position{line: 454, col: 37, offset: 15203},
+									pos: position{line: 493, col: 37, offset: 16611},
 									expr: &ruleRefExpr{
-										pos:  position{line: 454, col: 38, offset: 15204},
+										pos:  position{line: 493, col: 38, offset: 16612},
 										name: "InlineElementID",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 454, col: 54, offset: 15220},
+									pos:  position{line: 493, col: 54, offset: 16628},
 									name: "TitleElement",
 								},
 							},
@@ -3209,69 +3675,69 @@ var g = &grammar{
 		},
 		{
 			name: "TitleElement",
-			pos:  position{line: 458, col: 1, offset: 15345},
+			pos:  position{line: 497, col: 1, offset: 16766},
 			expr: &actionExpr{
-				pos: position{line: 458, col: 17, offset: 15361},
+				pos: position{line: 497, col: 17, offset: 16782},
 				run: (*parser).callonTitleElement1,
 				expr: &labeledExpr{
-					pos:   position{line: 458, col: 17, offset: 15361},
+					pos:   position{line: 497, col: 17, offset: 16782},
 					label: "element",
 					expr: &choiceExpr{
-						pos: position{line: 458, col: 26, offset: 15370},
+						pos: position{line: 497, col: 26, offset: 16791},
 						alternatives: []interface{}{
 							&ruleRefExpr{
-								pos:  position{line: 458, col: 26, offset: 15370},
+								pos:  position{line: 497, col: 26, offset: 16791},
 								name: "Word",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 459, col: 11, offset: 15386},
+								pos:  position{line: 498, col: 11, offset: 16806},
 								name: "LineBreak",
 							},
 							&oneOrMoreExpr{
-								pos: position{line: 460, col: 11, offset: 15432},
+								pos: position{line: 499, col: 11, offset: 16851},
 								expr: &ruleRefExpr{
-									pos:  position{line: 460, col: 11, offset: 15432},
+									pos:  position{line: 499, col: 11, offset: 16851},
 									name: "Space",
 								},
 							},
 							&ruleRefExpr{
-								pos:  position{line: 461, col: 11, offset: 15451},
+								pos:  position{line: 500, col: 11, offset: 16869},
 								name: "CrossReference",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 462, col: 11, offset: 15477},
+								pos:  position{line: 501, col: 11, offset: 16894},
 								name: "InlinePassthrough",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 463, col: 11, offset: 15506},
+								pos:  position{line: 502, col: 11, offset: 16922},
 								name: "InlineIcon",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 464, col: 11, offset: 15528},
+								pos:  position{line: 503, col: 11, offset: 16943},
 								name: "InlineImage",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 465, col: 11, offset: 15551},
+								pos:  position{line: 504, col: 11, offset: 16965},
 								name: "Link",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 466, col: 11, offset: 15567},
+								pos:  position{line: 505, col: 11, offset: 16980},
 								name: "InlineFootnote",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 467, col: 11, offset: 15593},
+								pos:  position{line: 506, col: 11, offset: 17005},
 								name: "QuotedString",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 468, col: 11, offset: 15617},
+								pos:  position{line: 507, col: 11, offset: 17028},
 								name: "QuotedText",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 469, col: 11, offset: 15639},
+								pos:  position{line: 508, col: 11, offset: 17049},
 								name: "AttributeSubstitution",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 470, col: 11, offset: 15672},
+								pos:  position{line: 509, col: 11, offset: 17081},
 								name: "AnyChar",
 							},
 						},
@@ -3281,18 +3747,18 @@ var g = &grammar{
 		},
 		{
 			name: "TableOfContentsPlaceHolder",
-			pos:  position{line: 477, col: 1, offset: 15830},
+			pos:  position{line: 516, col: 1, offset: 17232},
 			expr: &seqExpr{
-				pos: position{line: 477, col: 31, offset: 15860},
+				pos: position{line: 516, col: 31, offset: 17262},
 				exprs: []interface{}{
 					&litMatcher{
-						pos:        position{line: 477, col: 31, offset: 15860},
+						pos:        position{line: 516, col: 31, offset: 17262},
 						val:        "toc::[]",
 						ignoreCase: false,
 						want:       "\"toc::[]\"",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 477, col: 41, offset: 15870},
+						pos:  position{line: 516, col: 41, offset: 17272},
 						name: "EOL",
 					},
 				},
@@ -3300,40 +3766,40 @@ var g = &grammar{
 		},
 		{
 			name: "UserMacroBlock",
-			pos:  position{line: 482, col: 1, offset: 15986},
+			pos:  position{line: 521, col: 1, offset: 17383},
 			expr: &actionExpr{
-				pos: position{line: 482, col: 19, offset: 16004},
+				pos: position{line: 521, col: 19, offset: 17401},
 				run: (*parser).callonUserMacroBlock1,
 				expr: &seqExpr{
-					pos: position{line: 482, col: 19, offset: 16004},
+					pos: position{line: 521, col: 19, offset: 17401},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 482, col: 19, offset: 16004},
+							pos:   position{line: 521, col: 19, offset: 17401},
 							label: "name",
 							expr: &ruleRefExpr{
-								pos:  position{line: 482, col: 25, offset: 16010},
+								pos:  position{line: 521, col: 25, offset: 17407},
 								name: "UserMacroName",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 482, col: 40, offset: 16025},
+							pos:        position{line: 521, col: 40, offset: 17422},
 							val:        "::",
 							ignoreCase: false,
 							want:       "\"::\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 482, col: 45, offset: 16030},
+							pos:   position{line: 521, col: 45, offset: 17427},
 							label: "value",
 							expr: &ruleRefExpr{
-								pos:  position{line: 482, col: 52, offset: 16037},
+								pos:  position{line: 521, col: 52, offset: 17434},
 								name: "UserMacroValue",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 482, col: 68, offset: 16053},
+							pos:   position{line: 521, col: 68, offset: 17450},
 							label: "attrs",
 							expr: &ruleRefExpr{
-								pos:  position{line: 482, col: 75, offset: 16060},
+								pos:  position{line: 521, col: 75, offset: 17457},
 								name: "UserMacroAttributes",
 							},
 						},
@@ -3343,40 +3809,40 @@ var g = &grammar{
 		},
 		{
 			name: "InlineUserMacro",
-			pos:  position{line: 486, col: 1, offset: 16179},
+			pos:  position{line: 525, col: 1, offset: 17588},
 			expr: &actionExpr{
-				pos: position{line: 486, col: 20, offset: 16198},
+				pos: position{line: 525, col: 20, offset: 17607},
 				run: (*parser).callonInlineUserMacro1,
 				expr: &seqExpr{
-					pos: position{line: 486, col: 20, offset: 16198},
+					pos: position{line: 525, col: 20, offset: 17607},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 486, col: 20, offset: 16198},
+							pos:   position{line: 525, col: 20, offset: 17607},
 							label: "name",
 							expr: &ruleRefExpr{
-								pos:  position{line: 486, col: 26, offset: 16204},
+								pos:  position{line: 525, col: 26, offset: 17613},
 								name: "UserMacroName",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 486, col: 41, offset: 16219},
+							pos:        position{line: 525, col: 41, offset: 17628},
 							val:        ":",
 							ignoreCase: false,
 							want:       "\":\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 486, col: 45, offset: 16223},
+							pos:   position{line: 525, col: 45, offset: 17632},
 							label: "value",
 							expr: &ruleRefExpr{
-								pos:  position{line: 486, col: 52, offset: 16230},
+								pos:  position{line: 525, col: 52, offset: 17639},
 								name: "UserMacroValue",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 486, col: 68, offset: 16246},
+							pos:   position{line: 525, col: 68, offset: 17655},
 							label: "attrs",
 							expr: &ruleRefExpr{
-								pos:  position{line: 486, col: 75, offset: 16253},
+								pos:  position{line: 525, col: 75, offset: 17662},
 								name: "UserMacroAttributes",
 							},
 						},
@@ -3386,14 +3852,14 @@ var g = &grammar{
 		},
 		{
 			name: "UserMacroName",
-			pos:  position{line: 490, col: 1, offset: 16373},
+			pos:  position{line: 529, col: 1, offset: 17794},
 			expr: &actionExpr{
-				pos: position{line: 490, col: 18, offset: 16390},
+				pos: position{line: 529, col: 18, offset: 17811},
 				run: (*parser).callonUserMacroName1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 490, col: 19, offset: 16391},
+					pos: position{line: 529, col: 19, offset: 17812},
 					expr: &charClassMatcher{
-						pos:        position{line: 490, col: 19, offset: 16391},
+						pos:        position{line: 529, col: 19, offset: 17812},
 						val:        "[\\pL0-9_-]",
 						chars:      []rune{'_', '-'},
 						ranges:     []rune{'0', '9'},
@@ -3406,14 +3872,14 @@ var g = &grammar{
 		},
 		{
 			name: "UserMacroValue",
-			pos:  position{line: 494, col: 1, offset: 16444},
+			pos:  position{line: 533, col: 1, offset: 17861},
 			expr: &actionExpr{
-				pos: position{line: 494, col: 19, offset: 16462},
+				pos: position{line: 533, col: 19, offset: 17879},
 				run: (*parser).callonUserMacroValue1,
 				expr: &zeroOrMoreExpr{
-					pos: position{line: 494, col: 19, offset: 16462},
+					pos: position{line: 533, col: 19, offset: 17879},
 					expr: &charClassMatcher{
-						pos:        position{line: 494, col: 19, offset: 16462},
+						pos:        position{line: 533, col: 19, offset: 17879},
 						val:        "[^:[ \\r\\n]",
 						chars:      []rune{':', '[', ' ', '\r', '\n'},
 						ignoreCase: false,
@@ -3424,32 +3890,39 @@ var g = &grammar{
 		},
 		{
 			name: "UserMacroAttributes",
-			pos:  position{line: 498, col: 1, offset: 16514},
+			pos:  position{line: 538, col: 1, offset: 18043},
 			expr: &actionExpr{
-				pos: position{line: 498, col: 24, offset: 16537},
+				pos: position{line: 538, col: 24, offset: 18066},
 				run: (*parser).callonUserMacroAttributes1,
 				expr: &seqExpr{
-					pos: position{line: 498, col: 24, offset: 16537},
+					pos: position{line: 538, col: 24, offset: 18066},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 498, col: 24, offset: 16537},
+							pos:        position{line: 538, col: 24, offset: 18066},
 							val:        "[",
 							ignoreCase: false,
 							want:       "\"[\"",
 						},
+						&zeroOrMoreExpr{
+							pos: position{line: 538, col: 28, offset: 18070},
+							expr: &ruleRefExpr{
+								pos:  position{line: 538, col: 28, offset: 18070},
+								name: "Space",
+							},
+						},
 						&labeledExpr{
-							pos:   position{line: 498, col: 28, offset: 16541},
+							pos:   position{line: 538, col: 35, offset: 18077},
 							label: "attrs",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 498, col: 34, offset: 16547},
+								pos: position{line: 538, col: 41, offset: 18083},
 								expr: &ruleRefExpr{
-									pos:  position{line: 498, col: 35, offset: 16548},
-									name: "GenericAttribute",
+									pos:  position{line: 538, col: 42, offset: 18084},
+									name: "AttributeGroupEntry",
 								},
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 498, col: 54, offset: 16567},
+							pos:        position{line: 538, col: 64, offset: 18106},
 							val:        "]",
 							ignoreCase: false,
 							want:       "\"]\"",
@@ -3460,41 +3933,41 @@ var g = &grammar{
 		},
 		{
 			name: "FileInclusion",
-			pos:  position{line: 505, col: 1, offset: 16756},
+			pos:  position{line: 545, col: 1, offset: 18248},
 			expr: &actionExpr{
-				pos: position{line: 505, col: 18, offset: 16773},
+				pos: position{line: 545, col: 18, offset: 18265},
 				run: (*parser).callonFileInclusion1,
 				expr: &seqExpr{
-					pos: position{line: 505, col: 18, offset: 16773},
+					pos: position{line: 545, col: 18, offset: 18265},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 505, col: 18, offset: 16773},
+							pos:   position{line: 545, col: 18, offset: 18265},
 							label: "incl",
 							expr: &actionExpr{
-								pos: position{line: 505, col: 24, offset: 16779},
+								pos: position{line: 545, col: 24, offset: 18271},
 								run: (*parser).callonFileInclusion4,
 								expr: &seqExpr{
-									pos: position{line: 505, col: 24, offset: 16779},
+									pos: position{line: 545, col: 24, offset: 18271},
 									exprs: []interface{}{
 										&litMatcher{
-											pos:        position{line: 505, col: 24, offset: 16779},
+											pos:        position{line: 545, col: 24, offset: 18271},
 											val:        "include::",
 											ignoreCase: false,
 											want:       "\"include::\"",
 										},
 										&labeledExpr{
-											pos:   position{line: 505, col: 36, offset: 16791},
+											pos:   position{line: 545, col: 36, offset: 18283},
 											label: "path",
 											expr: &ruleRefExpr{
-												pos:  position{line: 505, col: 42, offset: 16797},
+												pos:  position{line: 545, col: 42, offset: 18289},
 												name: "FileLocation",
 											},
 										},
 										&labeledExpr{
-											pos:   position{line: 505, col: 56, offset: 16811},
+											pos:   position{line: 545, col: 56, offset: 18303},
 											label: "inlineAttributes",
 											expr: &ruleRefExpr{
-												pos:  position{line: 505, col: 74, offset: 16829},
+												pos:  position{line: 545, col: 74, offset: 18321},
 												name: "FileIncludeAttributes",
 											},
 										},
@@ -3503,14 +3976,14 @@ var g = &grammar{
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 507, col: 8, offset: 16978},
+							pos: position{line: 547, col: 8, offset: 18496},
 							expr: &ruleRefExpr{
-								pos:  position{line: 507, col: 8, offset: 16978},
+								pos:  position{line: 547, col: 8, offset: 18496},
 								name: "Space",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 507, col: 15, offset: 16985},
+							pos:  position{line: 547, col: 15, offset: 18503},
 							name: "EOL",
 						},
 					},
@@ -3519,37 +3992,37 @@ var g = &grammar{
 		},
 		{
 			name: "FileIncludeAttributes",
-			pos:  position{line: 511, col: 1, offset: 17041},
+			pos:  position{line: 551, col: 1, offset: 18555},
 			expr: &actionExpr{
-				pos: position{line: 511, col: 26, offset: 17066},
+				pos: position{line: 551, col: 26, offset: 18580},
 				run: (*parser).callonFileIncludeAttributes1,
 				expr: &seqExpr{
-					pos: position{line: 511, col: 26, offset: 17066},
+					pos: position{line: 551, col: 26, offset: 18580},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 511, col: 26, offset: 17066},
+							pos:        position{line: 551, col: 26, offset: 18580},
 							val:        "[",
 							ignoreCase: false,
 							want:       "\"[\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 511, col: 30, offset: 17070},
+							pos:   position{line: 551, col: 30, offset: 18584},
 							label: "attrs",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 511, col: 36, offset: 17076},
+								pos: position{line: 551, col: 36, offset: 18590},
 								expr: &choiceExpr{
-									pos: position{line: 511, col: 37, offset: 17077},
+									pos: position{line: 551, col: 37, offset: 18591},
 									alternatives: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 511, col: 37, offset: 17077},
+											pos:  position{line: 551, col: 37, offset: 18591},
 											name: "LineRangesAttribute",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 511, col: 59, offset: 17099},
+											pos:  position{line: 551, col: 59, offset: 18613},
 											name: "TagRangesAttribute",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 511, col: 80, offset: 17120},
+											pos:  position{line: 551, col: 80, offset: 18634},
 											name: "GenericAttribute",
 										},
 									},
@@ -3557,7 +4030,7 @@ var g = &grammar{
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 511, col: 99, offset: 17139},
+							pos:        position{line: 551, col: 99, offset: 18653},
 							val:        "]",
 							ignoreCase: false,
 							want:       "\"]\"",
@@ -3568,31 +4041,31 @@ var g = &grammar{
 		},
 		{
 			name: "LineRangesAttribute",
-			pos:  position{line: 515, col: 1, offset: 17215},
+			pos:  position{line: 555, col: 1, offset: 18725},
 			expr: &actionExpr{
-				pos: position{line: 515, col: 24, offset: 17238},
+				pos: position{line: 555, col: 24, offset: 18748},
 				run: (*parser).callonLineRangesAttribute1,
 				expr: &seqExpr{
-					pos: position{line: 515, col: 24, offset: 17238},
+					pos: position{line: 555, col: 24, offset: 18748},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 515, col: 24, offset: 17238},
+							pos:        position{line: 555, col: 24, offset: 18748},
 							val:        "lines=",
 							ignoreCase: false,
 							want:       "\"lines=\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 515, col: 33, offset: 17247},
+							pos:   position{line: 555, col: 33, offset: 18757},
 							label: "lines",
 							expr: &ruleRefExpr{
-								pos:  position{line: 515, col: 40, offset: 17254},
+								pos:  position{line: 555, col: 40, offset: 18764},
 								name: "LineRangesAttributeValue",
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 515, col: 66, offset: 17280},
+							pos: position{line: 555, col: 66, offset: 18790},
 							expr: &litMatcher{
-								pos:        position{line: 515, col: 66, offset: 17280},
+								pos:        position{line: 555, col: 66, offset: 18790},
 								val:        ",",
 								ignoreCase: false,
 								want:       "\",\"",
@@ -3604,73 +4077,73 @@ var g = &grammar{
 		},
 		{
 			name: "LineRangesAttributeValue",
-			pos:  position{line: 519, col: 1, offset: 17343},
+			pos:  position{line: 559, col: 1, offset: 18849},
 			expr: &actionExpr{
-				pos: position{line: 519, col: 29, offset: 17371},
+				pos: position{line: 559, col: 29, offset: 18877},
 				run: (*parser).callonLineRangesAttributeValue1,
 				expr: &seqExpr{
-					pos: position{line: 519, col: 29, offset: 17371},
+					pos: position{line: 559, col: 29, offset: 18877},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 519, col: 29, offset: 17371},
+							pos:   position{line: 559, col: 29, offset: 18877},
 							label: "value",
 							expr: &choiceExpr{
-								pos: position{line: 519, col: 36, offset: 17378},
+								pos: position{line: 559, col: 36, offset: 18884},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 519, col: 36, offset: 17378},
+										pos:  position{line: 559, col: 36, offset: 18884},
 										name: "MultipleLineRanges",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 520, col: 11, offset: 17496},
+										pos:  position{line: 560, col: 11, offset: 19001},
 										name: "MultipleQuotedLineRanges",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 521, col: 11, offset: 17533},
+										pos:  position{line: 561, col: 11, offset: 19037},
 										name: "MultiLineRange",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 522, col: 11, offset: 17560},
+										pos:  position{line: 562, col: 11, offset: 19063},
 										name: "MultiLineQuotedRange",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 523, col: 11, offset: 17593},
+										pos:  position{line: 563, col: 11, offset: 19095},
 										name: "SingleLineQuotedRange",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 524, col: 11, offset: 17626},
+										pos:  position{line: 564, col: 11, offset: 19127},
 										name: "SingleLineRange",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 525, col: 11, offset: 17654},
+										pos:  position{line: 565, col: 11, offset: 19154},
 										name: "UndefinedLineRange",
 									},
 								},
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 525, col: 31, offset: 17674},
+							pos: position{line: 565, col: 31, offset: 19174},
 							expr: &ruleRefExpr{
-								pos:  position{line: 525, col: 31, offset: 17674},
+								pos:  position{line: 565, col: 31, offset: 19174},
 								name: "Space",
 							},
 						},
 						&choiceExpr{
-							pos: position{line: 525, col: 39, offset: 17682},
+							pos: position{line: 565, col: 39, offset: 19182},
 							alternatives: []interface{}{
 								&andExpr{
-									pos: position{line: 525, col: 39, offset: 17682},
+									pos: position{line: 565, col: 39, offset: 19182},
 									expr: &litMatcher{
-										pos:        position{line: 525, col: 40, offset: 17683},
+										pos:        position{line: 565, col: 40, offset: 19183},
 										val:        ",",
 										ignoreCase: false,
 										want:       "\",\"",
 									},
 								},
 								&andExpr{
-									pos: position{line: 525, col: 46, offset: 17689},
+									pos: position{line: 565, col: 46, offset: 19189},
 									expr: &litMatcher{
-										pos:        position{line: 525, col: 47, offset: 17690},
+										pos:        position{line: 565, col: 47, offset: 19190},
 										val:        "]",
 										ignoreCase: false,
 										want:       "\"]\"",
@@ -3684,59 +4157,59 @@ var g = &grammar{
 		},
 		{
 			name: "MultipleLineRanges",
-			pos:  position{line: 529, col: 1, offset: 17726},
+			pos:  position{line: 569, col: 1, offset: 19222},
 			expr: &actionExpr{
-				pos: position{line: 529, col: 23, offset: 17748},
+				pos: position{line: 569, col: 23, offset: 19244},
 				run: (*parser).callonMultipleLineRanges1,
 				expr: &seqExpr{
-					pos: position{line: 529, col: 23, offset: 17748},
+					pos: position{line: 569, col: 23, offset: 19244},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 529, col: 23, offset: 17748},
+							pos:   position{line: 569, col: 23, offset: 19244},
 							label: "first",
 							expr: &choiceExpr{
-								pos: position{line: 529, col: 30, offset: 17755},
+								pos: position{line: 569, col: 30, offset: 19251},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 529, col: 30, offset: 17755},
+										pos:  position{line: 569, col: 30, offset: 19251},
 										name: "MultiLineRange",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 529, col: 47, offset: 17772},
+										pos:  position{line: 569, col: 47, offset: 19268},
 										name: "SingleLineRange",
 									},
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 530, col: 5, offset: 17795},
+							pos:   position{line: 570, col: 5, offset: 19290},
 							label: "others",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 530, col: 12, offset: 17802},
+								pos: position{line: 570, col: 12, offset: 19297},
 								expr: &actionExpr{
-									pos: position{line: 530, col: 13, offset: 17803},
+									pos: position{line: 570, col: 13, offset: 19298},
 									run: (*parser).callonMultipleLineRanges9,
 									expr: &seqExpr{
-										pos: position{line: 530, col: 13, offset: 17803},
+										pos: position{line: 570, col: 13, offset: 19298},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 530, col: 13, offset: 17803},
+												pos:        position{line: 570, col: 13, offset: 19298},
 												val:        ";",
 												ignoreCase: false,
 												want:       "\";\"",
 											},
 											&labeledExpr{
-												pos:   position{line: 530, col: 17, offset: 17807},
+												pos:   position{line: 570, col: 17, offset: 19302},
 												label: "other",
 												expr: &choiceExpr{
-													pos: position{line: 530, col: 24, offset: 17814},
+													pos: position{line: 570, col: 24, offset: 19309},
 													alternatives: []interface{}{
 														&ruleRefExpr{
-															pos:  position{line: 530, col: 24, offset: 17814},
+															pos:  position{line: 570, col: 24, offset: 19309},
 															name: "MultiLineRange",
 														},
 														&ruleRefExpr{
-															pos:  position{line: 530, col: 41, offset: 17831},
+															pos:  position{line: 570, col: 41, offset: 19326},
 															name: "SingleLineRange",
 														},
 													},
@@ -3753,65 +4226,76 @@ var g = &grammar{
 		},
 		{
 			name: "MultipleQuotedLineRanges",
-			pos:  position{line: 536, col: 1, offset: 17975},
+			pos:  position{line: 576, col: 1, offset: 19464},
 			expr: &actionExpr{
-				pos: position{line: 536, col: 29, offset: 18003},
+				pos: position{line: 576, col: 29, offset: 19492},
 				run: (*parser).callonMultipleQuotedLineRanges1,
 				expr: &seqExpr{
-					pos: position{line: 536, col: 29, offset: 18003},
+					pos: position{line: 576, col: 29, offset: 19492},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 536, col: 29, offset: 18003},
+							pos:        position{line: 576, col: 29, offset: 19492},
 							val:        "\"",
 							ignoreCase: false,
 							want:       "\"\\\"\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 536, col: 34, offset: 18008},
+							pos:   position{line: 576, col: 34, offset: 19497},
 							label: "first",
 							expr: &choiceExpr{
-								pos: position{line: 536, col: 41, offset: 18015},
+								pos: position{line: 576, col: 41, offset: 19504},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 536, col: 41, offset: 18015},
+										pos:  position{line: 576, col: 41, offset: 19504},
 										name: "MultiLineRange",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 536, col: 58, offset: 18032},
+										pos:  position{line: 576, col: 58, offset: 19521},
 										name: "SingleLineRange",
 									},
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 537, col: 5, offset: 18055},
+							pos:   position{line: 577, col: 5, offset: 19543},
 							label: "others",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 537, col: 12, offset: 18062},
+								pos: position{line: 577, col: 12, offset: 19550},
 								expr: &actionExpr{
-									pos: position{line: 537, col: 13, offset: 18063},
+									pos: position{line: 577, col: 13, offset: 19551},
 									run: (*parser).callonMultipleQuotedLineRanges10,
 									expr: &seqExpr{
-										pos: position{line: 537, col: 13, offset: 18063},
+										pos: position{line: 577, col: 13, offset: 19551},
 										exprs: []interface{}{
-											&litMatcher{
-												pos:        position{line: 537, col: 13, offset: 18063},
-												val:        ",",
-												ignoreCase: false,
-												want:       "\",\"",
+											&choiceExpr{
+												pos: position{line: 577, col: 14, offset: 19552},
+												alternatives: []interface{}{
+													&litMatcher{
+														pos:        position{line: 577, col: 14, offset: 19552},
+														val:        ",",
+														ignoreCase: false,
+														want:       "\",\"",
+													},
+													&litMatcher{
+														pos:        position{line: 577, col: 20, offset: 19558},
+														val:        ";",
+														ignoreCase: false,
+														want:       "\";\"",
+													},
+												},
 											},
 											&labeledExpr{
-												pos:   position{line: 537, col: 17, offset: 18067},
+												pos:   position{line: 577, col: 25, offset: 19563},
 												label: "other",
 												expr: &choiceExpr{
-													pos: position{line: 537, col: 24, offset: 18074},
+													pos: position{line: 577, col: 32, offset: 19570},
 													alternatives: []interface{}{
 														&ruleRefExpr{
-															pos:  position{line: 537, col: 24, offset: 18074},
+															pos:  position{line: 577, col: 32, offset: 19570},
 															name: "MultiLineRange",
 														},
 														&ruleRefExpr{
-															pos:  position{line: 537, col: 41, offset: 18091},
+															pos:  position{line: 577, col: 49, offset: 19587},
 															name: "SingleLineRange",
 														},
 													},
@@ -3823,7 +4307,7 @@ var g = &grammar{
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 539, col: 9, offset: 18146},
+							pos:        position{line: 579, col: 9, offset: 19640},
 							val:        "\"",
 							ignoreCase: false,
 							want:       "\"\\\"\"",
@@ -3834,33 +4318,36 @@ var g = &grammar{
 		},
 		{
 			name: "MultiLineRange",
-			pos:  position{line: 543, col: 1, offset: 18240},
+			pos:  position{line: 583, col: 1, offset: 19730},
 			expr: &actionExpr{
-				pos: position{line: 543, col: 19, offset: 18258},
+				pos: position{line: 583, col: 19, offset: 19748},
 				run: (*parser).callonMultiLineRange1,
 				expr: &seqExpr{
-					pos: position{line: 543, col: 19, offset: 18258},
+					pos: position{line: 583, col: 19, offset: 19748},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 543, col: 19, offset: 18258},
+							pos:   position{line: 583, col: 19, offset: 19748},
 							label: "start",
 							expr: &ruleRefExpr{
-								pos:  position{line: 543, col: 26, offset: 18265},
+								pos:  position{line: 583, col: 26, offset: 19755},
 								name: "NUMBER",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 543, col: 34, offset: 18273},
+							pos:        position{line: 583, col: 34, offset: 19763},
 							val:        "..",
 							ignoreCase: false,
 							want:       "\"..\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 543, col: 39, offset: 18278},
+							pos:   position{line: 583, col: 39, offset: 19768},
 							label: "end",
-							expr: &ruleRefExpr{
-								pos:  position{line: 543, col: 44, offset: 18283},
-								name: "NUMBER",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 583, col: 43, offset: 19772},
+								expr: &ruleRefExpr{
+									pos:  position{line: 583, col: 44, offset: 19773},
+									name: "NUMBER",
+								},
 							},
 						},
 					},
@@ -3869,43 +4356,46 @@ var g = &grammar{
 		},
 		{
 			name: "MultiLineQuotedRange",
-			pos:  position{line: 547, col: 1, offset: 18375},
+			pos:  position{line: 590, col: 1, offset: 19988},
 			expr: &actionExpr{
-				pos: position{line: 547, col: 25, offset: 18399},
+				pos: position{line: 590, col: 25, offset: 20012},
 				run: (*parser).callonMultiLineQuotedRange1,
 				expr: &seqExpr{
-					pos: position{line: 547, col: 25, offset: 18399},
+					pos: position{line: 590, col: 25, offset: 20012},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 547, col: 25, offset: 18399},
+							pos:        position{line: 590, col: 25, offset: 20012},
 							val:        "\"",
 							ignoreCase: false,
 							want:       "\"\\\"\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 547, col: 30, offset: 18404},
+							pos:   position{line: 590, col: 30, offset: 20017},
 							label: "start",
 							expr: &ruleRefExpr{
-								pos:  position{line: 547, col: 37, offset: 18411},
+								pos:  position{line: 590, col: 37, offset: 20024},
 								name: "NUMBER",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 547, col: 45, offset: 18419},
+							pos:        position{line: 590, col: 45, offset: 20032},
 							val:        "..",
 							ignoreCase: false,
 							want:       "\"..\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 547, col: 50, offset: 18424},
+							pos:   position{line: 590, col: 50, offset: 20037},
 							label: "end",
-							expr: &ruleRefExpr{
-								pos:  position{line: 547, col: 55, offset: 18429},
-								name: "NUMBER",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 590, col: 54, offset: 20041},
+								expr: &ruleRefExpr{
+									pos:  position{line: 590, col: 55, offset: 20042},
+									name: "NUMBER",
+								},
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 547, col: 63, offset: 18437},
+							pos:        position{line: 590, col: 64, offset: 20051},
 							val:        "\"",
 							ignoreCase: false,
 							want:       "\"\\\"\"",
@@ -3916,15 +4406,15 @@ var g = &grammar{
 		},
 		{
 			name: "SingleLineRange",
-			pos:  position{line: 551, col: 1, offset: 18526},
+			pos:  position{line: 597, col: 1, offset: 20266},
 			expr: &actionExpr{
-				pos: position{line: 551, col: 20, offset: 18545},
+				pos: position{line: 597, col: 20, offset: 20285},
 				run: (*parser).callonSingleLineRange1,
 				expr: &labeledExpr{
-					pos:   position{line: 551, col: 20, offset: 18545},
+					pos:   position{line: 597, col: 20, offset: 20285},
 					label: "singleline",
 					expr: &ruleRefExpr{
-						pos:  position{line: 551, col: 32, offset: 18557},
+						pos:  position{line: 597, col: 32, offset: 20297},
 						name: "NUMBER",
 					},
 				},
@@ -3932,29 +4422,29 @@ var g = &grammar{
 		},
 		{
 			name: "SingleLineQuotedRange",
-			pos:  position{line: 555, col: 1, offset: 18656},
+			pos:  position{line: 601, col: 1, offset: 20392},
 			expr: &actionExpr{
-				pos: position{line: 555, col: 26, offset: 18681},
+				pos: position{line: 601, col: 26, offset: 20417},
 				run: (*parser).callonSingleLineQuotedRange1,
 				expr: &seqExpr{
-					pos: position{line: 555, col: 26, offset: 18681},
+					pos: position{line: 601, col: 26, offset: 20417},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 555, col: 26, offset: 18681},
+							pos:        position{line: 601, col: 26, offset: 20417},
 							val:        "\"",
 							ignoreCase: false,
 							want:       "\"\\\"\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 555, col: 31, offset: 18686},
+							pos:   position{line: 601, col: 31, offset: 20422},
 							label: "singleline",
 							expr: &ruleRefExpr{
-								pos:  position{line: 555, col: 43, offset: 18698},
+								pos:  position{line: 601, col: 43, offset: 20434},
 								name: "NUMBER",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 555, col: 51, offset: 18706},
+							pos:        position{line: 601, col: 51, offset: 20442},
 							val:        "\"",
 							ignoreCase: false,
 							want:       "\"\\\"\"",
@@ -3965,14 +4455,14 @@ var g = &grammar{
 		},
 		{
 			name: "UndefinedLineRange",
-			pos:  position{line: 559, col: 1, offset: 18802},
+			pos:  position{line: 605, col: 1, offset: 20534},
 			expr: &actionExpr{
-				pos: position{line: 559, col: 23, offset: 18824},
+				pos: position{line: 605, col: 23, offset: 20556},
 				run: (*parser).callonUndefinedLineRange1,
 				expr: &zeroOrMoreExpr{
-					pos: position{line: 559, col: 23, offset: 18824},
+					pos: position{line: 605, col: 23, offset: 20556},
 					expr: &charClassMatcher{
-						pos:        position{line: 559, col: 23, offset: 18824},
+						pos:        position{line: 605, col: 23, offset: 20556},
 						val:        "[^\\], ]",
 						chars:      []rune{']', ',', ' '},
 						ignoreCase: false,
@@ -3983,24 +4473,24 @@ var g = &grammar{
 		},
 		{
 			name: "TagRangesAttribute",
-			pos:  position{line: 563, col: 1, offset: 18873},
+			pos:  position{line: 609, col: 1, offset: 20601},
 			expr: &actionExpr{
-				pos: position{line: 563, col: 23, offset: 18895},
+				pos: position{line: 609, col: 23, offset: 20623},
 				run: (*parser).callonTagRangesAttribute1,
 				expr: &seqExpr{
-					pos: position{line: 563, col: 23, offset: 18895},
+					pos: position{line: 609, col: 23, offset: 20623},
 					exprs: []interface{}{
 						&choiceExpr{
-							pos: position{line: 563, col: 24, offset: 18896},
+							pos: position{line: 609, col: 24, offset: 20624},
 							alternatives: []interface{}{
 								&litMatcher{
-									pos:        position{line: 563, col: 24, offset: 18896},
+									pos:        position{line: 609, col: 24, offset: 20624},
 									val:        "tags=",
 									ignoreCase: false,
 									want:       "\"tags=\"",
 								},
 								&litMatcher{
-									pos:        position{line: 563, col: 34, offset: 18906},
+									pos:        position{line: 609, col: 34, offset: 20634},
 									val:        "tag=",
 									ignoreCase: false,
 									want:       "\"tag=\"",
@@ -4008,17 +4498,17 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 563, col: 42, offset: 18914},
+							pos:   position{line: 609, col: 42, offset: 20642},
 							label: "tags",
 							expr: &ruleRefExpr{
-								pos:  position{line: 563, col: 48, offset: 18920},
+								pos:  position{line: 609, col: 48, offset: 20648},
 								name: "TagRangesAttributeValue",
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 563, col: 73, offset: 18945},
+							pos: position{line: 609, col: 73, offset: 20673},
 							expr: &litMatcher{
-								pos:        position{line: 563, col: 73, offset: 18945},
+								pos:        position{line: 609, col: 73, offset: 20673},
 								val:        ",",
 								ignoreCase: false,
 								want:       "\",\"",
@@ -4030,44 +4520,44 @@ var g = &grammar{
 		},
 		{
 			name: "TagRangesAttributeValue",
-			pos:  position{line: 567, col: 1, offset: 19098},
+			pos:  position{line: 613, col: 1, offset: 20822},
 			expr: &actionExpr{
-				pos: position{line: 567, col: 28, offset: 19125},
+				pos: position{line: 613, col: 28, offset: 20849},
 				run: (*parser).callonTagRangesAttributeValue1,
 				expr: &seqExpr{
-					pos: position{line: 567, col: 28, offset: 19125},
+					pos: position{line: 613, col: 28, offset: 20849},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 567, col: 28, offset: 19125},
+							pos:   position{line: 613, col: 28, offset: 20849},
 							label: "value",
 							expr: &ruleRefExpr{
-								pos:  position{line: 567, col: 35, offset: 19132},
+								pos:  position{line: 613, col: 35, offset: 20856},
 								name: "MultipleTagRanges",
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 567, col: 54, offset: 19151},
+							pos: position{line: 613, col: 54, offset: 20875},
 							expr: &ruleRefExpr{
-								pos:  position{line: 567, col: 54, offset: 19151},
+								pos:  position{line: 613, col: 54, offset: 20875},
 								name: "Space",
 							},
 						},
 						&choiceExpr{
-							pos: position{line: 567, col: 62, offset: 19159},
+							pos: position{line: 613, col: 62, offset: 20883},
 							alternatives: []interface{}{
 								&andExpr{
-									pos: position{line: 567, col: 62, offset: 19159},
+									pos: position{line: 613, col: 62, offset: 20883},
 									expr: &litMatcher{
-										pos:        position{line: 567, col: 63, offset: 19160},
+										pos:        position{line: 613, col: 63, offset: 20884},
 										val:        ",",
 										ignoreCase: false,
 										want:       "\",\"",
 									},
 								},
 								&andExpr{
-									pos: position{line: 567, col: 69, offset: 19166},
+									pos: position{line: 613, col: 69, offset: 20890},
 									expr: &litMatcher{
-										pos:        position{line: 567, col: 70, offset: 19167},
+										pos:        position{line: 613, col: 70, offset: 20891},
 										val:        "]",
 										ignoreCase: false,
 										want:       "\"]\"",
@@ -4081,43 +4571,43 @@ var g = &grammar{
 		},
 		{
 			name: "MultipleTagRanges",
-			pos:  position{line: 571, col: 1, offset: 19203},
+			pos:  position{line: 617, col: 1, offset: 20923},
 			expr: &actionExpr{
-				pos: position{line: 571, col: 22, offset: 19224},
+				pos: position{line: 617, col: 22, offset: 20944},
 				run: (*parser).callonMultipleTagRanges1,
 				expr: &seqExpr{
-					pos: position{line: 571, col: 22, offset: 19224},
+					pos: position{line: 617, col: 22, offset: 20944},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 571, col: 22, offset: 19224},
+							pos:   position{line: 617, col: 22, offset: 20944},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 571, col: 29, offset: 19231},
+								pos:  position{line: 617, col: 29, offset: 20951},
 								name: "TagRange",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 572, col: 5, offset: 19246},
+							pos:   position{line: 618, col: 5, offset: 20965},
 							label: "others",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 572, col: 12, offset: 19253},
+								pos: position{line: 618, col: 12, offset: 20972},
 								expr: &actionExpr{
-									pos: position{line: 572, col: 13, offset: 19254},
+									pos: position{line: 618, col: 13, offset: 20973},
 									run: (*parser).callonMultipleTagRanges7,
 									expr: &seqExpr{
-										pos: position{line: 572, col: 13, offset: 19254},
+										pos: position{line: 618, col: 13, offset: 20973},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 572, col: 13, offset: 19254},
+												pos:        position{line: 618, col: 13, offset: 20973},
 												val:        ";",
 												ignoreCase: false,
 												want:       "\";\"",
 											},
 											&labeledExpr{
-												pos:   position{line: 572, col: 17, offset: 19258},
+												pos:   position{line: 618, col: 17, offset: 20977},
 												label: "other",
 												expr: &ruleRefExpr{
-													pos:  position{line: 572, col: 24, offset: 19265},
+													pos:  position{line: 618, col: 24, offset: 20984},
 													name: "TagRange",
 												},
 											},
@@ -4132,25 +4622,25 @@ var g = &grammar{
 		},
 		{
 			name: "TagRange",
-			pos:  position{line: 578, col: 1, offset: 19402},
+			pos:  position{line: 624, col: 1, offset: 21115},
 			expr: &choiceExpr{
-				pos: position{line: 578, col: 13, offset: 19414},
+				pos: position{line: 624, col: 13, offset: 21127},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 578, col: 13, offset: 19414},
+						pos: position{line: 624, col: 13, offset: 21127},
 						run: (*parser).callonTagRange2,
 						expr: &labeledExpr{
-							pos:   position{line: 578, col: 13, offset: 19414},
+							pos:   position{line: 624, col: 13, offset: 21127},
 							label: "tag",
 							expr: &choiceExpr{
-								pos: position{line: 578, col: 18, offset: 19419},
+								pos: position{line: 624, col: 18, offset: 21132},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 578, col: 18, offset: 19419},
+										pos:  position{line: 624, col: 18, offset: 21132},
 										name: "Alphanums",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 578, col: 30, offset: 19431},
+										pos:  position{line: 624, col: 30, offset: 21144},
 										name: "TagWildcard",
 									},
 								},
@@ -4158,29 +4648,29 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 580, col: 5, offset: 19501},
+						pos: position{line: 626, col: 5, offset: 21212},
 						run: (*parser).callonTagRange7,
 						expr: &seqExpr{
-							pos: position{line: 580, col: 5, offset: 19501},
+							pos: position{line: 626, col: 5, offset: 21212},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 580, col: 5, offset: 19501},
+									pos:        position{line: 626, col: 5, offset: 21212},
 									val:        "!",
 									ignoreCase: false,
 									want:       "\"!\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 580, col: 9, offset: 19505},
+									pos:   position{line: 626, col: 9, offset: 21216},
 									label: "tag",
 									expr: &choiceExpr{
-										pos: position{line: 580, col: 14, offset: 19510},
+										pos: position{line: 626, col: 14, offset: 21221},
 										alternatives: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 580, col: 14, offset: 19510},
+												pos:  position{line: 626, col: 14, offset: 21221},
 												name: "Alphanums",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 580, col: 26, offset: 19522},
+												pos:  position{line: 626, col: 26, offset: 21233},
 												name: "TagWildcard",
 											},
 										},
@@ -4194,23 +4684,23 @@ var g = &grammar{
 		},
 		{
 			name: "TagWildcard",
-			pos:  position{line: 584, col: 1, offset: 19594},
+			pos:  position{line: 630, col: 1, offset: 21301},
 			expr: &actionExpr{
-				pos: position{line: 584, col: 16, offset: 19609},
+				pos: position{line: 630, col: 16, offset: 21316},
 				run: (*parser).callonTagWildcard1,
 				expr: &seqExpr{
-					pos: position{line: 584, col: 16, offset: 19609},
+					pos: position{line: 630, col: 16, offset: 21316},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 584, col: 16, offset: 19609},
+							pos:   position{line: 630, col: 16, offset: 21316},
 							label: "stars",
 							expr: &actionExpr{
-								pos: position{line: 584, col: 23, offset: 19616},
+								pos: position{line: 630, col: 23, offset: 21323},
 								run: (*parser).callonTagWildcard4,
 								expr: &oneOrMoreExpr{
-									pos: position{line: 584, col: 23, offset: 19616},
+									pos: position{line: 630, col: 23, offset: 21323},
 									expr: &litMatcher{
-										pos:        position{line: 584, col: 24, offset: 19617},
+										pos:        position{line: 630, col: 24, offset: 21324},
 										val:        "*",
 										ignoreCase: false,
 										want:       "\"*\"",
@@ -4219,7 +4709,7 @@ var g = &grammar{
 							},
 						},
 						&andCodeExpr{
-							pos: position{line: 587, col: 5, offset: 19674},
+							pos: position{line: 633, col: 5, offset: 21378},
 							run: (*parser).callonTagWildcard7,
 						},
 					},
@@ -4228,18 +4718,18 @@ var g = &grammar{
 		},
 		{
 			name: "VerbatimFileContent",
-			pos:  position{line: 595, col: 1, offset: 19924},
+			pos:  position{line: 641, col: 1, offset: 21620},
 			expr: &zeroOrMoreExpr{
-				pos: position{line: 595, col: 24, offset: 19947},
+				pos: position{line: 641, col: 24, offset: 21643},
 				expr: &choiceExpr{
-					pos: position{line: 595, col: 25, offset: 19948},
+					pos: position{line: 641, col: 25, offset: 21644},
 					alternatives: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 595, col: 25, offset: 19948},
+							pos:  position{line: 641, col: 25, offset: 21644},
 							name: "FileInclusion",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 595, col: 41, offset: 19964},
+							pos:  position{line: 641, col: 41, offset: 21660},
 							name: "VerbatimFileLine",
 						},
 					},
@@ -4248,30 +4738,30 @@ var g = &grammar{
 		},
 		{
 			name: "VerbatimFileLine",
-			pos:  position{line: 597, col: 1, offset: 19986},
+			pos:  position{line: 643, col: 1, offset: 21680},
 			expr: &actionExpr{
-				pos: position{line: 597, col: 21, offset: 20006},
+				pos: position{line: 643, col: 21, offset: 21700},
 				run: (*parser).callonVerbatimFileLine1,
 				expr: &seqExpr{
-					pos: position{line: 597, col: 21, offset: 20006},
+					pos: position{line: 643, col: 21, offset: 21700},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 597, col: 21, offset: 20006},
+							pos: position{line: 643, col: 21, offset: 21700},
 							expr: &ruleRefExpr{
-								pos:  position{line: 597, col: 22, offset: 20007},
+								pos:  position{line: 643, col: 22, offset: 21701},
 								name: "EOF",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 597, col: 26, offset: 20011},
+							pos:   position{line: 643, col: 26, offset: 21705},
 							label: "content",
 							expr: &actionExpr{
-								pos: position{line: 597, col: 35, offset: 20020},
+								pos: position{line: 643, col: 35, offset: 21714},
 								run: (*parser).callonVerbatimFileLine6,
 								expr: &zeroOrMoreExpr{
-									pos: position{line: 597, col: 35, offset: 20020},
+									pos: position{line: 643, col: 35, offset: 21714},
 									expr: &charClassMatcher{
-										pos:        position{line: 597, col: 35, offset: 20020},
+										pos:        position{line: 643, col: 35, offset: 21714},
 										val:        "[^\\r\\n]",
 										chars:      []rune{'\r', '\n'},
 										ignoreCase: false,
@@ -4281,7 +4771,7 @@ var g = &grammar{
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 599, col: 12, offset: 20084},
+							pos:  position{line: 645, col: 12, offset: 21776},
 							name: "EOL",
 						},
 					},
@@ -4290,34 +4780,34 @@ var g = &grammar{
 		},
 		{
 			name: "IncludedFileLine",
-			pos:  position{line: 606, col: 1, offset: 20290},
+			pos:  position{line: 652, col: 1, offset: 21991},
 			expr: &actionExpr{
-				pos: position{line: 606, col: 21, offset: 20310},
+				pos: position{line: 652, col: 21, offset: 22011},
 				run: (*parser).callonIncludedFileLine1,
 				expr: &seqExpr{
-					pos: position{line: 606, col: 21, offset: 20310},
+					pos: position{line: 652, col: 21, offset: 22011},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 606, col: 21, offset: 20310},
+							pos:   position{line: 652, col: 21, offset: 22011},
 							label: "content",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 606, col: 29, offset: 20318},
+								pos: position{line: 652, col: 29, offset: 22019},
 								expr: &choiceExpr{
-									pos: position{line: 606, col: 30, offset: 20319},
+									pos: position{line: 652, col: 30, offset: 22020},
 									alternatives: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 606, col: 30, offset: 20319},
+											pos:  position{line: 652, col: 30, offset: 22020},
 											name: "IncludedFileStartTag",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 606, col: 53, offset: 20342},
+											pos:  position{line: 652, col: 53, offset: 22043},
 											name: "IncludedFileEndTag",
 										},
 										&actionExpr{
-											pos: position{line: 606, col: 74, offset: 20363},
+											pos: position{line: 652, col: 74, offset: 22064},
 											run: (*parser).callonIncludedFileLine8,
 											expr: &anyMatcher{
-												line: 606, col: 74, offset: 20363,
+												line: 652, col: 74, offset: 22064,
 											},
 										},
 									},
@@ -4325,7 +4815,7 @@ var g = &grammar{
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 606, col: 107, offset: 20396},
+							pos:  position{line: 652, col: 107, offset: 22097},
 							name: "EOL",
 						},
 					},
@@ -4334,33 +4824,33 @@ var g = &grammar{
 		},
 		{
 			name: "IncludedFileStartTag",
-			pos:  position{line: 610, col: 1, offset: 20471},
+			pos:  position{line: 656, col: 1, offset: 22168},
 			expr: &actionExpr{
-				pos: position{line: 610, col: 25, offset: 20495},
+				pos: position{line: 656, col: 25, offset: 22192},
 				run: (*parser).callonIncludedFileStartTag1,
 				expr: &seqExpr{
-					pos: position{line: 610, col: 25, offset: 20495},
+					pos: position{line: 656, col: 25, offset: 22192},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 610, col: 25, offset: 20495},
+							pos:        position{line: 656, col: 25, offset: 22192},
 							val:        "tag::",
 							ignoreCase: false,
 							want:       "\"tag::\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 610, col: 33, offset: 20503},
+							pos:   position{line: 656, col: 33, offset: 22200},
 							label: "tag",
 							expr: &actionExpr{
-								pos: position{line: 610, col: 38, offset: 20508},
+								pos: position{line: 656, col: 38, offset: 22205},
 								run: (*parser).callonIncludedFileStartTag5,
 								expr: &ruleRefExpr{
-									pos:  position{line: 610, col: 38, offset: 20508},
+									pos:  position{line: 656, col: 38, offset: 22205},
 									name: "Alphanums",
 								},
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 610, col: 78, offset: 20548},
+							pos:        position{line: 656, col: 78, offset: 22245},
 							val:        "[]",
 							ignoreCase: false,
 							want:       "\"[]\"",
@@ -4371,33 +4861,33 @@ var g = &grammar{
 		},
 		{
 			name: "IncludedFileEndTag",
-			pos:  position{line: 614, col: 1, offset: 20617},
+			pos:  position{line: 660, col: 1, offset: 22310},
 			expr: &actionExpr{
-				pos: position{line: 614, col: 23, offset: 20639},
+				pos: position{line: 660, col: 23, offset: 22332},
 				run: (*parser).callonIncludedFileEndTag1,
 				expr: &seqExpr{
-					pos: position{line: 614, col: 23, offset: 20639},
+					pos: position{line: 660, col: 23, offset: 22332},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 614, col: 23, offset: 20639},
+							pos:        position{line: 660, col: 23, offset: 22332},
 							val:        "end::",
 							ignoreCase: false,
 							want:       "\"end::\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 614, col: 31, offset: 20647},
+							pos:   position{line: 660, col: 31, offset: 22340},
 							label: "tag",
 							expr: &actionExpr{
-								pos: position{line: 614, col: 36, offset: 20652},
+								pos: position{line: 660, col: 36, offset: 22345},
 								run: (*parser).callonIncludedFileEndTag5,
 								expr: &ruleRefExpr{
-									pos:  position{line: 614, col: 36, offset: 20652},
+									pos:  position{line: 660, col: 36, offset: 22345},
 									name: "Alphanums",
 								},
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 614, col: 76, offset: 20692},
+							pos:        position{line: 660, col: 76, offset: 22385},
 							val:        "[]",
 							ignoreCase: false,
 							want:       "\"[]\"",
@@ -4408,32 +4898,32 @@ var g = &grammar{
 		},
 		{
 			name: "ListParagraph",
-			pos:  position{line: 621, col: 1, offset: 20863},
+			pos:  position{line: 667, col: 1, offset: 22549},
 			expr: &choiceExpr{
-				pos: position{line: 621, col: 18, offset: 20880},
+				pos: position{line: 667, col: 18, offset: 22566},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 621, col: 18, offset: 20880},
+						pos: position{line: 667, col: 18, offset: 22566},
 						run: (*parser).callonListParagraph2,
 						expr: &labeledExpr{
-							pos:   position{line: 621, col: 18, offset: 20880},
+							pos:   position{line: 667, col: 18, offset: 22566},
 							label: "comment",
 							expr: &ruleRefExpr{
-								pos:  position{line: 621, col: 27, offset: 20889},
+								pos:  position{line: 667, col: 27, offset: 22575},
 								name: "SingleLineComment",
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 623, col: 9, offset: 20948},
+						pos: position{line: 669, col: 9, offset: 22632},
 						run: (*parser).callonListParagraph5,
 						expr: &labeledExpr{
-							pos:   position{line: 623, col: 9, offset: 20948},
+							pos:   position{line: 669, col: 9, offset: 22632},
 							label: "lines",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 623, col: 15, offset: 20954},
+								pos: position{line: 669, col: 15, offset: 22638},
 								expr: &ruleRefExpr{
-									pos:  position{line: 623, col: 16, offset: 20955},
+									pos:  position{line: 669, col: 16, offset: 22639},
 									name: "ListParagraphLine",
 								},
 							},
@@ -4444,96 +4934,96 @@ var g = &grammar{
 		},
 		{
 			name: "ListParagraphLine",
-			pos:  position{line: 627, col: 1, offset: 21051},
+			pos:  position{line: 673, col: 1, offset: 22747},
 			expr: &actionExpr{
-				pos: position{line: 627, col: 22, offset: 21072},
+				pos: position{line: 673, col: 22, offset: 22768},
 				run: (*parser).callonListParagraphLine1,
 				expr: &seqExpr{
-					pos: position{line: 627, col: 22, offset: 21072},
+					pos: position{line: 673, col: 22, offset: 22768},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 627, col: 22, offset: 21072},
+							pos: position{line: 673, col: 22, offset: 22768},
 							expr: &ruleRefExpr{
-								pos:  position{line: 627, col: 23, offset: 21073},
+								pos:  position{line: 673, col: 23, offset: 22769},
 								name: "EOF",
 							},
 						},
 						&notExpr{
-							pos: position{line: 628, col: 5, offset: 21082},
+							pos: position{line: 674, col: 5, offset: 22777},
 							expr: &ruleRefExpr{
-								pos:  position{line: 628, col: 6, offset: 21083},
+								pos:  position{line: 674, col: 6, offset: 22778},
 								name: "BlankLine",
 							},
 						},
 						&notExpr{
-							pos: position{line: 629, col: 5, offset: 21099},
+							pos: position{line: 675, col: 5, offset: 22793},
 							expr: &ruleRefExpr{
-								pos:  position{line: 629, col: 6, offset: 21100},
+								pos:  position{line: 675, col: 6, offset: 22794},
 								name: "SingleLineComment",
 							},
 						},
 						&notExpr{
-							pos: position{line: 630, col: 5, offset: 21123},
+							pos: position{line: 676, col: 5, offset: 22816},
 							expr: &ruleRefExpr{
-								pos:  position{line: 630, col: 6, offset: 21124},
+								pos:  position{line: 676, col: 6, offset: 22817},
 								name: "OrderedListItemPrefix",
 							},
 						},
 						&notExpr{
-							pos: position{line: 631, col: 5, offset: 21151},
+							pos: position{line: 677, col: 5, offset: 22843},
 							expr: &ruleRefExpr{
-								pos:  position{line: 631, col: 6, offset: 21152},
+								pos:  position{line: 677, col: 6, offset: 22844},
 								name: "UnorderedListItemPrefix",
 							},
 						},
 						&notExpr{
-							pos: position{line: 632, col: 5, offset: 21181},
+							pos: position{line: 678, col: 5, offset: 22872},
 							expr: &ruleRefExpr{
-								pos:  position{line: 632, col: 6, offset: 21182},
+								pos:  position{line: 678, col: 6, offset: 22873},
 								name: "CalloutListItemPrefix",
 							},
 						},
 						&notExpr{
-							pos: position{line: 633, col: 5, offset: 21209},
+							pos: position{line: 679, col: 5, offset: 22899},
 							expr: &ruleRefExpr{
-								pos:  position{line: 633, col: 6, offset: 21210},
+								pos:  position{line: 679, col: 6, offset: 22900},
 								name: "ListItemContinuation",
 							},
 						},
 						&notExpr{
-							pos: position{line: 634, col: 5, offset: 21236},
+							pos: position{line: 680, col: 5, offset: 22925},
 							expr: &ruleRefExpr{
-								pos:  position{line: 634, col: 6, offset: 21237},
+								pos:  position{line: 680, col: 6, offset: 22926},
 								name: "ElementAttribute",
 							},
 						},
 						&notExpr{
-							pos: position{line: 635, col: 5, offset: 21259},
+							pos: position{line: 681, col: 5, offset: 22947},
 							expr: &ruleRefExpr{
-								pos:  position{line: 635, col: 6, offset: 21260},
+								pos:  position{line: 681, col: 6, offset: 22948},
 								name: "BlockDelimiter",
 							},
 						},
 						&notExpr{
-							pos: position{line: 636, col: 5, offset: 21280},
+							pos: position{line: 682, col: 5, offset: 22967},
 							expr: &ruleRefExpr{
-								pos:  position{line: 636, col: 6, offset: 21281},
+								pos:  position{line: 682, col: 6, offset: 22968},
 								name: "LabeledListItemPrefix",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 637, col: 5, offset: 21309},
+							pos:   position{line: 683, col: 5, offset: 22995},
 							label: "line",
 							expr: &actionExpr{
-								pos: position{line: 637, col: 11, offset: 21315},
+								pos: position{line: 683, col: 11, offset: 23001},
 								run: (*parser).callonListParagraphLine24,
 								expr: &labeledExpr{
-									pos:   position{line: 637, col: 11, offset: 21315},
+									pos:   position{line: 683, col: 11, offset: 23001},
 									label: "elements",
 									expr: &oneOrMoreExpr{
-										pos: position{line: 637, col: 20, offset: 21324},
+										pos: position{line: 683, col: 20, offset: 23010},
 										expr: &ruleRefExpr{
-											pos:  position{line: 637, col: 21, offset: 21325},
+											pos:  position{line: 683, col: 21, offset: 23011},
 											name: "InlineElement",
 										},
 									},
@@ -4541,7 +5031,7 @@ var g = &grammar{
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 639, col: 12, offset: 21426},
+							pos:  position{line: 685, col: 12, offset: 23127},
 							name: "EOL",
 						},
 					},
@@ -4550,25 +5040,25 @@ var g = &grammar{
 		},
 		{
 			name: "ListItemContinuation",
-			pos:  position{line: 643, col: 1, offset: 21469},
+			pos:  position{line: 689, col: 1, offset: 23166},
 			expr: &seqExpr{
-				pos: position{line: 643, col: 25, offset: 21493},
+				pos: position{line: 689, col: 25, offset: 23190},
 				exprs: []interface{}{
 					&litMatcher{
-						pos:        position{line: 643, col: 25, offset: 21493},
+						pos:        position{line: 689, col: 25, offset: 23190},
 						val:        "+",
 						ignoreCase: false,
 						want:       "\"+\"",
 					},
 					&zeroOrMoreExpr{
-						pos: position{line: 643, col: 29, offset: 21497},
+						pos: position{line: 689, col: 29, offset: 23194},
 						expr: &ruleRefExpr{
-							pos:  position{line: 643, col: 29, offset: 21497},
+							pos:  position{line: 689, col: 29, offset: 23194},
 							name: "Space",
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 643, col: 36, offset: 21504},
+						pos:  position{line: 689, col: 36, offset: 23201},
 						name: "Newline",
 					},
 				},
@@ -4576,22 +5066,22 @@ var g = &grammar{
 		},
 		{
 			name: "ContinuedListItemElement",
-			pos:  position{line: 645, col: 1, offset: 21578},
+			pos:  position{line: 691, col: 1, offset: 23273},
 			expr: &actionExpr{
-				pos: position{line: 645, col: 29, offset: 21606},
+				pos: position{line: 691, col: 29, offset: 23301},
 				run: (*parser).callonContinuedListItemElement1,
 				expr: &seqExpr{
-					pos: position{line: 645, col: 29, offset: 21606},
+					pos: position{line: 691, col: 29, offset: 23301},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 645, col: 29, offset: 21606},
+							pos:  position{line: 691, col: 29, offset: 23301},
 							name: "ListItemContinuation",
 						},
 						&labeledExpr{
-							pos:   position{line: 645, col: 50, offset: 21627},
+							pos:   position{line: 691, col: 50, offset: 23322},
 							label: "element",
 							expr: &ruleRefExpr{
-								pos:  position{line: 645, col: 58, offset: 21635},
+								pos:  position{line: 691, col: 58, offset: 23330},
 								name: "ContinuedListItemContent",
 							},
 						},
@@ -4601,80 +5091,80 @@ var g = &grammar{
 		},
 		{
 			name: "ContinuedListItemContent",
-			pos:  position{line: 649, col: 1, offset: 21745},
+			pos:  position{line: 695, col: 1, offset: 23452},
 			expr: &actionExpr{
-				pos: position{line: 649, col: 29, offset: 21773},
+				pos: position{line: 695, col: 29, offset: 23480},
 				run: (*parser).callonContinuedListItemContent1,
 				expr: &seqExpr{
-					pos: position{line: 649, col: 29, offset: 21773},
+					pos: position{line: 695, col: 29, offset: 23480},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 649, col: 29, offset: 21773},
+							pos: position{line: 695, col: 29, offset: 23480},
 							expr: &ruleRefExpr{
-								pos:  position{line: 649, col: 30, offset: 21774},
+								pos:  position{line: 695, col: 30, offset: 23481},
 								name: "EOF",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 650, col: 5, offset: 21784},
+							pos:   position{line: 696, col: 5, offset: 23490},
 							label: "content",
 							expr: &choiceExpr{
-								pos: position{line: 650, col: 14, offset: 21793},
+								pos: position{line: 696, col: 14, offset: 23499},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 650, col: 14, offset: 21793},
+										pos:  position{line: 696, col: 14, offset: 23499},
 										name: "DelimitedBlock",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 651, col: 11, offset: 21819},
+										pos:  position{line: 697, col: 11, offset: 23524},
 										name: "FileInclusion",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 652, col: 11, offset: 21844},
+										pos:  position{line: 698, col: 11, offset: 23548},
 										name: "VerseParagraph",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 653, col: 11, offset: 21899},
+										pos:  position{line: 699, col: 11, offset: 23602},
 										name: "ImageBlock",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 654, col: 11, offset: 21922},
+										pos:  position{line: 700, col: 11, offset: 23624},
 										name: "OrderedListItem",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 655, col: 11, offset: 21950},
+										pos:  position{line: 701, col: 11, offset: 23651},
 										name: "UnorderedListItem",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 656, col: 11, offset: 21980},
+										pos:  position{line: 702, col: 11, offset: 23680},
 										name: "LabeledListItem",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 658, col: 11, offset: 22047},
+										pos:  position{line: 704, col: 11, offset: 23745},
 										name: "BlankLine",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 659, col: 11, offset: 22099},
+										pos:  position{line: 705, col: 11, offset: 23796},
 										name: "LiteralBlock",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 660, col: 11, offset: 22124},
+										pos:  position{line: 706, col: 11, offset: 23820},
 										name: "AttributeDeclaration",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 661, col: 11, offset: 22157},
+										pos:  position{line: 707, col: 11, offset: 23852},
 										name: "AttributeReset",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 662, col: 11, offset: 22184},
+										pos:  position{line: 708, col: 11, offset: 23878},
 										name: "TableOfContentsPlaceHolder",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 663, col: 11, offset: 22222},
+										pos:  position{line: 709, col: 11, offset: 23915},
 										name: "UserMacroBlock",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 664, col: 11, offset: 22248},
+										pos:  position{line: 710, col: 11, offset: 23940},
 										name: "ContinuedParagraph",
 									},
 								},
@@ -4686,37 +5176,37 @@ var g = &grammar{
 		},
 		{
 			name: "OrderedListItem",
-			pos:  position{line: 671, col: 1, offset: 22418},
+			pos:  position{line: 717, col: 1, offset: 24103},
 			expr: &actionExpr{
-				pos: position{line: 671, col: 20, offset: 22437},
+				pos: position{line: 717, col: 20, offset: 24122},
 				run: (*parser).callonOrderedListItem1,
 				expr: &seqExpr{
-					pos: position{line: 671, col: 20, offset: 22437},
+					pos: position{line: 717, col: 20, offset: 24122},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 671, col: 20, offset: 22437},
+							pos:   position{line: 717, col: 20, offset: 24122},
 							label: "attributes",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 671, col: 31, offset: 22448},
+								pos: position{line: 717, col: 31, offset: 24133},
 								expr: &ruleRefExpr{
-									pos:  position{line: 671, col: 32, offset: 22449},
+									pos:  position{line: 717, col: 32, offset: 24134},
 									name: "Attributes",
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 671, col: 45, offset: 22462},
+							pos:   position{line: 717, col: 45, offset: 24147},
 							label: "prefix",
 							expr: &ruleRefExpr{
-								pos:  position{line: 671, col: 53, offset: 22470},
+								pos:  position{line: 717, col: 53, offset: 24155},
 								name: "OrderedListItemPrefix",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 671, col: 76, offset: 22493},
+							pos:   position{line: 717, col: 76, offset: 24178},
 							label: "content",
 							expr: &ruleRefExpr{
-								pos:  position{line: 671, col: 85, offset: 22502},
+								pos:  position{line: 717, col: 85, offset: 24187},
 								name: "OrderedListItemContent",
 							},
 						},
@@ -4726,42 +5216,42 @@ var g = &grammar{
 		},
 		{
 			name: "OrderedListItemPrefix",
-			pos:  position{line: 675, col: 1, offset: 22646},
+			pos:  position{line: 721, col: 1, offset: 24343},
 			expr: &actionExpr{
-				pos: position{line: 676, col: 5, offset: 22677},
+				pos: position{line: 722, col: 5, offset: 24373},
 				run: (*parser).callonOrderedListItemPrefix1,
 				expr: &seqExpr{
-					pos: position{line: 676, col: 5, offset: 22677},
+					pos: position{line: 722, col: 5, offset: 24373},
 					exprs: []interface{}{
 						&zeroOrMoreExpr{
-							pos: position{line: 676, col: 5, offset: 22677},
+							pos: position{line: 722, col: 5, offset: 24373},
 							expr: &ruleRefExpr{
-								pos:  position{line: 676, col: 5, offset: 22677},
+								pos:  position{line: 722, col: 5, offset: 24373},
 								name: "Space",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 676, col: 12, offset: 22684},
+							pos:   position{line: 722, col: 12, offset: 24380},
 							label: "prefix",
 							expr: &choiceExpr{
-								pos: position{line: 678, col: 9, offset: 22749},
+								pos: position{line: 724, col: 9, offset: 24443},
 								alternatives: []interface{}{
 									&actionExpr{
-										pos: position{line: 678, col: 9, offset: 22749},
+										pos: position{line: 724, col: 9, offset: 24443},
 										run: (*parser).callonOrderedListItemPrefix7,
 										expr: &seqExpr{
-											pos: position{line: 678, col: 9, offset: 22749},
+											pos: position{line: 724, col: 9, offset: 24443},
 											exprs: []interface{}{
 												&labeledExpr{
-													pos:   position{line: 678, col: 9, offset: 22749},
+													pos:   position{line: 724, col: 9, offset: 24443},
 													label: "depth",
 													expr: &actionExpr{
-														pos: position{line: 678, col: 16, offset: 22756},
+														pos: position{line: 724, col: 16, offset: 24450},
 														run: (*parser).callonOrderedListItemPrefix10,
 														expr: &oneOrMoreExpr{
-															pos: position{line: 678, col: 16, offset: 22756},
+															pos: position{line: 724, col: 16, offset: 24450},
 															expr: &litMatcher{
-																pos:        position{line: 678, col: 17, offset: 22757},
+																pos:        position{line: 724, col: 17, offset: 24451},
 																val:        ".",
 																ignoreCase: false,
 																want:       "\".\"",
@@ -4770,22 +5260,22 @@ var g = &grammar{
 													},
 												},
 												&andCodeExpr{
-													pos: position{line: 682, col: 9, offset: 22861},
+													pos: position{line: 728, col: 9, offset: 24551},
 													run: (*parser).callonOrderedListItemPrefix13,
 												},
 											},
 										},
 									},
 									&actionExpr{
-										pos: position{line: 701, col: 11, offset: 23597},
+										pos: position{line: 747, col: 11, offset: 25268},
 										run: (*parser).callonOrderedListItemPrefix14,
 										expr: &seqExpr{
-											pos: position{line: 701, col: 11, offset: 23597},
+											pos: position{line: 747, col: 11, offset: 25268},
 											exprs: []interface{}{
 												&oneOrMoreExpr{
-													pos: position{line: 701, col: 11, offset: 23597},
+													pos: position{line: 747, col: 11, offset: 25268},
 													expr: &charClassMatcher{
-														pos:        position{line: 701, col: 12, offset: 23598},
+														pos:        position{line: 747, col: 12, offset: 25269},
 														val:        "[0-9]",
 														ranges:     []rune{'0', '9'},
 														ignoreCase: false,
@@ -4793,7 +5283,7 @@ var g = &grammar{
 													},
 												},
 												&litMatcher{
-													pos:        position{line: 701, col: 20, offset: 23606},
+													pos:        position{line: 747, col: 20, offset: 25277},
 													val:        ".",
 													ignoreCase: false,
 													want:       "\".\"",
@@ -4802,20 +5292,20 @@ var g = &grammar{
 										},
 									},
 									&actionExpr{
-										pos: position{line: 703, col: 13, offset: 23719},
+										pos: position{line: 749, col: 13, offset: 25388},
 										run: (*parser).callonOrderedListItemPrefix19,
 										expr: &seqExpr{
-											pos: position{line: 703, col: 13, offset: 23719},
+											pos: position{line: 749, col: 13, offset: 25388},
 											exprs: []interface{}{
 												&charClassMatcher{
-													pos:        position{line: 703, col: 14, offset: 23720},
+													pos:        position{line: 749, col: 14, offset: 25389},
 													val:        "[a-z]",
 													ranges:     []rune{'a', 'z'},
 													ignoreCase: false,
 													inverted:   false,
 												},
 												&litMatcher{
-													pos:        position{line: 703, col: 21, offset: 23727},
+													pos:        position{line: 749, col: 21, offset: 25396},
 													val:        ".",
 													ignoreCase: false,
 													want:       "\".\"",
@@ -4824,20 +5314,20 @@ var g = &grammar{
 										},
 									},
 									&actionExpr{
-										pos: position{line: 705, col: 13, offset: 23843},
+										pos: position{line: 751, col: 13, offset: 25510},
 										run: (*parser).callonOrderedListItemPrefix23,
 										expr: &seqExpr{
-											pos: position{line: 705, col: 13, offset: 23843},
+											pos: position{line: 751, col: 13, offset: 25510},
 											exprs: []interface{}{
 												&charClassMatcher{
-													pos:        position{line: 705, col: 14, offset: 23844},
+													pos:        position{line: 751, col: 14, offset: 25511},
 													val:        "[A-Z]",
 													ranges:     []rune{'A', 'Z'},
 													ignoreCase: false,
 													inverted:   false,
 												},
 												&litMatcher{
-													pos:        position{line: 705, col: 21, offset: 23851},
+													pos:        position{line: 751, col: 21, offset: 25518},
 													val:        ".",
 													ignoreCase: false,
 													want:       "\".\"",
@@ -4846,15 +5336,15 @@ var g = &grammar{
 										},
 									},
 									&actionExpr{
-										pos: position{line: 707, col: 13, offset: 23967},
+										pos: position{line: 753, col: 13, offset: 25632},
 										run: (*parser).callonOrderedListItemPrefix27,
 										expr: &seqExpr{
-											pos: position{line: 707, col: 13, offset: 23967},
+											pos: position{line: 753, col: 13, offset: 25632},
 											exprs: []interface{}{
 												&oneOrMoreExpr{
-													pos: position{line: 707, col: 13, offset: 23967},
+													pos: position{line: 753, col: 13, offset: 25632},
 													expr: &charClassMatcher{
-														pos:        position{line: 707, col: 14, offset: 23968},
+														pos:        position{line: 753, col: 14, offset: 25633},
 														val:        "[a-z]",
 														ranges:     []rune{'a', 'z'},
 														ignoreCase: false,
@@ -4862,7 +5352,7 @@ var g = &grammar{
 													},
 												},
 												&litMatcher{
-													pos:        position{line: 707, col: 22, offset: 23976},
+													pos:        position{line: 753, col: 22, offset: 25641},
 													val:        ")",
 													ignoreCase: false,
 													want:       "\")\"",
@@ -4871,15 +5361,15 @@ var g = &grammar{
 										},
 									},
 									&actionExpr{
-										pos: position{line: 709, col: 13, offset: 24092},
+										pos: position{line: 755, col: 13, offset: 25755},
 										run: (*parser).callonOrderedListItemPrefix32,
 										expr: &seqExpr{
-											pos: position{line: 709, col: 13, offset: 24092},
+											pos: position{line: 755, col: 13, offset: 25755},
 											exprs: []interface{}{
 												&oneOrMoreExpr{
-													pos: position{line: 709, col: 13, offset: 24092},
+													pos: position{line: 755, col: 13, offset: 25755},
 													expr: &charClassMatcher{
-														pos:        position{line: 709, col: 14, offset: 24093},
+														pos:        position{line: 755, col: 14, offset: 25756},
 														val:        "[A-Z]",
 														ranges:     []rune{'A', 'Z'},
 														ignoreCase: false,
@@ -4887,7 +5377,7 @@ var g = &grammar{
 													},
 												},
 												&litMatcher{
-													pos:        position{line: 709, col: 22, offset: 24101},
+													pos:        position{line: 755, col: 22, offset: 25764},
 													val:        ")",
 													ignoreCase: false,
 													want:       "\")\"",
@@ -4899,9 +5389,9 @@ var g = &grammar{
 							},
 						},
 						&oneOrMoreExpr{
-							pos: position{line: 711, col: 12, offset: 24216},
+							pos: position{line: 757, col: 12, offset: 25877},
 							expr: &ruleRefExpr{
-								pos:  position{line: 711, col: 12, offset: 24216},
+								pos:  position{line: 757, col: 12, offset: 25877},
 								name: "Space",
 							},
 						},
@@ -4911,17 +5401,17 @@ var g = &grammar{
 		},
 		{
 			name: "OrderedListItemContent",
-			pos:  position{line: 715, col: 1, offset: 24255},
+			pos:  position{line: 761, col: 1, offset: 25912},
 			expr: &actionExpr{
-				pos: position{line: 715, col: 27, offset: 24281},
+				pos: position{line: 761, col: 27, offset: 25938},
 				run: (*parser).callonOrderedListItemContent1,
 				expr: &labeledExpr{
-					pos:   position{line: 715, col: 27, offset: 24281},
+					pos:   position{line: 761, col: 27, offset: 25938},
 					label: "elements",
 					expr: &oneOrMoreExpr{
-						pos: position{line: 715, col: 37, offset: 24291},
+						pos: position{line: 761, col: 37, offset: 25948},
 						expr: &ruleRefExpr{
-							pos:  position{line: 715, col: 37, offset: 24291},
+							pos:  position{line: 761, col: 37, offset: 25948},
 							name: "ListParagraph",
 						},
 					},
@@ -4930,48 +5420,48 @@ var g = &grammar{
 		},
 		{
 			name: "UnorderedListItem",
-			pos:  position{line: 722, col: 1, offset: 24498},
+			pos:  position{line: 768, col: 1, offset: 26148},
 			expr: &actionExpr{
-				pos: position{line: 722, col: 22, offset: 24519},
+				pos: position{line: 768, col: 22, offset: 26169},
 				run: (*parser).callonUnorderedListItem1,
 				expr: &seqExpr{
-					pos: position{line: 722, col: 22, offset: 24519},
+					pos: position{line: 768, col: 22, offset: 26169},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 722, col: 22, offset: 24519},
+							pos:   position{line: 768, col: 22, offset: 26169},
 							label: "attributes",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 722, col: 33, offset: 24530},
+								pos: position{line: 768, col: 33, offset: 26180},
 								expr: &ruleRefExpr{
-									pos:  position{line: 722, col: 34, offset: 24531},
+									pos:  position{line: 768, col: 34, offset: 26181},
 									name: "Attributes",
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 722, col: 47, offset: 24544},
+							pos:   position{line: 768, col: 47, offset: 26194},
 							label: "prefix",
 							expr: &ruleRefExpr{
-								pos:  position{line: 722, col: 55, offset: 24552},
+								pos:  position{line: 768, col: 55, offset: 26202},
 								name: "UnorderedListItemPrefix",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 722, col: 80, offset: 24577},
+							pos:   position{line: 768, col: 80, offset: 26227},
 							label: "checkstyle",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 722, col: 91, offset: 24588},
+								pos: position{line: 768, col: 91, offset: 26238},
 								expr: &ruleRefExpr{
-									pos:  position{line: 722, col: 92, offset: 24589},
+									pos:  position{line: 768, col: 92, offset: 26239},
 									name: "UnorderedListItemCheckStyle",
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 722, col: 122, offset: 24619},
+							pos:   position{line: 768, col: 122, offset: 26269},
 							label: "content",
 							expr: &ruleRefExpr{
-								pos:  position{line: 722, col: 131, offset: 24628},
+								pos:  position{line: 768, col: 131, offset: 26278},
 								name: "UnorderedListItemContent",
 							},
 						},
@@ -4981,42 +5471,42 @@ var g = &grammar{
 		},
 		{
 			name: "UnorderedListItemPrefix",
-			pos:  position{line: 726, col: 1, offset: 24790},
+			pos:  position{line: 772, col: 1, offset: 26452},
 			expr: &actionExpr{
-				pos: position{line: 727, col: 5, offset: 24823},
+				pos: position{line: 773, col: 5, offset: 26484},
 				run: (*parser).callonUnorderedListItemPrefix1,
 				expr: &seqExpr{
-					pos: position{line: 727, col: 5, offset: 24823},
+					pos: position{line: 773, col: 5, offset: 26484},
 					exprs: []interface{}{
 						&zeroOrMoreExpr{
-							pos: position{line: 727, col: 5, offset: 24823},
+							pos: position{line: 773, col: 5, offset: 26484},
 							expr: &ruleRefExpr{
-								pos:  position{line: 727, col: 5, offset: 24823},
+								pos:  position{line: 773, col: 5, offset: 26484},
 								name: "Space",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 727, col: 12, offset: 24830},
+							pos:   position{line: 773, col: 12, offset: 26491},
 							label: "prefix",
 							expr: &choiceExpr{
-								pos: position{line: 727, col: 20, offset: 24838},
+								pos: position{line: 773, col: 20, offset: 26499},
 								alternatives: []interface{}{
 									&actionExpr{
-										pos: position{line: 729, col: 9, offset: 24897},
+										pos: position{line: 775, col: 9, offset: 26556},
 										run: (*parser).callonUnorderedListItemPrefix7,
 										expr: &seqExpr{
-											pos: position{line: 729, col: 9, offset: 24897},
+											pos: position{line: 775, col: 9, offset: 26556},
 											exprs: []interface{}{
 												&labeledExpr{
-													pos:   position{line: 729, col: 9, offset: 24897},
+													pos:   position{line: 775, col: 9, offset: 26556},
 													label: "depth",
 													expr: &actionExpr{
-														pos: position{line: 729, col: 16, offset: 24904},
+														pos: position{line: 775, col: 16, offset: 26563},
 														run: (*parser).callonUnorderedListItemPrefix10,
 														expr: &oneOrMoreExpr{
-															pos: position{line: 729, col: 16, offset: 24904},
+															pos: position{line: 775, col: 16, offset: 26563},
 															expr: &litMatcher{
-																pos:        position{line: 729, col: 17, offset: 24905},
+																pos:        position{line: 775, col: 17, offset: 26564},
 																val:        "*",
 																ignoreCase: false,
 																want:       "\"*\"",
@@ -5025,20 +5515,20 @@ var g = &grammar{
 													},
 												},
 												&andCodeExpr{
-													pos: position{line: 733, col: 9, offset: 25009},
+													pos: position{line: 779, col: 9, offset: 26664},
 													run: (*parser).callonUnorderedListItemPrefix13,
 												},
 											},
 										},
 									},
 									&labeledExpr{
-										pos:   position{line: 750, col: 14, offset: 25733},
+										pos:   position{line: 796, col: 14, offset: 27371},
 										label: "depth",
 										expr: &actionExpr{
-											pos: position{line: 750, col: 21, offset: 25740},
+											pos: position{line: 796, col: 21, offset: 27378},
 											run: (*parser).callonUnorderedListItemPrefix15,
 											expr: &litMatcher{
-												pos:        position{line: 750, col: 22, offset: 25741},
+												pos:        position{line: 796, col: 22, offset: 27379},
 												val:        "-",
 												ignoreCase: false,
 												want:       "\"-\"",
@@ -5049,9 +5539,9 @@ var g = &grammar{
 							},
 						},
 						&oneOrMoreExpr{
-							pos: position{line: 752, col: 13, offset: 25829},
+							pos: position{line: 798, col: 13, offset: 27465},
 							expr: &ruleRefExpr{
-								pos:  position{line: 752, col: 13, offset: 25829},
+								pos:  position{line: 798, col: 13, offset: 27465},
 								name: "Space",
 							},
 						},
@@ -5061,53 +5551,53 @@ var g = &grammar{
 		},
 		{
 			name: "UnorderedListItemCheckStyle",
-			pos:  position{line: 756, col: 1, offset: 25869},
+			pos:  position{line: 802, col: 1, offset: 27501},
 			expr: &actionExpr{
-				pos: position{line: 756, col: 32, offset: 25900},
+				pos: position{line: 802, col: 32, offset: 27532},
 				run: (*parser).callonUnorderedListItemCheckStyle1,
 				expr: &seqExpr{
-					pos: position{line: 756, col: 32, offset: 25900},
+					pos: position{line: 802, col: 32, offset: 27532},
 					exprs: []interface{}{
 						&andExpr{
-							pos: position{line: 756, col: 32, offset: 25900},
+							pos: position{line: 802, col: 32, offset: 27532},
 							expr: &litMatcher{
-								pos:        position{line: 756, col: 33, offset: 25901},
+								pos:        position{line: 802, col: 33, offset: 27533},
 								val:        "[",
 								ignoreCase: false,
 								want:       "\"[\"",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 756, col: 37, offset: 25905},
+							pos:   position{line: 802, col: 37, offset: 27537},
 							label: "style",
 							expr: &choiceExpr{
-								pos: position{line: 757, col: 7, offset: 25920},
+								pos: position{line: 803, col: 7, offset: 27551},
 								alternatives: []interface{}{
 									&actionExpr{
-										pos: position{line: 757, col: 7, offset: 25920},
+										pos: position{line: 803, col: 7, offset: 27551},
 										run: (*parser).callonUnorderedListItemCheckStyle7,
 										expr: &litMatcher{
-											pos:        position{line: 757, col: 7, offset: 25920},
+											pos:        position{line: 803, col: 7, offset: 27551},
 											val:        "[ ]",
 											ignoreCase: false,
 											want:       "\"[ ]\"",
 										},
 									},
 									&actionExpr{
-										pos: position{line: 758, col: 7, offset: 25966},
+										pos: position{line: 804, col: 7, offset: 27596},
 										run: (*parser).callonUnorderedListItemCheckStyle9,
 										expr: &litMatcher{
-											pos:        position{line: 758, col: 7, offset: 25966},
+											pos:        position{line: 804, col: 7, offset: 27596},
 											val:        "[*]",
 											ignoreCase: false,
 											want:       "\"[*]\"",
 										},
 									},
 									&actionExpr{
-										pos: position{line: 759, col: 7, offset: 26010},
+										pos: position{line: 805, col: 7, offset: 27639},
 										run: (*parser).callonUnorderedListItemCheckStyle11,
 										expr: &litMatcher{
-											pos:        position{line: 759, col: 7, offset: 26010},
+											pos:        position{line: 805, col: 7, offset: 27639},
 											val:        "[x]",
 											ignoreCase: false,
 											want:       "\"[x]\"",
@@ -5117,9 +5607,9 @@ var g = &grammar{
 							},
 						},
 						&oneOrMoreExpr{
-							pos: position{line: 760, col: 7, offset: 26053},
+							pos: position{line: 806, col: 7, offset: 27681},
 							expr: &ruleRefExpr{
-								pos:  position{line: 760, col: 7, offset: 26053},
+								pos:  position{line: 806, col: 7, offset: 27681},
 								name: "Space",
 							},
 						},
@@ -5129,17 +5619,17 @@ var g = &grammar{
 		},
 		{
 			name: "UnorderedListItemContent",
-			pos:  position{line: 764, col: 1, offset: 26099},
+			pos:  position{line: 810, col: 1, offset: 27723},
 			expr: &actionExpr{
-				pos: position{line: 764, col: 29, offset: 26127},
+				pos: position{line: 810, col: 29, offset: 27751},
 				run: (*parser).callonUnorderedListItemContent1,
 				expr: &labeledExpr{
-					pos:   position{line: 764, col: 29, offset: 26127},
+					pos:   position{line: 810, col: 29, offset: 27751},
 					label: "elements",
 					expr: &oneOrMoreExpr{
-						pos: position{line: 764, col: 39, offset: 26137},
+						pos: position{line: 810, col: 39, offset: 27761},
 						expr: &ruleRefExpr{
-							pos:  position{line: 764, col: 39, offset: 26137},
+							pos:  position{line: 810, col: 39, offset: 27761},
 							name: "ListParagraph",
 						},
 					},
@@ -5148,47 +5638,47 @@ var g = &grammar{
 		},
 		{
 			name: "LabeledListItem",
-			pos:  position{line: 771, col: 1, offset: 26460},
+			pos:  position{line: 817, col: 1, offset: 28077},
 			expr: &actionExpr{
-				pos: position{line: 771, col: 20, offset: 26479},
+				pos: position{line: 817, col: 20, offset: 28096},
 				run: (*parser).callonLabeledListItem1,
 				expr: &seqExpr{
-					pos: position{line: 771, col: 20, offset: 26479},
+					pos: position{line: 817, col: 20, offset: 28096},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 771, col: 20, offset: 26479},
+							pos:   position{line: 817, col: 20, offset: 28096},
 							label: "attributes",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 771, col: 31, offset: 26490},
+								pos: position{line: 817, col: 31, offset: 28107},
 								expr: &ruleRefExpr{
-									pos:  position{line: 771, col: 32, offset: 26491},
+									pos:  position{line: 817, col: 32, offset: 28108},
 									name: "Attributes",
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 771, col: 45, offset: 26504},
+							pos:   position{line: 817, col: 45, offset: 28121},
 							label: "term",
 							expr: &ruleRefExpr{
-								pos:  position{line: 771, col: 51, offset: 26510},
+								pos:  position{line: 817, col: 51, offset: 28127},
 								name: "VerbatimLabeledListItemTerm",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 771, col: 80, offset: 26539},
+							pos:   position{line: 817, col: 80, offset: 28156},
 							label: "separator",
 							expr: &ruleRefExpr{
-								pos:  position{line: 771, col: 91, offset: 26550},
+								pos:  position{line: 817, col: 91, offset: 28167},
 								name: "LabeledListItemSeparator",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 771, col: 117, offset: 26576},
+							pos:   position{line: 817, col: 117, offset: 28193},
 							label: "description",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 771, col: 129, offset: 26588},
+								pos: position{line: 817, col: 129, offset: 28205},
 								expr: &ruleRefExpr{
-									pos:  position{line: 771, col: 130, offset: 26589},
+									pos:  position{line: 817, col: 130, offset: 28206},
 									name: "LabeledListItemDescription",
 								},
 							},
@@ -5199,16 +5689,16 @@ var g = &grammar{
 		},
 		{
 			name: "LabeledListItemPrefix",
-			pos:  position{line: 775, col: 1, offset: 26739},
+			pos:  position{line: 821, col: 1, offset: 28368},
 			expr: &seqExpr{
-				pos: position{line: 775, col: 26, offset: 26764},
+				pos: position{line: 821, col: 26, offset: 28393},
 				exprs: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 775, col: 26, offset: 26764},
+						pos:  position{line: 821, col: 26, offset: 28393},
 						name: "VerbatimLabeledListItemTerm",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 775, col: 54, offset: 26792},
+						pos:  position{line: 821, col: 54, offset: 28421},
 						name: "LabeledListItemSeparator",
 					},
 				},
@@ -5216,14 +5706,14 @@ var g = &grammar{
 		},
 		{
 			name: "VerbatimLabeledListItemChars",
-			pos:  position{line: 777, col: 1, offset: 26820},
+			pos:  position{line: 823, col: 1, offset: 28447},
 			expr: &choiceExpr{
-				pos: position{line: 777, col: 33, offset: 26852},
+				pos: position{line: 823, col: 33, offset: 28479},
 				alternatives: []interface{}{
 					&oneOrMoreExpr{
-						pos: position{line: 777, col: 33, offset: 26852},
+						pos: position{line: 823, col: 33, offset: 28479},
 						expr: &charClassMatcher{
-							pos:        position{line: 777, col: 33, offset: 26852},
+							pos:        position{line: 823, col: 33, offset: 28479},
 							val:        "[^:\\r\\n]",
 							chars:      []rune{':', '\r', '\n'},
 							ignoreCase: false,
@@ -5231,18 +5721,18 @@ var g = &grammar{
 						},
 					},
 					&seqExpr{
-						pos: position{line: 777, col: 45, offset: 26864},
+						pos: position{line: 823, col: 45, offset: 28491},
 						exprs: []interface{}{
 							&litMatcher{
-								pos:        position{line: 777, col: 45, offset: 26864},
+								pos:        position{line: 823, col: 45, offset: 28491},
 								val:        ":",
 								ignoreCase: false,
 								want:       "\":\"",
 							},
 							&notExpr{
-								pos: position{line: 777, col: 49, offset: 26868},
+								pos: position{line: 823, col: 49, offset: 28495},
 								expr: &litMatcher{
-									pos:        position{line: 777, col: 50, offset: 26869},
+									pos:        position{line: 823, col: 50, offset: 28496},
 									val:        ":",
 									ignoreCase: false,
 									want:       "\":\"",
@@ -5255,20 +5745,20 @@ var g = &grammar{
 		},
 		{
 			name: "VerbatimLabeledListItemTerm",
-			pos:  position{line: 778, col: 1, offset: 26874},
+			pos:  position{line: 824, col: 1, offset: 28500},
 			expr: &actionExpr{
-				pos: position{line: 778, col: 32, offset: 26905},
+				pos: position{line: 824, col: 32, offset: 28531},
 				run: (*parser).callonVerbatimLabeledListItemTerm1,
 				expr: &labeledExpr{
-					pos:   position{line: 778, col: 32, offset: 26905},
+					pos:   position{line: 824, col: 32, offset: 28531},
 					label: "content",
 					expr: &actionExpr{
-						pos: position{line: 778, col: 42, offset: 26915},
+						pos: position{line: 824, col: 42, offset: 28541},
 						run: (*parser).callonVerbatimLabeledListItemTerm3,
 						expr: &oneOrMoreExpr{
-							pos: position{line: 778, col: 42, offset: 26915},
+							pos: position{line: 824, col: 42, offset: 28541},
 							expr: &ruleRefExpr{
-								pos:  position{line: 778, col: 42, offset: 26915},
+								pos:  position{line: 824, col: 42, offset: 28541},
 								name: "VerbatimLabeledListItemChars",
 							},
 						},
@@ -5278,36 +5768,36 @@ var g = &grammar{
 		},
 		{
 			name: "LabeledListItemTerm",
-			pos:  position{line: 784, col: 1, offset: 27076},
+			pos:  position{line: 830, col: 1, offset: 28729},
 			expr: &actionExpr{
-				pos: position{line: 784, col: 24, offset: 27099},
+				pos: position{line: 830, col: 24, offset: 28752},
 				run: (*parser).callonLabeledListItemTerm1,
 				expr: &labeledExpr{
-					pos:   position{line: 784, col: 24, offset: 27099},
+					pos:   position{line: 830, col: 24, offset: 28752},
 					label: "elements",
 					expr: &oneOrMoreExpr{
-						pos: position{line: 784, col: 33, offset: 27108},
+						pos: position{line: 830, col: 33, offset: 28761},
 						expr: &seqExpr{
-							pos: position{line: 784, col: 34, offset: 27109},
+							pos: position{line: 830, col: 34, offset: 28762},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 784, col: 34, offset: 27109},
+									pos: position{line: 830, col: 34, offset: 28762},
 									expr: &ruleRefExpr{
-										pos:  position{line: 784, col: 35, offset: 27110},
+										pos:  position{line: 830, col: 35, offset: 28763},
 										name: "Newline",
 									},
 								},
 								&notExpr{
-									pos: position{line: 784, col: 43, offset: 27118},
+									pos: position{line: 830, col: 43, offset: 28771},
 									expr: &litMatcher{
-										pos:        position{line: 784, col: 44, offset: 27119},
+										pos:        position{line: 830, col: 44, offset: 28772},
 										val:        "::",
 										ignoreCase: false,
 										want:       "\"::\"",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 784, col: 49, offset: 27124},
+									pos:  position{line: 830, col: 49, offset: 28777},
 									name: "LabeledListItemTermElement",
 								},
 							},
@@ -5318,77 +5808,77 @@ var g = &grammar{
 		},
 		{
 			name: "LabeledListItemTermElement",
-			pos:  position{line: 788, col: 1, offset: 27255},
+			pos:  position{line: 834, col: 1, offset: 28921},
 			expr: &actionExpr{
-				pos: position{line: 788, col: 31, offset: 27285},
+				pos: position{line: 834, col: 31, offset: 28951},
 				run: (*parser).callonLabeledListItemTermElement1,
 				expr: &labeledExpr{
-					pos:   position{line: 788, col: 31, offset: 27285},
+					pos:   position{line: 834, col: 31, offset: 28951},
 					label: "element",
 					expr: &choiceExpr{
-						pos: position{line: 788, col: 40, offset: 27294},
+						pos: position{line: 834, col: 40, offset: 28960},
 						alternatives: []interface{}{
 							&ruleRefExpr{
-								pos:  position{line: 788, col: 40, offset: 27294},
+								pos:  position{line: 834, col: 40, offset: 28960},
 								name: "Word",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 789, col: 11, offset: 27310},
+								pos:  position{line: 835, col: 11, offset: 28975},
 								name: "LineBreak",
 							},
 							&oneOrMoreExpr{
-								pos: position{line: 790, col: 11, offset: 27360},
+								pos: position{line: 836, col: 11, offset: 29024},
 								expr: &ruleRefExpr{
-									pos:  position{line: 790, col: 11, offset: 27360},
+									pos:  position{line: 836, col: 11, offset: 29024},
 									name: "Space",
 								},
 							},
 							&ruleRefExpr{
-								pos:  position{line: 791, col: 11, offset: 27379},
+								pos:  position{line: 837, col: 11, offset: 29042},
 								name: "CrossReference",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 792, col: 11, offset: 27405},
+								pos:  position{line: 838, col: 11, offset: 29067},
 								name: "ConcealedIndexTerm",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 793, col: 11, offset: 27435},
+								pos:  position{line: 839, col: 11, offset: 29096},
 								name: "IndexTerm",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 794, col: 11, offset: 27456},
+								pos:  position{line: 840, col: 11, offset: 29116},
 								name: "InlinePassthrough",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 795, col: 11, offset: 27485},
+								pos:  position{line: 841, col: 11, offset: 29144},
 								name: "InlineIcon",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 796, col: 11, offset: 27507},
+								pos:  position{line: 842, col: 11, offset: 29165},
 								name: "InlineImage",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 797, col: 11, offset: 27531},
+								pos:  position{line: 843, col: 11, offset: 29188},
 								name: "Link",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 798, col: 11, offset: 27547},
+								pos:  position{line: 844, col: 11, offset: 29203},
 								name: "InlineFootnote",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 799, col: 11, offset: 27573},
+								pos:  position{line: 845, col: 11, offset: 29228},
 								name: "QuotedString",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 800, col: 11, offset: 27597},
+								pos:  position{line: 846, col: 11, offset: 29251},
 								name: "QuotedText",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 801, col: 11, offset: 27619},
+								pos:  position{line: 847, col: 11, offset: 29272},
 								name: "AttributeSubstitution",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 802, col: 11, offset: 27652},
+								pos:  position{line: 848, col: 11, offset: 29304},
 								name: "AnyChar",
 							},
 						},
@@ -5398,23 +5888,23 @@ var g = &grammar{
 		},
 		{
 			name: "LabeledListItemSeparator",
-			pos:  position{line: 806, col: 1, offset: 27695},
+			pos:  position{line: 852, col: 1, offset: 29343},
 			expr: &actionExpr{
-				pos: position{line: 807, col: 5, offset: 27729},
+				pos: position{line: 853, col: 5, offset: 29376},
 				run: (*parser).callonLabeledListItemSeparator1,
 				expr: &seqExpr{
-					pos: position{line: 807, col: 5, offset: 27729},
+					pos: position{line: 853, col: 5, offset: 29376},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 807, col: 5, offset: 27729},
+							pos:   position{line: 853, col: 5, offset: 29376},
 							label: "separator",
 							expr: &actionExpr{
-								pos: position{line: 807, col: 16, offset: 27740},
+								pos: position{line: 853, col: 16, offset: 29387},
 								run: (*parser).callonLabeledListItemSeparator4,
 								expr: &oneOrMoreExpr{
-									pos: position{line: 807, col: 16, offset: 27740},
+									pos: position{line: 853, col: 16, offset: 29387},
 									expr: &litMatcher{
-										pos:        position{line: 807, col: 17, offset: 27741},
+										pos:        position{line: 853, col: 17, offset: 29388},
 										val:        ":",
 										ignoreCase: false,
 										want:       "\":\"",
@@ -5423,30 +5913,30 @@ var g = &grammar{
 							},
 						},
 						&andCodeExpr{
-							pos: position{line: 810, col: 5, offset: 27802},
+							pos: position{line: 856, col: 5, offset: 29446},
 							run: (*parser).callonLabeledListItemSeparator7,
 						},
 						&choiceExpr{
-							pos: position{line: 814, col: 6, offset: 27982},
+							pos: position{line: 860, col: 6, offset: 29622},
 							alternatives: []interface{}{
 								&oneOrMoreExpr{
-									pos: position{line: 814, col: 6, offset: 27982},
+									pos: position{line: 860, col: 6, offset: 29622},
 									expr: &choiceExpr{
-										pos: position{line: 814, col: 7, offset: 27983},
+										pos: position{line: 860, col: 7, offset: 29623},
 										alternatives: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 814, col: 7, offset: 27983},
+												pos:  position{line: 860, col: 7, offset: 29623},
 												name: "Space",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 814, col: 15, offset: 27991},
+												pos:  position{line: 860, col: 15, offset: 29631},
 												name: "Newline",
 											},
 										},
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 814, col: 27, offset: 28003},
+									pos:  position{line: 860, col: 27, offset: 29643},
 									name: "EOL",
 								},
 							},
@@ -5457,17 +5947,17 @@ var g = &grammar{
 		},
 		{
 			name: "LabeledListItemDescription",
-			pos:  position{line: 818, col: 1, offset: 28047},
+			pos:  position{line: 864, col: 1, offset: 29683},
 			expr: &actionExpr{
-				pos: position{line: 818, col: 31, offset: 28077},
+				pos: position{line: 864, col: 31, offset: 29713},
 				run: (*parser).callonLabeledListItemDescription1,
 				expr: &labeledExpr{
-					pos:   position{line: 818, col: 31, offset: 28077},
+					pos:   position{line: 864, col: 31, offset: 29713},
 					label: "elements",
 					expr: &oneOrMoreExpr{
-						pos: position{line: 818, col: 40, offset: 28086},
+						pos: position{line: 864, col: 40, offset: 29722},
 						expr: &ruleRefExpr{
-							pos:  position{line: 818, col: 41, offset: 28087},
+							pos:  position{line: 864, col: 41, offset: 29723},
 							name: "ListParagraph",
 						},
 					},
@@ -5476,55 +5966,55 @@ var g = &grammar{
 		},
 		{
 			name: "AdmonitionKind",
-			pos:  position{line: 825, col: 1, offset: 28285},
+			pos:  position{line: 871, col: 1, offset: 29914},
 			expr: &choiceExpr{
-				pos: position{line: 825, col: 19, offset: 28303},
+				pos: position{line: 871, col: 19, offset: 29932},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 825, col: 19, offset: 28303},
+						pos: position{line: 871, col: 19, offset: 29932},
 						run: (*parser).callonAdmonitionKind2,
 						expr: &litMatcher{
-							pos:        position{line: 825, col: 19, offset: 28303},
+							pos:        position{line: 871, col: 19, offset: 29932},
 							val:        "TIP",
 							ignoreCase: false,
 							want:       "\"TIP\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 827, col: 9, offset: 28351},
+						pos: position{line: 873, col: 9, offset: 29978},
 						run: (*parser).callonAdmonitionKind4,
 						expr: &litMatcher{
-							pos:        position{line: 827, col: 9, offset: 28351},
+							pos:        position{line: 873, col: 9, offset: 29978},
 							val:        "NOTE",
 							ignoreCase: false,
 							want:       "\"NOTE\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 829, col: 9, offset: 28401},
+						pos: position{line: 875, col: 9, offset: 30026},
 						run: (*parser).callonAdmonitionKind6,
 						expr: &litMatcher{
-							pos:        position{line: 829, col: 9, offset: 28401},
+							pos:        position{line: 875, col: 9, offset: 30026},
 							val:        "IMPORTANT",
 							ignoreCase: false,
 							want:       "\"IMPORTANT\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 831, col: 9, offset: 28461},
+						pos: position{line: 877, col: 9, offset: 30084},
 						run: (*parser).callonAdmonitionKind8,
 						expr: &litMatcher{
-							pos:        position{line: 831, col: 9, offset: 28461},
+							pos:        position{line: 877, col: 9, offset: 30084},
 							val:        "WARNING",
 							ignoreCase: false,
 							want:       "\"WARNING\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 833, col: 9, offset: 28517},
+						pos: position{line: 879, col: 9, offset: 30138},
 						run: (*parser).callonAdmonitionKind10,
 						expr: &litMatcher{
-							pos:        position{line: 833, col: 9, offset: 28517},
+							pos:        position{line: 879, col: 9, offset: 30138},
 							val:        "CAUTION",
 							ignoreCase: false,
 							want:       "\"CAUTION\"",
@@ -5535,48 +6025,48 @@ var g = &grammar{
 		},
 		{
 			name: "Paragraph",
-			pos:  position{line: 842, col: 1, offset: 28833},
+			pos:  position{line: 888, col: 1, offset: 30445},
 			expr: &choiceExpr{
-				pos: position{line: 844, col: 5, offset: 28882},
+				pos: position{line: 890, col: 5, offset: 30492},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 844, col: 5, offset: 28882},
+						pos: position{line: 890, col: 5, offset: 30492},
 						run: (*parser).callonParagraph2,
 						expr: &seqExpr{
-							pos: position{line: 844, col: 5, offset: 28882},
+							pos: position{line: 890, col: 5, offset: 30492},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 844, col: 5, offset: 28882},
+									pos:   position{line: 890, col: 5, offset: 30492},
 									label: "attributes",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 844, col: 16, offset: 28893},
+										pos: position{line: 890, col: 16, offset: 30503},
 										expr: &ruleRefExpr{
-											pos:  position{line: 844, col: 17, offset: 28894},
+											pos:  position{line: 890, col: 17, offset: 30504},
 											name: "Attributes",
 										},
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 844, col: 30, offset: 28907},
+									pos:   position{line: 890, col: 30, offset: 30517},
 									label: "t",
 									expr: &ruleRefExpr{
-										pos:  position{line: 844, col: 33, offset: 28910},
+										pos:  position{line: 890, col: 33, offset: 30520},
 										name: "AdmonitionKind",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 844, col: 49, offset: 28926},
+									pos:        position{line: 890, col: 49, offset: 30536},
 									val:        ": ",
 									ignoreCase: false,
 									want:       "\": \"",
 								},
 								&labeledExpr{
-									pos:   position{line: 844, col: 54, offset: 28931},
+									pos:   position{line: 890, col: 54, offset: 30541},
 									label: "lines",
 									expr: &oneOrMoreExpr{
-										pos: position{line: 844, col: 60, offset: 28937},
+										pos: position{line: 890, col: 60, offset: 30547},
 										expr: &ruleRefExpr{
-											pos:  position{line: 844, col: 61, offset: 28938},
+											pos:  position{line: 890, col: 61, offset: 30548},
 											name: "InlineElements",
 										},
 									},
@@ -5585,33 +6075,33 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 848, col: 5, offset: 29123},
+						pos: position{line: 894, col: 5, offset: 30745},
 						run: (*parser).callonParagraph13,
 						expr: &seqExpr{
-							pos: position{line: 848, col: 5, offset: 29123},
+							pos: position{line: 894, col: 5, offset: 30745},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 848, col: 5, offset: 29123},
+									pos:   position{line: 894, col: 5, offset: 30745},
 									label: "attributes",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 848, col: 16, offset: 29134},
+										pos: position{line: 894, col: 16, offset: 30756},
 										expr: &ruleRefExpr{
-											pos:  position{line: 848, col: 17, offset: 29135},
+											pos:  position{line: 894, col: 17, offset: 30757},
 											name: "Attributes",
 										},
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 848, col: 30, offset: 29148},
+									pos:        position{line: 894, col: 30, offset: 30770},
 									val:        "> ",
 									ignoreCase: false,
 									want:       "\"> \"",
 								},
 								&labeledExpr{
-									pos:   position{line: 848, col: 35, offset: 29153},
+									pos:   position{line: 894, col: 35, offset: 30775},
 									label: "content",
 									expr: &ruleRefExpr{
-										pos:  position{line: 848, col: 44, offset: 29162},
+										pos:  position{line: 894, col: 44, offset: 30784},
 										name: "MarkdownQuoteBlockVerbatimContent",
 									},
 								},
@@ -5619,38 +6109,38 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 852, col: 5, offset: 29361},
+						pos: position{line: 898, col: 5, offset: 30995},
 						run: (*parser).callonParagraph21,
 						expr: &seqExpr{
-							pos: position{line: 852, col: 5, offset: 29361},
+							pos: position{line: 898, col: 5, offset: 30995},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 852, col: 5, offset: 29361},
+									pos:   position{line: 898, col: 5, offset: 30995},
 									label: "attributes",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 852, col: 16, offset: 29372},
+										pos: position{line: 898, col: 16, offset: 31006},
 										expr: &ruleRefExpr{
-											pos:  position{line: 852, col: 17, offset: 29373},
+											pos:  position{line: 898, col: 17, offset: 31007},
 											name: "Attributes",
 										},
 									},
 								},
 								&andCodeExpr{
-									pos: position{line: 852, col: 30, offset: 29386},
+									pos: position{line: 898, col: 30, offset: 31020},
 									run: (*parser).callonParagraph26,
 								},
 								&notExpr{
-									pos: position{line: 859, col: 7, offset: 29672},
+									pos: position{line: 905, col: 7, offset: 31299},
 									expr: &ruleRefExpr{
-										pos:  position{line: 859, col: 8, offset: 29673},
+										pos:  position{line: 905, col: 8, offset: 31300},
 										name: "BlockDelimiter",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 859, col: 23, offset: 29688},
+									pos:   position{line: 905, col: 23, offset: 31315},
 									label: "content",
 									expr: &ruleRefExpr{
-										pos:  position{line: 859, col: 32, offset: 29697},
+										pos:  position{line: 905, col: 32, offset: 31324},
 										name: "OpenPassthroughParagraphContent",
 									},
 								},
@@ -5658,36 +6148,36 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 863, col: 5, offset: 29898},
+						pos: position{line: 909, col: 5, offset: 31537},
 						run: (*parser).callonParagraph31,
 						expr: &seqExpr{
-							pos: position{line: 863, col: 5, offset: 29898},
+							pos: position{line: 909, col: 5, offset: 31537},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 863, col: 5, offset: 29898},
+									pos:   position{line: 909, col: 5, offset: 31537},
 									label: "attributes",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 863, col: 16, offset: 29909},
+										pos: position{line: 909, col: 16, offset: 31548},
 										expr: &ruleRefExpr{
-											pos:  position{line: 863, col: 17, offset: 29910},
+											pos:  position{line: 909, col: 17, offset: 31549},
 											name: "Attributes",
 										},
 									},
 								},
 								&notExpr{
-									pos: position{line: 863, col: 30, offset: 29923},
+									pos: position{line: 909, col: 30, offset: 31562},
 									expr: &ruleRefExpr{
-										pos:  position{line: 863, col: 31, offset: 29924},
+										pos:  position{line: 909, col: 31, offset: 31563},
 										name: "BlockDelimiter",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 863, col: 46, offset: 29939},
+									pos:   position{line: 909, col: 46, offset: 31578},
 									label: "lines",
 									expr: &oneOrMoreExpr{
-										pos: position{line: 863, col: 52, offset: 29945},
+										pos: position{line: 909, col: 52, offset: 31584},
 										expr: &ruleRefExpr{
-											pos:  position{line: 863, col: 53, offset: 29946},
+											pos:  position{line: 909, col: 53, offset: 31585},
 											name: "InlineElements",
 										},
 									},
@@ -5700,36 +6190,36 @@ var g = &grammar{
 		},
 		{
 			name: "MarkdownQuoteBlockVerbatimContent",
-			pos:  position{line: 867, col: 1, offset: 30046},
+			pos:  position{line: 913, col: 1, offset: 31697},
 			expr: &oneOrMoreExpr{
-				pos: position{line: 867, col: 38, offset: 30083},
+				pos: position{line: 913, col: 38, offset: 31734},
 				expr: &actionExpr{
-					pos: position{line: 867, col: 39, offset: 30084},
+					pos: position{line: 913, col: 39, offset: 31735},
 					run: (*parser).callonMarkdownQuoteBlockVerbatimContent2,
 					expr: &seqExpr{
-						pos: position{line: 867, col: 39, offset: 30084},
+						pos: position{line: 913, col: 39, offset: 31735},
 						exprs: []interface{}{
 							&notExpr{
-								pos: position{line: 867, col: 39, offset: 30084},
+								pos: position{line: 913, col: 39, offset: 31735},
 								expr: &ruleRefExpr{
-									pos:  position{line: 867, col: 40, offset: 30085},
+									pos:  position{line: 913, col: 40, offset: 31736},
 									name: "BlankLine",
 								},
 							},
 							&zeroOrOneExpr{
-								pos: position{line: 867, col: 50, offset: 30095},
+								pos: position{line: 913, col: 50, offset: 31746},
 								expr: &litMatcher{
-									pos:        position{line: 867, col: 50, offset: 30095},
+									pos:        position{line: 913, col: 50, offset: 31746},
 									val:        "> ",
 									ignoreCase: false,
 									want:       "\"> \"",
 								},
 							},
 							&labeledExpr{
-								pos:   position{line: 867, col: 56, offset: 30101},
+								pos:   position{line: 913, col: 56, offset: 31752},
 								label: "content",
 								expr: &ruleRefExpr{
-									pos:  position{line: 867, col: 65, offset: 30110},
+									pos:  position{line: 913, col: 65, offset: 31761},
 									name: "VerbatimContent",
 								},
 							},
@@ -5740,29 +6230,29 @@ var g = &grammar{
 		},
 		{
 			name: "MarkdownQuoteBlockAttribution",
-			pos:  position{line: 871, col: 1, offset: 30255},
+			pos:  position{line: 917, col: 1, offset: 31902},
 			expr: &actionExpr{
-				pos: position{line: 871, col: 34, offset: 30288},
+				pos: position{line: 917, col: 34, offset: 31935},
 				run: (*parser).callonMarkdownQuoteBlockAttribution1,
 				expr: &seqExpr{
-					pos: position{line: 871, col: 34, offset: 30288},
+					pos: position{line: 917, col: 34, offset: 31935},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 871, col: 34, offset: 30288},
+							pos:        position{line: 917, col: 34, offset: 31935},
 							val:        "-- ",
 							ignoreCase: false,
 							want:       "\"-- \"",
 						},
 						&labeledExpr{
-							pos:   position{line: 871, col: 40, offset: 30294},
+							pos:   position{line: 917, col: 40, offset: 31941},
 							label: "author",
 							expr: &actionExpr{
-								pos: position{line: 871, col: 48, offset: 30302},
+								pos: position{line: 917, col: 48, offset: 31949},
 								run: (*parser).callonMarkdownQuoteBlockAttribution5,
 								expr: &oneOrMoreExpr{
-									pos: position{line: 871, col: 49, offset: 30303},
+									pos: position{line: 917, col: 49, offset: 31950},
 									expr: &charClassMatcher{
-										pos:        position{line: 871, col: 49, offset: 30303},
+										pos:        position{line: 917, col: 49, offset: 31950},
 										val:        "[^\\r\\n]",
 										chars:      []rune{'\r', '\n'},
 										ignoreCase: false,
@@ -5772,7 +6262,7 @@ var g = &grammar{
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 873, col: 8, offset: 30355},
+							pos:  position{line: 919, col: 8, offset: 32000},
 							name: "EOL",
 						},
 					},
@@ -5781,27 +6271,27 @@ var g = &grammar{
 		},
 		{
 			name: "OpenPassthroughParagraphContent",
-			pos:  position{line: 877, col: 1, offset: 30391},
+			pos:  position{line: 923, col: 1, offset: 32032},
 			expr: &oneOrMoreExpr{
-				pos: position{line: 877, col: 36, offset: 30426},
+				pos: position{line: 923, col: 36, offset: 32067},
 				expr: &actionExpr{
-					pos: position{line: 877, col: 37, offset: 30427},
+					pos: position{line: 923, col: 37, offset: 32068},
 					run: (*parser).callonOpenPassthroughParagraphContent2,
 					expr: &seqExpr{
-						pos: position{line: 877, col: 37, offset: 30427},
+						pos: position{line: 923, col: 37, offset: 32068},
 						exprs: []interface{}{
 							&notExpr{
-								pos: position{line: 877, col: 37, offset: 30427},
+								pos: position{line: 923, col: 37, offset: 32068},
 								expr: &ruleRefExpr{
-									pos:  position{line: 877, col: 38, offset: 30428},
+									pos:  position{line: 923, col: 38, offset: 32069},
 									name: "BlankLine",
 								},
 							},
 							&labeledExpr{
-								pos:   position{line: 877, col: 48, offset: 30438},
+								pos:   position{line: 923, col: 48, offset: 32079},
 								label: "content",
 								expr: &ruleRefExpr{
-									pos:  position{line: 877, col: 57, offset: 30447},
+									pos:  position{line: 923, col: 57, offset: 32088},
 									name: "VerbatimContent",
 								},
 							},
@@ -5812,43 +6302,43 @@ var g = &grammar{
 		},
 		{
 			name: "SimpleParagraph",
-			pos:  position{line: 882, col: 1, offset: 30665},
+			pos:  position{line: 928, col: 1, offset: 32301},
 			expr: &actionExpr{
-				pos: position{line: 882, col: 20, offset: 30684},
+				pos: position{line: 928, col: 20, offset: 32320},
 				run: (*parser).callonSimpleParagraph1,
 				expr: &seqExpr{
-					pos: position{line: 882, col: 20, offset: 30684},
+					pos: position{line: 928, col: 20, offset: 32320},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 882, col: 20, offset: 30684},
+							pos:   position{line: 928, col: 20, offset: 32320},
 							label: "attributes",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 882, col: 31, offset: 30695},
+								pos: position{line: 928, col: 31, offset: 32331},
 								expr: &ruleRefExpr{
-									pos:  position{line: 882, col: 32, offset: 30696},
+									pos:  position{line: 928, col: 32, offset: 32332},
 									name: "Attributes",
 								},
 							},
 						},
 						&andCodeExpr{
-							pos: position{line: 883, col: 5, offset: 30715},
+							pos: position{line: 929, col: 5, offset: 32350},
 							run: (*parser).callonSimpleParagraph6,
 						},
 						&labeledExpr{
-							pos:   position{line: 891, col: 5, offset: 31009},
+							pos:   position{line: 937, col: 5, offset: 32636},
 							label: "firstLine",
 							expr: &ruleRefExpr{
-								pos:  position{line: 891, col: 16, offset: 31020},
+								pos:  position{line: 937, col: 16, offset: 32647},
 								name: "FirstParagraphLine",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 892, col: 5, offset: 31044},
+							pos:   position{line: 938, col: 5, offset: 32670},
 							label: "otherLines",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 892, col: 16, offset: 31055},
+								pos: position{line: 938, col: 16, offset: 32681},
 								expr: &ruleRefExpr{
-									pos:  position{line: 892, col: 17, offset: 31056},
+									pos:  position{line: 938, col: 17, offset: 32682},
 									name: "OtherParagraphLine",
 								},
 							},
@@ -5859,27 +6349,27 @@ var g = &grammar{
 		},
 		{
 			name: "FirstParagraphLine",
-			pos:  position{line: 896, col: 1, offset: 31194},
+			pos:  position{line: 942, col: 1, offset: 32832},
 			expr: &actionExpr{
-				pos: position{line: 897, col: 5, offset: 31222},
+				pos: position{line: 943, col: 5, offset: 32859},
 				run: (*parser).callonFirstParagraphLine1,
 				expr: &seqExpr{
-					pos: position{line: 897, col: 5, offset: 31222},
+					pos: position{line: 943, col: 5, offset: 32859},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 897, col: 5, offset: 31222},
+							pos:   position{line: 943, col: 5, offset: 32859},
 							label: "elements",
 							expr: &seqExpr{
-								pos: position{line: 897, col: 15, offset: 31232},
+								pos: position{line: 943, col: 15, offset: 32869},
 								exprs: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 897, col: 15, offset: 31232},
+										pos:  position{line: 943, col: 15, offset: 32869},
 										name: "Word",
 									},
 									&zeroOrMoreExpr{
-										pos: position{line: 897, col: 20, offset: 31237},
+										pos: position{line: 943, col: 20, offset: 32874},
 										expr: &ruleRefExpr{
-											pos:  position{line: 897, col: 20, offset: 31237},
+											pos:  position{line: 943, col: 20, offset: 32874},
 											name: "InlineElement",
 										},
 									},
@@ -5887,7 +6377,7 @@ var g = &grammar{
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 897, col: 36, offset: 31253},
+							pos:  position{line: 943, col: 36, offset: 32890},
 							name: "EOL",
 						},
 					},
@@ -5896,15 +6386,15 @@ var g = &grammar{
 		},
 		{
 			name: "OtherParagraphLine",
-			pos:  position{line: 901, col: 1, offset: 31328},
+			pos:  position{line: 947, col: 1, offset: 32978},
 			expr: &actionExpr{
-				pos: position{line: 901, col: 23, offset: 31350},
+				pos: position{line: 947, col: 23, offset: 33000},
 				run: (*parser).callonOtherParagraphLine1,
 				expr: &labeledExpr{
-					pos:   position{line: 901, col: 23, offset: 31350},
+					pos:   position{line: 947, col: 23, offset: 33000},
 					label: "elements",
 					expr: &ruleRefExpr{
-						pos:  position{line: 901, col: 33, offset: 31360},
+						pos:  position{line: 947, col: 33, offset: 33010},
 						name: "InlineElements",
 					},
 				},
@@ -5912,46 +6402,46 @@ var g = &grammar{
 		},
 		{
 			name: "ContinuedParagraph",
-			pos:  position{line: 906, col: 1, offset: 31485},
+			pos:  position{line: 952, col: 1, offset: 33130},
 			expr: &choiceExpr{
-				pos: position{line: 908, col: 5, offset: 31543},
+				pos: position{line: 954, col: 5, offset: 33186},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 908, col: 5, offset: 31543},
+						pos: position{line: 954, col: 5, offset: 33186},
 						run: (*parser).callonContinuedParagraph2,
 						expr: &seqExpr{
-							pos: position{line: 908, col: 5, offset: 31543},
+							pos: position{line: 954, col: 5, offset: 33186},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 908, col: 5, offset: 31543},
+									pos:   position{line: 954, col: 5, offset: 33186},
 									label: "attributes",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 908, col: 16, offset: 31554},
+										pos: position{line: 954, col: 16, offset: 33197},
 										expr: &ruleRefExpr{
-											pos:  position{line: 908, col: 17, offset: 31555},
+											pos:  position{line: 954, col: 17, offset: 33198},
 											name: "Attributes",
 										},
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 908, col: 30, offset: 31568},
+									pos:   position{line: 954, col: 30, offset: 33211},
 									label: "t",
 									expr: &ruleRefExpr{
-										pos:  position{line: 908, col: 33, offset: 31571},
+										pos:  position{line: 954, col: 33, offset: 33214},
 										name: "AdmonitionKind",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 908, col: 49, offset: 31587},
+									pos:        position{line: 954, col: 49, offset: 33230},
 									val:        ": ",
 									ignoreCase: false,
 									want:       "\": \"",
 								},
 								&labeledExpr{
-									pos:   position{line: 908, col: 54, offset: 31592},
+									pos:   position{line: 954, col: 54, offset: 33235},
 									label: "lines",
 									expr: &ruleRefExpr{
-										pos:  position{line: 908, col: 61, offset: 31599},
+										pos:  position{line: 954, col: 61, offset: 33242},
 										name: "ContinuedParagraphLines",
 									},
 								},
@@ -5959,27 +6449,27 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 912, col: 5, offset: 31803},
+						pos: position{line: 958, col: 5, offset: 33458},
 						run: (*parser).callonContinuedParagraph12,
 						expr: &seqExpr{
-							pos: position{line: 912, col: 5, offset: 31803},
+							pos: position{line: 958, col: 5, offset: 33458},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 912, col: 5, offset: 31803},
+									pos:   position{line: 958, col: 5, offset: 33458},
 									label: "attributes",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 912, col: 16, offset: 31814},
+										pos: position{line: 958, col: 16, offset: 33469},
 										expr: &ruleRefExpr{
-											pos:  position{line: 912, col: 17, offset: 31815},
+											pos:  position{line: 958, col: 17, offset: 33470},
 											name: "Attributes",
 										},
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 912, col: 30, offset: 31828},
+									pos:   position{line: 958, col: 30, offset: 33483},
 									label: "lines",
 									expr: &ruleRefExpr{
-										pos:  position{line: 912, col: 37, offset: 31835},
+										pos:  position{line: 958, col: 37, offset: 33490},
 										name: "ContinuedParagraphLines",
 									},
 								},
@@ -5991,38 +6481,38 @@ var g = &grammar{
 		},
 		{
 			name: "ContinuedParagraphLines",
-			pos:  position{line: 916, col: 1, offset: 31940},
+			pos:  position{line: 962, col: 1, offset: 33607},
 			expr: &actionExpr{
-				pos: position{line: 916, col: 28, offset: 31967},
+				pos: position{line: 962, col: 28, offset: 33634},
 				run: (*parser).callonContinuedParagraphLines1,
 				expr: &seqExpr{
-					pos: position{line: 916, col: 28, offset: 31967},
+					pos: position{line: 962, col: 28, offset: 33634},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 916, col: 28, offset: 31967},
+							pos:   position{line: 962, col: 28, offset: 33634},
 							label: "firstLine",
 							expr: &ruleRefExpr{
-								pos:  position{line: 916, col: 39, offset: 31978},
+								pos:  position{line: 962, col: 39, offset: 33645},
 								name: "FirstParagraphLine",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 916, col: 59, offset: 31998},
+							pos:   position{line: 962, col: 59, offset: 33665},
 							label: "otherLines",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 916, col: 70, offset: 32009},
+								pos: position{line: 962, col: 70, offset: 33676},
 								expr: &seqExpr{
-									pos: position{line: 916, col: 71, offset: 32010},
+									pos: position{line: 962, col: 71, offset: 33677},
 									exprs: []interface{}{
 										&notExpr{
-											pos: position{line: 916, col: 71, offset: 32010},
+											pos: position{line: 962, col: 71, offset: 33677},
 											expr: &ruleRefExpr{
-												pos:  position{line: 916, col: 72, offset: 32011},
+												pos:  position{line: 962, col: 72, offset: 33678},
 												name: "ListItemContinuation",
 											},
 										},
 										&ruleRefExpr{
-											pos:  position{line: 916, col: 93, offset: 32032},
+											pos:  position{line: 962, col: 93, offset: 33699},
 											name: "OtherParagraphLine",
 										},
 									},
@@ -6035,52 +6525,52 @@ var g = &grammar{
 		},
 		{
 			name: "VerseParagraph",
-			pos:  position{line: 920, col: 1, offset: 32142},
+			pos:  position{line: 966, col: 1, offset: 33805},
 			expr: &choiceExpr{
-				pos: position{line: 922, col: 5, offset: 32196},
+				pos: position{line: 968, col: 5, offset: 33857},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 922, col: 5, offset: 32196},
+						pos: position{line: 968, col: 5, offset: 33857},
 						run: (*parser).callonVerseParagraph2,
 						expr: &seqExpr{
-							pos: position{line: 922, col: 5, offset: 32196},
+							pos: position{line: 968, col: 5, offset: 33857},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 922, col: 5, offset: 32196},
+									pos:   position{line: 968, col: 5, offset: 33857},
 									label: "attributes",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 922, col: 16, offset: 32207},
+										pos: position{line: 968, col: 16, offset: 33868},
 										expr: &ruleRefExpr{
-											pos:  position{line: 922, col: 17, offset: 32208},
+											pos:  position{line: 968, col: 17, offset: 33869},
 											name: "Attributes",
 										},
 									},
 								},
 								&andCodeExpr{
-									pos: position{line: 923, col: 5, offset: 32226},
+									pos: position{line: 969, col: 5, offset: 33886},
 									run: (*parser).callonVerseParagraph7,
 								},
 								&labeledExpr{
-									pos:   position{line: 930, col: 5, offset: 32438},
+									pos:   position{line: 976, col: 5, offset: 34091},
 									label: "t",
 									expr: &ruleRefExpr{
-										pos:  position{line: 930, col: 8, offset: 32441},
+										pos:  position{line: 976, col: 8, offset: 34094},
 										name: "AdmonitionKind",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 930, col: 24, offset: 32457},
+									pos:        position{line: 976, col: 24, offset: 34110},
 									val:        ": ",
 									ignoreCase: false,
 									want:       "\": \"",
 								},
 								&labeledExpr{
-									pos:   position{line: 930, col: 29, offset: 32462},
+									pos:   position{line: 976, col: 29, offset: 34115},
 									label: "lines",
 									expr: &oneOrMoreExpr{
-										pos: position{line: 930, col: 35, offset: 32468},
+										pos: position{line: 976, col: 35, offset: 34121},
 										expr: &ruleRefExpr{
-											pos:  position{line: 930, col: 36, offset: 32469},
+											pos:  position{line: 976, col: 36, offset: 34122},
 											name: "InlineElements",
 										},
 									},
@@ -6089,33 +6579,33 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 934, col: 5, offset: 32665},
+						pos: position{line: 980, col: 5, offset: 34330},
 						run: (*parser).callonVerseParagraph14,
 						expr: &seqExpr{
-							pos: position{line: 934, col: 5, offset: 32665},
+							pos: position{line: 980, col: 5, offset: 34330},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 934, col: 5, offset: 32665},
+									pos:   position{line: 980, col: 5, offset: 34330},
 									label: "attributes",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 934, col: 16, offset: 32676},
+										pos: position{line: 980, col: 16, offset: 34341},
 										expr: &ruleRefExpr{
-											pos:  position{line: 934, col: 17, offset: 32677},
+											pos:  position{line: 980, col: 17, offset: 34342},
 											name: "Attributes",
 										},
 									},
 								},
 								&andCodeExpr{
-									pos: position{line: 935, col: 5, offset: 32695},
+									pos: position{line: 981, col: 5, offset: 34359},
 									run: (*parser).callonVerseParagraph19,
 								},
 								&labeledExpr{
-									pos:   position{line: 942, col: 5, offset: 32907},
+									pos:   position{line: 988, col: 5, offset: 34564},
 									label: "lines",
 									expr: &oneOrMoreExpr{
-										pos: position{line: 942, col: 11, offset: 32913},
+										pos: position{line: 988, col: 11, offset: 34570},
 										expr: &ruleRefExpr{
-											pos:  position{line: 942, col: 12, offset: 32914},
+											pos:  position{line: 988, col: 12, offset: 34571},
 											name: "InlineElements",
 										},
 									},
@@ -6128,57 +6618,57 @@ var g = &grammar{
 		},
 		{
 			name: "InlineElements",
-			pos:  position{line: 946, col: 1, offset: 33019},
+			pos:  position{line: 992, col: 1, offset: 34688},
 			expr: &actionExpr{
-				pos: position{line: 946, col: 19, offset: 33037},
+				pos: position{line: 992, col: 19, offset: 34706},
 				run: (*parser).callonInlineElements1,
 				expr: &seqExpr{
-					pos: position{line: 946, col: 19, offset: 33037},
+					pos: position{line: 992, col: 19, offset: 34706},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 946, col: 19, offset: 33037},
+							pos: position{line: 992, col: 19, offset: 34706},
 							expr: &ruleRefExpr{
-								pos:  position{line: 946, col: 20, offset: 33038},
+								pos:  position{line: 992, col: 20, offset: 34707},
 								name: "BlankLine",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 947, col: 5, offset: 33053},
+							pos:   position{line: 993, col: 5, offset: 34721},
 							label: "elements",
 							expr: &choiceExpr{
-								pos: position{line: 947, col: 15, offset: 33063},
+								pos: position{line: 993, col: 15, offset: 34731},
 								alternatives: []interface{}{
 									&actionExpr{
-										pos: position{line: 947, col: 15, offset: 33063},
+										pos: position{line: 993, col: 15, offset: 34731},
 										run: (*parser).callonInlineElements7,
 										expr: &labeledExpr{
-											pos:   position{line: 947, col: 15, offset: 33063},
+											pos:   position{line: 993, col: 15, offset: 34731},
 											label: "comment",
 											expr: &ruleRefExpr{
-												pos:  position{line: 947, col: 24, offset: 33072},
+												pos:  position{line: 993, col: 24, offset: 34740},
 												name: "SingleLineComment",
 											},
 										},
 									},
 									&actionExpr{
-										pos: position{line: 949, col: 9, offset: 33166},
+										pos: position{line: 995, col: 9, offset: 34849},
 										run: (*parser).callonInlineElements10,
 										expr: &seqExpr{
-											pos: position{line: 949, col: 9, offset: 33166},
+											pos: position{line: 995, col: 9, offset: 34849},
 											exprs: []interface{}{
 												&labeledExpr{
-													pos:   position{line: 949, col: 9, offset: 33166},
+													pos:   position{line: 995, col: 9, offset: 34849},
 													label: "elements",
 													expr: &oneOrMoreExpr{
-														pos: position{line: 949, col: 18, offset: 33175},
+														pos: position{line: 995, col: 18, offset: 34858},
 														expr: &ruleRefExpr{
-															pos:  position{line: 949, col: 19, offset: 33176},
+															pos:  position{line: 995, col: 19, offset: 34859},
 															name: "InlineElement",
 														},
 													},
 												},
 												&ruleRefExpr{
-													pos:  position{line: 949, col: 35, offset: 33192},
+													pos:  position{line: 995, col: 35, offset: 34875},
 													name: "EOL",
 												},
 											},
@@ -6193,98 +6683,98 @@ var g = &grammar{
 		},
 		{
 			name: "InlineElement",
-			pos:  position{line: 955, col: 1, offset: 33315},
+			pos:  position{line: 1001, col: 1, offset: 35009},
 			expr: &actionExpr{
-				pos: position{line: 956, col: 5, offset: 33339},
+				pos: position{line: 1002, col: 5, offset: 35032},
 				run: (*parser).callonInlineElement1,
 				expr: &labeledExpr{
-					pos:   position{line: 956, col: 5, offset: 33339},
+					pos:   position{line: 1002, col: 5, offset: 35032},
 					label: "element",
 					expr: &choiceExpr{
-						pos: position{line: 956, col: 14, offset: 33348},
+						pos: position{line: 1002, col: 14, offset: 35041},
 						alternatives: []interface{}{
 							&ruleRefExpr{
-								pos:  position{line: 956, col: 14, offset: 33348},
+								pos:  position{line: 1002, col: 14, offset: 35041},
 								name: "InlineWord",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 957, col: 11, offset: 33400},
+								pos:  position{line: 1003, col: 11, offset: 35092},
 								name: "LineBreak",
 							},
 							&oneOrMoreExpr{
-								pos: position{line: 958, col: 11, offset: 33446},
+								pos: position{line: 1004, col: 11, offset: 35137},
 								expr: &ruleRefExpr{
-									pos:  position{line: 958, col: 11, offset: 33446},
+									pos:  position{line: 1004, col: 11, offset: 35137},
 									name: "Space",
 								},
 							},
 							&seqExpr{
-								pos: position{line: 959, col: 11, offset: 33465},
+								pos: position{line: 1005, col: 11, offset: 35155},
 								exprs: []interface{}{
 									&notExpr{
-										pos: position{line: 959, col: 11, offset: 33465},
+										pos: position{line: 1005, col: 11, offset: 35155},
 										expr: &ruleRefExpr{
-											pos:  position{line: 959, col: 12, offset: 33466},
+											pos:  position{line: 1005, col: 12, offset: 35156},
 											name: "EOL",
 										},
 									},
 									&choiceExpr{
-										pos: position{line: 960, col: 13, offset: 33485},
+										pos: position{line: 1006, col: 13, offset: 35174},
 										alternatives: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 960, col: 13, offset: 33485},
+												pos:  position{line: 1006, col: 13, offset: 35174},
 												name: "QuotedString",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 961, col: 15, offset: 33513},
+												pos:  position{line: 1007, col: 15, offset: 35201},
 												name: "QuotedText",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 962, col: 15, offset: 33539},
+												pos:  position{line: 1008, col: 15, offset: 35226},
 												name: "InlineIcon",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 963, col: 15, offset: 33565},
+												pos:  position{line: 1009, col: 15, offset: 35251},
 												name: "InlineImage",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 964, col: 15, offset: 33593},
+												pos:  position{line: 1010, col: 15, offset: 35278},
 												name: "Link",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 965, col: 15, offset: 33614},
+												pos:  position{line: 1011, col: 15, offset: 35298},
 												name: "InlinePassthrough",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 966, col: 15, offset: 33648},
+												pos:  position{line: 1012, col: 15, offset: 35331},
 												name: "InlineFootnote",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 967, col: 15, offset: 33679},
+												pos:  position{line: 1013, col: 15, offset: 35361},
 												name: "CrossReference",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 968, col: 15, offset: 33710},
-												name: "InlineUserMacro",
+												pos:  position{line: 1014, col: 15, offset: 35391},
+												name: "InlineAnchor",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 969, col: 15, offset: 33742},
-												name: "AttributeSubstitution",
+												pos:  position{line: 1015, col: 15, offset: 35496},
+												name: "InlineUserMacro",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 970, col: 15, offset: 33780},
-												name: "InlineElementID",
+												pos:  position{line: 1016, col: 15, offset: 35527},
+												name: "AttributeSubstitution",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 971, col: 15, offset: 33811},
+												pos:  position{line: 1017, col: 15, offset: 35564},
 												name: "ConcealedIndexTerm",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 972, col: 15, offset: 33845},
+												pos:  position{line: 1018, col: 15, offset: 35597},
 												name: "IndexTerm",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 973, col: 15, offset: 33870},
+												pos:  position{line: 1019, col: 15, offset: 35621},
 												name: "AnyChar",
 											},
 										},
@@ -6298,34 +6788,34 @@ var g = &grammar{
 		},
 		{
 			name: "LineBreak",
-			pos:  position{line: 980, col: 1, offset: 34100},
+			pos:  position{line: 1026, col: 1, offset: 35844},
 			expr: &actionExpr{
-				pos: position{line: 980, col: 14, offset: 34113},
+				pos: position{line: 1026, col: 14, offset: 35857},
 				run: (*parser).callonLineBreak1,
 				expr: &seqExpr{
-					pos: position{line: 980, col: 14, offset: 34113},
+					pos: position{line: 1026, col: 14, offset: 35857},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 980, col: 14, offset: 34113},
+							pos:  position{line: 1026, col: 14, offset: 35857},
 							name: "Space",
 						},
 						&litMatcher{
-							pos:        position{line: 980, col: 20, offset: 34119},
+							pos:        position{line: 1026, col: 20, offset: 35863},
 							val:        "+",
 							ignoreCase: false,
 							want:       "\"+\"",
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 980, col: 24, offset: 34123},
+							pos: position{line: 1026, col: 24, offset: 35867},
 							expr: &ruleRefExpr{
-								pos:  position{line: 980, col: 24, offset: 34123},
+								pos:  position{line: 1026, col: 24, offset: 35867},
 								name: "Space",
 							},
 						},
 						&andExpr{
-							pos: position{line: 980, col: 31, offset: 34130},
+							pos: position{line: 1026, col: 31, offset: 35874},
 							expr: &ruleRefExpr{
-								pos:  position{line: 980, col: 32, offset: 34131},
+								pos:  position{line: 1026, col: 32, offset: 35875},
 								name: "EOL",
 							},
 						},
@@ -6335,20 +6825,20 @@ var g = &grammar{
 		},
 		{
 			name: "QuotedText",
-			pos:  position{line: 987, col: 1, offset: 34422},
+			pos:  position{line: 1033, col: 1, offset: 36175},
 			expr: &choiceExpr{
-				pos: position{line: 987, col: 15, offset: 34436},
+				pos: position{line: 1033, col: 15, offset: 36189},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 987, col: 15, offset: 34436},
+						pos:  position{line: 1033, col: 15, offset: 36189},
 						name: "UnconstrainedQuotedText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 987, col: 41, offset: 34462},
+						pos:  position{line: 1033, col: 41, offset: 36215},
 						name: "ConstrainedQuotedText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 987, col: 65, offset: 34486},
+						pos:  position{line: 1033, col: 65, offset: 36239},
 						name: "EscapedQuotedText",
 					},
 				},
@@ -6356,23 +6846,23 @@ var g = &grammar{
 		},
 		{
 			name: "ConstrainedQuotedTextMarker",
-			pos:  position{line: 989, col: 1, offset: 34507},
+			pos:  position{line: 1035, col: 1, offset: 36258},
 			expr: &choiceExpr{
-				pos: position{line: 989, col: 32, offset: 34538},
+				pos: position{line: 1035, col: 32, offset: 36289},
 				alternatives: []interface{}{
 					&seqExpr{
-						pos: position{line: 989, col: 32, offset: 34538},
+						pos: position{line: 1035, col: 32, offset: 36289},
 						exprs: []interface{}{
 							&litMatcher{
-								pos:        position{line: 989, col: 32, offset: 34538},
+								pos:        position{line: 1035, col: 32, offset: 36289},
 								val:        "*",
 								ignoreCase: false,
 								want:       "\"*\"",
 							},
 							&notExpr{
-								pos: position{line: 989, col: 36, offset: 34542},
+								pos: position{line: 1035, col: 36, offset: 36293},
 								expr: &litMatcher{
-									pos:        position{line: 989, col: 37, offset: 34543},
+									pos:        position{line: 1035, col: 37, offset: 36294},
 									val:        "*",
 									ignoreCase: false,
 									want:       "\"*\"",
@@ -6381,18 +6871,18 @@ var g = &grammar{
 						},
 					},
 					&seqExpr{
-						pos: position{line: 989, col: 43, offset: 34549},
+						pos: position{line: 1035, col: 43, offset: 36300},
 						exprs: []interface{}{
 							&litMatcher{
-								pos:        position{line: 989, col: 43, offset: 34549},
+								pos:        position{line: 1035, col: 43, offset: 36300},
 								val:        "_",
 								ignoreCase: false,
 								want:       "\"_\"",
 							},
 							&notExpr{
-								pos: position{line: 989, col: 47, offset: 34553},
+								pos: position{line: 1035, col: 47, offset: 36304},
 								expr: &litMatcher{
-									pos:        position{line: 989, col: 48, offset: 34554},
+									pos:        position{line: 1035, col: 48, offset: 36305},
 									val:        "_",
 									ignoreCase: false,
 									want:       "\"_\"",
@@ -6401,18 +6891,18 @@ var g = &grammar{
 						},
 					},
 					&seqExpr{
-						pos: position{line: 989, col: 54, offset: 34560},
+						pos: position{line: 1035, col: 54, offset: 36311},
 						exprs: []interface{}{
 							&litMatcher{
-								pos:        position{line: 989, col: 54, offset: 34560},
+								pos:        position{line: 1035, col: 54, offset: 36311},
 								val:        "#",
 								ignoreCase: false,
 								want:       "\"#\"",
 							},
 							&notExpr{
-								pos: position{line: 989, col: 58, offset: 34564},
+								pos: position{line: 1035, col: 58, offset: 36315},
 								expr: &litMatcher{
-									pos:        position{line: 989, col: 59, offset: 34565},
+									pos:        position{line: 1035, col: 59, offset: 36316},
 									val:        "#",
 									ignoreCase: false,
 									want:       "\"#\"",
@@ -6421,18 +6911,18 @@ var g = &grammar{
 						},
 					},
 					&seqExpr{
-						pos: position{line: 989, col: 65, offset: 34571},
+						pos: position{line: 1035, col: 65, offset: 36322},
 						exprs: []interface{}{
 							&litMatcher{
-								pos:        position{line: 989, col: 65, offset: 34571},
+								pos:        position{line: 1035, col: 65, offset: 36322},
 								val:        "`",
 								ignoreCase: false,
 								want:       "\"`\"",
 							},
 							&notExpr{
-								pos: position{line: 989, col: 69, offset: 34575},
+								pos: position{line: 1035, col: 69, offset: 36326},
 								expr: &litMatcher{
-									pos:        position{line: 989, col: 70, offset: 34576},
+									pos:        position{line: 1035, col: 70, offset: 36327},
 									val:        "`",
 									ignoreCase: false,
 									want:       "\"`\"",
@@ -6445,42 +6935,42 @@ var g = &grammar{
 		},
 		{
 			name: "UnconstrainedQuotedTextPrefix",
-			pos:  position{line: 991, col: 1, offset: 34583},
+			pos:  position{line: 1037, col: 1, offset: 36332},
 			expr: &choiceExpr{
-				pos: position{line: 991, col: 34, offset: 34616},
+				pos: position{line: 1037, col: 34, offset: 36365},
 				alternatives: []interface{}{
 					&litMatcher{
-						pos:        position{line: 991, col: 34, offset: 34616},
+						pos:        position{line: 1037, col: 34, offset: 36365},
 						val:        "**",
 						ignoreCase: false,
 						want:       "\"**\"",
 					},
 					&litMatcher{
-						pos:        position{line: 991, col: 41, offset: 34623},
+						pos:        position{line: 1037, col: 41, offset: 36372},
 						val:        "__",
 						ignoreCase: false,
 						want:       "\"__\"",
 					},
 					&litMatcher{
-						pos:        position{line: 991, col: 48, offset: 34630},
+						pos:        position{line: 1037, col: 48, offset: 36379},
 						val:        "``",
 						ignoreCase: false,
 						want:       "\"``\"",
 					},
 					&litMatcher{
-						pos:        position{line: 991, col: 55, offset: 34637},
+						pos:        position{line: 1037, col: 55, offset: 36386},
 						val:        "##",
 						ignoreCase: false,
 						want:       "\"##\"",
 					},
 					&litMatcher{
-						pos:        position{line: 991, col: 62, offset: 34644},
+						pos:        position{line: 1037, col: 62, offset: 36393},
 						val:        "^",
 						ignoreCase: false,
 						want:       "\"^\"",
 					},
 					&litMatcher{
-						pos:        position{line: 991, col: 68, offset: 34650},
+						pos:        position{line: 1037, col: 68, offset: 36399},
 						val:        "~",
 						ignoreCase: false,
 						want:       "\"~\"",
@@ -6490,42 +6980,42 @@ var g = &grammar{
 		},
 		{
 			name: "ConstrainedQuotedText",
-			pos:  position{line: 993, col: 1, offset: 34657},
+			pos:  position{line: 1039, col: 1, offset: 36404},
 			expr: &actionExpr{
-				pos: position{line: 993, col: 26, offset: 34682},
+				pos: position{line: 1039, col: 26, offset: 36429},
 				run: (*parser).callonConstrainedQuotedText1,
 				expr: &labeledExpr{
-					pos:   position{line: 993, col: 26, offset: 34682},
+					pos:   position{line: 1039, col: 26, offset: 36429},
 					label: "text",
 					expr: &choiceExpr{
-						pos: position{line: 993, col: 32, offset: 34688},
+						pos: position{line: 1039, col: 32, offset: 36435},
 						alternatives: []interface{}{
 							&ruleRefExpr{
-								pos:  position{line: 993, col: 32, offset: 34688},
+								pos:  position{line: 1039, col: 32, offset: 36435},
 								name: "SingleQuoteBoldText",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 994, col: 15, offset: 34724},
+								pos:  position{line: 1040, col: 15, offset: 36470},
 								name: "SingleQuoteItalicText",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 995, col: 15, offset: 34761},
+								pos:  position{line: 1041, col: 15, offset: 36506},
 								name: "SingleQuoteMarkedText",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 996, col: 15, offset: 34798},
+								pos:  position{line: 1042, col: 15, offset: 36542},
 								name: "SingleQuoteMonospaceText",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 997, col: 15, offset: 34839},
+								pos:  position{line: 1043, col: 15, offset: 36582},
 								name: "SubscriptText",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 998, col: 15, offset: 34869},
+								pos:  position{line: 1044, col: 15, offset: 36611},
 								name: "SuperscriptText",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 999, col: 15, offset: 34901},
+								pos:  position{line: 1045, col: 15, offset: 36642},
 								name: "SubscriptOrSuperscriptPrefix",
 							},
 						},
@@ -6535,24 +7025,24 @@ var g = &grammar{
 		},
 		{
 			name: "UnconstrainedQuotedText",
-			pos:  position{line: 1003, col: 1, offset: 35059},
+			pos:  position{line: 1049, col: 1, offset: 36796},
 			expr: &choiceExpr{
-				pos: position{line: 1003, col: 28, offset: 35086},
+				pos: position{line: 1049, col: 28, offset: 36823},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1003, col: 28, offset: 35086},
+						pos:  position{line: 1049, col: 28, offset: 36823},
 						name: "DoubleQuoteBoldText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1004, col: 15, offset: 35121},
+						pos:  position{line: 1050, col: 15, offset: 36857},
 						name: "DoubleQuoteItalicText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1005, col: 15, offset: 35158},
+						pos:  position{line: 1051, col: 15, offset: 36893},
 						name: "DoubleQuoteMarkedText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1006, col: 15, offset: 35195},
+						pos:  position{line: 1052, col: 15, offset: 36929},
 						name: "DoubleQuoteMonospaceText",
 					},
 				},
@@ -6560,32 +7050,32 @@ var g = &grammar{
 		},
 		{
 			name: "EscapedQuotedText",
-			pos:  position{line: 1008, col: 1, offset: 35223},
+			pos:  position{line: 1054, col: 1, offset: 36955},
 			expr: &choiceExpr{
-				pos: position{line: 1008, col: 22, offset: 35244},
+				pos: position{line: 1054, col: 22, offset: 36976},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1008, col: 22, offset: 35244},
+						pos:  position{line: 1054, col: 22, offset: 36976},
 						name: "EscapedBoldText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1009, col: 15, offset: 35276},
+						pos:  position{line: 1055, col: 15, offset: 37007},
 						name: "EscapedItalicText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1010, col: 15, offset: 35309},
+						pos:  position{line: 1056, col: 15, offset: 37039},
 						name: "EscapedMarkedText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1011, col: 15, offset: 35342},
+						pos:  position{line: 1057, col: 15, offset: 37071},
 						name: "EscapedMonospaceText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1012, col: 15, offset: 35379},
+						pos:  position{line: 1058, col: 15, offset: 37107},
 						name: "EscapedSubscriptText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1013, col: 15, offset: 35416},
+						pos:  position{line: 1059, col: 15, offset: 37143},
 						name: "EscapedSuperscriptText",
 					},
 				},
@@ -6593,21 +7083,21 @@ var g = &grammar{
 		},
 		{
 			name: "SubscriptOrSuperscriptPrefix",
-			pos:  position{line: 1015, col: 1, offset: 35442},
+			pos:  position{line: 1061, col: 1, offset: 37167},
 			expr: &choiceExpr{
-				pos: position{line: 1015, col: 33, offset: 35474},
+				pos: position{line: 1061, col: 33, offset: 37199},
 				alternatives: []interface{}{
 					&litMatcher{
-						pos:        position{line: 1015, col: 33, offset: 35474},
+						pos:        position{line: 1061, col: 33, offset: 37199},
 						val:        "^",
 						ignoreCase: false,
 						want:       "\"^\"",
 					},
 					&actionExpr{
-						pos: position{line: 1015, col: 39, offset: 35480},
+						pos: position{line: 1061, col: 39, offset: 37205},
 						run: (*parser).callonSubscriptOrSuperscriptPrefix3,
 						expr: &litMatcher{
-							pos:        position{line: 1015, col: 39, offset: 35480},
+							pos:        position{line: 1061, col: 39, offset: 37205},
 							val:        "~",
 							ignoreCase: false,
 							want:       "\"~\"",
@@ -6618,14 +7108,14 @@ var g = &grammar{
 		},
 		{
 			name: "OneOrMoreBackslashes",
-			pos:  position{line: 1019, col: 1, offset: 35617},
+			pos:  position{line: 1065, col: 1, offset: 37338},
 			expr: &actionExpr{
-				pos: position{line: 1019, col: 25, offset: 35641},
+				pos: position{line: 1065, col: 25, offset: 37362},
 				run: (*parser).callonOneOrMoreBackslashes1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 1019, col: 25, offset: 35641},
+					pos: position{line: 1065, col: 25, offset: 37362},
 					expr: &litMatcher{
-						pos:        position{line: 1019, col: 25, offset: 35641},
+						pos:        position{line: 1065, col: 25, offset: 37362},
 						val:        "\\",
 						ignoreCase: false,
 						want:       "\"\\\\\"",
@@ -6635,23 +7125,23 @@ var g = &grammar{
 		},
 		{
 			name: "TwoOrMoreBackslashes",
-			pos:  position{line: 1023, col: 1, offset: 35686},
+			pos:  position{line: 1069, col: 1, offset: 37403},
 			expr: &actionExpr{
-				pos: position{line: 1023, col: 25, offset: 35710},
+				pos: position{line: 1069, col: 25, offset: 37427},
 				run: (*parser).callonTwoOrMoreBackslashes1,
 				expr: &seqExpr{
-					pos: position{line: 1023, col: 25, offset: 35710},
+					pos: position{line: 1069, col: 25, offset: 37427},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 1023, col: 25, offset: 35710},
+							pos:        position{line: 1069, col: 25, offset: 37427},
 							val:        "\\\\",
 							ignoreCase: false,
 							want:       "\"\\\\\\\\\"",
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 1023, col: 30, offset: 35715},
+							pos: position{line: 1069, col: 30, offset: 37432},
 							expr: &litMatcher{
-								pos:        position{line: 1023, col: 30, offset: 35715},
+								pos:        position{line: 1069, col: 30, offset: 37432},
 								val:        "\\",
 								ignoreCase: false,
 								want:       "\"\\\\\"",
@@ -6663,16 +7153,16 @@ var g = &grammar{
 		},
 		{
 			name: "BoldText",
-			pos:  position{line: 1031, col: 1, offset: 35820},
+			pos:  position{line: 1077, col: 1, offset: 37529},
 			expr: &choiceExpr{
-				pos: position{line: 1031, col: 13, offset: 35832},
+				pos: position{line: 1077, col: 13, offset: 37541},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1031, col: 13, offset: 35832},
+						pos:  position{line: 1077, col: 13, offset: 37541},
 						name: "DoubleQuoteBoldText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1031, col: 35, offset: 35854},
+						pos:  position{line: 1077, col: 35, offset: 37563},
 						name: "SingleQuoteBoldText",
 					},
 				},
@@ -6680,49 +7170,49 @@ var g = &grammar{
 		},
 		{
 			name: "DoubleQuoteBoldText",
-			pos:  position{line: 1033, col: 1, offset: 35923},
+			pos:  position{line: 1079, col: 1, offset: 37630},
 			expr: &actionExpr{
-				pos: position{line: 1033, col: 24, offset: 35946},
+				pos: position{line: 1079, col: 24, offset: 37653},
 				run: (*parser).callonDoubleQuoteBoldText1,
 				expr: &seqExpr{
-					pos: position{line: 1033, col: 24, offset: 35946},
+					pos: position{line: 1079, col: 24, offset: 37653},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1033, col: 24, offset: 35946},
+							pos:   position{line: 1079, col: 24, offset: 37653},
 							label: "attrs",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1033, col: 30, offset: 35952},
+								pos: position{line: 1079, col: 30, offset: 37659},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1033, col: 31, offset: 35953},
+									pos:  position{line: 1079, col: 31, offset: 37660},
 									name: "QuotedTextAttrs",
 								},
 							},
 						},
 						&notExpr{
-							pos: position{line: 1033, col: 49, offset: 35971},
+							pos: position{line: 1079, col: 49, offset: 37678},
 							expr: &litMatcher{
-								pos:        position{line: 1033, col: 50, offset: 35972},
+								pos:        position{line: 1079, col: 50, offset: 37679},
 								val:        "\\\\",
 								ignoreCase: false,
 								want:       "\"\\\\\\\\\"",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1033, col: 55, offset: 35977},
+							pos:        position{line: 1079, col: 55, offset: 37684},
 							val:        "**",
 							ignoreCase: false,
 							want:       "\"**\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 1033, col: 60, offset: 35982},
+							pos:   position{line: 1079, col: 60, offset: 37689},
 							label: "elements",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1033, col: 70, offset: 35992},
+								pos:  position{line: 1079, col: 70, offset: 37699},
 								name: "DoubleQuoteBoldTextElements",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1033, col: 99, offset: 36021},
+							pos:        position{line: 1079, col: 99, offset: 37728},
 							val:        "**",
 							ignoreCase: false,
 							want:       "\"**\"",
@@ -6733,37 +7223,37 @@ var g = &grammar{
 		},
 		{
 			name: "DoubleQuoteBoldTextElements",
-			pos:  position{line: 1037, col: 1, offset: 36112},
+			pos:  position{line: 1083, col: 1, offset: 37831},
 			expr: &seqExpr{
-				pos: position{line: 1037, col: 32, offset: 36143},
+				pos: position{line: 1083, col: 32, offset: 37862},
 				exprs: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1037, col: 32, offset: 36143},
+						pos:  position{line: 1083, col: 32, offset: 37862},
 						name: "DoubleQuoteBoldTextElement",
 					},
 					&zeroOrMoreExpr{
-						pos: position{line: 1037, col: 59, offset: 36170},
+						pos: position{line: 1083, col: 59, offset: 37889},
 						expr: &seqExpr{
-							pos: position{line: 1037, col: 60, offset: 36171},
+							pos: position{line: 1083, col: 60, offset: 37890},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 1037, col: 60, offset: 36171},
+									pos: position{line: 1083, col: 60, offset: 37890},
 									expr: &litMatcher{
-										pos:        position{line: 1037, col: 62, offset: 36173},
+										pos:        position{line: 1083, col: 62, offset: 37892},
 										val:        "**",
 										ignoreCase: false,
 										want:       "\"**\"",
 									},
 								},
 								&choiceExpr{
-									pos: position{line: 1037, col: 69, offset: 36180},
+									pos: position{line: 1083, col: 69, offset: 37899},
 									alternatives: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 1037, col: 69, offset: 36180},
+											pos:  position{line: 1083, col: 69, offset: 37899},
 											name: "Space",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 1037, col: 77, offset: 36188},
+											pos:  position{line: 1083, col: 77, offset: 37907},
 											name: "DoubleQuoteBoldTextElement",
 										},
 									},
@@ -6776,68 +7266,68 @@ var g = &grammar{
 		},
 		{
 			name: "DoubleQuoteBoldTextElement",
-			pos:  position{line: 1039, col: 1, offset: 36255},
+			pos:  position{line: 1085, col: 1, offset: 37972},
 			expr: &choiceExpr{
-				pos: position{line: 1039, col: 31, offset: 36285},
+				pos: position{line: 1085, col: 31, offset: 38002},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1039, col: 31, offset: 36285},
+						pos:  position{line: 1085, col: 31, offset: 38002},
 						name: "Word",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1040, col: 11, offset: 36302},
+						pos:  position{line: 1086, col: 11, offset: 38018},
 						name: "SingleQuoteBoldText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1041, col: 11, offset: 36334},
+						pos:  position{line: 1087, col: 11, offset: 38049},
 						name: "ItalicText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1042, col: 11, offset: 36356},
+						pos:  position{line: 1088, col: 11, offset: 38070},
 						name: "MarkedText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1043, col: 11, offset: 36378},
+						pos:  position{line: 1089, col: 11, offset: 38091},
 						name: "MonospaceText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1044, col: 11, offset: 36403},
+						pos:  position{line: 1090, col: 11, offset: 38115},
 						name: "SubscriptText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1045, col: 11, offset: 36428},
+						pos:  position{line: 1091, col: 11, offset: 38139},
 						name: "SuperscriptText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1046, col: 11, offset: 36455},
+						pos:  position{line: 1092, col: 11, offset: 38165},
 						name: "InlineIcon",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1047, col: 11, offset: 36477},
+						pos:  position{line: 1093, col: 11, offset: 38186},
 						name: "InlineImage",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1048, col: 11, offset: 36500},
+						pos:  position{line: 1094, col: 11, offset: 38208},
 						name: "Link",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1049, col: 11, offset: 36516},
+						pos:  position{line: 1095, col: 11, offset: 38223},
 						name: "InlinePassthrough",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1050, col: 11, offset: 36545},
+						pos:  position{line: 1096, col: 11, offset: 38251},
 						name: "QuotedString",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1051, col: 11, offset: 36569},
+						pos:  position{line: 1097, col: 11, offset: 38274},
 						name: "AttributeSubstitution",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1052, col: 11, offset: 36602},
+						pos:  position{line: 1098, col: 11, offset: 38306},
 						name: "DoubleQuoteBoldTextStringElement",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1053, col: 11, offset: 36646},
+						pos:  position{line: 1099, col: 11, offset: 38349},
 						name: "DoubleQuoteBoldTextFallbackCharacter",
 					},
 				},
@@ -6845,26 +7335,26 @@ var g = &grammar{
 		},
 		{
 			name: "DoubleQuoteBoldTextStringElement",
-			pos:  position{line: 1056, col: 1, offset: 36688},
+			pos:  position{line: 1102, col: 1, offset: 38388},
 			expr: &actionExpr{
-				pos: position{line: 1056, col: 37, offset: 36724},
+				pos: position{line: 1102, col: 37, offset: 38424},
 				run: (*parser).callonDoubleQuoteBoldTextStringElement1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 1056, col: 37, offset: 36724},
+					pos: position{line: 1102, col: 37, offset: 38424},
 					expr: &seqExpr{
-						pos: position{line: 1056, col: 38, offset: 36725},
+						pos: position{line: 1102, col: 38, offset: 38425},
 						exprs: []interface{}{
 							&notExpr{
-								pos: position{line: 1056, col: 38, offset: 36725},
+								pos: position{line: 1102, col: 38, offset: 38425},
 								expr: &litMatcher{
-									pos:        position{line: 1056, col: 39, offset: 36726},
+									pos:        position{line: 1102, col: 39, offset: 38426},
 									val:        "**",
 									ignoreCase: false,
 									want:       "\"**\"",
 								},
 							},
 							&charClassMatcher{
-								pos:        position{line: 1056, col: 44, offset: 36731},
+								pos:        position{line: 1102, col: 44, offset: 38431},
 								val:        "[^\\r\\n ^~{}]",
 								chars:      []rune{'\r', '\n', ' ', '^', '~', '{', '}'},
 								ignoreCase: false,
@@ -6877,31 +7367,31 @@ var g = &grammar{
 		},
 		{
 			name: "DoubleQuoteBoldTextFallbackCharacter",
-			pos:  position{line: 1060, col: 1, offset: 36806},
+			pos:  position{line: 1106, col: 1, offset: 38518},
 			expr: &choiceExpr{
-				pos: position{line: 1061, col: 5, offset: 36852},
+				pos: position{line: 1107, col: 5, offset: 38563},
 				alternatives: []interface{}{
 					&charClassMatcher{
-						pos:        position{line: 1061, col: 5, offset: 36852},
+						pos:        position{line: 1107, col: 5, offset: 38563},
 						val:        "[^\\r\\n*]",
 						chars:      []rune{'\r', '\n', '*'},
 						ignoreCase: false,
 						inverted:   true,
 					},
 					&actionExpr{
-						pos: position{line: 1062, col: 7, offset: 36950},
+						pos: position{line: 1108, col: 7, offset: 38660},
 						run: (*parser).callonDoubleQuoteBoldTextFallbackCharacter3,
 						expr: &seqExpr{
-							pos: position{line: 1062, col: 7, offset: 36950},
+							pos: position{line: 1108, col: 7, offset: 38660},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 1062, col: 7, offset: 36950},
+									pos:        position{line: 1108, col: 7, offset: 38660},
 									val:        "**",
 									ignoreCase: false,
 									want:       "\"**\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1062, col: 12, offset: 36955},
+									pos:  position{line: 1108, col: 12, offset: 38665},
 									name: "Alphanums",
 								},
 							},
@@ -6912,49 +7402,49 @@ var g = &grammar{
 		},
 		{
 			name: "SingleQuoteBoldText",
-			pos:  position{line: 1066, col: 1, offset: 37122},
+			pos:  position{line: 1112, col: 1, offset: 38844},
 			expr: &choiceExpr{
-				pos: position{line: 1066, col: 24, offset: 37145},
+				pos: position{line: 1112, col: 24, offset: 38867},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 1066, col: 24, offset: 37145},
+						pos: position{line: 1112, col: 24, offset: 38867},
 						run: (*parser).callonSingleQuoteBoldText2,
 						expr: &seqExpr{
-							pos: position{line: 1066, col: 24, offset: 37145},
+							pos: position{line: 1112, col: 24, offset: 38867},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 1066, col: 24, offset: 37145},
+									pos:   position{line: 1112, col: 24, offset: 38867},
 									label: "attrs",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 1066, col: 30, offset: 37151},
+										pos: position{line: 1112, col: 30, offset: 38873},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1066, col: 31, offset: 37152},
+											pos:  position{line: 1112, col: 31, offset: 38874},
 											name: "QuotedTextAttrs",
 										},
 									},
 								},
 								&seqExpr{
-									pos: position{line: 1066, col: 50, offset: 37171},
+									pos: position{line: 1112, col: 50, offset: 38893},
 									exprs: []interface{}{
 										&notExpr{
-											pos: position{line: 1066, col: 50, offset: 37171},
+											pos: position{line: 1112, col: 50, offset: 38893},
 											expr: &litMatcher{
-												pos:        position{line: 1066, col: 51, offset: 37172},
+												pos:        position{line: 1112, col: 51, offset: 38894},
 												val:        "\\",
 												ignoreCase: false,
 												want:       "\"\\\\\"",
 											},
 										},
 										&litMatcher{
-											pos:        position{line: 1066, col: 55, offset: 37176},
+											pos:        position{line: 1112, col: 55, offset: 38898},
 											val:        "*",
 											ignoreCase: false,
 											want:       "\"*\"",
 										},
 										&notExpr{
-											pos: position{line: 1066, col: 59, offset: 37180},
+											pos: position{line: 1112, col: 59, offset: 38902},
 											expr: &litMatcher{
-												pos:        position{line: 1066, col: 60, offset: 37181},
+												pos:        position{line: 1112, col: 60, offset: 38903},
 												val:        "*",
 												ignoreCase: false,
 												want:       "\"*\"",
@@ -6963,25 +7453,25 @@ var g = &grammar{
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 1066, col: 65, offset: 37186},
+									pos:   position{line: 1112, col: 65, offset: 38908},
 									label: "elements",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1066, col: 75, offset: 37196},
+										pos:  position{line: 1112, col: 75, offset: 38918},
 										name: "SingleQuoteBoldTextElements",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1066, col: 104, offset: 37225},
+									pos:        position{line: 1112, col: 104, offset: 38947},
 									val:        "*",
 									ignoreCase: false,
 									want:       "\"*\"",
 								},
 								&andExpr{
-									pos: position{line: 1066, col: 108, offset: 37229},
+									pos: position{line: 1112, col: 108, offset: 38951},
 									expr: &notExpr{
-										pos: position{line: 1066, col: 110, offset: 37231},
+										pos: position{line: 1112, col: 110, offset: 38953},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1066, col: 111, offset: 37232},
+											pos:  position{line: 1112, col: 111, offset: 38954},
 											name: "Alphanum",
 										},
 									},
@@ -6990,58 +7480,58 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1068, col: 5, offset: 37428},
+						pos: position{line: 1114, col: 5, offset: 39164},
 						run: (*parser).callonSingleQuoteBoldText19,
 						expr: &seqExpr{
-							pos: position{line: 1068, col: 5, offset: 37428},
+							pos: position{line: 1114, col: 5, offset: 39164},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 1068, col: 5, offset: 37428},
+									pos:   position{line: 1114, col: 5, offset: 39164},
 									label: "attrs",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 1068, col: 11, offset: 37434},
+										pos: position{line: 1114, col: 11, offset: 39170},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1068, col: 12, offset: 37435},
+											pos:  position{line: 1114, col: 12, offset: 39171},
 											name: "QuotedTextAttrs",
 										},
 									},
 								},
 								&notExpr{
-									pos: position{line: 1068, col: 30, offset: 37453},
+									pos: position{line: 1114, col: 30, offset: 39189},
 									expr: &litMatcher{
-										pos:        position{line: 1068, col: 31, offset: 37454},
+										pos:        position{line: 1114, col: 31, offset: 39190},
 										val:        "\\\\",
 										ignoreCase: false,
 										want:       "\"\\\\\\\\\"",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1068, col: 36, offset: 37459},
+									pos:        position{line: 1114, col: 36, offset: 39195},
 									val:        "*",
 									ignoreCase: false,
 									want:       "\"*\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1068, col: 40, offset: 37463},
+									pos:   position{line: 1114, col: 40, offset: 39199},
 									label: "elements",
 									expr: &seqExpr{
-										pos: position{line: 1068, col: 50, offset: 37473},
+										pos: position{line: 1114, col: 50, offset: 39209},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 1068, col: 50, offset: 37473},
+												pos:        position{line: 1114, col: 50, offset: 39209},
 												val:        "*",
 												ignoreCase: false,
 												want:       "\"*\"",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 1068, col: 54, offset: 37477},
+												pos:  position{line: 1114, col: 54, offset: 39213},
 												name: "SingleQuoteBoldTextElements",
 											},
 										},
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1068, col: 83, offset: 37506},
+									pos:        position{line: 1114, col: 83, offset: 39242},
 									val:        "*",
 									ignoreCase: false,
 									want:       "\"*\"",
@@ -7054,21 +7544,21 @@ var g = &grammar{
 		},
 		{
 			name: "SingleQuoteBoldTextElements",
-			pos:  position{line: 1072, col: 1, offset: 37716},
+			pos:  position{line: 1118, col: 1, offset: 39464},
 			expr: &seqExpr{
-				pos: position{line: 1072, col: 32, offset: 37747},
+				pos: position{line: 1118, col: 32, offset: 39495},
 				exprs: []interface{}{
 					&notExpr{
-						pos: position{line: 1072, col: 32, offset: 37747},
+						pos: position{line: 1118, col: 32, offset: 39495},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1072, col: 33, offset: 37748},
+							pos:  position{line: 1118, col: 33, offset: 39496},
 							name: "Space",
 						},
 					},
 					&oneOrMoreExpr{
-						pos: position{line: 1072, col: 39, offset: 37754},
+						pos: position{line: 1118, col: 39, offset: 39502},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1072, col: 39, offset: 37754},
+							pos:  position{line: 1118, col: 39, offset: 39502},
 							name: "SingleQuoteBoldTextElement",
 						},
 					},
@@ -7077,43 +7567,43 @@ var g = &grammar{
 		},
 		{
 			name: "SingleQuoteBoldTextElement",
-			pos:  position{line: 1074, col: 1, offset: 37785},
+			pos:  position{line: 1120, col: 1, offset: 39531},
 			expr: &choiceExpr{
-				pos: position{line: 1074, col: 31, offset: 37815},
+				pos: position{line: 1120, col: 31, offset: 39561},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1074, col: 31, offset: 37815},
+						pos:  position{line: 1120, col: 31, offset: 39561},
 						name: "Word",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1075, col: 11, offset: 37832},
+						pos:  position{line: 1121, col: 11, offset: 39577},
 						name: "DoubleQuoteBoldText",
 					},
 					&seqExpr{
-						pos: position{line: 1076, col: 11, offset: 37863},
+						pos: position{line: 1122, col: 11, offset: 39607},
 						exprs: []interface{}{
 							&oneOrMoreExpr{
-								pos: position{line: 1076, col: 11, offset: 37863},
+								pos: position{line: 1122, col: 11, offset: 39607},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1076, col: 11, offset: 37863},
+									pos:  position{line: 1122, col: 11, offset: 39607},
 									name: "Space",
 								},
 							},
 							&zeroOrOneExpr{
-								pos: position{line: 1076, col: 18, offset: 37870},
+								pos: position{line: 1122, col: 18, offset: 39614},
 								expr: &seqExpr{
-									pos: position{line: 1076, col: 19, offset: 37871},
+									pos: position{line: 1122, col: 19, offset: 39615},
 									exprs: []interface{}{
 										&litMatcher{
-											pos:        position{line: 1076, col: 19, offset: 37871},
+											pos:        position{line: 1122, col: 19, offset: 39615},
 											val:        "*",
 											ignoreCase: false,
 											want:       "\"*\"",
 										},
 										&notExpr{
-											pos: position{line: 1076, col: 23, offset: 37875},
+											pos: position{line: 1122, col: 23, offset: 39619},
 											expr: &litMatcher{
-												pos:        position{line: 1076, col: 24, offset: 37876},
+												pos:        position{line: 1122, col: 24, offset: 39620},
 												val:        "*",
 												ignoreCase: false,
 												want:       "\"*\"",
@@ -7125,55 +7615,55 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1077, col: 11, offset: 37893},
+						pos:  position{line: 1123, col: 11, offset: 39636},
 						name: "ItalicText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1078, col: 11, offset: 37915},
+						pos:  position{line: 1124, col: 11, offset: 39657},
 						name: "MarkedText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1079, col: 11, offset: 37937},
+						pos:  position{line: 1125, col: 11, offset: 39678},
 						name: "MonospaceText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1080, col: 11, offset: 37962},
+						pos:  position{line: 1126, col: 11, offset: 39702},
 						name: "SubscriptText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1081, col: 11, offset: 37987},
+						pos:  position{line: 1127, col: 11, offset: 39726},
 						name: "SuperscriptText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1082, col: 11, offset: 38014},
+						pos:  position{line: 1128, col: 11, offset: 39752},
 						name: "InlineIcon",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1083, col: 11, offset: 38036},
+						pos:  position{line: 1129, col: 11, offset: 39773},
 						name: "InlineImage",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1084, col: 11, offset: 38060},
+						pos:  position{line: 1130, col: 11, offset: 39796},
 						name: "Link",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1085, col: 11, offset: 38078},
+						pos:  position{line: 1131, col: 11, offset: 39813},
 						name: "InlinePassthrough",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1086, col: 11, offset: 38107},
+						pos:  position{line: 1132, col: 11, offset: 39841},
 						name: "QuotedString",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1087, col: 11, offset: 38131},
+						pos:  position{line: 1133, col: 11, offset: 39864},
 						name: "AttributeSubstitution",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1088, col: 11, offset: 38164},
+						pos:  position{line: 1134, col: 11, offset: 39896},
 						name: "SingleQuoteBoldTextStringElement",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1089, col: 11, offset: 38208},
+						pos:  position{line: 1135, col: 11, offset: 39939},
 						name: "SingleQuoteBoldTextFallbackCharacter",
 					},
 				},
@@ -7181,14 +7671,14 @@ var g = &grammar{
 		},
 		{
 			name: "SingleQuoteBoldTextStringElement",
-			pos:  position{line: 1091, col: 1, offset: 38248},
+			pos:  position{line: 1137, col: 1, offset: 39977},
 			expr: &actionExpr{
-				pos: position{line: 1091, col: 37, offset: 38284},
+				pos: position{line: 1137, col: 37, offset: 40013},
 				run: (*parser).callonSingleQuoteBoldTextStringElement1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 1091, col: 37, offset: 38284},
+					pos: position{line: 1137, col: 37, offset: 40013},
 					expr: &charClassMatcher{
-						pos:        position{line: 1091, col: 37, offset: 38284},
+						pos:        position{line: 1137, col: 37, offset: 40013},
 						val:        "[^\\r\\n{} *^~]",
 						chars:      []rune{'\r', '\n', '{', '}', ' ', '*', '^', '~'},
 						ignoreCase: false,
@@ -7199,31 +7689,31 @@ var g = &grammar{
 		},
 		{
 			name: "SingleQuoteBoldTextFallbackCharacter",
-			pos:  position{line: 1095, col: 1, offset: 38514},
+			pos:  position{line: 1141, col: 1, offset: 40255},
 			expr: &choiceExpr{
-				pos: position{line: 1096, col: 5, offset: 38560},
+				pos: position{line: 1142, col: 5, offset: 40300},
 				alternatives: []interface{}{
 					&charClassMatcher{
-						pos:        position{line: 1096, col: 5, offset: 38560},
+						pos:        position{line: 1142, col: 5, offset: 40300},
 						val:        "[^\\r\\n*]",
 						chars:      []rune{'\r', '\n', '*'},
 						ignoreCase: false,
 						inverted:   true,
 					},
 					&actionExpr{
-						pos: position{line: 1097, col: 7, offset: 38658},
+						pos: position{line: 1143, col: 7, offset: 40397},
 						run: (*parser).callonSingleQuoteBoldTextFallbackCharacter3,
 						expr: &seqExpr{
-							pos: position{line: 1097, col: 7, offset: 38658},
+							pos: position{line: 1143, col: 7, offset: 40397},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 1097, col: 7, offset: 38658},
+									pos:        position{line: 1143, col: 7, offset: 40397},
 									val:        "*",
 									ignoreCase: false,
 									want:       "\"*\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1097, col: 11, offset: 38662},
+									pos:  position{line: 1143, col: 11, offset: 40401},
 									name: "Alphanums",
 								},
 							},
@@ -7234,40 +7724,40 @@ var g = &grammar{
 		},
 		{
 			name: "EscapedBoldText",
-			pos:  position{line: 1101, col: 1, offset: 38829},
+			pos:  position{line: 1147, col: 1, offset: 40580},
 			expr: &choiceExpr{
-				pos: position{line: 1102, col: 5, offset: 38854},
+				pos: position{line: 1148, col: 5, offset: 40604},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 1102, col: 5, offset: 38854},
+						pos: position{line: 1148, col: 5, offset: 40604},
 						run: (*parser).callonEscapedBoldText2,
 						expr: &seqExpr{
-							pos: position{line: 1102, col: 5, offset: 38854},
+							pos: position{line: 1148, col: 5, offset: 40604},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 1102, col: 5, offset: 38854},
+									pos:   position{line: 1148, col: 5, offset: 40604},
 									label: "backslashes",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1102, col: 18, offset: 38867},
+										pos:  position{line: 1148, col: 18, offset: 40617},
 										name: "TwoOrMoreBackslashes",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1102, col: 40, offset: 38889},
+									pos:        position{line: 1148, col: 40, offset: 40639},
 									val:        "**",
 									ignoreCase: false,
 									want:       "\"**\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1102, col: 45, offset: 38894},
+									pos:   position{line: 1148, col: 45, offset: 40644},
 									label: "elements",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1102, col: 55, offset: 38904},
+										pos:  position{line: 1148, col: 55, offset: 40654},
 										name: "DoubleQuoteBoldTextElements",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1102, col: 84, offset: 38933},
+									pos:        position{line: 1148, col: 84, offset: 40683},
 									val:        "**",
 									ignoreCase: false,
 									want:       "\"**\"",
@@ -7276,35 +7766,35 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1104, col: 9, offset: 39092},
+						pos: position{line: 1150, col: 9, offset: 40840},
 						run: (*parser).callonEscapedBoldText10,
 						expr: &seqExpr{
-							pos: position{line: 1104, col: 9, offset: 39092},
+							pos: position{line: 1150, col: 9, offset: 40840},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 1104, col: 9, offset: 39092},
+									pos:   position{line: 1150, col: 9, offset: 40840},
 									label: "backslashes",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1104, col: 22, offset: 39105},
+										pos:  position{line: 1150, col: 22, offset: 40853},
 										name: "OneOrMoreBackslashes",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1104, col: 44, offset: 39127},
+									pos:        position{line: 1150, col: 44, offset: 40875},
 									val:        "**",
 									ignoreCase: false,
 									want:       "\"**\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1104, col: 49, offset: 39132},
+									pos:   position{line: 1150, col: 49, offset: 40880},
 									label: "elements",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1104, col: 59, offset: 39142},
+										pos:  position{line: 1150, col: 59, offset: 40890},
 										name: "SingleQuoteBoldTextElements",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1104, col: 88, offset: 39171},
+									pos:        position{line: 1150, col: 88, offset: 40919},
 									val:        "*",
 									ignoreCase: false,
 									want:       "\"*\"",
@@ -7313,35 +7803,35 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1107, col: 9, offset: 39374},
+						pos: position{line: 1153, col: 9, offset: 41119},
 						run: (*parser).callonEscapedBoldText18,
 						expr: &seqExpr{
-							pos: position{line: 1107, col: 9, offset: 39374},
+							pos: position{line: 1153, col: 9, offset: 41119},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 1107, col: 9, offset: 39374},
+									pos:   position{line: 1153, col: 9, offset: 41119},
 									label: "backslashes",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1107, col: 22, offset: 39387},
+										pos:  position{line: 1153, col: 22, offset: 41132},
 										name: "OneOrMoreBackslashes",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1107, col: 44, offset: 39409},
+									pos:        position{line: 1153, col: 44, offset: 41154},
 									val:        "*",
 									ignoreCase: false,
 									want:       "\"*\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1107, col: 48, offset: 39413},
+									pos:   position{line: 1153, col: 48, offset: 41158},
 									label: "elements",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1107, col: 58, offset: 39423},
+										pos:  position{line: 1153, col: 58, offset: 41168},
 										name: "SingleQuoteBoldTextElements",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1107, col: 87, offset: 39452},
+									pos:        position{line: 1153, col: 87, offset: 41197},
 									val:        "*",
 									ignoreCase: false,
 									want:       "\"*\"",
@@ -7354,16 +7844,16 @@ var g = &grammar{
 		},
 		{
 			name: "ItalicText",
-			pos:  position{line: 1115, col: 1, offset: 39668},
+			pos:  position{line: 1161, col: 1, offset: 41405},
 			expr: &choiceExpr{
-				pos: position{line: 1115, col: 15, offset: 39682},
+				pos: position{line: 1161, col: 15, offset: 41419},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1115, col: 15, offset: 39682},
+						pos:  position{line: 1161, col: 15, offset: 41419},
 						name: "DoubleQuoteItalicText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1115, col: 39, offset: 39706},
+						pos:  position{line: 1161, col: 39, offset: 41443},
 						name: "SingleQuoteItalicText",
 					},
 				},
@@ -7371,49 +7861,49 @@ var g = &grammar{
 		},
 		{
 			name: "DoubleQuoteItalicText",
-			pos:  position{line: 1117, col: 1, offset: 39731},
+			pos:  position{line: 1163, col: 1, offset: 41466},
 			expr: &actionExpr{
-				pos: position{line: 1117, col: 26, offset: 39756},
+				pos: position{line: 1163, col: 26, offset: 41491},
 				run: (*parser).callonDoubleQuoteItalicText1,
 				expr: &seqExpr{
-					pos: position{line: 1117, col: 26, offset: 39756},
+					pos: position{line: 1163, col: 26, offset: 41491},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1117, col: 26, offset: 39756},
+							pos:   position{line: 1163, col: 26, offset: 41491},
 							label: "attrs",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1117, col: 32, offset: 39762},
+								pos: position{line: 1163, col: 32, offset: 41497},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1117, col: 33, offset: 39763},
+									pos:  position{line: 1163, col: 33, offset: 41498},
 									name: "QuotedTextAttrs",
 								},
 							},
 						},
 						&notExpr{
-							pos: position{line: 1117, col: 51, offset: 39781},
+							pos: position{line: 1163, col: 51, offset: 41516},
 							expr: &litMatcher{
-								pos:        position{line: 1117, col: 52, offset: 39782},
+								pos:        position{line: 1163, col: 52, offset: 41517},
 								val:        "\\\\",
 								ignoreCase: false,
 								want:       "\"\\\\\\\\\"",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1117, col: 57, offset: 39787},
+							pos:        position{line: 1163, col: 57, offset: 41522},
 							val:        "__",
 							ignoreCase: false,
 							want:       "\"__\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 1117, col: 62, offset: 39792},
+							pos:   position{line: 1163, col: 62, offset: 41527},
 							label: "elements",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1117, col: 72, offset: 39802},
+								pos:  position{line: 1163, col: 72, offset: 41537},
 								name: "DoubleQuoteItalicTextElements",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1117, col: 103, offset: 39833},
+							pos:        position{line: 1163, col: 103, offset: 41568},
 							val:        "__",
 							ignoreCase: false,
 							want:       "\"__\"",
@@ -7424,37 +7914,37 @@ var g = &grammar{
 		},
 		{
 			name: "DoubleQuoteItalicTextElements",
-			pos:  position{line: 1121, col: 1, offset: 39971},
+			pos:  position{line: 1167, col: 1, offset: 41718},
 			expr: &seqExpr{
-				pos: position{line: 1121, col: 34, offset: 40004},
+				pos: position{line: 1167, col: 34, offset: 41751},
 				exprs: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1121, col: 34, offset: 40004},
+						pos:  position{line: 1167, col: 34, offset: 41751},
 						name: "DoubleQuoteItalicTextElement",
 					},
 					&zeroOrMoreExpr{
-						pos: position{line: 1121, col: 63, offset: 40033},
+						pos: position{line: 1167, col: 63, offset: 41780},
 						expr: &seqExpr{
-							pos: position{line: 1121, col: 64, offset: 40034},
+							pos: position{line: 1167, col: 64, offset: 41781},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 1121, col: 64, offset: 40034},
+									pos: position{line: 1167, col: 64, offset: 41781},
 									expr: &litMatcher{
-										pos:        position{line: 1121, col: 66, offset: 40036},
+										pos:        position{line: 1167, col: 66, offset: 41783},
 										val:        "__",
 										ignoreCase: false,
 										want:       "\"__\"",
 									},
 								},
 								&choiceExpr{
-									pos: position{line: 1121, col: 73, offset: 40043},
+									pos: position{line: 1167, col: 73, offset: 41790},
 									alternatives: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 1121, col: 73, offset: 40043},
+											pos:  position{line: 1167, col: 73, offset: 41790},
 											name: "Space",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 1121, col: 81, offset: 40051},
+											pos:  position{line: 1167, col: 81, offset: 41798},
 											name: "DoubleQuoteItalicTextElement",
 										},
 									},
@@ -7467,64 +7957,64 @@ var g = &grammar{
 		},
 		{
 			name: "DoubleQuoteItalicTextElement",
-			pos:  position{line: 1123, col: 1, offset: 40120},
+			pos:  position{line: 1169, col: 1, offset: 41865},
 			expr: &choiceExpr{
-				pos: position{line: 1123, col: 33, offset: 40152},
+				pos: position{line: 1169, col: 33, offset: 41897},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1123, col: 33, offset: 40152},
+						pos:  position{line: 1169, col: 33, offset: 41897},
 						name: "Word",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1124, col: 11, offset: 40169},
+						pos:  position{line: 1170, col: 11, offset: 41913},
 						name: "SingleQuoteItalicText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1125, col: 11, offset: 40203},
+						pos:  position{line: 1171, col: 11, offset: 41946},
 						name: "BoldText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1126, col: 11, offset: 40223},
+						pos:  position{line: 1172, col: 11, offset: 41965},
 						name: "MarkedText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1127, col: 11, offset: 40245},
+						pos:  position{line: 1173, col: 11, offset: 41986},
 						name: "MonospaceText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1128, col: 11, offset: 40270},
+						pos:  position{line: 1174, col: 11, offset: 42010},
 						name: "SubscriptText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1129, col: 11, offset: 40295},
+						pos:  position{line: 1175, col: 11, offset: 42034},
 						name: "SuperscriptText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1130, col: 11, offset: 40322},
+						pos:  position{line: 1176, col: 11, offset: 42060},
 						name: "InlineIcon",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1131, col: 11, offset: 40344},
+						pos:  position{line: 1177, col: 11, offset: 42081},
 						name: "InlineImage",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1132, col: 11, offset: 40368},
+						pos:  position{line: 1178, col: 11, offset: 42104},
 						name: "Link",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1133, col: 11, offset: 40385},
+						pos:  position{line: 1179, col: 11, offset: 42120},
 						name: "InlinePassthrough",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1134, col: 11, offset: 40414},
+						pos:  position{line: 1180, col: 11, offset: 42148},
 						name: "QuotedString",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1135, col: 11, offset: 40438},
+						pos:  position{line: 1181, col: 11, offset: 42171},
 						name: "DoubleQuoteItalicTextStringElement",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1136, col: 11, offset: 40484},
+						pos:  position{line: 1182, col: 11, offset: 42216},
 						name: "DoubleQuoteItalicTextFallbackCharacter",
 					},
 				},
@@ -7532,26 +8022,26 @@ var g = &grammar{
 		},
 		{
 			name: "DoubleQuoteItalicTextStringElement",
-			pos:  position{line: 1138, col: 1, offset: 40526},
+			pos:  position{line: 1184, col: 1, offset: 42256},
 			expr: &actionExpr{
-				pos: position{line: 1138, col: 39, offset: 40564},
+				pos: position{line: 1184, col: 39, offset: 42294},
 				run: (*parser).callonDoubleQuoteItalicTextStringElement1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 1138, col: 39, offset: 40564},
+					pos: position{line: 1184, col: 39, offset: 42294},
 					expr: &seqExpr{
-						pos: position{line: 1138, col: 40, offset: 40565},
+						pos: position{line: 1184, col: 40, offset: 42295},
 						exprs: []interface{}{
 							&notExpr{
-								pos: position{line: 1138, col: 40, offset: 40565},
+								pos: position{line: 1184, col: 40, offset: 42295},
 								expr: &litMatcher{
-									pos:        position{line: 1138, col: 41, offset: 40566},
+									pos:        position{line: 1184, col: 41, offset: 42296},
 									val:        "__",
 									ignoreCase: false,
 									want:       "\"__\"",
 								},
 							},
 							&charClassMatcher{
-								pos:        position{line: 1138, col: 46, offset: 40571},
+								pos:        position{line: 1184, col: 46, offset: 42301},
 								val:        "[^\\r\\n ^~{}]",
 								chars:      []rune{'\r', '\n', ' ', '^', '~', '{', '}'},
 								ignoreCase: false,
@@ -7564,31 +8054,31 @@ var g = &grammar{
 		},
 		{
 			name: "DoubleQuoteItalicTextFallbackCharacter",
-			pos:  position{line: 1142, col: 1, offset: 40646},
+			pos:  position{line: 1188, col: 1, offset: 42388},
 			expr: &choiceExpr{
-				pos: position{line: 1143, col: 5, offset: 40694},
+				pos: position{line: 1189, col: 5, offset: 42435},
 				alternatives: []interface{}{
 					&charClassMatcher{
-						pos:        position{line: 1143, col: 5, offset: 40694},
+						pos:        position{line: 1189, col: 5, offset: 42435},
 						val:        "[^\\r\\n_]",
 						chars:      []rune{'\r', '\n', '_'},
 						ignoreCase: false,
 						inverted:   true,
 					},
 					&actionExpr{
-						pos: position{line: 1144, col: 7, offset: 40794},
+						pos: position{line: 1190, col: 7, offset: 42534},
 						run: (*parser).callonDoubleQuoteItalicTextFallbackCharacter3,
 						expr: &seqExpr{
-							pos: position{line: 1144, col: 7, offset: 40794},
+							pos: position{line: 1190, col: 7, offset: 42534},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 1144, col: 7, offset: 40794},
+									pos:        position{line: 1190, col: 7, offset: 42534},
 									val:        "__",
 									ignoreCase: false,
 									want:       "\"__\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1144, col: 12, offset: 40799},
+									pos:  position{line: 1190, col: 12, offset: 42539},
 									name: "Alphanums",
 								},
 							},
@@ -7599,49 +8089,49 @@ var g = &grammar{
 		},
 		{
 			name: "SingleQuoteItalicText",
-			pos:  position{line: 1148, col: 1, offset: 40968},
+			pos:  position{line: 1194, col: 1, offset: 42720},
 			expr: &choiceExpr{
-				pos: position{line: 1148, col: 26, offset: 40993},
+				pos: position{line: 1194, col: 26, offset: 42745},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 1148, col: 26, offset: 40993},
+						pos: position{line: 1194, col: 26, offset: 42745},
 						run: (*parser).callonSingleQuoteItalicText2,
 						expr: &seqExpr{
-							pos: position{line: 1148, col: 26, offset: 40993},
+							pos: position{line: 1194, col: 26, offset: 42745},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 1148, col: 26, offset: 40993},
+									pos:   position{line: 1194, col: 26, offset: 42745},
 									label: "attrs",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 1148, col: 32, offset: 40999},
+										pos: position{line: 1194, col: 32, offset: 42751},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1148, col: 33, offset: 41000},
+											pos:  position{line: 1194, col: 33, offset: 42752},
 											name: "QuotedTextAttrs",
 										},
 									},
 								},
 								&seqExpr{
-									pos: position{line: 1148, col: 52, offset: 41019},
+									pos: position{line: 1194, col: 52, offset: 42771},
 									exprs: []interface{}{
 										&notExpr{
-											pos: position{line: 1148, col: 52, offset: 41019},
+											pos: position{line: 1194, col: 52, offset: 42771},
 											expr: &litMatcher{
-												pos:        position{line: 1148, col: 53, offset: 41020},
+												pos:        position{line: 1194, col: 53, offset: 42772},
 												val:        "\\",
 												ignoreCase: false,
 												want:       "\"\\\\\"",
 											},
 										},
 										&litMatcher{
-											pos:        position{line: 1148, col: 57, offset: 41024},
+											pos:        position{line: 1194, col: 57, offset: 42776},
 											val:        "_",
 											ignoreCase: false,
 											want:       "\"_\"",
 										},
 										&notExpr{
-											pos: position{line: 1148, col: 61, offset: 41028},
+											pos: position{line: 1194, col: 61, offset: 42780},
 											expr: &litMatcher{
-												pos:        position{line: 1148, col: 62, offset: 41029},
+												pos:        position{line: 1194, col: 62, offset: 42781},
 												val:        "_",
 												ignoreCase: false,
 												want:       "\"_\"",
@@ -7650,15 +8140,15 @@ var g = &grammar{
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 1148, col: 67, offset: 41034},
+									pos:   position{line: 1194, col: 67, offset: 42786},
 									label: "elements",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1148, col: 77, offset: 41044},
+										pos:  position{line: 1194, col: 77, offset: 42796},
 										name: "SingleQuoteItalicTextElements",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1148, col: 108, offset: 41075},
+									pos:        position{line: 1194, col: 108, offset: 42827},
 									val:        "_",
 									ignoreCase: false,
 									want:       "\"_\"",
@@ -7667,58 +8157,58 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1150, col: 5, offset: 41267},
+						pos: position{line: 1196, col: 5, offset: 43033},
 						run: (*parser).callonSingleQuoteItalicText16,
 						expr: &seqExpr{
-							pos: position{line: 1150, col: 5, offset: 41267},
+							pos: position{line: 1196, col: 5, offset: 43033},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 1150, col: 5, offset: 41267},
+									pos:   position{line: 1196, col: 5, offset: 43033},
 									label: "attrs",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 1150, col: 11, offset: 41273},
+										pos: position{line: 1196, col: 11, offset: 43039},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1150, col: 12, offset: 41274},
+											pos:  position{line: 1196, col: 12, offset: 43040},
 											name: "QuotedTextAttrs",
 										},
 									},
 								},
 								&notExpr{
-									pos: position{line: 1150, col: 30, offset: 41292},
+									pos: position{line: 1196, col: 30, offset: 43058},
 									expr: &litMatcher{
-										pos:        position{line: 1150, col: 31, offset: 41293},
+										pos:        position{line: 1196, col: 31, offset: 43059},
 										val:        "\\\\",
 										ignoreCase: false,
 										want:       "\"\\\\\\\\\"",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1150, col: 36, offset: 41298},
+									pos:        position{line: 1196, col: 36, offset: 43064},
 									val:        "_",
 									ignoreCase: false,
 									want:       "\"_\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1150, col: 40, offset: 41302},
+									pos:   position{line: 1196, col: 40, offset: 43068},
 									label: "elements",
 									expr: &seqExpr{
-										pos: position{line: 1150, col: 50, offset: 41312},
+										pos: position{line: 1196, col: 50, offset: 43078},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 1150, col: 50, offset: 41312},
+												pos:        position{line: 1196, col: 50, offset: 43078},
 												val:        "_",
 												ignoreCase: false,
 												want:       "\"_\"",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 1150, col: 54, offset: 41316},
+												pos:  position{line: 1196, col: 54, offset: 43082},
 												name: "SingleQuoteItalicTextElements",
 											},
 										},
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1150, col: 85, offset: 41347},
+									pos:        position{line: 1196, col: 85, offset: 43113},
 									val:        "_",
 									ignoreCase: false,
 									want:       "\"_\"",
@@ -7731,21 +8221,21 @@ var g = &grammar{
 		},
 		{
 			name: "SingleQuoteItalicTextElements",
-			pos:  position{line: 1154, col: 1, offset: 41561},
+			pos:  position{line: 1200, col: 1, offset: 43339},
 			expr: &seqExpr{
-				pos: position{line: 1154, col: 34, offset: 41594},
+				pos: position{line: 1200, col: 34, offset: 43372},
 				exprs: []interface{}{
 					&notExpr{
-						pos: position{line: 1154, col: 34, offset: 41594},
+						pos: position{line: 1200, col: 34, offset: 43372},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1154, col: 35, offset: 41595},
+							pos:  position{line: 1200, col: 35, offset: 43373},
 							name: "Space",
 						},
 					},
 					&oneOrMoreExpr{
-						pos: position{line: 1154, col: 41, offset: 41601},
+						pos: position{line: 1200, col: 41, offset: 43379},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1154, col: 41, offset: 41601},
+							pos:  position{line: 1200, col: 41, offset: 43379},
 							name: "SingleQuoteItalicTextElement",
 						},
 					},
@@ -7754,43 +8244,43 @@ var g = &grammar{
 		},
 		{
 			name: "SingleQuoteItalicTextElement",
-			pos:  position{line: 1156, col: 1, offset: 41634},
+			pos:  position{line: 1202, col: 1, offset: 43410},
 			expr: &choiceExpr{
-				pos: position{line: 1156, col: 33, offset: 41666},
+				pos: position{line: 1202, col: 33, offset: 43442},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1156, col: 33, offset: 41666},
+						pos:  position{line: 1202, col: 33, offset: 43442},
 						name: "Word",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1157, col: 11, offset: 41683},
+						pos:  position{line: 1203, col: 11, offset: 43458},
 						name: "DoubleQuoteItalicText",
 					},
 					&seqExpr{
-						pos: position{line: 1158, col: 11, offset: 41716},
+						pos: position{line: 1204, col: 11, offset: 43490},
 						exprs: []interface{}{
 							&oneOrMoreExpr{
-								pos: position{line: 1158, col: 11, offset: 41716},
+								pos: position{line: 1204, col: 11, offset: 43490},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1158, col: 11, offset: 41716},
+									pos:  position{line: 1204, col: 11, offset: 43490},
 									name: "Space",
 								},
 							},
 							&zeroOrOneExpr{
-								pos: position{line: 1158, col: 18, offset: 41723},
+								pos: position{line: 1204, col: 18, offset: 43497},
 								expr: &seqExpr{
-									pos: position{line: 1158, col: 19, offset: 41724},
+									pos: position{line: 1204, col: 19, offset: 43498},
 									exprs: []interface{}{
 										&litMatcher{
-											pos:        position{line: 1158, col: 19, offset: 41724},
+											pos:        position{line: 1204, col: 19, offset: 43498},
 											val:        "_",
 											ignoreCase: false,
 											want:       "\"_\"",
 										},
 										&notExpr{
-											pos: position{line: 1158, col: 23, offset: 41728},
+											pos: position{line: 1204, col: 23, offset: 43502},
 											expr: &litMatcher{
-												pos:        position{line: 1158, col: 24, offset: 41729},
+												pos:        position{line: 1204, col: 24, offset: 43503},
 												val:        "_",
 												ignoreCase: false,
 												want:       "\"_\"",
@@ -7802,55 +8292,55 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1159, col: 11, offset: 41746},
+						pos:  position{line: 1205, col: 11, offset: 43519},
 						name: "BoldText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1160, col: 11, offset: 41766},
+						pos:  position{line: 1206, col: 11, offset: 43538},
 						name: "MarkedText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1161, col: 11, offset: 41788},
+						pos:  position{line: 1207, col: 11, offset: 43559},
 						name: "MonospaceText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1162, col: 11, offset: 41813},
+						pos:  position{line: 1208, col: 11, offset: 43583},
 						name: "SubscriptText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1163, col: 11, offset: 41838},
+						pos:  position{line: 1209, col: 11, offset: 43607},
 						name: "SuperscriptText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1164, col: 11, offset: 41865},
+						pos:  position{line: 1210, col: 11, offset: 43633},
 						name: "InlineIcon",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1165, col: 11, offset: 41887},
+						pos:  position{line: 1211, col: 11, offset: 43654},
 						name: "InlineImage",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1166, col: 11, offset: 41911},
+						pos:  position{line: 1212, col: 11, offset: 43677},
 						name: "Link",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1167, col: 11, offset: 41929},
+						pos:  position{line: 1213, col: 11, offset: 43694},
 						name: "InlinePassthrough",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1168, col: 11, offset: 41959},
+						pos:  position{line: 1214, col: 11, offset: 43723},
 						name: "QuotedString",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1169, col: 11, offset: 41983},
+						pos:  position{line: 1215, col: 11, offset: 43746},
 						name: "AttributeSubstitution",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1170, col: 11, offset: 42016},
+						pos:  position{line: 1216, col: 11, offset: 43778},
 						name: "SingleQuoteItalicTextStringElement",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1171, col: 11, offset: 42062},
+						pos:  position{line: 1217, col: 11, offset: 43823},
 						name: "SingleQuoteItalicTextFallbackCharacter",
 					},
 				},
@@ -7858,14 +8348,14 @@ var g = &grammar{
 		},
 		{
 			name: "SingleQuoteItalicTextStringElement",
-			pos:  position{line: 1173, col: 1, offset: 42104},
+			pos:  position{line: 1219, col: 1, offset: 43863},
 			expr: &actionExpr{
-				pos: position{line: 1173, col: 39, offset: 42142},
+				pos: position{line: 1219, col: 39, offset: 43901},
 				run: (*parser).callonSingleQuoteItalicTextStringElement1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 1173, col: 39, offset: 42142},
+					pos: position{line: 1219, col: 39, offset: 43901},
 					expr: &charClassMatcher{
-						pos:        position{line: 1173, col: 39, offset: 42142},
+						pos:        position{line: 1219, col: 39, offset: 43901},
 						val:        "[^\\r\\n{} _^~]",
 						chars:      []rune{'\r', '\n', '{', '}', ' ', '_', '^', '~'},
 						ignoreCase: false,
@@ -7876,31 +8366,31 @@ var g = &grammar{
 		},
 		{
 			name: "SingleQuoteItalicTextFallbackCharacter",
-			pos:  position{line: 1177, col: 1, offset: 42372},
+			pos:  position{line: 1223, col: 1, offset: 44143},
 			expr: &choiceExpr{
-				pos: position{line: 1178, col: 5, offset: 42420},
+				pos: position{line: 1224, col: 5, offset: 44190},
 				alternatives: []interface{}{
 					&charClassMatcher{
-						pos:        position{line: 1178, col: 5, offset: 42420},
+						pos:        position{line: 1224, col: 5, offset: 44190},
 						val:        "[^\\r\\n_]",
 						chars:      []rune{'\r', '\n', '_'},
 						ignoreCase: false,
 						inverted:   true,
 					},
 					&actionExpr{
-						pos: position{line: 1179, col: 7, offset: 42520},
+						pos: position{line: 1225, col: 7, offset: 44289},
 						run: (*parser).callonSingleQuoteItalicTextFallbackCharacter3,
 						expr: &seqExpr{
-							pos: position{line: 1179, col: 7, offset: 42520},
+							pos: position{line: 1225, col: 7, offset: 44289},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 1179, col: 7, offset: 42520},
+									pos:        position{line: 1225, col: 7, offset: 44289},
 									val:        "_",
 									ignoreCase: false,
 									want:       "\"_\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1179, col: 11, offset: 42524},
+									pos:  position{line: 1225, col: 11, offset: 44293},
 									name: "Alphanums",
 								},
 							},
@@ -7911,40 +8401,40 @@ var g = &grammar{
 		},
 		{
 			name: "EscapedItalicText",
-			pos:  position{line: 1183, col: 1, offset: 42694},
+			pos:  position{line: 1229, col: 1, offset: 44475},
 			expr: &choiceExpr{
-				pos: position{line: 1184, col: 5, offset: 42721},
+				pos: position{line: 1230, col: 5, offset: 44501},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 1184, col: 5, offset: 42721},
+						pos: position{line: 1230, col: 5, offset: 44501},
 						run: (*parser).callonEscapedItalicText2,
 						expr: &seqExpr{
-							pos: position{line: 1184, col: 5, offset: 42721},
+							pos: position{line: 1230, col: 5, offset: 44501},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 1184, col: 5, offset: 42721},
+									pos:   position{line: 1230, col: 5, offset: 44501},
 									label: "backslashes",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1184, col: 18, offset: 42734},
+										pos:  position{line: 1230, col: 18, offset: 44514},
 										name: "TwoOrMoreBackslashes",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1184, col: 40, offset: 42756},
+									pos:        position{line: 1230, col: 40, offset: 44536},
 									val:        "__",
 									ignoreCase: false,
 									want:       "\"__\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1184, col: 45, offset: 42761},
+									pos:   position{line: 1230, col: 45, offset: 44541},
 									label: "elements",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1184, col: 55, offset: 42771},
+										pos:  position{line: 1230, col: 55, offset: 44551},
 										name: "DoubleQuoteItalicTextElements",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1184, col: 86, offset: 42802},
+									pos:        position{line: 1230, col: 86, offset: 44582},
 									val:        "__",
 									ignoreCase: false,
 									want:       "\"__\"",
@@ -7953,35 +8443,35 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1186, col: 9, offset: 42961},
+						pos: position{line: 1232, col: 9, offset: 44739},
 						run: (*parser).callonEscapedItalicText10,
 						expr: &seqExpr{
-							pos: position{line: 1186, col: 9, offset: 42961},
+							pos: position{line: 1232, col: 9, offset: 44739},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 1186, col: 9, offset: 42961},
+									pos:   position{line: 1232, col: 9, offset: 44739},
 									label: "backslashes",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1186, col: 22, offset: 42974},
+										pos:  position{line: 1232, col: 22, offset: 44752},
 										name: "OneOrMoreBackslashes",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1186, col: 44, offset: 42996},
+									pos:        position{line: 1232, col: 44, offset: 44774},
 									val:        "__",
 									ignoreCase: false,
 									want:       "\"__\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1186, col: 49, offset: 43001},
+									pos:   position{line: 1232, col: 49, offset: 44779},
 									label: "elements",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1186, col: 59, offset: 43011},
+										pos:  position{line: 1232, col: 59, offset: 44789},
 										name: "SingleQuoteItalicTextElements",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1186, col: 90, offset: 43042},
+									pos:        position{line: 1232, col: 90, offset: 44820},
 									val:        "_",
 									ignoreCase: false,
 									want:       "\"_\"",
@@ -7990,35 +8480,35 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1189, col: 9, offset: 43245},
+						pos: position{line: 1235, col: 9, offset: 45020},
 						run: (*parser).callonEscapedItalicText18,
 						expr: &seqExpr{
-							pos: position{line: 1189, col: 9, offset: 43245},
+							pos: position{line: 1235, col: 9, offset: 45020},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 1189, col: 9, offset: 43245},
+									pos:   position{line: 1235, col: 9, offset: 45020},
 									label: "backslashes",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1189, col: 22, offset: 43258},
+										pos:  position{line: 1235, col: 22, offset: 45033},
 										name: "OneOrMoreBackslashes",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1189, col: 44, offset: 43280},
+									pos:        position{line: 1235, col: 44, offset: 45055},
 									val:        "_",
 									ignoreCase: false,
 									want:       "\"_\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1189, col: 48, offset: 43284},
+									pos:   position{line: 1235, col: 48, offset: 45059},
 									label: "elements",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1189, col: 58, offset: 43294},
+										pos:  position{line: 1235, col: 58, offset: 45069},
 										name: "SingleQuoteItalicTextElements",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1189, col: 89, offset: 43325},
+									pos:        position{line: 1235, col: 89, offset: 45100},
 									val:        "_",
 									ignoreCase: false,
 									want:       "\"_\"",
@@ -8031,16 +8521,16 @@ var g = &grammar{
 		},
 		{
 			name: "MonospaceText",
-			pos:  position{line: 1196, col: 1, offset: 43542},
+			pos:  position{line: 1242, col: 1, offset: 45310},
 			expr: &choiceExpr{
-				pos: position{line: 1196, col: 18, offset: 43559},
+				pos: position{line: 1242, col: 18, offset: 45327},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1196, col: 18, offset: 43559},
+						pos:  position{line: 1242, col: 18, offset: 45327},
 						name: "DoubleQuoteMonospaceText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1196, col: 45, offset: 43586},
+						pos:  position{line: 1242, col: 45, offset: 45354},
 						name: "SingleQuoteMonospaceText",
 					},
 				},
@@ -8048,49 +8538,49 @@ var g = &grammar{
 		},
 		{
 			name: "DoubleQuoteMonospaceText",
-			pos:  position{line: 1198, col: 1, offset: 43614},
+			pos:  position{line: 1244, col: 1, offset: 45380},
 			expr: &actionExpr{
-				pos: position{line: 1198, col: 29, offset: 43642},
+				pos: position{line: 1244, col: 29, offset: 45408},
 				run: (*parser).callonDoubleQuoteMonospaceText1,
 				expr: &seqExpr{
-					pos: position{line: 1198, col: 29, offset: 43642},
+					pos: position{line: 1244, col: 29, offset: 45408},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1198, col: 29, offset: 43642},
+							pos:   position{line: 1244, col: 29, offset: 45408},
 							label: "attrs",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1198, col: 35, offset: 43648},
+								pos: position{line: 1244, col: 35, offset: 45414},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1198, col: 36, offset: 43649},
+									pos:  position{line: 1244, col: 36, offset: 45415},
 									name: "QuotedTextAttrs",
 								},
 							},
 						},
 						&notExpr{
-							pos: position{line: 1198, col: 54, offset: 43667},
+							pos: position{line: 1244, col: 54, offset: 45433},
 							expr: &litMatcher{
-								pos:        position{line: 1198, col: 55, offset: 43668},
+								pos:        position{line: 1244, col: 55, offset: 45434},
 								val:        "\\\\",
 								ignoreCase: false,
 								want:       "\"\\\\\\\\\"",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1198, col: 60, offset: 43673},
+							pos:        position{line: 1244, col: 60, offset: 45439},
 							val:        "``",
 							ignoreCase: false,
 							want:       "\"``\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 1198, col: 65, offset: 43678},
+							pos:   position{line: 1244, col: 65, offset: 45444},
 							label: "elements",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1198, col: 75, offset: 43688},
+								pos:  position{line: 1244, col: 75, offset: 45454},
 								name: "DoubleQuoteMonospaceTextElements",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1198, col: 109, offset: 43722},
+							pos:        position{line: 1244, col: 109, offset: 45488},
 							val:        "``",
 							ignoreCase: false,
 							want:       "\"``\"",
@@ -8101,37 +8591,37 @@ var g = &grammar{
 		},
 		{
 			name: "DoubleQuoteMonospaceTextElements",
-			pos:  position{line: 1202, col: 1, offset: 43863},
+			pos:  position{line: 1248, col: 1, offset: 45641},
 			expr: &seqExpr{
-				pos: position{line: 1202, col: 37, offset: 43899},
+				pos: position{line: 1248, col: 37, offset: 45677},
 				exprs: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1202, col: 37, offset: 43899},
+						pos:  position{line: 1248, col: 37, offset: 45677},
 						name: "DoubleQuoteMonospaceTextElement",
 					},
 					&zeroOrMoreExpr{
-						pos: position{line: 1202, col: 69, offset: 43931},
+						pos: position{line: 1248, col: 69, offset: 45709},
 						expr: &seqExpr{
-							pos: position{line: 1202, col: 70, offset: 43932},
+							pos: position{line: 1248, col: 70, offset: 45710},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 1202, col: 70, offset: 43932},
+									pos: position{line: 1248, col: 70, offset: 45710},
 									expr: &litMatcher{
-										pos:        position{line: 1202, col: 72, offset: 43934},
+										pos:        position{line: 1248, col: 72, offset: 45712},
 										val:        "``",
 										ignoreCase: false,
 										want:       "\"``\"",
 									},
 								},
 								&choiceExpr{
-									pos: position{line: 1202, col: 79, offset: 43941},
+									pos: position{line: 1248, col: 79, offset: 45719},
 									alternatives: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 1202, col: 79, offset: 43941},
+											pos:  position{line: 1248, col: 79, offset: 45719},
 											name: "Space",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 1202, col: 87, offset: 43949},
+											pos:  position{line: 1248, col: 87, offset: 45727},
 											name: "DoubleQuoteMonospaceTextElement",
 										},
 									},
@@ -8144,64 +8634,64 @@ var g = &grammar{
 		},
 		{
 			name: "DoubleQuoteMonospaceTextElement",
-			pos:  position{line: 1204, col: 1, offset: 44020},
+			pos:  position{line: 1250, col: 1, offset: 45796},
 			expr: &choiceExpr{
-				pos: position{line: 1204, col: 36, offset: 44055},
+				pos: position{line: 1250, col: 36, offset: 45831},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1204, col: 36, offset: 44055},
+						pos:  position{line: 1250, col: 36, offset: 45831},
 						name: "Word",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1205, col: 11, offset: 44072},
+						pos:  position{line: 1251, col: 11, offset: 45847},
 						name: "SingleQuoteMonospaceText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1206, col: 11, offset: 44109},
+						pos:  position{line: 1252, col: 11, offset: 45883},
 						name: "BoldText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1207, col: 11, offset: 44129},
+						pos:  position{line: 1253, col: 11, offset: 45902},
 						name: "ItalicText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1208, col: 11, offset: 44151},
+						pos:  position{line: 1254, col: 11, offset: 45923},
 						name: "MarkedText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1209, col: 11, offset: 44173},
+						pos:  position{line: 1255, col: 11, offset: 45944},
 						name: "SubscriptText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1210, col: 11, offset: 44198},
+						pos:  position{line: 1256, col: 11, offset: 45968},
 						name: "SuperscriptText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1211, col: 11, offset: 44225},
+						pos:  position{line: 1257, col: 11, offset: 45994},
 						name: "InlineIcon",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1212, col: 11, offset: 44247},
+						pos:  position{line: 1258, col: 11, offset: 46015},
 						name: "InlineImage",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1213, col: 11, offset: 44270},
+						pos:  position{line: 1259, col: 11, offset: 46037},
 						name: "Link",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1214, col: 11, offset: 44286},
+						pos:  position{line: 1260, col: 11, offset: 46052},
 						name: "InlinePassthrough",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1215, col: 11, offset: 44316},
+						pos:  position{line: 1261, col: 11, offset: 46081},
 						name: "QuotedString",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1216, col: 11, offset: 44340},
+						pos:  position{line: 1262, col: 11, offset: 46104},
 						name: "DoubleQuoteMonospaceTextStringElement",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1217, col: 11, offset: 44389},
+						pos:  position{line: 1263, col: 11, offset: 46152},
 						name: "DoubleQuoteMonospaceTextFallbackCharacter",
 					},
 				},
@@ -8209,26 +8699,26 @@ var g = &grammar{
 		},
 		{
 			name: "DoubleQuoteMonospaceTextStringElement",
-			pos:  position{line: 1219, col: 1, offset: 44434},
+			pos:  position{line: 1265, col: 1, offset: 46195},
 			expr: &actionExpr{
-				pos: position{line: 1219, col: 42, offset: 44475},
+				pos: position{line: 1265, col: 42, offset: 46236},
 				run: (*parser).callonDoubleQuoteMonospaceTextStringElement1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 1219, col: 42, offset: 44475},
+					pos: position{line: 1265, col: 42, offset: 46236},
 					expr: &seqExpr{
-						pos: position{line: 1219, col: 43, offset: 44476},
+						pos: position{line: 1265, col: 43, offset: 46237},
 						exprs: []interface{}{
 							&notExpr{
-								pos: position{line: 1219, col: 43, offset: 44476},
+								pos: position{line: 1265, col: 43, offset: 46237},
 								expr: &litMatcher{
-									pos:        position{line: 1219, col: 44, offset: 44477},
+									pos:        position{line: 1265, col: 44, offset: 46238},
 									val:        "``",
 									ignoreCase: false,
 									want:       "\"``\"",
 								},
 							},
 							&charClassMatcher{
-								pos:        position{line: 1219, col: 49, offset: 44482},
+								pos:        position{line: 1265, col: 49, offset: 46243},
 								val:        "[^\\r\\n ^~{}]",
 								chars:      []rune{'\r', '\n', ' ', '^', '~', '{', '}'},
 								ignoreCase: false,
@@ -8241,31 +8731,31 @@ var g = &grammar{
 		},
 		{
 			name: "DoubleQuoteMonospaceTextFallbackCharacter",
-			pos:  position{line: 1223, col: 1, offset: 44557},
+			pos:  position{line: 1269, col: 1, offset: 46330},
 			expr: &choiceExpr{
-				pos: position{line: 1224, col: 5, offset: 44608},
+				pos: position{line: 1270, col: 5, offset: 46380},
 				alternatives: []interface{}{
 					&charClassMatcher{
-						pos:        position{line: 1224, col: 5, offset: 44608},
+						pos:        position{line: 1270, col: 5, offset: 46380},
 						val:        "[^\\r\\n`]",
 						chars:      []rune{'\r', '\n', '`'},
 						ignoreCase: false,
 						inverted:   true,
 					},
 					&actionExpr{
-						pos: position{line: 1225, col: 7, offset: 44711},
+						pos: position{line: 1271, col: 7, offset: 46482},
 						run: (*parser).callonDoubleQuoteMonospaceTextFallbackCharacter3,
 						expr: &seqExpr{
-							pos: position{line: 1225, col: 7, offset: 44711},
+							pos: position{line: 1271, col: 7, offset: 46482},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 1225, col: 7, offset: 44711},
+									pos:        position{line: 1271, col: 7, offset: 46482},
 									val:        "``",
 									ignoreCase: false,
 									want:       "\"``\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1225, col: 12, offset: 44716},
+									pos:  position{line: 1271, col: 12, offset: 46487},
 									name: "Alphanums",
 								},
 							},
@@ -8276,49 +8766,49 @@ var g = &grammar{
 		},
 		{
 			name: "SingleQuoteMonospaceText",
-			pos:  position{line: 1229, col: 1, offset: 44888},
+			pos:  position{line: 1275, col: 1, offset: 46671},
 			expr: &choiceExpr{
-				pos: position{line: 1229, col: 29, offset: 44916},
+				pos: position{line: 1275, col: 29, offset: 46699},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 1229, col: 29, offset: 44916},
+						pos: position{line: 1275, col: 29, offset: 46699},
 						run: (*parser).callonSingleQuoteMonospaceText2,
 						expr: &seqExpr{
-							pos: position{line: 1229, col: 29, offset: 44916},
+							pos: position{line: 1275, col: 29, offset: 46699},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 1229, col: 29, offset: 44916},
+									pos:   position{line: 1275, col: 29, offset: 46699},
 									label: "attrs",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 1229, col: 35, offset: 44922},
+										pos: position{line: 1275, col: 35, offset: 46705},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1229, col: 36, offset: 44923},
+											pos:  position{line: 1275, col: 36, offset: 46706},
 											name: "QuotedTextAttrs",
 										},
 									},
 								},
 								&seqExpr{
-									pos: position{line: 1229, col: 55, offset: 44942},
+									pos: position{line: 1275, col: 55, offset: 46725},
 									exprs: []interface{}{
 										&notExpr{
-											pos: position{line: 1229, col: 55, offset: 44942},
+											pos: position{line: 1275, col: 55, offset: 46725},
 											expr: &litMatcher{
-												pos:        position{line: 1229, col: 56, offset: 44943},
+												pos:        position{line: 1275, col: 56, offset: 46726},
 												val:        "\\",
 												ignoreCase: false,
 												want:       "\"\\\\\"",
 											},
 										},
 										&litMatcher{
-											pos:        position{line: 1229, col: 60, offset: 44947},
+											pos:        position{line: 1275, col: 60, offset: 46730},
 											val:        "`",
 											ignoreCase: false,
 											want:       "\"`\"",
 										},
 										&notExpr{
-											pos: position{line: 1229, col: 64, offset: 44951},
+											pos: position{line: 1275, col: 64, offset: 46734},
 											expr: &litMatcher{
-												pos:        position{line: 1229, col: 65, offset: 44952},
+												pos:        position{line: 1275, col: 65, offset: 46735},
 												val:        "`",
 												ignoreCase: false,
 												want:       "\"`\"",
@@ -8327,15 +8817,15 @@ var g = &grammar{
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 1229, col: 70, offset: 44957},
+									pos:   position{line: 1275, col: 70, offset: 46740},
 									label: "elements",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1229, col: 80, offset: 44967},
+										pos:  position{line: 1275, col: 80, offset: 46750},
 										name: "SingleQuoteMonospaceTextElements",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1229, col: 114, offset: 45001},
+									pos:        position{line: 1275, col: 114, offset: 46784},
 									val:        "`",
 									ignoreCase: false,
 									want:       "\"`\"",
@@ -8344,58 +8834,58 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1231, col: 5, offset: 45196},
+						pos: position{line: 1277, col: 5, offset: 46993},
 						run: (*parser).callonSingleQuoteMonospaceText16,
 						expr: &seqExpr{
-							pos: position{line: 1231, col: 5, offset: 45196},
+							pos: position{line: 1277, col: 5, offset: 46993},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 1231, col: 5, offset: 45196},
+									pos:   position{line: 1277, col: 5, offset: 46993},
 									label: "attrs",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 1231, col: 11, offset: 45202},
+										pos: position{line: 1277, col: 11, offset: 46999},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1231, col: 12, offset: 45203},
+											pos:  position{line: 1277, col: 12, offset: 47000},
 											name: "QuotedTextAttrs",
 										},
 									},
 								},
 								&notExpr{
-									pos: position{line: 1231, col: 30, offset: 45221},
+									pos: position{line: 1277, col: 30, offset: 47018},
 									expr: &litMatcher{
-										pos:        position{line: 1231, col: 31, offset: 45222},
+										pos:        position{line: 1277, col: 31, offset: 47019},
 										val:        "\\\\",
 										ignoreCase: false,
 										want:       "\"\\\\\\\\\"",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1231, col: 36, offset: 45227},
+									pos:        position{line: 1277, col: 36, offset: 47024},
 									val:        "`",
 									ignoreCase: false,
 									want:       "\"`\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1231, col: 40, offset: 45231},
+									pos:   position{line: 1277, col: 40, offset: 47028},
 									label: "elements",
 									expr: &seqExpr{
-										pos: position{line: 1231, col: 50, offset: 45241},
+										pos: position{line: 1277, col: 50, offset: 47038},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 1231, col: 50, offset: 45241},
+												pos:        position{line: 1277, col: 50, offset: 47038},
 												val:        "`",
 												ignoreCase: false,
 												want:       "\"`\"",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 1231, col: 54, offset: 45245},
+												pos:  position{line: 1277, col: 54, offset: 47042},
 												name: "SingleQuoteMonospaceTextElements",
 											},
 										},
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1231, col: 88, offset: 45279},
+									pos:        position{line: 1277, col: 88, offset: 47076},
 									val:        "`",
 									ignoreCase: false,
 									want:       "\"`\"",
@@ -8408,21 +8898,21 @@ var g = &grammar{
 		},
 		{
 			name: "SingleQuoteMonospaceTextElements",
-			pos:  position{line: 1235, col: 1, offset: 45499},
+			pos:  position{line: 1281, col: 1, offset: 47308},
 			expr: &seqExpr{
-				pos: position{line: 1235, col: 37, offset: 45535},
+				pos: position{line: 1281, col: 37, offset: 47344},
 				exprs: []interface{}{
 					&notExpr{
-						pos: position{line: 1235, col: 37, offset: 45535},
+						pos: position{line: 1281, col: 37, offset: 47344},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1235, col: 38, offset: 45536},
+							pos:  position{line: 1281, col: 38, offset: 47345},
 							name: "Space",
 						},
 					},
 					&oneOrMoreExpr{
-						pos: position{line: 1235, col: 44, offset: 45542},
+						pos: position{line: 1281, col: 44, offset: 47351},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1235, col: 44, offset: 45542},
+							pos:  position{line: 1281, col: 44, offset: 47351},
 							name: "SingleQuoteMonospaceTextElement",
 						},
 					},
@@ -8431,43 +8921,43 @@ var g = &grammar{
 		},
 		{
 			name: "SingleQuoteMonospaceTextElement",
-			pos:  position{line: 1237, col: 1, offset: 45578},
+			pos:  position{line: 1283, col: 1, offset: 47385},
 			expr: &choiceExpr{
-				pos: position{line: 1237, col: 37, offset: 45614},
+				pos: position{line: 1283, col: 37, offset: 47421},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1237, col: 37, offset: 45614},
+						pos:  position{line: 1283, col: 37, offset: 47421},
 						name: "Word",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1238, col: 11, offset: 45631},
+						pos:  position{line: 1284, col: 11, offset: 47437},
 						name: "DoubleQuoteMonospaceText",
 					},
 					&seqExpr{
-						pos: position{line: 1239, col: 11, offset: 45668},
+						pos: position{line: 1285, col: 11, offset: 47473},
 						exprs: []interface{}{
 							&oneOrMoreExpr{
-								pos: position{line: 1239, col: 11, offset: 45668},
+								pos: position{line: 1285, col: 11, offset: 47473},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1239, col: 11, offset: 45668},
+									pos:  position{line: 1285, col: 11, offset: 47473},
 									name: "Space",
 								},
 							},
 							&zeroOrOneExpr{
-								pos: position{line: 1239, col: 18, offset: 45675},
+								pos: position{line: 1285, col: 18, offset: 47480},
 								expr: &seqExpr{
-									pos: position{line: 1239, col: 19, offset: 45676},
+									pos: position{line: 1285, col: 19, offset: 47481},
 									exprs: []interface{}{
 										&litMatcher{
-											pos:        position{line: 1239, col: 19, offset: 45676},
+											pos:        position{line: 1285, col: 19, offset: 47481},
 											val:        "`",
 											ignoreCase: false,
 											want:       "\"`\"",
 										},
 										&notExpr{
-											pos: position{line: 1239, col: 23, offset: 45680},
+											pos: position{line: 1285, col: 23, offset: 47485},
 											expr: &litMatcher{
-												pos:        position{line: 1239, col: 24, offset: 45681},
+												pos:        position{line: 1285, col: 24, offset: 47486},
 												val:        "`",
 												ignoreCase: false,
 												want:       "\"`\"",
@@ -8479,59 +8969,59 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1240, col: 11, offset: 45810},
+						pos:  position{line: 1286, col: 11, offset: 47614},
 						name: "Newline",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1241, col: 11, offset: 45849},
+						pos:  position{line: 1287, col: 11, offset: 47652},
 						name: "BoldText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1242, col: 11, offset: 45869},
+						pos:  position{line: 1288, col: 11, offset: 47671},
 						name: "ItalicText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1243, col: 11, offset: 45891},
+						pos:  position{line: 1289, col: 11, offset: 47692},
 						name: "MarkedText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1244, col: 11, offset: 45913},
+						pos:  position{line: 1290, col: 11, offset: 47713},
 						name: "SubscriptText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1245, col: 11, offset: 45938},
+						pos:  position{line: 1291, col: 11, offset: 47737},
 						name: "SuperscriptText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1246, col: 11, offset: 45965},
+						pos:  position{line: 1292, col: 11, offset: 47763},
 						name: "InlineIcon",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1247, col: 11, offset: 45987},
+						pos:  position{line: 1293, col: 11, offset: 47784},
 						name: "InlineImage",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1248, col: 11, offset: 46011},
+						pos:  position{line: 1294, col: 11, offset: 47807},
 						name: "Link",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1249, col: 11, offset: 46028},
+						pos:  position{line: 1295, col: 11, offset: 47823},
 						name: "InlinePassthrough",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1250, col: 11, offset: 46058},
+						pos:  position{line: 1296, col: 11, offset: 47852},
 						name: "QuotedString",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1251, col: 11, offset: 46082},
+						pos:  position{line: 1297, col: 11, offset: 47875},
 						name: "AttributeSubstitution",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1252, col: 11, offset: 46115},
+						pos:  position{line: 1298, col: 11, offset: 47907},
 						name: "SingleQuoteMonospaceTextStringElement",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1253, col: 11, offset: 46164},
+						pos:  position{line: 1299, col: 11, offset: 47955},
 						name: "SingleQuoteMonospaceTextFallbackCharacter",
 					},
 				},
@@ -8539,14 +9029,14 @@ var g = &grammar{
 		},
 		{
 			name: "SingleQuoteMonospaceTextStringElement",
-			pos:  position{line: 1255, col: 1, offset: 46209},
+			pos:  position{line: 1301, col: 1, offset: 47998},
 			expr: &actionExpr{
-				pos: position{line: 1255, col: 42, offset: 46250},
+				pos: position{line: 1301, col: 42, offset: 48039},
 				run: (*parser).callonSingleQuoteMonospaceTextStringElement1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 1255, col: 42, offset: 46250},
+					pos: position{line: 1301, col: 42, offset: 48039},
 					expr: &charClassMatcher{
-						pos:        position{line: 1255, col: 42, offset: 46250},
+						pos:        position{line: 1301, col: 42, offset: 48039},
 						val:        "[^\\r\\n {}`^~]",
 						chars:      []rune{'\r', '\n', ' ', '{', '}', '`', '^', '~'},
 						ignoreCase: false,
@@ -8557,31 +9047,31 @@ var g = &grammar{
 		},
 		{
 			name: "SingleQuoteMonospaceTextFallbackCharacter",
-			pos:  position{line: 1259, col: 1, offset: 46472},
+			pos:  position{line: 1305, col: 1, offset: 48273},
 			expr: &choiceExpr{
-				pos: position{line: 1260, col: 5, offset: 46523},
+				pos: position{line: 1306, col: 5, offset: 48323},
 				alternatives: []interface{}{
 					&charClassMatcher{
-						pos:        position{line: 1260, col: 5, offset: 46523},
+						pos:        position{line: 1306, col: 5, offset: 48323},
 						val:        "[^\\r\\n`]",
 						chars:      []rune{'\r', '\n', '`'},
 						ignoreCase: false,
 						inverted:   true,
 					},
 					&actionExpr{
-						pos: position{line: 1261, col: 7, offset: 46626},
+						pos: position{line: 1307, col: 7, offset: 48425},
 						run: (*parser).callonSingleQuoteMonospaceTextFallbackCharacter3,
 						expr: &seqExpr{
-							pos: position{line: 1261, col: 7, offset: 46626},
+							pos: position{line: 1307, col: 7, offset: 48425},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 1261, col: 7, offset: 46626},
+									pos:        position{line: 1307, col: 7, offset: 48425},
 									val:        "`",
 									ignoreCase: false,
 									want:       "\"`\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1261, col: 11, offset: 46630},
+									pos:  position{line: 1307, col: 11, offset: 48429},
 									name: "Alphanums",
 								},
 							},
@@ -8592,40 +9082,40 @@ var g = &grammar{
 		},
 		{
 			name: "EscapedMonospaceText",
-			pos:  position{line: 1265, col: 1, offset: 46803},
+			pos:  position{line: 1311, col: 1, offset: 48614},
 			expr: &choiceExpr{
-				pos: position{line: 1266, col: 5, offset: 46833},
+				pos: position{line: 1312, col: 5, offset: 48643},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 1266, col: 5, offset: 46833},
+						pos: position{line: 1312, col: 5, offset: 48643},
 						run: (*parser).callonEscapedMonospaceText2,
 						expr: &seqExpr{
-							pos: position{line: 1266, col: 5, offset: 46833},
+							pos: position{line: 1312, col: 5, offset: 48643},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 1266, col: 5, offset: 46833},
+									pos:   position{line: 1312, col: 5, offset: 48643},
 									label: "backslashes",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1266, col: 18, offset: 46846},
+										pos:  position{line: 1312, col: 18, offset: 48656},
 										name: "TwoOrMoreBackslashes",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1266, col: 40, offset: 46868},
+									pos:        position{line: 1312, col: 40, offset: 48678},
 									val:        "``",
 									ignoreCase: false,
 									want:       "\"``\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1266, col: 45, offset: 46873},
+									pos:   position{line: 1312, col: 45, offset: 48683},
 									label: "elements",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1266, col: 55, offset: 46883},
+										pos:  position{line: 1312, col: 55, offset: 48693},
 										name: "DoubleQuoteMonospaceTextElements",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1266, col: 89, offset: 46917},
+									pos:        position{line: 1312, col: 89, offset: 48727},
 									val:        "``",
 									ignoreCase: false,
 									want:       "\"``\"",
@@ -8634,35 +9124,35 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1268, col: 9, offset: 47076},
+						pos: position{line: 1314, col: 9, offset: 48884},
 						run: (*parser).callonEscapedMonospaceText10,
 						expr: &seqExpr{
-							pos: position{line: 1268, col: 9, offset: 47076},
+							pos: position{line: 1314, col: 9, offset: 48884},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 1268, col: 9, offset: 47076},
+									pos:   position{line: 1314, col: 9, offset: 48884},
 									label: "backslashes",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1268, col: 22, offset: 47089},
+										pos:  position{line: 1314, col: 22, offset: 48897},
 										name: "OneOrMoreBackslashes",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1268, col: 44, offset: 47111},
+									pos:        position{line: 1314, col: 44, offset: 48919},
 									val:        "``",
 									ignoreCase: false,
 									want:       "\"``\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1268, col: 49, offset: 47116},
+									pos:   position{line: 1314, col: 49, offset: 48924},
 									label: "elements",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1268, col: 59, offset: 47126},
+										pos:  position{line: 1314, col: 59, offset: 48934},
 										name: "SingleQuoteMonospaceTextElements",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1268, col: 93, offset: 47160},
+									pos:        position{line: 1314, col: 93, offset: 48968},
 									val:        "`",
 									ignoreCase: false,
 									want:       "\"`\"",
@@ -8671,35 +9161,35 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1271, col: 9, offset: 47363},
+						pos: position{line: 1317, col: 9, offset: 49168},
 						run: (*parser).callonEscapedMonospaceText18,
 						expr: &seqExpr{
-							pos: position{line: 1271, col: 9, offset: 47363},
+							pos: position{line: 1317, col: 9, offset: 49168},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 1271, col: 9, offset: 47363},
+									pos:   position{line: 1317, col: 9, offset: 49168},
 									label: "backslashes",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1271, col: 22, offset: 47376},
+										pos:  position{line: 1317, col: 22, offset: 49181},
 										name: "OneOrMoreBackslashes",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1271, col: 44, offset: 47398},
+									pos:        position{line: 1317, col: 44, offset: 49203},
 									val:        "`",
 									ignoreCase: false,
 									want:       "\"`\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1271, col: 48, offset: 47402},
+									pos:   position{line: 1317, col: 48, offset: 49207},
 									label: "elements",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1271, col: 58, offset: 47412},
+										pos:  position{line: 1317, col: 58, offset: 49217},
 										name: "SingleQuoteMonospaceTextElements",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1271, col: 92, offset: 47446},
+									pos:        position{line: 1317, col: 92, offset: 49251},
 									val:        "`",
 									ignoreCase: false,
 									want:       "\"`\"",
@@ -8712,16 +9202,16 @@ var g = &grammar{
 		},
 		{
 			name: "MarkedText",
-			pos:  position{line: 1279, col: 1, offset: 47662},
+			pos:  position{line: 1325, col: 1, offset: 49459},
 			expr: &choiceExpr{
-				pos: position{line: 1279, col: 15, offset: 47676},
+				pos: position{line: 1325, col: 15, offset: 49473},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1279, col: 15, offset: 47676},
+						pos:  position{line: 1325, col: 15, offset: 49473},
 						name: "DoubleQuoteMarkedText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1279, col: 39, offset: 47700},
+						pos:  position{line: 1325, col: 39, offset: 49497},
 						name: "SingleQuoteMarkedText",
 					},
 				},
@@ -8729,49 +9219,49 @@ var g = &grammar{
 		},
 		{
 			name: "DoubleQuoteMarkedText",
-			pos:  position{line: 1281, col: 1, offset: 47725},
+			pos:  position{line: 1327, col: 1, offset: 49520},
 			expr: &actionExpr{
-				pos: position{line: 1281, col: 26, offset: 47750},
+				pos: position{line: 1327, col: 26, offset: 49545},
 				run: (*parser).callonDoubleQuoteMarkedText1,
 				expr: &seqExpr{
-					pos: position{line: 1281, col: 26, offset: 47750},
+					pos: position{line: 1327, col: 26, offset: 49545},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1281, col: 26, offset: 47750},
+							pos:   position{line: 1327, col: 26, offset: 49545},
 							label: "attrs",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1281, col: 32, offset: 47756},
+								pos: position{line: 1327, col: 32, offset: 49551},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1281, col: 33, offset: 47757},
+									pos:  position{line: 1327, col: 33, offset: 49552},
 									name: "QuotedTextAttrs",
 								},
 							},
 						},
 						&notExpr{
-							pos: position{line: 1281, col: 51, offset: 47775},
+							pos: position{line: 1327, col: 51, offset: 49570},
 							expr: &litMatcher{
-								pos:        position{line: 1281, col: 52, offset: 47776},
+								pos:        position{line: 1327, col: 52, offset: 49571},
 								val:        "\\\\",
 								ignoreCase: false,
 								want:       "\"\\\\\\\\\"",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1281, col: 57, offset: 47781},
+							pos:        position{line: 1327, col: 57, offset: 49576},
 							val:        "##",
 							ignoreCase: false,
 							want:       "\"##\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 1281, col: 62, offset: 47786},
+							pos:   position{line: 1327, col: 62, offset: 49581},
 							label: "elements",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1281, col: 72, offset: 47796},
+								pos:  position{line: 1327, col: 72, offset: 49591},
 								name: "DoubleQuoteMarkedTextElements",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1281, col: 103, offset: 47827},
+							pos:        position{line: 1327, col: 103, offset: 49622},
 							val:        "##",
 							ignoreCase: false,
 							want:       "\"##\"",
@@ -8782,37 +9272,37 @@ var g = &grammar{
 		},
 		{
 			name: "DoubleQuoteMarkedTextElements",
-			pos:  position{line: 1285, col: 1, offset: 47965},
+			pos:  position{line: 1331, col: 1, offset: 49772},
 			expr: &seqExpr{
-				pos: position{line: 1285, col: 34, offset: 47998},
+				pos: position{line: 1331, col: 34, offset: 49805},
 				exprs: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1285, col: 34, offset: 47998},
+						pos:  position{line: 1331, col: 34, offset: 49805},
 						name: "DoubleQuoteMarkedTextElement",
 					},
 					&zeroOrMoreExpr{
-						pos: position{line: 1285, col: 63, offset: 48027},
+						pos: position{line: 1331, col: 63, offset: 49834},
 						expr: &seqExpr{
-							pos: position{line: 1285, col: 64, offset: 48028},
+							pos: position{line: 1331, col: 64, offset: 49835},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 1285, col: 64, offset: 48028},
+									pos: position{line: 1331, col: 64, offset: 49835},
 									expr: &litMatcher{
-										pos:        position{line: 1285, col: 66, offset: 48030},
+										pos:        position{line: 1331, col: 66, offset: 49837},
 										val:        "##",
 										ignoreCase: false,
 										want:       "\"##\"",
 									},
 								},
 								&choiceExpr{
-									pos: position{line: 1285, col: 73, offset: 48037},
+									pos: position{line: 1331, col: 73, offset: 49844},
 									alternatives: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 1285, col: 73, offset: 48037},
+											pos:  position{line: 1331, col: 73, offset: 49844},
 											name: "Space",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 1285, col: 81, offset: 48045},
+											pos:  position{line: 1331, col: 81, offset: 49852},
 											name: "DoubleQuoteMarkedTextElement",
 										},
 									},
@@ -8825,64 +9315,64 @@ var g = &grammar{
 		},
 		{
 			name: "DoubleQuoteMarkedTextElement",
-			pos:  position{line: 1287, col: 1, offset: 48114},
+			pos:  position{line: 1333, col: 1, offset: 49919},
 			expr: &choiceExpr{
-				pos: position{line: 1287, col: 33, offset: 48146},
+				pos: position{line: 1333, col: 33, offset: 49951},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1287, col: 33, offset: 48146},
+						pos:  position{line: 1333, col: 33, offset: 49951},
 						name: "Word",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1288, col: 11, offset: 48162},
+						pos:  position{line: 1334, col: 11, offset: 49966},
 						name: "SingleQuoteMarkedText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1289, col: 11, offset: 48195},
+						pos:  position{line: 1335, col: 11, offset: 49998},
 						name: "BoldText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1290, col: 11, offset: 48215},
+						pos:  position{line: 1336, col: 11, offset: 50017},
 						name: "ItalicText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1291, col: 11, offset: 48237},
+						pos:  position{line: 1337, col: 11, offset: 50038},
 						name: "MonospaceText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1292, col: 11, offset: 48262},
+						pos:  position{line: 1338, col: 11, offset: 50062},
 						name: "SubscriptText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1293, col: 11, offset: 48287},
+						pos:  position{line: 1339, col: 11, offset: 50086},
 						name: "SuperscriptText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1294, col: 11, offset: 48314},
+						pos:  position{line: 1340, col: 11, offset: 50112},
 						name: "InlineIcon",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1295, col: 11, offset: 48336},
+						pos:  position{line: 1341, col: 11, offset: 50133},
 						name: "InlineImage",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1296, col: 11, offset: 48359},
+						pos:  position{line: 1342, col: 11, offset: 50155},
 						name: "Link",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1297, col: 11, offset: 48375},
+						pos:  position{line: 1343, col: 11, offset: 50170},
 						name: "InlinePassthrough",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1298, col: 11, offset: 48404},
+						pos:  position{line: 1344, col: 11, offset: 50198},
 						name: "QuotedString",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1299, col: 11, offset: 48428},
+						pos:  position{line: 1345, col: 11, offset: 50221},
 						name: "DoubleQuoteMarkedTextStringElement",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1300, col: 11, offset: 48474},
+						pos:  position{line: 1346, col: 11, offset: 50266},
 						name: "DoubleQuoteMarkedTextFallbackCharacter",
 					},
 				},
@@ -8890,26 +9380,26 @@ var g = &grammar{
 		},
 		{
 			name: "DoubleQuoteMarkedTextStringElement",
-			pos:  position{line: 1302, col: 1, offset: 48516},
+			pos:  position{line: 1348, col: 1, offset: 50306},
 			expr: &actionExpr{
-				pos: position{line: 1302, col: 39, offset: 48554},
+				pos: position{line: 1348, col: 39, offset: 50344},
 				run: (*parser).callonDoubleQuoteMarkedTextStringElement1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 1302, col: 39, offset: 48554},
+					pos: position{line: 1348, col: 39, offset: 50344},
 					expr: &seqExpr{
-						pos: position{line: 1302, col: 40, offset: 48555},
+						pos: position{line: 1348, col: 40, offset: 50345},
 						exprs: []interface{}{
 							&notExpr{
-								pos: position{line: 1302, col: 40, offset: 48555},
+								pos: position{line: 1348, col: 40, offset: 50345},
 								expr: &litMatcher{
-									pos:        position{line: 1302, col: 41, offset: 48556},
+									pos:        position{line: 1348, col: 41, offset: 50346},
 									val:        "##",
 									ignoreCase: false,
 									want:       "\"##\"",
 								},
 							},
 							&charClassMatcher{
-								pos:        position{line: 1302, col: 46, offset: 48561},
+								pos:        position{line: 1348, col: 46, offset: 50351},
 								val:        "[^\\r\\n ^~{}]",
 								chars:      []rune{'\r', '\n', ' ', '^', '~', '{', '}'},
 								ignoreCase: false,
@@ -8922,31 +9412,31 @@ var g = &grammar{
 		},
 		{
 			name: "DoubleQuoteMarkedTextFallbackCharacter",
-			pos:  position{line: 1306, col: 1, offset: 48635},
+			pos:  position{line: 1352, col: 1, offset: 50437},
 			expr: &choiceExpr{
-				pos: position{line: 1307, col: 5, offset: 48682},
+				pos: position{line: 1353, col: 5, offset: 50483},
 				alternatives: []interface{}{
 					&charClassMatcher{
-						pos:        position{line: 1307, col: 5, offset: 48682},
+						pos:        position{line: 1353, col: 5, offset: 50483},
 						val:        "[^\\r\\n#]",
 						chars:      []rune{'\r', '\n', '#'},
 						ignoreCase: false,
 						inverted:   true,
 					},
 					&actionExpr{
-						pos: position{line: 1308, col: 7, offset: 48782},
+						pos: position{line: 1354, col: 7, offset: 50582},
 						run: (*parser).callonDoubleQuoteMarkedTextFallbackCharacter3,
 						expr: &seqExpr{
-							pos: position{line: 1308, col: 7, offset: 48782},
+							pos: position{line: 1354, col: 7, offset: 50582},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 1308, col: 7, offset: 48782},
+									pos:        position{line: 1354, col: 7, offset: 50582},
 									val:        "##",
 									ignoreCase: false,
 									want:       "\"##\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1308, col: 12, offset: 48787},
+									pos:  position{line: 1354, col: 12, offset: 50587},
 									name: "Alphanums",
 								},
 							},
@@ -8957,49 +9447,49 @@ var g = &grammar{
 		},
 		{
 			name: "SingleQuoteMarkedText",
-			pos:  position{line: 1312, col: 1, offset: 48956},
+			pos:  position{line: 1358, col: 1, offset: 50768},
 			expr: &choiceExpr{
-				pos: position{line: 1312, col: 26, offset: 48981},
+				pos: position{line: 1358, col: 26, offset: 50793},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 1312, col: 26, offset: 48981},
+						pos: position{line: 1358, col: 26, offset: 50793},
 						run: (*parser).callonSingleQuoteMarkedText2,
 						expr: &seqExpr{
-							pos: position{line: 1312, col: 26, offset: 48981},
+							pos: position{line: 1358, col: 26, offset: 50793},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 1312, col: 26, offset: 48981},
+									pos:   position{line: 1358, col: 26, offset: 50793},
 									label: "attrs",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 1312, col: 32, offset: 48987},
+										pos: position{line: 1358, col: 32, offset: 50799},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1312, col: 33, offset: 48988},
+											pos:  position{line: 1358, col: 33, offset: 50800},
 											name: "QuotedTextAttrs",
 										},
 									},
 								},
 								&seqExpr{
-									pos: position{line: 1312, col: 52, offset: 49007},
+									pos: position{line: 1358, col: 52, offset: 50819},
 									exprs: []interface{}{
 										&notExpr{
-											pos: position{line: 1312, col: 52, offset: 49007},
+											pos: position{line: 1358, col: 52, offset: 50819},
 											expr: &litMatcher{
-												pos:        position{line: 1312, col: 53, offset: 49008},
+												pos:        position{line: 1358, col: 53, offset: 50820},
 												val:        "\\",
 												ignoreCase: false,
 												want:       "\"\\\\\"",
 											},
 										},
 										&litMatcher{
-											pos:        position{line: 1312, col: 57, offset: 49012},
+											pos:        position{line: 1358, col: 57, offset: 50824},
 											val:        "#",
 											ignoreCase: false,
 											want:       "\"#\"",
 										},
 										&notExpr{
-											pos: position{line: 1312, col: 61, offset: 49016},
+											pos: position{line: 1358, col: 61, offset: 50828},
 											expr: &litMatcher{
-												pos:        position{line: 1312, col: 62, offset: 49017},
+												pos:        position{line: 1358, col: 62, offset: 50829},
 												val:        "#",
 												ignoreCase: false,
 												want:       "\"#\"",
@@ -9008,15 +9498,15 @@ var g = &grammar{
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 1312, col: 67, offset: 49022},
+									pos:   position{line: 1358, col: 67, offset: 50834},
 									label: "elements",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1312, col: 77, offset: 49032},
+										pos:  position{line: 1358, col: 77, offset: 50844},
 										name: "SingleQuoteMarkedTextElements",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1312, col: 108, offset: 49063},
+									pos:        position{line: 1358, col: 108, offset: 50875},
 									val:        "#",
 									ignoreCase: false,
 									want:       "\"#\"",
@@ -9025,58 +9515,58 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1314, col: 5, offset: 49255},
+						pos: position{line: 1360, col: 5, offset: 51081},
 						run: (*parser).callonSingleQuoteMarkedText16,
 						expr: &seqExpr{
-							pos: position{line: 1314, col: 5, offset: 49255},
+							pos: position{line: 1360, col: 5, offset: 51081},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 1314, col: 5, offset: 49255},
+									pos:   position{line: 1360, col: 5, offset: 51081},
 									label: "attrs",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 1314, col: 11, offset: 49261},
+										pos: position{line: 1360, col: 11, offset: 51087},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1314, col: 12, offset: 49262},
+											pos:  position{line: 1360, col: 12, offset: 51088},
 											name: "QuotedTextAttrs",
 										},
 									},
 								},
 								&notExpr{
-									pos: position{line: 1314, col: 30, offset: 49280},
+									pos: position{line: 1360, col: 30, offset: 51106},
 									expr: &litMatcher{
-										pos:        position{line: 1314, col: 31, offset: 49281},
+										pos:        position{line: 1360, col: 31, offset: 51107},
 										val:        "\\\\",
 										ignoreCase: false,
 										want:       "\"\\\\\\\\\"",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1314, col: 36, offset: 49286},
+									pos:        position{line: 1360, col: 36, offset: 51112},
 									val:        "#",
 									ignoreCase: false,
 									want:       "\"#\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1314, col: 40, offset: 49290},
+									pos:   position{line: 1360, col: 40, offset: 51116},
 									label: "elements",
 									expr: &seqExpr{
-										pos: position{line: 1314, col: 50, offset: 49300},
+										pos: position{line: 1360, col: 50, offset: 51126},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 1314, col: 50, offset: 49300},
+												pos:        position{line: 1360, col: 50, offset: 51126},
 												val:        "#",
 												ignoreCase: false,
 												want:       "\"#\"",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 1314, col: 54, offset: 49304},
+												pos:  position{line: 1360, col: 54, offset: 51130},
 												name: "SingleQuoteMarkedTextElements",
 											},
 										},
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1314, col: 85, offset: 49335},
+									pos:        position{line: 1360, col: 85, offset: 51161},
 									val:        "#",
 									ignoreCase: false,
 									want:       "\"#\"",
@@ -9089,21 +9579,21 @@ var g = &grammar{
 		},
 		{
 			name: "SingleQuoteMarkedTextElements",
-			pos:  position{line: 1318, col: 1, offset: 49548},
+			pos:  position{line: 1364, col: 1, offset: 51386},
 			expr: &seqExpr{
-				pos: position{line: 1318, col: 34, offset: 49581},
+				pos: position{line: 1364, col: 34, offset: 51419},
 				exprs: []interface{}{
 					&notExpr{
-						pos: position{line: 1318, col: 34, offset: 49581},
+						pos: position{line: 1364, col: 34, offset: 51419},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1318, col: 35, offset: 49582},
+							pos:  position{line: 1364, col: 35, offset: 51420},
 							name: "Space",
 						},
 					},
 					&oneOrMoreExpr{
-						pos: position{line: 1318, col: 41, offset: 49588},
+						pos: position{line: 1364, col: 41, offset: 51426},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1318, col: 41, offset: 49588},
+							pos:  position{line: 1364, col: 41, offset: 51426},
 							name: "SingleQuoteMarkedTextElement",
 						},
 					},
@@ -9112,43 +9602,43 @@ var g = &grammar{
 		},
 		{
 			name: "SingleQuoteMarkedTextElement",
-			pos:  position{line: 1320, col: 1, offset: 49621},
+			pos:  position{line: 1366, col: 1, offset: 51457},
 			expr: &choiceExpr{
-				pos: position{line: 1320, col: 33, offset: 49653},
+				pos: position{line: 1366, col: 33, offset: 51489},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1320, col: 33, offset: 49653},
+						pos:  position{line: 1366, col: 33, offset: 51489},
 						name: "Word",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1321, col: 11, offset: 49669},
+						pos:  position{line: 1367, col: 11, offset: 51504},
 						name: "DoubleQuoteMarkedText",
 					},
 					&seqExpr{
-						pos: position{line: 1322, col: 11, offset: 49702},
+						pos: position{line: 1368, col: 11, offset: 51536},
 						exprs: []interface{}{
 							&oneOrMoreExpr{
-								pos: position{line: 1322, col: 11, offset: 49702},
+								pos: position{line: 1368, col: 11, offset: 51536},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1322, col: 11, offset: 49702},
+									pos:  position{line: 1368, col: 11, offset: 51536},
 									name: "Space",
 								},
 							},
 							&zeroOrOneExpr{
-								pos: position{line: 1322, col: 18, offset: 49709},
+								pos: position{line: 1368, col: 18, offset: 51543},
 								expr: &seqExpr{
-									pos: position{line: 1322, col: 19, offset: 49710},
+									pos: position{line: 1368, col: 19, offset: 51544},
 									exprs: []interface{}{
 										&litMatcher{
-											pos:        position{line: 1322, col: 19, offset: 49710},
+											pos:        position{line: 1368, col: 19, offset: 51544},
 											val:        "#",
 											ignoreCase: false,
 											want:       "\"#\"",
 										},
 										&notExpr{
-											pos: position{line: 1322, col: 23, offset: 49714},
+											pos: position{line: 1368, col: 23, offset: 51548},
 											expr: &litMatcher{
-												pos:        position{line: 1322, col: 24, offset: 49715},
+												pos:        position{line: 1368, col: 24, offset: 51549},
 												val:        "#",
 												ignoreCase: false,
 												want:       "\"#\"",
@@ -9160,55 +9650,55 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1323, col: 11, offset: 49732},
+						pos:  position{line: 1369, col: 11, offset: 51565},
 						name: "BoldText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1324, col: 11, offset: 49752},
+						pos:  position{line: 1370, col: 11, offset: 51584},
 						name: "ItalicText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1325, col: 11, offset: 49774},
+						pos:  position{line: 1371, col: 11, offset: 51605},
 						name: "MonospaceText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1326, col: 11, offset: 49799},
+						pos:  position{line: 1372, col: 11, offset: 51629},
 						name: "SubscriptText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1327, col: 11, offset: 49824},
+						pos:  position{line: 1373, col: 11, offset: 51653},
 						name: "SuperscriptText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1328, col: 11, offset: 49851},
+						pos:  position{line: 1374, col: 11, offset: 51679},
 						name: "InlineIcon",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1329, col: 11, offset: 49873},
+						pos:  position{line: 1375, col: 11, offset: 51700},
 						name: "InlineImage",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1330, col: 11, offset: 49896},
+						pos:  position{line: 1376, col: 11, offset: 51722},
 						name: "Link",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1331, col: 11, offset: 49912},
+						pos:  position{line: 1377, col: 11, offset: 51737},
 						name: "InlinePassthrough",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1332, col: 11, offset: 49941},
+						pos:  position{line: 1378, col: 11, offset: 51765},
 						name: "QuotedString",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1333, col: 11, offset: 49965},
+						pos:  position{line: 1379, col: 11, offset: 51788},
 						name: "AttributeSubstitution",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1334, col: 11, offset: 49998},
+						pos:  position{line: 1380, col: 11, offset: 51820},
 						name: "SingleQuoteMarkedTextStringElement",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1335, col: 11, offset: 50044},
+						pos:  position{line: 1381, col: 11, offset: 51865},
 						name: "SingleQuoteMarkedTextFallbackCharacter",
 					},
 				},
@@ -9216,14 +9706,14 @@ var g = &grammar{
 		},
 		{
 			name: "SingleQuoteMarkedTextStringElement",
-			pos:  position{line: 1337, col: 1, offset: 50086},
+			pos:  position{line: 1383, col: 1, offset: 51905},
 			expr: &actionExpr{
-				pos: position{line: 1337, col: 39, offset: 50124},
+				pos: position{line: 1383, col: 39, offset: 51943},
 				run: (*parser).callonSingleQuoteMarkedTextStringElement1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 1337, col: 39, offset: 50124},
+					pos: position{line: 1383, col: 39, offset: 51943},
 					expr: &charClassMatcher{
-						pos:        position{line: 1337, col: 39, offset: 50124},
+						pos:        position{line: 1383, col: 39, offset: 51943},
 						val:        "[^\\r\\n{} #^~]",
 						chars:      []rune{'\r', '\n', '{', '}', ' ', '#', '^', '~'},
 						ignoreCase: false,
@@ -9234,31 +9724,31 @@ var g = &grammar{
 		},
 		{
 			name: "SingleQuoteMarkedTextFallbackCharacter",
-			pos:  position{line: 1341, col: 1, offset: 50354},
+			pos:  position{line: 1387, col: 1, offset: 52185},
 			expr: &choiceExpr{
-				pos: position{line: 1342, col: 5, offset: 50401},
+				pos: position{line: 1388, col: 5, offset: 52231},
 				alternatives: []interface{}{
 					&charClassMatcher{
-						pos:        position{line: 1342, col: 5, offset: 50401},
+						pos:        position{line: 1388, col: 5, offset: 52231},
 						val:        "[^\\r\\n#]",
 						chars:      []rune{'\r', '\n', '#'},
 						ignoreCase: false,
 						inverted:   true,
 					},
 					&actionExpr{
-						pos: position{line: 1343, col: 7, offset: 50499},
+						pos: position{line: 1389, col: 7, offset: 52328},
 						run: (*parser).callonSingleQuoteMarkedTextFallbackCharacter3,
 						expr: &seqExpr{
-							pos: position{line: 1343, col: 7, offset: 50499},
+							pos: position{line: 1389, col: 7, offset: 52328},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 1343, col: 7, offset: 50499},
+									pos:        position{line: 1389, col: 7, offset: 52328},
 									val:        "#",
 									ignoreCase: false,
 									want:       "\"#\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1343, col: 11, offset: 50503},
+									pos:  position{line: 1389, col: 11, offset: 52332},
 									name: "Alphanums",
 								},
 							},
@@ -9269,40 +9759,40 @@ var g = &grammar{
 		},
 		{
 			name: "EscapedMarkedText",
-			pos:  position{line: 1347, col: 1, offset: 50670},
+			pos:  position{line: 1393, col: 1, offset: 52511},
 			expr: &choiceExpr{
-				pos: position{line: 1348, col: 5, offset: 50696},
+				pos: position{line: 1394, col: 5, offset: 52536},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 1348, col: 5, offset: 50696},
+						pos: position{line: 1394, col: 5, offset: 52536},
 						run: (*parser).callonEscapedMarkedText2,
 						expr: &seqExpr{
-							pos: position{line: 1348, col: 5, offset: 50696},
+							pos: position{line: 1394, col: 5, offset: 52536},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 1348, col: 5, offset: 50696},
+									pos:   position{line: 1394, col: 5, offset: 52536},
 									label: "backslashes",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1348, col: 18, offset: 50709},
+										pos:  position{line: 1394, col: 18, offset: 52549},
 										name: "TwoOrMoreBackslashes",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1348, col: 40, offset: 50731},
+									pos:        position{line: 1394, col: 40, offset: 52571},
 									val:        "##",
 									ignoreCase: false,
 									want:       "\"##\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1348, col: 45, offset: 50736},
+									pos:   position{line: 1394, col: 45, offset: 52576},
 									label: "elements",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1348, col: 55, offset: 50746},
+										pos:  position{line: 1394, col: 55, offset: 52586},
 										name: "DoubleQuoteMarkedTextElements",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1348, col: 86, offset: 50777},
+									pos:        position{line: 1394, col: 86, offset: 52617},
 									val:        "##",
 									ignoreCase: false,
 									want:       "\"##\"",
@@ -9311,35 +9801,35 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1350, col: 9, offset: 50936},
+						pos: position{line: 1396, col: 9, offset: 52774},
 						run: (*parser).callonEscapedMarkedText10,
 						expr: &seqExpr{
-							pos: position{line: 1350, col: 9, offset: 50936},
+							pos: position{line: 1396, col: 9, offset: 52774},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 1350, col: 9, offset: 50936},
+									pos:   position{line: 1396, col: 9, offset: 52774},
 									label: "backslashes",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1350, col: 22, offset: 50949},
+										pos:  position{line: 1396, col: 22, offset: 52787},
 										name: "OneOrMoreBackslashes",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1350, col: 44, offset: 50971},
+									pos:        position{line: 1396, col: 44, offset: 52809},
 									val:        "##",
 									ignoreCase: false,
 									want:       "\"##\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1350, col: 49, offset: 50976},
+									pos:   position{line: 1396, col: 49, offset: 52814},
 									label: "elements",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1350, col: 59, offset: 50986},
+										pos:  position{line: 1396, col: 59, offset: 52824},
 										name: "SingleQuoteMarkedTextElements",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1350, col: 90, offset: 51017},
+									pos:        position{line: 1396, col: 90, offset: 52855},
 									val:        "#",
 									ignoreCase: false,
 									want:       "\"#\"",
@@ -9348,35 +9838,35 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1353, col: 9, offset: 51220},
+						pos: position{line: 1399, col: 9, offset: 53055},
 						run: (*parser).callonEscapedMarkedText18,
 						expr: &seqExpr{
-							pos: position{line: 1353, col: 9, offset: 51220},
+							pos: position{line: 1399, col: 9, offset: 53055},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 1353, col: 9, offset: 51220},
+									pos:   position{line: 1399, col: 9, offset: 53055},
 									label: "backslashes",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1353, col: 22, offset: 51233},
+										pos:  position{line: 1399, col: 22, offset: 53068},
 										name: "OneOrMoreBackslashes",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1353, col: 44, offset: 51255},
+									pos:        position{line: 1399, col: 44, offset: 53090},
 									val:        "#",
 									ignoreCase: false,
 									want:       "\"#\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1353, col: 48, offset: 51259},
+									pos:   position{line: 1399, col: 48, offset: 53094},
 									label: "elements",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1353, col: 58, offset: 51269},
+										pos:  position{line: 1399, col: 58, offset: 53104},
 										name: "SingleQuoteMarkedTextElements",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1353, col: 89, offset: 51300},
+									pos:        position{line: 1399, col: 89, offset: 53135},
 									val:        "#",
 									ignoreCase: false,
 									want:       "\"#\"",
@@ -9389,49 +9879,49 @@ var g = &grammar{
 		},
 		{
 			name: "SubscriptText",
-			pos:  position{line: 1358, col: 1, offset: 51455},
+			pos:  position{line: 1404, col: 1, offset: 53285},
 			expr: &actionExpr{
-				pos: position{line: 1358, col: 18, offset: 51472},
+				pos: position{line: 1404, col: 18, offset: 53302},
 				run: (*parser).callonSubscriptText1,
 				expr: &seqExpr{
-					pos: position{line: 1358, col: 18, offset: 51472},
+					pos: position{line: 1404, col: 18, offset: 53302},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1358, col: 18, offset: 51472},
+							pos:   position{line: 1404, col: 18, offset: 53302},
 							label: "attrs",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1358, col: 24, offset: 51478},
+								pos: position{line: 1404, col: 24, offset: 53308},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1358, col: 25, offset: 51479},
+									pos:  position{line: 1404, col: 25, offset: 53309},
 									name: "QuotedTextAttrs",
 								},
 							},
 						},
 						&notExpr{
-							pos: position{line: 1358, col: 43, offset: 51497},
+							pos: position{line: 1404, col: 43, offset: 53327},
 							expr: &litMatcher{
-								pos:        position{line: 1358, col: 44, offset: 51498},
+								pos:        position{line: 1404, col: 44, offset: 53328},
 								val:        "\\",
 								ignoreCase: false,
 								want:       "\"\\\\\"",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1358, col: 48, offset: 51502},
+							pos:        position{line: 1404, col: 48, offset: 53332},
 							val:        "~",
 							ignoreCase: false,
 							want:       "\"~\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 1358, col: 52, offset: 51506},
+							pos:   position{line: 1404, col: 52, offset: 53336},
 							label: "element",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1358, col: 61, offset: 51515},
+								pos:  position{line: 1404, col: 61, offset: 53345},
 								name: "SubscriptTextElement",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1358, col: 83, offset: 51537},
+							pos:        position{line: 1404, col: 83, offset: 53367},
 							val:        "~",
 							ignoreCase: false,
 							want:       "\"~\"",
@@ -9442,16 +9932,16 @@ var g = &grammar{
 		},
 		{
 			name: "SubscriptTextElement",
-			pos:  position{line: 1362, col: 1, offset: 51637},
+			pos:  position{line: 1408, col: 1, offset: 53479},
 			expr: &choiceExpr{
-				pos: position{line: 1362, col: 25, offset: 51661},
+				pos: position{line: 1408, col: 25, offset: 53503},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1362, col: 25, offset: 51661},
+						pos:  position{line: 1408, col: 25, offset: 53503},
 						name: "QuotedText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1362, col: 38, offset: 51674},
+						pos:  position{line: 1408, col: 38, offset: 53516},
 						name: "NonSubscriptText",
 					},
 				},
@@ -9459,14 +9949,14 @@ var g = &grammar{
 		},
 		{
 			name: "NonSubscriptText",
-			pos:  position{line: 1364, col: 1, offset: 51695},
+			pos:  position{line: 1410, col: 1, offset: 53535},
 			expr: &actionExpr{
-				pos: position{line: 1364, col: 21, offset: 51715},
+				pos: position{line: 1410, col: 21, offset: 53555},
 				run: (*parser).callonNonSubscriptText1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 1364, col: 21, offset: 51715},
+					pos: position{line: 1410, col: 21, offset: 53555},
 					expr: &charClassMatcher{
-						pos:        position{line: 1364, col: 21, offset: 51715},
+						pos:        position{line: 1410, col: 21, offset: 53555},
 						val:        "[^\\r\\n ~]",
 						chars:      []rune{'\r', '\n', ' ', '~'},
 						ignoreCase: false,
@@ -9477,37 +9967,37 @@ var g = &grammar{
 		},
 		{
 			name: "EscapedSubscriptText",
-			pos:  position{line: 1368, col: 1, offset: 51796},
+			pos:  position{line: 1414, col: 1, offset: 53632},
 			expr: &actionExpr{
-				pos: position{line: 1368, col: 25, offset: 51820},
+				pos: position{line: 1414, col: 25, offset: 53656},
 				run: (*parser).callonEscapedSubscriptText1,
 				expr: &seqExpr{
-					pos: position{line: 1368, col: 25, offset: 51820},
+					pos: position{line: 1414, col: 25, offset: 53656},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1368, col: 25, offset: 51820},
+							pos:   position{line: 1414, col: 25, offset: 53656},
 							label: "backslashes",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1368, col: 38, offset: 51833},
+								pos:  position{line: 1414, col: 38, offset: 53669},
 								name: "OneOrMoreBackslashes",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1368, col: 60, offset: 51855},
+							pos:        position{line: 1414, col: 60, offset: 53691},
 							val:        "~",
 							ignoreCase: false,
 							want:       "\"~\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 1368, col: 64, offset: 51859},
+							pos:   position{line: 1414, col: 64, offset: 53695},
 							label: "element",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1368, col: 73, offset: 51868},
+								pos:  position{line: 1414, col: 73, offset: 53704},
 								name: "SubscriptTextElement",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1368, col: 95, offset: 51890},
+							pos:        position{line: 1414, col: 95, offset: 53726},
 							val:        "~",
 							ignoreCase: false,
 							want:       "\"~\"",
@@ -9518,49 +10008,49 @@ var g = &grammar{
 		},
 		{
 			name: "SuperscriptText",
-			pos:  position{line: 1372, col: 1, offset: 52023},
+			pos:  position{line: 1418, col: 1, offset: 53855},
 			expr: &actionExpr{
-				pos: position{line: 1372, col: 20, offset: 52042},
+				pos: position{line: 1418, col: 20, offset: 53874},
 				run: (*parser).callonSuperscriptText1,
 				expr: &seqExpr{
-					pos: position{line: 1372, col: 20, offset: 52042},
+					pos: position{line: 1418, col: 20, offset: 53874},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1372, col: 20, offset: 52042},
+							pos:   position{line: 1418, col: 20, offset: 53874},
 							label: "attrs",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1372, col: 26, offset: 52048},
+								pos: position{line: 1418, col: 26, offset: 53880},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1372, col: 27, offset: 52049},
+									pos:  position{line: 1418, col: 27, offset: 53881},
 									name: "QuotedTextAttrs",
 								},
 							},
 						},
 						&notExpr{
-							pos: position{line: 1372, col: 45, offset: 52067},
+							pos: position{line: 1418, col: 45, offset: 53899},
 							expr: &litMatcher{
-								pos:        position{line: 1372, col: 46, offset: 52068},
+								pos:        position{line: 1418, col: 46, offset: 53900},
 								val:        "\\",
 								ignoreCase: false,
 								want:       "\"\\\\\"",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1372, col: 50, offset: 52072},
+							pos:        position{line: 1418, col: 50, offset: 53904},
 							val:        "^",
 							ignoreCase: false,
 							want:       "\"^\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 1372, col: 54, offset: 52076},
+							pos:   position{line: 1418, col: 54, offset: 53908},
 							label: "element",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1372, col: 63, offset: 52085},
+								pos:  position{line: 1418, col: 63, offset: 53917},
 								name: "SuperscriptTextElement",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1372, col: 87, offset: 52109},
+							pos:        position{line: 1418, col: 87, offset: 53941},
 							val:        "^",
 							ignoreCase: false,
 							want:       "\"^\"",
@@ -9571,16 +10061,16 @@ var g = &grammar{
 		},
 		{
 			name: "SuperscriptTextElement",
-			pos:  position{line: 1376, col: 1, offset: 52211},
+			pos:  position{line: 1422, col: 1, offset: 54055},
 			expr: &choiceExpr{
-				pos: position{line: 1376, col: 27, offset: 52237},
+				pos: position{line: 1422, col: 27, offset: 54081},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1376, col: 27, offset: 52237},
+						pos:  position{line: 1422, col: 27, offset: 54081},
 						name: "QuotedText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1376, col: 40, offset: 52250},
+						pos:  position{line: 1422, col: 40, offset: 54094},
 						name: "NonSuperscriptText",
 					},
 				},
@@ -9588,14 +10078,14 @@ var g = &grammar{
 		},
 		{
 			name: "NonSuperscriptText",
-			pos:  position{line: 1378, col: 1, offset: 52273},
+			pos:  position{line: 1424, col: 1, offset: 54115},
 			expr: &actionExpr{
-				pos: position{line: 1378, col: 23, offset: 52295},
+				pos: position{line: 1424, col: 23, offset: 54137},
 				run: (*parser).callonNonSuperscriptText1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 1378, col: 23, offset: 52295},
+					pos: position{line: 1424, col: 23, offset: 54137},
 					expr: &charClassMatcher{
-						pos:        position{line: 1378, col: 23, offset: 52295},
+						pos:        position{line: 1424, col: 23, offset: 54137},
 						val:        "[^\\r\\n ^]",
 						chars:      []rune{'\r', '\n', ' ', '^'},
 						ignoreCase: false,
@@ -9606,37 +10096,37 @@ var g = &grammar{
 		},
 		{
 			name: "EscapedSuperscriptText",
-			pos:  position{line: 1382, col: 1, offset: 52376},
+			pos:  position{line: 1428, col: 1, offset: 54214},
 			expr: &actionExpr{
-				pos: position{line: 1382, col: 27, offset: 52402},
+				pos: position{line: 1428, col: 27, offset: 54240},
 				run: (*parser).callonEscapedSuperscriptText1,
 				expr: &seqExpr{
-					pos: position{line: 1382, col: 27, offset: 52402},
+					pos: position{line: 1428, col: 27, offset: 54240},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1382, col: 27, offset: 52402},
+							pos:   position{line: 1428, col: 27, offset: 54240},
 							label: "backslashes",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1382, col: 40, offset: 52415},
+								pos:  position{line: 1428, col: 40, offset: 54253},
 								name: "OneOrMoreBackslashes",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1382, col: 62, offset: 52437},
+							pos:        position{line: 1428, col: 62, offset: 54275},
 							val:        "^",
 							ignoreCase: false,
 							want:       "\"^\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 1382, col: 66, offset: 52441},
+							pos:   position{line: 1428, col: 66, offset: 54279},
 							label: "element",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1382, col: 75, offset: 52450},
+								pos:  position{line: 1428, col: 75, offset: 54288},
 								name: "SuperscriptTextElement",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1382, col: 99, offset: 52474},
+							pos:        position{line: 1428, col: 99, offset: 54312},
 							val:        "^",
 							ignoreCase: false,
 							want:       "\"^\"",
@@ -9647,20 +10137,20 @@ var g = &grammar{
 		},
 		{
 			name: "InlinePassthrough",
-			pos:  position{line: 1389, col: 1, offset: 52723},
+			pos:  position{line: 1435, col: 1, offset: 54554},
 			expr: &choiceExpr{
-				pos: position{line: 1389, col: 22, offset: 52744},
+				pos: position{line: 1435, col: 22, offset: 54575},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1389, col: 22, offset: 52744},
+						pos:  position{line: 1435, col: 22, offset: 54575},
 						name: "TriplePlusPassthrough",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1389, col: 46, offset: 52768},
+						pos:  position{line: 1435, col: 46, offset: 54599},
 						name: "SinglePlusPassthrough",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1389, col: 70, offset: 52792},
+						pos:  position{line: 1435, col: 70, offset: 54623},
 						name: "PassthroughMacro",
 					},
 				},
@@ -9668,9 +10158,9 @@ var g = &grammar{
 		},
 		{
 			name: "SinglePlusPassthroughPrefix",
-			pos:  position{line: 1391, col: 1, offset: 52812},
+			pos:  position{line: 1437, col: 1, offset: 54641},
 			expr: &litMatcher{
-				pos:        position{line: 1391, col: 32, offset: 52843},
+				pos:        position{line: 1437, col: 32, offset: 54672},
 				val:        "+",
 				ignoreCase: false,
 				want:       "\"+\"",
@@ -9678,33 +10168,33 @@ var g = &grammar{
 		},
 		{
 			name: "SinglePlusPassthrough",
-			pos:  position{line: 1393, col: 1, offset: 52850},
+			pos:  position{line: 1439, col: 1, offset: 54677},
 			expr: &actionExpr{
-				pos: position{line: 1393, col: 26, offset: 52875},
+				pos: position{line: 1439, col: 26, offset: 54702},
 				run: (*parser).callonSinglePlusPassthrough1,
 				expr: &seqExpr{
-					pos: position{line: 1393, col: 26, offset: 52875},
+					pos: position{line: 1439, col: 26, offset: 54702},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 1393, col: 26, offset: 52875},
+							pos:  position{line: 1439, col: 26, offset: 54702},
 							name: "SinglePlusPassthroughPrefix",
 						},
 						&labeledExpr{
-							pos:   position{line: 1393, col: 54, offset: 52903},
+							pos:   position{line: 1439, col: 54, offset: 54730},
 							label: "content",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1393, col: 63, offset: 52912},
+								pos:  position{line: 1439, col: 63, offset: 54739},
 								name: "SinglePlusPassthroughContent",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1393, col: 93, offset: 52942},
+							pos:  position{line: 1439, col: 93, offset: 54769},
 							name: "SinglePlusPassthroughPrefix",
 						},
 						&notExpr{
-							pos: position{line: 1393, col: 121, offset: 52970},
+							pos: position{line: 1439, col: 121, offset: 54797},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1393, col: 122, offset: 52971},
+								pos:  position{line: 1439, col: 122, offset: 54798},
 								name: "Alphanum",
 							},
 						},
@@ -9714,85 +10204,85 @@ var g = &grammar{
 		},
 		{
 			name: "SinglePlusPassthroughContent",
-			pos:  position{line: 1397, col: 1, offset: 53080},
+			pos:  position{line: 1443, col: 1, offset: 54919},
 			expr: &choiceExpr{
-				pos: position{line: 1397, col: 33, offset: 53112},
+				pos: position{line: 1443, col: 33, offset: 54951},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 1397, col: 34, offset: 53113},
+						pos: position{line: 1443, col: 34, offset: 54952},
 						run: (*parser).callonSinglePlusPassthroughContent2,
 						expr: &seqExpr{
-							pos: position{line: 1397, col: 34, offset: 53113},
+							pos: position{line: 1443, col: 34, offset: 54952},
 							exprs: []interface{}{
 								&seqExpr{
-									pos: position{line: 1397, col: 35, offset: 53114},
+									pos: position{line: 1443, col: 35, offset: 54953},
 									exprs: []interface{}{
 										&notExpr{
-											pos: position{line: 1397, col: 35, offset: 53114},
+											pos: position{line: 1443, col: 35, offset: 54953},
 											expr: &ruleRefExpr{
-												pos:  position{line: 1397, col: 36, offset: 53115},
+												pos:  position{line: 1443, col: 36, offset: 54954},
 												name: "SinglePlusPassthroughPrefix",
 											},
 										},
 										&notExpr{
-											pos: position{line: 1397, col: 64, offset: 53143},
+											pos: position{line: 1443, col: 64, offset: 54982},
 											expr: &ruleRefExpr{
-												pos:  position{line: 1397, col: 65, offset: 53144},
+												pos:  position{line: 1443, col: 65, offset: 54983},
 												name: "Space",
 											},
 										},
 										&notExpr{
-											pos: position{line: 1397, col: 71, offset: 53150},
+											pos: position{line: 1443, col: 71, offset: 54989},
 											expr: &ruleRefExpr{
-												pos:  position{line: 1397, col: 72, offset: 53151},
+												pos:  position{line: 1443, col: 72, offset: 54990},
 												name: "Newline",
 											},
 										},
 										&anyMatcher{
-											line: 1397, col: 80, offset: 53159,
+											line: 1443, col: 80, offset: 54998,
 										},
 									},
 								},
 								&zeroOrMoreExpr{
-									pos: position{line: 1397, col: 83, offset: 53162},
+									pos: position{line: 1443, col: 83, offset: 55001},
 									expr: &seqExpr{
-										pos: position{line: 1397, col: 84, offset: 53163},
+										pos: position{line: 1443, col: 84, offset: 55002},
 										exprs: []interface{}{
 											&notExpr{
-												pos: position{line: 1397, col: 84, offset: 53163},
+												pos: position{line: 1443, col: 84, offset: 55002},
 												expr: &seqExpr{
-													pos: position{line: 1397, col: 86, offset: 53165},
+													pos: position{line: 1443, col: 86, offset: 55004},
 													exprs: []interface{}{
 														&oneOrMoreExpr{
-															pos: position{line: 1397, col: 86, offset: 53165},
+															pos: position{line: 1443, col: 86, offset: 55004},
 															expr: &ruleRefExpr{
-																pos:  position{line: 1397, col: 86, offset: 53165},
+																pos:  position{line: 1443, col: 86, offset: 55004},
 																name: "Space",
 															},
 														},
 														&ruleRefExpr{
-															pos:  position{line: 1397, col: 93, offset: 53172},
+															pos:  position{line: 1443, col: 93, offset: 55011},
 															name: "SinglePlusPassthroughPrefix",
 														},
 													},
 												},
 											},
 											&notExpr{
-												pos: position{line: 1397, col: 122, offset: 53201},
+												pos: position{line: 1443, col: 122, offset: 55040},
 												expr: &ruleRefExpr{
-													pos:  position{line: 1397, col: 123, offset: 53202},
+													pos:  position{line: 1443, col: 123, offset: 55041},
 													name: "SinglePlusPassthroughPrefix",
 												},
 											},
 											&notExpr{
-												pos: position{line: 1397, col: 151, offset: 53230},
+												pos: position{line: 1443, col: 151, offset: 55069},
 												expr: &ruleRefExpr{
-													pos:  position{line: 1397, col: 152, offset: 53231},
+													pos:  position{line: 1443, col: 152, offset: 55070},
 													name: "Newline",
 												},
 											},
 											&anyMatcher{
-												line: 1397, col: 160, offset: 53239,
+												line: 1443, col: 160, offset: 55078,
 											},
 										},
 									},
@@ -9801,34 +10291,34 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1399, col: 7, offset: 53383},
+						pos: position{line: 1445, col: 7, offset: 55236},
 						run: (*parser).callonSinglePlusPassthroughContent24,
 						expr: &seqExpr{
-							pos: position{line: 1399, col: 8, offset: 53384},
+							pos: position{line: 1445, col: 8, offset: 55237},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 1399, col: 8, offset: 53384},
+									pos: position{line: 1445, col: 8, offset: 55237},
 									expr: &ruleRefExpr{
-										pos:  position{line: 1399, col: 9, offset: 53385},
+										pos:  position{line: 1445, col: 9, offset: 55238},
 										name: "Space",
 									},
 								},
 								&notExpr{
-									pos: position{line: 1399, col: 15, offset: 53391},
+									pos: position{line: 1445, col: 15, offset: 55244},
 									expr: &ruleRefExpr{
-										pos:  position{line: 1399, col: 16, offset: 53392},
+										pos:  position{line: 1445, col: 16, offset: 55245},
 										name: "Newline",
 									},
 								},
 								&notExpr{
-									pos: position{line: 1399, col: 24, offset: 53400},
+									pos: position{line: 1445, col: 24, offset: 55253},
 									expr: &ruleRefExpr{
-										pos:  position{line: 1399, col: 25, offset: 53401},
+										pos:  position{line: 1445, col: 25, offset: 55254},
 										name: "SinglePlusPassthroughPrefix",
 									},
 								},
 								&anyMatcher{
-									line: 1399, col: 53, offset: 53429,
+									line: 1445, col: 53, offset: 55282,
 								},
 							},
 						},
@@ -9838,9 +10328,9 @@ var g = &grammar{
 		},
 		{
 			name: "TriplePlusPassthroughPrefix",
-			pos:  position{line: 1403, col: 1, offset: 53515},
+			pos:  position{line: 1449, col: 1, offset: 55380},
 			expr: &litMatcher{
-				pos:        position{line: 1403, col: 32, offset: 53546},
+				pos:        position{line: 1449, col: 32, offset: 55411},
 				val:        "+++",
 				ignoreCase: false,
 				want:       "\"+++\"",
@@ -9848,33 +10338,33 @@ var g = &grammar{
 		},
 		{
 			name: "TriplePlusPassthrough",
-			pos:  position{line: 1405, col: 1, offset: 53555},
+			pos:  position{line: 1451, col: 1, offset: 55418},
 			expr: &actionExpr{
-				pos: position{line: 1405, col: 26, offset: 53580},
+				pos: position{line: 1451, col: 26, offset: 55443},
 				run: (*parser).callonTriplePlusPassthrough1,
 				expr: &seqExpr{
-					pos: position{line: 1405, col: 26, offset: 53580},
+					pos: position{line: 1451, col: 26, offset: 55443},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 1405, col: 26, offset: 53580},
+							pos:  position{line: 1451, col: 26, offset: 55443},
 							name: "TriplePlusPassthroughPrefix",
 						},
 						&labeledExpr{
-							pos:   position{line: 1405, col: 54, offset: 53608},
+							pos:   position{line: 1451, col: 54, offset: 55471},
 							label: "content",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1405, col: 63, offset: 53617},
+								pos:  position{line: 1451, col: 63, offset: 55480},
 								name: "TriplePlusPassthroughContent",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1405, col: 93, offset: 53647},
+							pos:  position{line: 1451, col: 93, offset: 55510},
 							name: "TriplePlusPassthroughPrefix",
 						},
 						&notExpr{
-							pos: position{line: 1405, col: 121, offset: 53675},
+							pos: position{line: 1451, col: 121, offset: 55538},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1405, col: 122, offset: 53676},
+								pos:  position{line: 1451, col: 122, offset: 55539},
 								name: "Alphanum",
 							},
 						},
@@ -9884,63 +10374,63 @@ var g = &grammar{
 		},
 		{
 			name: "TriplePlusPassthroughContent",
-			pos:  position{line: 1409, col: 1, offset: 53785},
+			pos:  position{line: 1455, col: 1, offset: 55660},
 			expr: &choiceExpr{
-				pos: position{line: 1409, col: 33, offset: 53817},
+				pos: position{line: 1455, col: 33, offset: 55692},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 1409, col: 34, offset: 53818},
+						pos: position{line: 1455, col: 34, offset: 55693},
 						run: (*parser).callonTriplePlusPassthroughContent2,
 						expr: &zeroOrMoreExpr{
-							pos: position{line: 1409, col: 34, offset: 53818},
+							pos: position{line: 1455, col: 34, offset: 55693},
 							expr: &seqExpr{
-								pos: position{line: 1409, col: 35, offset: 53819},
+								pos: position{line: 1455, col: 35, offset: 55694},
 								exprs: []interface{}{
 									&notExpr{
-										pos: position{line: 1409, col: 35, offset: 53819},
+										pos: position{line: 1455, col: 35, offset: 55694},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1409, col: 36, offset: 53820},
+											pos:  position{line: 1455, col: 36, offset: 55695},
 											name: "TriplePlusPassthroughPrefix",
 										},
 									},
 									&anyMatcher{
-										line: 1409, col: 64, offset: 53848,
+										line: 1455, col: 64, offset: 55723,
 									},
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1411, col: 7, offset: 54015},
+						pos: position{line: 1457, col: 7, offset: 55904},
 						run: (*parser).callonTriplePlusPassthroughContent8,
 						expr: &zeroOrOneExpr{
-							pos: position{line: 1411, col: 7, offset: 54015},
+							pos: position{line: 1457, col: 7, offset: 55904},
 							expr: &seqExpr{
-								pos: position{line: 1411, col: 8, offset: 54016},
+								pos: position{line: 1457, col: 8, offset: 55905},
 								exprs: []interface{}{
 									&notExpr{
-										pos: position{line: 1411, col: 8, offset: 54016},
+										pos: position{line: 1457, col: 8, offset: 55905},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1411, col: 9, offset: 54017},
+											pos:  position{line: 1457, col: 9, offset: 55906},
 											name: "Space",
 										},
 									},
 									&notExpr{
-										pos: position{line: 1411, col: 15, offset: 54023},
+										pos: position{line: 1457, col: 15, offset: 55912},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1411, col: 16, offset: 54024},
+											pos:  position{line: 1457, col: 16, offset: 55913},
 											name: "Newline",
 										},
 									},
 									&notExpr{
-										pos: position{line: 1411, col: 24, offset: 54032},
+										pos: position{line: 1457, col: 24, offset: 55921},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1411, col: 25, offset: 54033},
+											pos:  position{line: 1457, col: 25, offset: 55922},
 											name: "TriplePlusPassthroughPrefix",
 										},
 									},
 									&anyMatcher{
-										line: 1411, col: 53, offset: 54061,
+										line: 1457, col: 53, offset: 55950,
 									},
 								},
 							},
@@ -9951,35 +10441,35 @@ var g = &grammar{
 		},
 		{
 			name: "PassthroughMacro",
-			pos:  position{line: 1415, col: 1, offset: 54148},
+			pos:  position{line: 1461, col: 1, offset: 56049},
 			expr: &choiceExpr{
-				pos: position{line: 1415, col: 21, offset: 54168},
+				pos: position{line: 1461, col: 21, offset: 56069},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 1415, col: 21, offset: 54168},
+						pos: position{line: 1461, col: 21, offset: 56069},
 						run: (*parser).callonPassthroughMacro2,
 						expr: &seqExpr{
-							pos: position{line: 1415, col: 21, offset: 54168},
+							pos: position{line: 1461, col: 21, offset: 56069},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 1415, col: 21, offset: 54168},
+									pos:        position{line: 1461, col: 21, offset: 56069},
 									val:        "pass:[",
 									ignoreCase: false,
 									want:       "\"pass:[\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1415, col: 30, offset: 54177},
+									pos:   position{line: 1461, col: 30, offset: 56078},
 									label: "content",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 1415, col: 38, offset: 54185},
+										pos: position{line: 1461, col: 38, offset: 56086},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1415, col: 39, offset: 54186},
+											pos:  position{line: 1461, col: 39, offset: 56087},
 											name: "PassthroughMacroCharacter",
 										},
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1415, col: 67, offset: 54214},
+									pos:        position{line: 1461, col: 67, offset: 56115},
 									val:        "]",
 									ignoreCase: false,
 									want:       "\"]\"",
@@ -9988,31 +10478,31 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1417, col: 5, offset: 54312},
+						pos: position{line: 1463, col: 5, offset: 56227},
 						run: (*parser).callonPassthroughMacro9,
 						expr: &seqExpr{
-							pos: position{line: 1417, col: 5, offset: 54312},
+							pos: position{line: 1463, col: 5, offset: 56227},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 1417, col: 5, offset: 54312},
+									pos:        position{line: 1463, col: 5, offset: 56227},
 									val:        "pass:q[",
 									ignoreCase: false,
 									want:       "\"pass:q[\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1417, col: 15, offset: 54322},
+									pos:   position{line: 1463, col: 15, offset: 56237},
 									label: "content",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 1417, col: 23, offset: 54330},
+										pos: position{line: 1463, col: 23, offset: 56245},
 										expr: &choiceExpr{
-											pos: position{line: 1417, col: 24, offset: 54331},
+											pos: position{line: 1463, col: 24, offset: 56246},
 											alternatives: []interface{}{
 												&ruleRefExpr{
-													pos:  position{line: 1417, col: 24, offset: 54331},
+													pos:  position{line: 1463, col: 24, offset: 56246},
 													name: "QuotedText",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 1417, col: 37, offset: 54344},
+													pos:  position{line: 1463, col: 37, offset: 56259},
 													name: "PassthroughMacroCharacter",
 												},
 											},
@@ -10020,7 +10510,7 @@ var g = &grammar{
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1417, col: 65, offset: 54372},
+									pos:        position{line: 1463, col: 65, offset: 56287},
 									val:        "]",
 									ignoreCase: false,
 									want:       "\"]\"",
@@ -10033,12 +10523,12 @@ var g = &grammar{
 		},
 		{
 			name: "PassthroughMacroCharacter",
-			pos:  position{line: 1421, col: 1, offset: 54472},
+			pos:  position{line: 1467, col: 1, offset: 56399},
 			expr: &actionExpr{
-				pos: position{line: 1421, col: 30, offset: 54501},
+				pos: position{line: 1467, col: 30, offset: 56428},
 				run: (*parser).callonPassthroughMacroCharacter1,
 				expr: &charClassMatcher{
-					pos:        position{line: 1421, col: 30, offset: 54501},
+					pos:        position{line: 1467, col: 30, offset: 56428},
 					val:        "[^\\]]",
 					chars:      []rune{']'},
 					ignoreCase: false,
@@ -10048,16 +10538,16 @@ var g = &grammar{
 		},
 		{
 			name: "CrossReference",
-			pos:  position{line: 1428, col: 1, offset: 54681},
+			pos:  position{line: 1474, col: 1, offset: 56617},
 			expr: &choiceExpr{
-				pos: position{line: 1428, col: 19, offset: 54699},
+				pos: position{line: 1474, col: 19, offset: 56635},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1428, col: 19, offset: 54699},
+						pos:  position{line: 1474, col: 19, offset: 56635},
 						name: "InternalCrossReference",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1428, col: 44, offset: 54724},
+						pos:  position{line: 1474, col: 44, offset: 56660},
 						name: "ExternalCrossReference",
 					},
 				},
@@ -10065,53 +10555,53 @@ var g = &grammar{
 		},
 		{
 			name: "InternalCrossReference",
-			pos:  position{line: 1430, col: 1, offset: 54751},
+			pos:  position{line: 1476, col: 1, offset: 56685},
 			expr: &choiceExpr{
-				pos: position{line: 1430, col: 27, offset: 54777},
+				pos: position{line: 1476, col: 27, offset: 56711},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 1430, col: 27, offset: 54777},
+						pos: position{line: 1476, col: 27, offset: 56711},
 						run: (*parser).callonInternalCrossReference2,
 						expr: &seqExpr{
-							pos: position{line: 1430, col: 27, offset: 54777},
+							pos: position{line: 1476, col: 27, offset: 56711},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 1430, col: 27, offset: 54777},
+									pos:        position{line: 1476, col: 27, offset: 56711},
 									val:        "<<",
 									ignoreCase: false,
 									want:       "\"<<\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1430, col: 32, offset: 54782},
+									pos:   position{line: 1476, col: 32, offset: 56716},
 									label: "id",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1430, col: 36, offset: 54786},
+										pos:  position{line: 1476, col: 36, offset: 56720},
 										name: "ID",
 									},
 								},
 								&zeroOrMoreExpr{
-									pos: position{line: 1430, col: 40, offset: 54790},
+									pos: position{line: 1476, col: 40, offset: 56724},
 									expr: &ruleRefExpr{
-										pos:  position{line: 1430, col: 40, offset: 54790},
+										pos:  position{line: 1476, col: 40, offset: 56724},
 										name: "Space",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1430, col: 47, offset: 54797},
+									pos:        position{line: 1476, col: 47, offset: 56731},
 									val:        ",",
 									ignoreCase: false,
 									want:       "\",\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1430, col: 51, offset: 54801},
+									pos:   position{line: 1476, col: 51, offset: 56735},
 									label: "label",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1430, col: 58, offset: 54808},
+										pos:  position{line: 1476, col: 58, offset: 56742},
 										name: "CrossReferenceLabel",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1430, col: 79, offset: 54829},
+									pos:        position{line: 1476, col: 79, offset: 56763},
 									val:        ">>",
 									ignoreCase: false,
 									want:       "\">>\"",
@@ -10120,27 +10610,27 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1432, col: 5, offset: 54914},
+						pos: position{line: 1478, col: 5, offset: 56862},
 						run: (*parser).callonInternalCrossReference13,
 						expr: &seqExpr{
-							pos: position{line: 1432, col: 5, offset: 54914},
+							pos: position{line: 1478, col: 5, offset: 56862},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 1432, col: 5, offset: 54914},
+									pos:        position{line: 1478, col: 5, offset: 56862},
 									val:        "<<",
 									ignoreCase: false,
 									want:       "\"<<\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1432, col: 10, offset: 54919},
+									pos:   position{line: 1478, col: 10, offset: 56867},
 									label: "id",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1432, col: 14, offset: 54923},
+										pos:  position{line: 1478, col: 14, offset: 56871},
 										name: "ID",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1432, col: 18, offset: 54927},
+									pos:        position{line: 1478, col: 18, offset: 56875},
 									val:        ">>",
 									ignoreCase: false,
 									want:       "\">>\"",
@@ -10153,32 +10643,32 @@ var g = &grammar{
 		},
 		{
 			name: "ExternalCrossReference",
-			pos:  position{line: 1436, col: 1, offset: 55003},
+			pos:  position{line: 1482, col: 1, offset: 56963},
 			expr: &actionExpr{
-				pos: position{line: 1436, col: 27, offset: 55029},
+				pos: position{line: 1482, col: 27, offset: 56989},
 				run: (*parser).callonExternalCrossReference1,
 				expr: &seqExpr{
-					pos: position{line: 1436, col: 27, offset: 55029},
+					pos: position{line: 1482, col: 27, offset: 56989},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 1436, col: 27, offset: 55029},
+							pos:        position{line: 1482, col: 27, offset: 56989},
 							val:        "xref:",
 							ignoreCase: false,
 							want:       "\"xref:\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 1436, col: 35, offset: 55037},
+							pos:   position{line: 1482, col: 35, offset: 56997},
 							label: "url",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1436, col: 40, offset: 55042},
+								pos:  position{line: 1482, col: 40, offset: 57002},
 								name: "FileLocation",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1436, col: 54, offset: 55056},
+							pos:   position{line: 1482, col: 54, offset: 57016},
 							label: "inlineAttributes",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1436, col: 72, offset: 55074},
+								pos:  position{line: 1482, col: 72, offset: 57034},
 								name: "LinkAttributes",
 							},
 						},
@@ -10188,24 +10678,24 @@ var g = &grammar{
 		},
 		{
 			name: "CrossReferenceLabel",
-			pos:  position{line: 1440, col: 1, offset: 55201},
+			pos:  position{line: 1486, col: 1, offset: 57173},
 			expr: &ruleRefExpr{
-				pos:  position{line: 1440, col: 24, offset: 55224},
+				pos:  position{line: 1486, col: 24, offset: 57196},
 				name: "ElementTitleContent",
 			},
 		},
 		{
 			name: "Link",
-			pos:  position{line: 1445, col: 1, offset: 55351},
+			pos:  position{line: 1491, col: 1, offset: 57318},
 			expr: &choiceExpr{
-				pos: position{line: 1445, col: 9, offset: 55359},
+				pos: position{line: 1491, col: 9, offset: 57326},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1445, col: 9, offset: 55359},
+						pos:  position{line: 1491, col: 9, offset: 57326},
 						name: "RelativeLink",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1445, col: 24, offset: 55374},
+						pos:  position{line: 1491, col: 24, offset: 57341},
 						name: "ExternalLink",
 					},
 				},
@@ -10213,32 +10703,32 @@ var g = &grammar{
 		},
 		{
 			name: "RelativeLink",
-			pos:  position{line: 1448, col: 1, offset: 55458},
+			pos:  position{line: 1494, col: 1, offset: 57422},
 			expr: &actionExpr{
-				pos: position{line: 1448, col: 17, offset: 55474},
+				pos: position{line: 1494, col: 17, offset: 57438},
 				run: (*parser).callonRelativeLink1,
 				expr: &seqExpr{
-					pos: position{line: 1448, col: 17, offset: 55474},
+					pos: position{line: 1494, col: 17, offset: 57438},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 1448, col: 17, offset: 55474},
+							pos:        position{line: 1494, col: 17, offset: 57438},
 							val:        "link:",
 							ignoreCase: false,
 							want:       "\"link:\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 1448, col: 25, offset: 55482},
+							pos:   position{line: 1494, col: 25, offset: 57446},
 							label: "url",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1448, col: 30, offset: 55487},
+								pos:  position{line: 1494, col: 30, offset: 57451},
 								name: "Location",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1448, col: 40, offset: 55497},
+							pos:   position{line: 1494, col: 40, offset: 57461},
 							label: "inlineAttributes",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1448, col: 58, offset: 55515},
+								pos:  position{line: 1494, col: 58, offset: 57479},
 								name: "LinkAttributes",
 							},
 						},
@@ -10248,28 +10738,28 @@ var g = &grammar{
 		},
 		{
 			name: "ExternalLink",
-			pos:  position{line: 1452, col: 1, offset: 55630},
+			pos:  position{line: 1498, col: 1, offset: 57606},
 			expr: &actionExpr{
-				pos: position{line: 1452, col: 17, offset: 55646},
+				pos: position{line: 1498, col: 17, offset: 57622},
 				run: (*parser).callonExternalLink1,
 				expr: &seqExpr{
-					pos: position{line: 1452, col: 17, offset: 55646},
+					pos: position{line: 1498, col: 17, offset: 57622},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1452, col: 17, offset: 55646},
+							pos:   position{line: 1498, col: 17, offset: 57622},
 							label: "url",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1452, col: 22, offset: 55651},
+								pos:  position{line: 1498, col: 22, offset: 57627},
 								name: "LocationWithScheme",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1452, col: 42, offset: 55671},
+							pos:   position{line: 1498, col: 42, offset: 57647},
 							label: "inlineAttributes",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1452, col: 59, offset: 55688},
+								pos: position{line: 1498, col: 59, offset: 57664},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1452, col: 60, offset: 55689},
+									pos:  position{line: 1498, col: 60, offset: 57665},
 									name: "LinkAttributes",
 								},
 							},
@@ -10280,50 +10770,50 @@ var g = &grammar{
 		},
 		{
 			name: "LinkAttributes",
-			pos:  position{line: 1456, col: 1, offset: 55786},
+			pos:  position{line: 1502, col: 1, offset: 57774},
 			expr: &actionExpr{
-				pos: position{line: 1456, col: 19, offset: 55804},
+				pos: position{line: 1502, col: 19, offset: 57792},
 				run: (*parser).callonLinkAttributes1,
 				expr: &seqExpr{
-					pos: position{line: 1456, col: 19, offset: 55804},
+					pos: position{line: 1502, col: 19, offset: 57792},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 1456, col: 19, offset: 55804},
+							pos:        position{line: 1502, col: 19, offset: 57792},
 							val:        "[",
 							ignoreCase: false,
 							want:       "\"[\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 1456, col: 23, offset: 55808},
+							pos:   position{line: 1502, col: 23, offset: 57796},
 							label: "firstAttr",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 1456, col: 33, offset: 55818},
+								pos: position{line: 1502, col: 33, offset: 57806},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1456, col: 34, offset: 55819},
+									pos:  position{line: 1502, col: 34, offset: 57807},
 									name: "FirstLinkAttributeElement",
 								},
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 1457, col: 5, offset: 55852},
+							pos: position{line: 1503, col: 5, offset: 57839},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1457, col: 5, offset: 55852},
+								pos:  position{line: 1503, col: 5, offset: 57839},
 								name: "Space",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1457, col: 12, offset: 55859},
+							pos:   position{line: 1503, col: 12, offset: 57846},
 							label: "otherattrs",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 1457, col: 23, offset: 55870},
+								pos: position{line: 1503, col: 23, offset: 57857},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1457, col: 24, offset: 55871},
+									pos:  position{line: 1503, col: 24, offset: 57858},
 									name: "GenericAttribute",
 								},
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1457, col: 43, offset: 55890},
+							pos:        position{line: 1503, col: 43, offset: 57877},
 							val:        "]",
 							ignoreCase: false,
 							want:       "\"]\"",
@@ -10334,46 +10824,46 @@ var g = &grammar{
 		},
 		{
 			name: "FirstLinkAttributeElement",
-			pos:  position{line: 1461, col: 1, offset: 56011},
+			pos:  position{line: 1507, col: 1, offset: 57994},
 			expr: &actionExpr{
-				pos: position{line: 1461, col: 30, offset: 56040},
+				pos: position{line: 1507, col: 30, offset: 58023},
 				run: (*parser).callonFirstLinkAttributeElement1,
 				expr: &labeledExpr{
-					pos:   position{line: 1461, col: 30, offset: 56040},
+					pos:   position{line: 1507, col: 30, offset: 58023},
 					label: "element",
 					expr: &choiceExpr{
-						pos: position{line: 1463, col: 5, offset: 56093},
+						pos: position{line: 1509, col: 5, offset: 58074},
 						alternatives: []interface{}{
 							&actionExpr{
-								pos: position{line: 1463, col: 6, offset: 56094},
+								pos: position{line: 1509, col: 6, offset: 58075},
 								run: (*parser).callonFirstLinkAttributeElement4,
 								expr: &seqExpr{
-									pos: position{line: 1463, col: 6, offset: 56094},
+									pos: position{line: 1509, col: 6, offset: 58075},
 									exprs: []interface{}{
 										&litMatcher{
-											pos:        position{line: 1463, col: 6, offset: 56094},
+											pos:        position{line: 1509, col: 6, offset: 58075},
 											val:        "\"",
 											ignoreCase: false,
 											want:       "\"\\\"\"",
 										},
 										&labeledExpr{
-											pos:   position{line: 1463, col: 11, offset: 56099},
+											pos:   position{line: 1509, col: 11, offset: 58080},
 											label: "elements",
 											expr: &oneOrMoreExpr{
-												pos: position{line: 1463, col: 20, offset: 56108},
+												pos: position{line: 1509, col: 20, offset: 58089},
 												expr: &choiceExpr{
-													pos: position{line: 1463, col: 21, offset: 56109},
+													pos: position{line: 1509, col: 21, offset: 58090},
 													alternatives: []interface{}{
 														&ruleRefExpr{
-															pos:  position{line: 1463, col: 21, offset: 56109},
+															pos:  position{line: 1509, col: 21, offset: 58090},
 															name: "QuotedString",
 														},
 														&ruleRefExpr{
-															pos:  position{line: 1463, col: 36, offset: 56124},
+															pos:  position{line: 1509, col: 36, offset: 58105},
 															name: "QuotedText",
 														},
 														&ruleRefExpr{
-															pos:  position{line: 1463, col: 49, offset: 56137},
+															pos:  position{line: 1509, col: 49, offset: 58118},
 															name: "QuotedAttributeChar",
 														},
 													},
@@ -10381,17 +10871,17 @@ var g = &grammar{
 											},
 										},
 										&litMatcher{
-											pos:        position{line: 1463, col: 71, offset: 56159},
+											pos:        position{line: 1509, col: 71, offset: 58140},
 											val:        "\"",
 											ignoreCase: false,
 											want:       "\"\\\"\"",
 										},
 										&andExpr{
-											pos: position{line: 1463, col: 76, offset: 56164},
+											pos: position{line: 1509, col: 76, offset: 58145},
 											expr: &notExpr{
-												pos: position{line: 1463, col: 78, offset: 56166},
+												pos: position{line: 1509, col: 78, offset: 58147},
 												expr: &litMatcher{
-													pos:        position{line: 1463, col: 79, offset: 56167},
+													pos:        position{line: 1509, col: 79, offset: 58148},
 													val:        "=",
 													ignoreCase: false,
 													want:       "\"=\"",
@@ -10399,9 +10889,9 @@ var g = &grammar{
 											},
 										},
 										&zeroOrOneExpr{
-											pos: position{line: 1463, col: 84, offset: 56172},
+											pos: position{line: 1509, col: 84, offset: 58153},
 											expr: &litMatcher{
-												pos:        position{line: 1463, col: 84, offset: 56172},
+												pos:        position{line: 1509, col: 84, offset: 58153},
 												val:        ",",
 												ignoreCase: false,
 												want:       "\",\"",
@@ -10411,29 +10901,29 @@ var g = &grammar{
 								},
 							},
 							&actionExpr{
-								pos: position{line: 1467, col: 6, offset: 56303},
+								pos: position{line: 1513, col: 6, offset: 58297},
 								run: (*parser).callonFirstLinkAttributeElement19,
 								expr: &seqExpr{
-									pos: position{line: 1467, col: 6, offset: 56303},
+									pos: position{line: 1513, col: 6, offset: 58297},
 									exprs: []interface{}{
 										&labeledExpr{
-											pos:   position{line: 1467, col: 6, offset: 56303},
+											pos:   position{line: 1513, col: 6, offset: 58297},
 											label: "elements",
 											expr: &oneOrMoreExpr{
-												pos: position{line: 1467, col: 15, offset: 56312},
+												pos: position{line: 1513, col: 15, offset: 58306},
 												expr: &choiceExpr{
-													pos: position{line: 1467, col: 16, offset: 56313},
+													pos: position{line: 1513, col: 16, offset: 58307},
 													alternatives: []interface{}{
 														&ruleRefExpr{
-															pos:  position{line: 1467, col: 16, offset: 56313},
+															pos:  position{line: 1513, col: 16, offset: 58307},
 															name: "QuotedString",
 														},
 														&ruleRefExpr{
-															pos:  position{line: 1467, col: 31, offset: 56328},
+															pos:  position{line: 1513, col: 31, offset: 58322},
 															name: "QuotedText",
 														},
 														&ruleRefExpr{
-															pos:  position{line: 1467, col: 44, offset: 56341},
+															pos:  position{line: 1513, col: 44, offset: 58335},
 															name: "UnquotedAttributeChar",
 														},
 													},
@@ -10441,11 +10931,11 @@ var g = &grammar{
 											},
 										},
 										&andExpr{
-											pos: position{line: 1467, col: 68, offset: 56365},
+											pos: position{line: 1513, col: 68, offset: 58359},
 											expr: &notExpr{
-												pos: position{line: 1467, col: 70, offset: 56367},
+												pos: position{line: 1513, col: 70, offset: 58361},
 												expr: &litMatcher{
-													pos:        position{line: 1467, col: 71, offset: 56368},
+													pos:        position{line: 1513, col: 71, offset: 58362},
 													val:        "=",
 													ignoreCase: false,
 													want:       "\"=\"",
@@ -10453,9 +10943,9 @@ var g = &grammar{
 											},
 										},
 										&zeroOrOneExpr{
-											pos: position{line: 1467, col: 76, offset: 56373},
+											pos: position{line: 1513, col: 76, offset: 58367},
 											expr: &litMatcher{
-												pos:        position{line: 1467, col: 76, offset: 56373},
+												pos:        position{line: 1513, col: 76, offset: 58367},
 												val:        ",",
 												ignoreCase: false,
 												want:       "\",\"",
@@ -10471,12 +10961,12 @@ var g = &grammar{
 		},
 		{
 			name: "AttributeChar",
-			pos:  position{line: 1473, col: 1, offset: 56493},
+			pos:  position{line: 1519, col: 1, offset: 58498},
 			expr: &actionExpr{
-				pos: position{line: 1473, col: 18, offset: 56510},
+				pos: position{line: 1519, col: 18, offset: 58515},
 				run: (*parser).callonAttributeChar1,
 				expr: &charClassMatcher{
-					pos:        position{line: 1473, col: 18, offset: 56510},
+					pos:        position{line: 1519, col: 18, offset: 58515},
 					val:        "[^\\r\\n\"=\\],]",
 					chars:      []rune{'\r', '\n', '"', '=', ']', ','},
 					ignoreCase: false,
@@ -10486,12 +10976,12 @@ var g = &grammar{
 		},
 		{
 			name: "QuotedAttributeChar",
-			pos:  position{line: 1477, col: 1, offset: 56600},
+			pos:  position{line: 1523, col: 1, offset: 58617},
 			expr: &actionExpr{
-				pos: position{line: 1477, col: 24, offset: 56623},
+				pos: position{line: 1523, col: 24, offset: 58640},
 				run: (*parser).callonQuotedAttributeChar1,
 				expr: &charClassMatcher{
-					pos:        position{line: 1477, col: 24, offset: 56623},
+					pos:        position{line: 1523, col: 24, offset: 58640},
 					val:        "[^\\r\\n\"=\\]]",
 					chars:      []rune{'\r', '\n', '"', '=', ']'},
 					ignoreCase: false,
@@ -10501,12 +10991,12 @@ var g = &grammar{
 		},
 		{
 			name: "UnquotedAttributeChar",
-			pos:  position{line: 1481, col: 1, offset: 56720},
+			pos:  position{line: 1527, col: 1, offset: 58749},
 			expr: &actionExpr{
-				pos: position{line: 1481, col: 26, offset: 56745},
+				pos: position{line: 1527, col: 26, offset: 58774},
 				run: (*parser).callonUnquotedAttributeChar1,
 				expr: &charClassMatcher{
-					pos:        position{line: 1481, col: 26, offset: 56745},
+					pos:        position{line: 1527, col: 26, offset: 58774},
 					val:        "[^\\r\\n\"=\\],]",
 					chars:      []rune{'\r', '\n', '"', '=', ']', ','},
 					ignoreCase: false,
@@ -10516,46 +11006,46 @@ var g = &grammar{
 		},
 		{
 			name: "InlineLinks",
-			pos:  position{line: 1486, col: 1, offset: 56907},
+			pos:  position{line: 1532, col: 1, offset: 58947},
 			expr: &actionExpr{
-				pos: position{line: 1487, col: 5, offset: 56928},
+				pos: position{line: 1533, col: 5, offset: 58967},
 				run: (*parser).callonInlineLinks1,
 				expr: &seqExpr{
-					pos: position{line: 1487, col: 5, offset: 56928},
+					pos: position{line: 1533, col: 5, offset: 58967},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1487, col: 5, offset: 56928},
+							pos:   position{line: 1533, col: 5, offset: 58967},
 							label: "elements",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 1487, col: 14, offset: 56937},
+								pos: position{line: 1533, col: 14, offset: 58976},
 								expr: &choiceExpr{
-									pos: position{line: 1487, col: 15, offset: 56938},
+									pos: position{line: 1533, col: 15, offset: 58977},
 									alternatives: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 1487, col: 15, offset: 56938},
+											pos:  position{line: 1533, col: 15, offset: 58977},
 											name: "Word",
 										},
 										&oneOrMoreExpr{
-											pos: position{line: 1488, col: 11, offset: 56954},
+											pos: position{line: 1534, col: 11, offset: 58992},
 											expr: &ruleRefExpr{
-												pos:  position{line: 1488, col: 11, offset: 56954},
+												pos:  position{line: 1534, col: 11, offset: 58992},
 												name: "Space",
 											},
 										},
 										&ruleRefExpr{
-											pos:  position{line: 1489, col: 11, offset: 56973},
+											pos:  position{line: 1535, col: 11, offset: 59010},
 											name: "ResolvedLink",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 1490, col: 11, offset: 56998},
+											pos:  position{line: 1536, col: 11, offset: 59034},
 											name: "Parenthesis",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 1491, col: 11, offset: 57021},
+											pos:  position{line: 1537, col: 11, offset: 59056},
 											name: "AnyChar",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 1492, col: 11, offset: 57040},
+											pos:  position{line: 1538, col: 11, offset: 59074},
 											name: "Newline",
 										},
 									},
@@ -10563,7 +11053,7 @@ var g = &grammar{
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1492, col: 21, offset: 57050},
+							pos:  position{line: 1538, col: 21, offset: 59084},
 							name: "EOF",
 						},
 					},
@@ -10572,16 +11062,16 @@ var g = &grammar{
 		},
 		{
 			name: "ResolvedLink",
-			pos:  position{line: 1496, col: 1, offset: 57124},
+			pos:  position{line: 1542, col: 1, offset: 59171},
 			expr: &choiceExpr{
-				pos: position{line: 1496, col: 17, offset: 57140},
+				pos: position{line: 1542, col: 17, offset: 59187},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1496, col: 17, offset: 57140},
+						pos:  position{line: 1542, col: 17, offset: 59187},
 						name: "ResolvedRelativeLink",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1496, col: 40, offset: 57163},
+						pos:  position{line: 1542, col: 40, offset: 59210},
 						name: "ResolvedExternalLink",
 					},
 				},
@@ -10589,41 +11079,41 @@ var g = &grammar{
 		},
 		{
 			name: "ResolvedRelativeLink",
-			pos:  position{line: 1499, col: 1, offset: 57294},
+			pos:  position{line: 1545, col: 1, offset: 59338},
 			expr: &actionExpr{
-				pos: position{line: 1499, col: 25, offset: 57318},
+				pos: position{line: 1545, col: 25, offset: 59362},
 				run: (*parser).callonResolvedRelativeLink1,
 				expr: &seqExpr{
-					pos: position{line: 1499, col: 25, offset: 57318},
+					pos: position{line: 1545, col: 25, offset: 59362},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 1499, col: 25, offset: 57318},
+							pos:        position{line: 1545, col: 25, offset: 59362},
 							val:        "link:",
 							ignoreCase: false,
 							want:       "\"link:\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 1499, col: 33, offset: 57326},
+							pos:   position{line: 1545, col: 33, offset: 59370},
 							label: "url",
 							expr: &choiceExpr{
-								pos: position{line: 1499, col: 38, offset: 57331},
+								pos: position{line: 1545, col: 38, offset: 59375},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 1499, col: 38, offset: 57331},
+										pos:  position{line: 1545, col: 38, offset: 59375},
 										name: "ResolvedLocation",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1499, col: 57, offset: 57350},
+										pos:  position{line: 1545, col: 57, offset: 59394},
 										name: "ResolvedFileLocation",
 									},
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1499, col: 79, offset: 57372},
+							pos:   position{line: 1545, col: 79, offset: 59416},
 							label: "inlineAttributes",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1499, col: 97, offset: 57390},
+								pos:  position{line: 1545, col: 97, offset: 59434},
 								name: "LinkAttributes",
 							},
 						},
@@ -10633,28 +11123,28 @@ var g = &grammar{
 		},
 		{
 			name: "ResolvedExternalLink",
-			pos:  position{line: 1503, col: 1, offset: 57505},
+			pos:  position{line: 1549, col: 1, offset: 59561},
 			expr: &actionExpr{
-				pos: position{line: 1503, col: 25, offset: 57529},
+				pos: position{line: 1549, col: 25, offset: 59585},
 				run: (*parser).callonResolvedExternalLink1,
 				expr: &seqExpr{
-					pos: position{line: 1503, col: 25, offset: 57529},
+					pos: position{line: 1549, col: 25, offset: 59585},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1503, col: 25, offset: 57529},
+							pos:   position{line: 1549, col: 25, offset: 59585},
 							label: "url",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1503, col: 30, offset: 57534},
+								pos:  position{line: 1549, col: 30, offset: 59590},
 								name: "ResolvedLocation",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1503, col: 48, offset: 57552},
+							pos:   position{line: 1549, col: 48, offset: 59608},
 							label: "inlineAttributes",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1503, col: 65, offset: 57569},
+								pos: position{line: 1549, col: 65, offset: 59625},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1503, col: 66, offset: 57570},
+									pos:  position{line: 1549, col: 66, offset: 59626},
 									name: "LinkAttributes",
 								},
 							},
@@ -10665,55 +11155,55 @@ var g = &grammar{
 		},
 		{
 			name: "ImageBlock",
-			pos:  position{line: 1510, col: 1, offset: 57772},
+			pos:  position{line: 1556, col: 1, offset: 59837},
 			expr: &actionExpr{
-				pos: position{line: 1510, col: 15, offset: 57786},
+				pos: position{line: 1556, col: 15, offset: 59851},
 				run: (*parser).callonImageBlock1,
 				expr: &seqExpr{
-					pos: position{line: 1510, col: 15, offset: 57786},
+					pos: position{line: 1556, col: 15, offset: 59851},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1510, col: 15, offset: 57786},
+							pos:   position{line: 1556, col: 15, offset: 59851},
 							label: "attributes",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1510, col: 26, offset: 57797},
+								pos: position{line: 1556, col: 26, offset: 59862},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1510, col: 27, offset: 57798},
+									pos:  position{line: 1556, col: 27, offset: 59863},
 									name: "Attributes",
 								},
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1510, col: 40, offset: 57811},
+							pos:        position{line: 1556, col: 40, offset: 59876},
 							val:        "image::",
 							ignoreCase: false,
 							want:       "\"image::\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 1510, col: 50, offset: 57821},
+							pos:   position{line: 1556, col: 50, offset: 59886},
 							label: "path",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1510, col: 56, offset: 57827},
+								pos:  position{line: 1556, col: 56, offset: 59892},
 								name: "Location",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1510, col: 66, offset: 57837},
+							pos:   position{line: 1556, col: 66, offset: 59902},
 							label: "inlineAttributes",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1510, col: 84, offset: 57855},
+								pos:  position{line: 1556, col: 84, offset: 59920},
 								name: "ImageAttributes",
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 1510, col: 101, offset: 57872},
+							pos: position{line: 1556, col: 101, offset: 59937},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1510, col: 101, offset: 57872},
+								pos:  position{line: 1556, col: 101, offset: 59937},
 								name: "Space",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1510, col: 108, offset: 57879},
+							pos:  position{line: 1556, col: 108, offset: 59944},
 							name: "EOL",
 						},
 					},
@@ -10722,41 +11212,41 @@ var g = &grammar{
 		},
 		{
 			name: "InlineImage",
-			pos:  position{line: 1514, col: 1, offset: 57995},
+			pos:  position{line: 1560, col: 1, offset: 60072},
 			expr: &actionExpr{
-				pos: position{line: 1514, col: 16, offset: 58010},
+				pos: position{line: 1560, col: 16, offset: 60087},
 				run: (*parser).callonInlineImage1,
 				expr: &seqExpr{
-					pos: position{line: 1514, col: 16, offset: 58010},
+					pos: position{line: 1560, col: 16, offset: 60087},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 1514, col: 16, offset: 58010},
+							pos:        position{line: 1560, col: 16, offset: 60087},
 							val:        "image:",
 							ignoreCase: false,
 							want:       "\"image:\"",
 						},
 						&notExpr{
-							pos: position{line: 1514, col: 25, offset: 58019},
+							pos: position{line: 1560, col: 25, offset: 60096},
 							expr: &litMatcher{
-								pos:        position{line: 1514, col: 26, offset: 58020},
+								pos:        position{line: 1560, col: 26, offset: 60097},
 								val:        ":",
 								ignoreCase: false,
 								want:       "\":\"",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1514, col: 30, offset: 58024},
+							pos:   position{line: 1560, col: 30, offset: 60101},
 							label: "path",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1514, col: 36, offset: 58030},
+								pos:  position{line: 1560, col: 36, offset: 60107},
 								name: "Location",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1514, col: 46, offset: 58040},
+							pos:   position{line: 1560, col: 46, offset: 60117},
 							label: "inlineAttributes",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1514, col: 64, offset: 58058},
+								pos:  position{line: 1560, col: 64, offset: 60135},
 								name: "ImageAttributes",
 							},
 						},
@@ -10766,99 +11256,99 @@ var g = &grammar{
 		},
 		{
 			name: "ImageAttributes",
-			pos:  position{line: 1518, col: 1, offset: 58176},
+			pos:  position{line: 1564, col: 1, offset: 60265},
 			expr: &actionExpr{
-				pos: position{line: 1518, col: 20, offset: 58195},
+				pos: position{line: 1564, col: 20, offset: 60284},
 				run: (*parser).callonImageAttributes1,
 				expr: &seqExpr{
-					pos: position{line: 1518, col: 20, offset: 58195},
+					pos: position{line: 1564, col: 20, offset: 60284},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 1518, col: 20, offset: 58195},
+							pos:        position{line: 1564, col: 20, offset: 60284},
 							val:        "[",
 							ignoreCase: false,
 							want:       "\"[\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 1518, col: 24, offset: 58199},
+							pos:   position{line: 1564, col: 24, offset: 60288},
 							label: "alt",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1518, col: 28, offset: 58203},
+								pos: position{line: 1564, col: 28, offset: 60292},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1518, col: 29, offset: 58204},
+									pos:  position{line: 1564, col: 29, offset: 60293},
 									name: "StandaloneAttributeValue",
 								},
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 1518, col: 56, offset: 58231},
+							pos: position{line: 1564, col: 56, offset: 60320},
 							expr: &litMatcher{
-								pos:        position{line: 1518, col: 56, offset: 58231},
+								pos:        position{line: 1564, col: 56, offset: 60320},
 								val:        ",",
 								ignoreCase: false,
 								want:       "\",\"",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1518, col: 61, offset: 58236},
+							pos:   position{line: 1564, col: 61, offset: 60325},
 							label: "width",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1518, col: 67, offset: 58242},
+								pos: position{line: 1564, col: 67, offset: 60331},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1518, col: 68, offset: 58243},
+									pos:  position{line: 1564, col: 68, offset: 60332},
 									name: "StandaloneAttributeValue",
 								},
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 1518, col: 95, offset: 58270},
+							pos: position{line: 1564, col: 95, offset: 60359},
 							expr: &litMatcher{
-								pos:        position{line: 1518, col: 95, offset: 58270},
+								pos:        position{line: 1564, col: 95, offset: 60359},
 								val:        ",",
 								ignoreCase: false,
 								want:       "\",\"",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1518, col: 100, offset: 58275},
+							pos:   position{line: 1564, col: 100, offset: 60364},
 							label: "height",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1518, col: 107, offset: 58282},
+								pos: position{line: 1564, col: 107, offset: 60371},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1518, col: 108, offset: 58283},
+									pos:  position{line: 1564, col: 108, offset: 60372},
 									name: "StandaloneAttributeValue",
 								},
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 1518, col: 135, offset: 58310},
+							pos: position{line: 1564, col: 135, offset: 60399},
 							expr: &litMatcher{
-								pos:        position{line: 1518, col: 135, offset: 58310},
+								pos:        position{line: 1564, col: 135, offset: 60399},
 								val:        ",",
 								ignoreCase: false,
 								want:       "\",\"",
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 1518, col: 140, offset: 58315},
+							pos: position{line: 1564, col: 140, offset: 60404},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1518, col: 140, offset: 58315},
+								pos:  position{line: 1564, col: 140, offset: 60404},
 								name: "Space",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1518, col: 147, offset: 58322},
+							pos:   position{line: 1564, col: 147, offset: 60411},
 							label: "otherattrs",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 1518, col: 158, offset: 58333},
+								pos: position{line: 1564, col: 158, offset: 60422},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1518, col: 159, offset: 58334},
+									pos:  position{line: 1564, col: 159, offset: 60423},
 									name: "GenericAttribute",
 								},
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1518, col: 178, offset: 58353},
+							pos:        position{line: 1564, col: 178, offset: 60442},
 							val:        "]",
 							ignoreCase: false,
 							want:       "\"]\"",
@@ -10869,29 +11359,29 @@ var g = &grammar{
 		},
 		{
 			name: "InlineIcon",
-			pos:  position{line: 1525, col: 1, offset: 58645},
+			pos:  position{line: 1571, col: 1, offset: 60727},
 			expr: &actionExpr{
-				pos: position{line: 1525, col: 15, offset: 58659},
+				pos: position{line: 1571, col: 15, offset: 60741},
 				run: (*parser).callonInlineIcon1,
 				expr: &seqExpr{
-					pos: position{line: 1525, col: 15, offset: 58659},
+					pos: position{line: 1571, col: 15, offset: 60741},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 1525, col: 15, offset: 58659},
+							pos:        position{line: 1571, col: 15, offset: 60741},
 							val:        "icon:",
 							ignoreCase: false,
 							want:       "\"icon:\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 1525, col: 23, offset: 58667},
+							pos:   position{line: 1571, col: 23, offset: 60749},
 							label: "iconClass",
 							expr: &actionExpr{
-								pos: position{line: 1525, col: 34, offset: 58678},
+								pos: position{line: 1571, col: 34, offset: 60760},
 								run: (*parser).callonInlineIcon5,
 								expr: &oneOrMoreExpr{
-									pos: position{line: 1525, col: 34, offset: 58678},
+									pos: position{line: 1571, col: 34, offset: 60760},
 									expr: &charClassMatcher{
-										pos:        position{line: 1525, col: 34, offset: 58678},
+										pos:        position{line: 1571, col: 34, offset: 60760},
 										val:        "[\\pL0-9_-]",
 										chars:      []rune{'_', '-'},
 										ranges:     []rune{'0', '9'},
@@ -10903,10 +11393,10 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1525, col: 78, offset: 58722},
+							pos:   position{line: 1571, col: 78, offset: 60804},
 							label: "inlineAttributes",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1525, col: 96, offset: 58740},
+								pos:  position{line: 1571, col: 96, offset: 60822},
 								name: "IconAttributes",
 							},
 						},
@@ -10916,59 +11406,59 @@ var g = &grammar{
 		},
 		{
 			name: "IconAttributes",
-			pos:  position{line: 1529, col: 1, offset: 58847},
+			pos:  position{line: 1575, col: 1, offset: 60941},
 			expr: &actionExpr{
-				pos: position{line: 1529, col: 19, offset: 58865},
+				pos: position{line: 1575, col: 19, offset: 60959},
 				run: (*parser).callonIconAttributes1,
 				expr: &seqExpr{
-					pos: position{line: 1529, col: 19, offset: 58865},
+					pos: position{line: 1575, col: 19, offset: 60959},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 1529, col: 19, offset: 58865},
+							pos:        position{line: 1575, col: 19, offset: 60959},
 							val:        "[",
 							ignoreCase: false,
 							want:       "\"[\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 1529, col: 23, offset: 58869},
+							pos:   position{line: 1575, col: 23, offset: 60963},
 							label: "size",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1529, col: 28, offset: 58874},
+								pos: position{line: 1575, col: 28, offset: 60968},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1529, col: 29, offset: 58875},
+									pos:  position{line: 1575, col: 29, offset: 60969},
 									name: "StandaloneAttributeValue",
 								},
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 1529, col: 56, offset: 58902},
+							pos: position{line: 1575, col: 56, offset: 60996},
 							expr: &litMatcher{
-								pos:        position{line: 1529, col: 56, offset: 58902},
+								pos:        position{line: 1575, col: 56, offset: 60996},
 								val:        ",",
 								ignoreCase: false,
 								want:       "\",\"",
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 1529, col: 61, offset: 58907},
+							pos: position{line: 1575, col: 61, offset: 61001},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1529, col: 61, offset: 58907},
+								pos:  position{line: 1575, col: 61, offset: 61001},
 								name: "Space",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1529, col: 68, offset: 58914},
+							pos:   position{line: 1575, col: 68, offset: 61008},
 							label: "others",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 1529, col: 75, offset: 58921},
+								pos: position{line: 1575, col: 75, offset: 61015},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1529, col: 76, offset: 58922},
+									pos:  position{line: 1575, col: 76, offset: 61016},
 									name: "GenericAttribute",
 								},
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1529, col: 95, offset: 58941},
+							pos:        position{line: 1575, col: 95, offset: 61035},
 							val:        "]",
 							ignoreCase: false,
 							want:       "\"]\"",
@@ -10979,32 +11469,32 @@ var g = &grammar{
 		},
 		{
 			name: "InlineFootnote",
-			pos:  position{line: 1536, col: 1, offset: 59219},
+			pos:  position{line: 1582, col: 1, offset: 61306},
 			expr: &choiceExpr{
-				pos: position{line: 1536, col: 19, offset: 59237},
+				pos: position{line: 1582, col: 19, offset: 61324},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 1536, col: 19, offset: 59237},
+						pos: position{line: 1582, col: 19, offset: 61324},
 						run: (*parser).callonInlineFootnote2,
 						expr: &seqExpr{
-							pos: position{line: 1536, col: 19, offset: 59237},
+							pos: position{line: 1582, col: 19, offset: 61324},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 1536, col: 19, offset: 59237},
+									pos:        position{line: 1582, col: 19, offset: 61324},
 									val:        "footnote:[",
 									ignoreCase: false,
 									want:       "\"footnote:[\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1536, col: 32, offset: 59250},
+									pos:   position{line: 1582, col: 32, offset: 61337},
 									label: "content",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1536, col: 41, offset: 59259},
+										pos:  position{line: 1582, col: 41, offset: 61346},
 										name: "FootnoteContent",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1536, col: 58, offset: 59276},
+									pos:        position{line: 1582, col: 58, offset: 61363},
 									val:        "]",
 									ignoreCase: false,
 									want:       "\"]\"",
@@ -11013,44 +11503,44 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1538, col: 5, offset: 59346},
+						pos: position{line: 1584, col: 5, offset: 61447},
 						run: (*parser).callonInlineFootnote8,
 						expr: &seqExpr{
-							pos: position{line: 1538, col: 5, offset: 59346},
+							pos: position{line: 1584, col: 5, offset: 61447},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 1538, col: 5, offset: 59346},
+									pos:        position{line: 1584, col: 5, offset: 61447},
 									val:        "footnote:",
 									ignoreCase: false,
 									want:       "\"footnote:\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1538, col: 17, offset: 59358},
+									pos:   position{line: 1584, col: 17, offset: 61459},
 									label: "ref",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1538, col: 22, offset: 59363},
+										pos:  position{line: 1584, col: 22, offset: 61464},
 										name: "FootnoteRef",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1538, col: 35, offset: 59376},
+									pos:        position{line: 1584, col: 35, offset: 61477},
 									val:        "[",
 									ignoreCase: false,
 									want:       "\"[\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1538, col: 39, offset: 59380},
+									pos:   position{line: 1584, col: 39, offset: 61481},
 									label: "content",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 1538, col: 47, offset: 59388},
+										pos: position{line: 1584, col: 47, offset: 61489},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1538, col: 48, offset: 59389},
+											pos:  position{line: 1584, col: 48, offset: 61490},
 											name: "FootnoteContent",
 										},
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1538, col: 66, offset: 59407},
+									pos:        position{line: 1584, col: 66, offset: 61508},
 									val:        "]",
 									ignoreCase: false,
 									want:       "\"]\"",
@@ -11063,37 +11553,37 @@ var g = &grammar{
 		},
 		{
 			name: "FootnoteRef",
-			pos:  position{line: 1542, col: 1, offset: 59472},
+			pos:  position{line: 1588, col: 1, offset: 61585},
 			expr: &ruleRefExpr{
-				pos:  position{line: 1542, col: 16, offset: 59487},
+				pos:  position{line: 1588, col: 16, offset: 61600},
 				name: "Alphanums",
 			},
 		},
 		{
 			name: "FootnoteContent",
-			pos:  position{line: 1544, col: 1, offset: 59500},
+			pos:  position{line: 1590, col: 1, offset: 61611},
 			expr: &actionExpr{
-				pos: position{line: 1544, col: 20, offset: 59519},
+				pos: position{line: 1590, col: 20, offset: 61630},
 				run: (*parser).callonFootnoteContent1,
 				expr: &labeledExpr{
-					pos:   position{line: 1544, col: 20, offset: 59519},
+					pos:   position{line: 1590, col: 20, offset: 61630},
 					label: "elements",
 					expr: &oneOrMoreExpr{
-						pos: position{line: 1544, col: 29, offset: 59528},
+						pos: position{line: 1590, col: 29, offset: 61639},
 						expr: &seqExpr{
-							pos: position{line: 1544, col: 30, offset: 59529},
+							pos: position{line: 1590, col: 30, offset: 61640},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 1544, col: 30, offset: 59529},
+									pos: position{line: 1590, col: 30, offset: 61640},
 									expr: &litMatcher{
-										pos:        position{line: 1544, col: 31, offset: 59530},
+										pos:        position{line: 1590, col: 31, offset: 61641},
 										val:        "]",
 										ignoreCase: false,
 										want:       "\"]\"",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1544, col: 35, offset: 59534},
+									pos:  position{line: 1590, col: 35, offset: 61645},
 									name: "InlineElement",
 								},
 							},
@@ -11104,64 +11594,68 @@ var g = &grammar{
 		},
 		{
 			name: "DelimitedBlock",
-			pos:  position{line: 1551, col: 1, offset: 59865},
+			pos:  position{line: 1597, col: 1, offset: 61986},
 			expr: &actionExpr{
-				pos: position{line: 1551, col: 19, offset: 59883},
+				pos: position{line: 1597, col: 19, offset: 62004},
 				run: (*parser).callonDelimitedBlock1,
 				expr: &seqExpr{
-					pos: position{line: 1551, col: 19, offset: 59883},
+					pos: position{line: 1597, col: 19, offset: 62004},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 1551, col: 19, offset: 59883},
+							pos: position{line: 1597, col: 19, offset: 62004},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1551, col: 20, offset: 59884},
+								pos:  position{line: 1597, col: 20, offset: 62005},
 								name: "Alphanum",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1552, col: 5, offset: 59973},
+							pos:   position{line: 1598, col: 5, offset: 62093},
 							label: "block",
 							expr: &choiceExpr{
-								pos: position{line: 1552, col: 12, offset: 59980},
+								pos: position{line: 1598, col: 12, offset: 62100},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 1552, col: 12, offset: 59980},
+										pos:  position{line: 1598, col: 12, offset: 62100},
 										name: "FencedBlock",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1553, col: 11, offset: 60004},
+										pos:  position{line: 1599, col: 11, offset: 62123},
 										name: "ListingBlock",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1554, col: 11, offset: 60029},
+										pos:  position{line: 1600, col: 11, offset: 62147},
 										name: "ExampleBlock",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1555, col: 11, offset: 60054},
+										pos:  position{line: 1601, col: 11, offset: 62171},
+										name: "OpenBlock",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1602, col: 11, offset: 62191},
 										name: "VerseBlock",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1556, col: 11, offset: 60077},
+										pos:  position{line: 1603, col: 11, offset: 62213},
 										name: "QuoteBlock",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1557, col: 11, offset: 60100},
+										pos:  position{line: 1604, col: 11, offset: 62235},
 										name: "SidebarBlock",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1558, col: 11, offset: 60124},
+										pos:  position{line: 1605, col: 11, offset: 62258},
 										name: "SingleLineComment",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1559, col: 11, offset: 60153},
+										pos:  position{line: 1606, col: 11, offset: 62286},
 										name: "PassthroughBlock",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1560, col: 11, offset: 60181},
+										pos:  position{line: 1607, col: 11, offset: 62313},
 										name: "Table",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1561, col: 11, offset: 60198},
+										pos:  position{line: 1608, col: 11, offset: 62329},
 										name: "CommentBlock",
 									},
 								},
@@ -11173,52 +11667,56 @@ var g = &grammar{
 		},
 		{
 			name: "BlockDelimiter",
-			pos:  position{line: 1565, col: 1, offset: 60243},
+			pos:  position{line: 1612, col: 1, offset: 62370},
 			expr: &choiceExpr{
-				pos: position{line: 1565, col: 19, offset: 60261},
+				pos: position{line: 1612, col: 19, offset: 62388},
 				alternatives: []interface{}{
 					&seqExpr{
-						pos: position{line: 1565, col: 19, offset: 60261},
+						pos: position{line: 1612, col: 19, offset: 62388},
 						exprs: []interface{}{
 							&notExpr{
-								pos: position{line: 1565, col: 19, offset: 60261},
+								pos: position{line: 1612, col: 19, offset: 62388},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1565, col: 21, offset: 60263},
+									pos:  position{line: 1612, col: 21, offset: 62390},
 									name: "Alphanum",
 								},
 							},
 							&ruleRefExpr{
-								pos:  position{line: 1565, col: 31, offset: 60273},
+								pos:  position{line: 1612, col: 31, offset: 62400},
 								name: "LiteralBlockDelimiter",
 							},
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1566, col: 19, offset: 60345},
+						pos:  position{line: 1613, col: 19, offset: 62471},
 						name: "FencedBlockDelimiter",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1567, col: 19, offset: 60386},
+						pos:  position{line: 1614, col: 19, offset: 62511},
 						name: "ListingBlockDelimiter",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1568, col: 19, offset: 60428},
+						pos:  position{line: 1615, col: 19, offset: 62552},
 						name: "ExampleBlockDelimiter",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1569, col: 19, offset: 60470},
+						pos:  position{line: 1616, col: 19, offset: 62593},
+						name: "OpenBlockDelimiter",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 1617, col: 19, offset: 62630},
 						name: "CommentBlockDelimiter",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1570, col: 19, offset: 60512},
+						pos:  position{line: 1618, col: 19, offset: 62671},
 						name: "QuoteBlockDelimiter",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1571, col: 19, offset: 60551},
+						pos:  position{line: 1619, col: 19, offset: 62709},
 						name: "SidebarBlockDelimiter",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1572, col: 19, offset: 60592},
+						pos:  position{line: 1620, col: 19, offset: 62749},
 						name: "PassthroughBlockDelimiter",
 					},
 				},
@@ -11226,16 +11724,16 @@ var g = &grammar{
 		},
 		{
 			name: "VerbatimContent",
-			pos:  position{line: 1574, col: 1, offset: 60621},
+			pos:  position{line: 1622, col: 1, offset: 62776},
 			expr: &choiceExpr{
-				pos: position{line: 1574, col: 20, offset: 60640},
+				pos: position{line: 1622, col: 20, offset: 62795},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1574, col: 20, offset: 60640},
+						pos:  position{line: 1622, col: 20, offset: 62795},
 						name: "FileInclusion",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1574, col: 36, offset: 60656},
+						pos:  position{line: 1622, col: 36, offset: 62811},
 						name: "VerbatimLine",
 					},
 				},
@@ -11243,41 +11741,41 @@ var g = &grammar{
 		},
 		{
 			name: "VerbatimLine",
-			pos:  position{line: 1576, col: 1, offset: 60672},
+			pos:  position{line: 1624, col: 1, offset: 62825},
 			expr: &actionExpr{
-				pos: position{line: 1576, col: 17, offset: 60688},
+				pos: position{line: 1624, col: 17, offset: 62841},
 				run: (*parser).callonVerbatimLine1,
 				expr: &seqExpr{
-					pos: position{line: 1576, col: 17, offset: 60688},
+					pos: position{line: 1624, col: 17, offset: 62841},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 1576, col: 17, offset: 60688},
+							pos: position{line: 1624, col: 17, offset: 62841},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1576, col: 18, offset: 60689},
+								pos:  position{line: 1624, col: 18, offset: 62842},
 								name: "EOF",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1576, col: 22, offset: 60693},
+							pos:   position{line: 1624, col: 22, offset: 62846},
 							label: "content",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1576, col: 31, offset: 60702},
+								pos:  position{line: 1624, col: 31, offset: 62855},
 								name: "VerbatimLineContent",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1576, col: 52, offset: 60723},
+							pos:   position{line: 1624, col: 52, offset: 62876},
 							label: "callouts",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1576, col: 61, offset: 60732},
+								pos: position{line: 1624, col: 61, offset: 62885},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1576, col: 62, offset: 60733},
+									pos:  position{line: 1624, col: 62, offset: 62886},
 									name: "Callouts",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1576, col: 73, offset: 60744},
+							pos:  position{line: 1624, col: 73, offset: 62897},
 							name: "EOL",
 						},
 					},
@@ -11286,36 +11784,36 @@ var g = &grammar{
 		},
 		{
 			name: "VerbatimLineContent",
-			pos:  position{line: 1580, col: 1, offset: 60818},
+			pos:  position{line: 1628, col: 1, offset: 62983},
 			expr: &actionExpr{
-				pos: position{line: 1580, col: 24, offset: 60841},
+				pos: position{line: 1628, col: 24, offset: 63006},
 				run: (*parser).callonVerbatimLineContent1,
 				expr: &zeroOrMoreExpr{
-					pos: position{line: 1580, col: 24, offset: 60841},
+					pos: position{line: 1628, col: 24, offset: 63006},
 					expr: &seqExpr{
-						pos: position{line: 1580, col: 25, offset: 60842},
+						pos: position{line: 1628, col: 25, offset: 63007},
 						exprs: []interface{}{
 							&notExpr{
-								pos: position{line: 1580, col: 25, offset: 60842},
+								pos: position{line: 1628, col: 25, offset: 63007},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1580, col: 26, offset: 60843},
+									pos:  position{line: 1628, col: 26, offset: 63008},
 									name: "Callouts",
 								},
 							},
 							&choiceExpr{
-								pos: position{line: 1580, col: 36, offset: 60853},
+								pos: position{line: 1628, col: 36, offset: 63018},
 								alternatives: []interface{}{
 									&oneOrMoreExpr{
-										pos: position{line: 1580, col: 36, offset: 60853},
+										pos: position{line: 1628, col: 36, offset: 63018},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1580, col: 36, offset: 60853},
+											pos:  position{line: 1628, col: 36, offset: 63018},
 											name: "Space",
 										},
 									},
 									&oneOrMoreExpr{
-										pos: position{line: 1580, col: 45, offset: 60862},
+										pos: position{line: 1628, col: 45, offset: 63027},
 										expr: &charClassMatcher{
-											pos:        position{line: 1580, col: 45, offset: 60862},
+											pos:        position{line: 1628, col: 45, offset: 63027},
 											val:        "[^ \\r\\n]",
 											chars:      []rune{' ', '\r', '\n'},
 											ignoreCase: false,
@@ -11331,40 +11829,40 @@ var g = &grammar{
 		},
 		{
 			name: "Callouts",
-			pos:  position{line: 1584, col: 1, offset: 60916},
+			pos:  position{line: 1632, col: 1, offset: 63077},
 			expr: &oneOrMoreExpr{
-				pos: position{line: 1584, col: 13, offset: 60928},
+				pos: position{line: 1632, col: 13, offset: 63089},
 				expr: &ruleRefExpr{
-					pos:  position{line: 1584, col: 13, offset: 60928},
+					pos:  position{line: 1632, col: 13, offset: 63089},
 					name: "Callout",
 				},
 			},
 		},
 		{
 			name: "Callout",
-			pos:  position{line: 1586, col: 1, offset: 60940},
+			pos:  position{line: 1634, col: 1, offset: 63099},
 			expr: &actionExpr{
-				pos: position{line: 1586, col: 12, offset: 60951},
+				pos: position{line: 1634, col: 12, offset: 63110},
 				run: (*parser).callonCallout1,
 				expr: &seqExpr{
-					pos: position{line: 1586, col: 12, offset: 60951},
+					pos: position{line: 1634, col: 12, offset: 63110},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 1586, col: 12, offset: 60951},
+							pos:        position{line: 1634, col: 12, offset: 63110},
 							val:        "<",
 							ignoreCase: false,
 							want:       "\"<\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 1586, col: 16, offset: 60955},
+							pos:   position{line: 1634, col: 16, offset: 63114},
 							label: "ref",
 							expr: &actionExpr{
-								pos: position{line: 1586, col: 21, offset: 60960},
+								pos: position{line: 1634, col: 21, offset: 63119},
 								run: (*parser).callonCallout5,
 								expr: &oneOrMoreExpr{
-									pos: position{line: 1586, col: 21, offset: 60960},
+									pos: position{line: 1634, col: 21, offset: 63119},
 									expr: &charClassMatcher{
-										pos:        position{line: 1586, col: 21, offset: 60960},
+										pos:        position{line: 1634, col: 21, offset: 63119},
 										val:        "[0-9]",
 										ranges:     []rune{'0', '9'},
 										ignoreCase: false,
@@ -11374,29 +11872,29 @@ var g = &grammar{
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1586, col: 69, offset: 61008},
+							pos:        position{line: 1634, col: 69, offset: 63167},
 							val:        ">",
 							ignoreCase: false,
 							want:       "\">\"",
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 1586, col: 73, offset: 61012},
+							pos: position{line: 1634, col: 73, offset: 63171},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1586, col: 73, offset: 61012},
+								pos:  position{line: 1634, col: 73, offset: 63171},
 								name: "Space",
 							},
 						},
 						&andExpr{
-							pos: position{line: 1586, col: 80, offset: 61019},
+							pos: position{line: 1634, col: 80, offset: 63178},
 							expr: &choiceExpr{
-								pos: position{line: 1586, col: 82, offset: 61021},
+								pos: position{line: 1634, col: 82, offset: 63180},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 1586, col: 82, offset: 61021},
+										pos:  position{line: 1634, col: 82, offset: 63180},
 										name: "EOL",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1586, col: 88, offset: 61027},
+										pos:  position{line: 1634, col: 88, offset: 63186},
 										name: "Callout",
 									},
 								},
@@ -11408,28 +11906,28 @@ var g = &grammar{
 		},
 		{
 			name: "CalloutListItem",
-			pos:  position{line: 1590, col: 1, offset: 61084},
+			pos:  position{line: 1638, col: 1, offset: 63255},
 			expr: &actionExpr{
-				pos: position{line: 1590, col: 20, offset: 61103},
+				pos: position{line: 1638, col: 20, offset: 63274},
 				run: (*parser).callonCalloutListItem1,
 				expr: &seqExpr{
-					pos: position{line: 1590, col: 20, offset: 61103},
+					pos: position{line: 1638, col: 20, offset: 63274},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1590, col: 20, offset: 61103},
+							pos:   position{line: 1638, col: 20, offset: 63274},
 							label: "ref",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1590, col: 25, offset: 61108},
+								pos:  position{line: 1638, col: 25, offset: 63279},
 								name: "CalloutListItemPrefix",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1590, col: 48, offset: 61131},
+							pos:   position{line: 1638, col: 48, offset: 63302},
 							label: "description",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 1590, col: 61, offset: 61144},
+								pos: position{line: 1638, col: 61, offset: 63315},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1590, col: 61, offset: 61144},
+									pos:  position{line: 1638, col: 61, offset: 63315},
 									name: "ListParagraph",
 								},
 							},
@@ -11440,29 +11938,29 @@ var g = &grammar{
 		},
 		{
 			name: "CalloutListItemPrefix",
-			pos:  position{line: 1594, col: 1, offset: 61245},
+			pos:  position{line: 1642, col: 1, offset: 63428},
 			expr: &actionExpr{
-				pos: position{line: 1594, col: 26, offset: 61270},
+				pos: position{line: 1642, col: 26, offset: 63453},
 				run: (*parser).callonCalloutListItemPrefix1,
 				expr: &seqExpr{
-					pos: position{line: 1594, col: 26, offset: 61270},
+					pos: position{line: 1642, col: 26, offset: 63453},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 1594, col: 26, offset: 61270},
+							pos:        position{line: 1642, col: 26, offset: 63453},
 							val:        "<",
 							ignoreCase: false,
 							want:       "\"<\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 1594, col: 30, offset: 61274},
+							pos:   position{line: 1642, col: 30, offset: 63457},
 							label: "ref",
 							expr: &actionExpr{
-								pos: position{line: 1594, col: 35, offset: 61279},
+								pos: position{line: 1642, col: 35, offset: 63462},
 								run: (*parser).callonCalloutListItemPrefix5,
 								expr: &oneOrMoreExpr{
-									pos: position{line: 1594, col: 35, offset: 61279},
+									pos: position{line: 1642, col: 35, offset: 63462},
 									expr: &charClassMatcher{
-										pos:        position{line: 1594, col: 35, offset: 61279},
+										pos:        position{line: 1642, col: 35, offset: 63462},
 										val:        "[0-9]",
 										ranges:     []rune{'0', '9'},
 										ignoreCase: false,
@@ -11472,15 +11970,15 @@ var g = &grammar{
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1594, col: 83, offset: 61327},
+							pos:        position{line: 1642, col: 83, offset: 63510},
 							val:        ">",
 							ignoreCase: false,
 							want:       "\">\"",
 						},
 						&oneOrMoreExpr{
-							pos: position{line: 1594, col: 87, offset: 61331},
+							pos: position{line: 1642, col: 87, offset: 63514},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1594, col: 87, offset: 61331},
+								pos:  position{line: 1642, col: 87, offset: 63514},
 								name: "Space",
 							},
 						},
@@ -11490,25 +11988,25 @@ var g = &grammar{
 		},
 		{
 			name: "FencedBlockDelimiter",
-			pos:  position{line: 1601, col: 1, offset: 61565},
+			pos:  position{line: 1649, col: 1, offset: 63741},
 			expr: &seqExpr{
-				pos: position{line: 1601, col: 25, offset: 61589},
+				pos: position{line: 1649, col: 25, offset: 63765},
 				exprs: []interface{}{
 					&litMatcher{
-						pos:        position{line: 1601, col: 25, offset: 61589},
+						pos:        position{line: 1649, col: 25, offset: 63765},
 						val:        "```",
 						ignoreCase: false,
 						want:       "\"```\"",
 					},
 					&zeroOrMoreExpr{
-						pos: position{line: 1601, col: 31, offset: 61595},
+						pos: position{line: 1649, col: 31, offset: 63771},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1601, col: 31, offset: 61595},
+							pos:  position{line: 1649, col: 31, offset: 63771},
 							name: "Space",
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1601, col: 38, offset: 61602},
+						pos:  position{line: 1649, col: 38, offset: 63778},
 						name: "EOL",
 					},
 				},
@@ -11516,25 +12014,25 @@ var g = &grammar{
 		},
 		{
 			name: "FencedBlockStartDelimiter",
-			pos:  position{line: 1603, col: 1, offset: 61664},
+			pos:  position{line: 1651, col: 1, offset: 63838},
 			expr: &seqExpr{
-				pos: position{line: 1603, col: 30, offset: 61693},
+				pos: position{line: 1651, col: 30, offset: 63867},
 				exprs: []interface{}{
 					&litMatcher{
-						pos:        position{line: 1603, col: 30, offset: 61693},
+						pos:        position{line: 1651, col: 30, offset: 63867},
 						val:        "```",
 						ignoreCase: false,
 						want:       "\"```\"",
 					},
 					&zeroOrMoreExpr{
-						pos: position{line: 1603, col: 36, offset: 61699},
+						pos: position{line: 1651, col: 36, offset: 63873},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1603, col: 36, offset: 61699},
+							pos:  position{line: 1651, col: 36, offset: 63873},
 							name: "Space",
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1603, col: 43, offset: 61706},
+						pos:  position{line: 1651, col: 43, offset: 63880},
 						name: "EOL",
 					},
 				},
@@ -11542,102 +12040,260 @@ var g = &grammar{
 		},
 		{
 			name: "FencedBlockEndDelimiter",
-			pos:  position{line: 1605, col: 1, offset: 61713},
+			pos:  position{line: 1653, col: 1, offset: 63885},
+			expr: &choiceExpr{
+				pos: position{line: 1653, col: 28, offset: 63912},
+				alternatives: []interface{}{
+					&seqExpr{
+						pos: position{line: 1653, col: 29, offset: 63913},
+						exprs: []interface{}{
+							&litMatcher{
+								pos:        position{line: 1653, col: 29, offset: 63913},
+								val:        "```",
+								ignoreCase: false,
+								want:       "\"```\"",
+							},
+							&zeroOrMoreExpr{
+								pos: position{line: 1653, col: 35, offset: 63919},
+								expr: &ruleRefExpr{
+									pos:  position{line: 1653, col: 35, offset: 63919},
+									name: "Space",
+								},
+							},
+							&ruleRefExpr{
+								pos:  position{line: 1653, col: 42, offset: 63926},
+								name: "EOL",
+							},
+						},
+					},
+					&ruleRefExpr{
+						pos:  position{line: 1653, col: 49, offset: 63933},
+						name: "EOF",
+					},
+				},
+			},
+		},
+		{
+			name: "FencedBlock",
+			pos:  position{line: 1655, col: 1, offset: 63938},
+			expr: &actionExpr{
+				pos: position{line: 1655, col: 16, offset: 63953},
+				run: (*parser).callonFencedBlock1,
+				expr: &seqExpr{
+					pos: position{line: 1655, col: 16, offset: 63953},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 1655, col: 16, offset: 63953},
+							label: "attributes",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 1655, col: 27, offset: 63964},
+								expr: &ruleRefExpr{
+									pos:  position{line: 1655, col: 28, offset: 63965},
+									name: "Attributes",
+								},
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 1655, col: 41, offset: 63978},
+							name: "FencedBlockStartDelimiter",
+						},
+						&labeledExpr{
+							pos:   position{line: 1655, col: 67, offset: 64004},
+							label: "content",
+							expr: &ruleRefExpr{
+								pos:  position{line: 1655, col: 76, offset: 64013},
+								name: "FencedBlockVerbatimContent",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 1655, col: 104, offset: 64041},
+							name: "FencedBlockEndDelimiter",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "FencedBlockVerbatimContent",
+			pos:  position{line: 1659, col: 1, offset: 64172},
+			expr: &zeroOrMoreExpr{
+				pos: position{line: 1659, col: 31, offset: 64202},
+				expr: &actionExpr{
+					pos: position{line: 1659, col: 32, offset: 64203},
+					run: (*parser).callonFencedBlockVerbatimContent2,
+					expr: &seqExpr{
+						pos: position{line: 1659, col: 32, offset: 64203},
+						exprs: []interface{}{
+							&notExpr{
+								pos: position{line: 1659, col: 32, offset: 64203},
+								expr: &ruleRefExpr{
+									pos:  position{line: 1659, col: 33, offset: 64204},
+									name: "FencedBlockEndDelimiter",
+								},
+							},
+							&labeledExpr{
+								pos:   position{line: 1659, col: 57, offset: 64228},
+								label: "content",
+								expr: &ruleRefExpr{
+									pos:  position{line: 1659, col: 66, offset: 64237},
+									name: "VerbatimContent",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "ListingBlockDelimiter",
+			pos:  position{line: 1666, col: 1, offset: 64574},
+			expr: &seqExpr{
+				pos: position{line: 1666, col: 26, offset: 64599},
+				exprs: []interface{}{
+					&litMatcher{
+						pos:        position{line: 1666, col: 26, offset: 64599},
+						val:        "----",
+						ignoreCase: false,
+						want:       "\"----\"",
+					},
+					&zeroOrMoreExpr{
+						pos: position{line: 1666, col: 33, offset: 64606},
+						expr: &ruleRefExpr{
+							pos:  position{line: 1666, col: 33, offset: 64606},
+							name: "Space",
+						},
+					},
+					&ruleRefExpr{
+						pos:  position{line: 1666, col: 40, offset: 64613},
+						name: "EOL",
+					},
+				},
+			},
+		},
+		{
+			name: "ListingBlockStartDelimiter",
+			pos:  position{line: 1668, col: 1, offset: 64618},
+			expr: &seqExpr{
+				pos: position{line: 1668, col: 31, offset: 64648},
+				exprs: []interface{}{
+					&litMatcher{
+						pos:        position{line: 1668, col: 31, offset: 64648},
+						val:        "----",
+						ignoreCase: false,
+						want:       "\"----\"",
+					},
+					&zeroOrMoreExpr{
+						pos: position{line: 1668, col: 38, offset: 64655},
+						expr: &ruleRefExpr{
+							pos:  position{line: 1668, col: 38, offset: 64655},
+							name: "Space",
+						},
+					},
+					&ruleRefExpr{
+						pos:  position{line: 1668, col: 45, offset: 64662},
+						name: "EOL",
+					},
+				},
+			},
+		},
+		{
+			name: "ListingBlockEndDelimiter",
+			pos:  position{line: 1670, col: 1, offset: 64667},
 			expr: &choiceExpr{
-				pos: position{line: 1605, col: 28, offset: 61740},
+				pos: position{line: 1670, col: 29, offset: 64695},
 				alternatives: []interface{}{
 					&seqExpr{
-						pos: position{line: 1605, col: 29, offset: 61741},
+						pos: position{line: 1670, col: 30, offset: 64696},
 						exprs: []interface{}{
 							&litMatcher{
-								pos:        position{line: 1605, col: 29, offset: 61741},
-								val:        "```",
+								pos:        position{line: 1670, col: 30, offset: 64696},
+								val:        "----",
 								ignoreCase: false,
-								want:       "\"```\"",
+								want:       "\"----\"",
 							},
 							&zeroOrMoreExpr{
-								pos: position{line: 1605, col: 35, offset: 61747},
+								pos: position{line: 1670, col: 37, offset: 64703},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1605, col: 35, offset: 61747},
+									pos:  position{line: 1670, col: 37, offset: 64703},
 									name: "Space",
 								},
 							},
 							&ruleRefExpr{
-								pos:  position{line: 1605, col: 42, offset: 61754},
+								pos:  position{line: 1670, col: 44, offset: 64710},
 								name: "EOL",
 							},
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1605, col: 49, offset: 61761},
+						pos:  position{line: 1670, col: 51, offset: 64717},
 						name: "EOF",
 					},
 				},
 			},
 		},
 		{
-			name: "FencedBlock",
-			pos:  position{line: 1607, col: 1, offset: 61768},
+			name: "ListingBlock",
+			pos:  position{line: 1672, col: 1, offset: 64722},
 			expr: &actionExpr{
-				pos: position{line: 1607, col: 16, offset: 61783},
-				run: (*parser).callonFencedBlock1,
+				pos: position{line: 1672, col: 17, offset: 64738},
+				run: (*parser).callonListingBlock1,
 				expr: &seqExpr{
-					pos: position{line: 1607, col: 16, offset: 61783},
+					pos: position{line: 1672, col: 17, offset: 64738},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1607, col: 16, offset: 61783},
+							pos:   position{line: 1672, col: 17, offset: 64738},
 							label: "attributes",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1607, col: 27, offset: 61794},
+								pos: position{line: 1672, col: 28, offset: 64749},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1607, col: 28, offset: 61795},
+									pos:  position{line: 1672, col: 29, offset: 64750},
 									name: "Attributes",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1607, col: 41, offset: 61808},
-							name: "FencedBlockStartDelimiter",
+							pos:  position{line: 1672, col: 42, offset: 64763},
+							name: "ListingBlockStartDelimiter",
 						},
 						&labeledExpr{
-							pos:   position{line: 1607, col: 67, offset: 61834},
+							pos:   position{line: 1672, col: 69, offset: 64790},
 							label: "content",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1607, col: 76, offset: 61843},
-								name: "FencedBlockVerbatimContent",
+								pos:  position{line: 1672, col: 78, offset: 64799},
+								name: "ListingBlockVerbatimContent",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1607, col: 104, offset: 61871},
-							name: "FencedBlockEndDelimiter",
+							pos:  position{line: 1672, col: 107, offset: 64828},
+							name: "ListingBlockEndDelimiter",
 						},
 					},
 				},
 			},
 		},
 		{
-			name: "FencedBlockVerbatimContent",
-			pos:  position{line: 1611, col: 1, offset: 61990},
+			name: "ListingBlockVerbatimContent",
+			pos:  position{line: 1676, col: 1, offset: 64961},
 			expr: &zeroOrMoreExpr{
-				pos: position{line: 1611, col: 31, offset: 62020},
+				pos: position{line: 1676, col: 32, offset: 64992},
 				expr: &actionExpr{
-					pos: position{line: 1611, col: 32, offset: 62021},
-					run: (*parser).callonFencedBlockVerbatimContent2,
+					pos: position{line: 1676, col: 33, offset: 64993},
+					run: (*parser).callonListingBlockVerbatimContent2,
 					expr: &seqExpr{
-						pos: position{line: 1611, col: 32, offset: 62021},
+						pos: position{line: 1676, col: 33, offset: 64993},
 						exprs: []interface{}{
 							&notExpr{
-								pos: position{line: 1611, col: 32, offset: 62021},
+								pos: position{line: 1676, col: 33, offset: 64993},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1611, col: 33, offset: 62022},
-									name: "FencedBlockEndDelimiter",
+									pos:  position{line: 1676, col: 34, offset: 64994},
+									name: "ListingBlockEndDelimiter",
 								},
 							},
 							&labeledExpr{
-								pos:   position{line: 1611, col: 57, offset: 62046},
+								pos:   position{line: 1676, col: 59, offset: 65019},
 								label: "content",
 								expr: &ruleRefExpr{
-									pos:  position{line: 1611, col: 66, offset: 62055},
+									pos:  position{line: 1676, col: 68, offset: 65028},
 									name: "VerbatimContent",
 								},
 							},
@@ -11647,155 +12303,155 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "ListingBlockDelimiter",
-			pos:  position{line: 1618, col: 1, offset: 62399},
+			name: "ExampleBlockDelimiter",
+			pos:  position{line: 1683, col: 1, offset: 65365},
 			expr: &seqExpr{
-				pos: position{line: 1618, col: 26, offset: 62424},
+				pos: position{line: 1683, col: 26, offset: 65390},
 				exprs: []interface{}{
 					&litMatcher{
-						pos:        position{line: 1618, col: 26, offset: 62424},
-						val:        "----",
+						pos:        position{line: 1683, col: 26, offset: 65390},
+						val:        "====",
 						ignoreCase: false,
-						want:       "\"----\"",
+						want:       "\"====\"",
 					},
 					&zeroOrMoreExpr{
-						pos: position{line: 1618, col: 33, offset: 62431},
+						pos: position{line: 1683, col: 33, offset: 65397},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1618, col: 33, offset: 62431},
+							pos:  position{line: 1683, col: 33, offset: 65397},
 							name: "Space",
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1618, col: 40, offset: 62438},
+						pos:  position{line: 1683, col: 40, offset: 65404},
 						name: "EOL",
 					},
 				},
 			},
 		},
 		{
-			name: "ListingBlockStartDelimiter",
-			pos:  position{line: 1620, col: 1, offset: 62445},
+			name: "ExampleBlockStartDelimiter",
+			pos:  position{line: 1685, col: 1, offset: 65409},
 			expr: &seqExpr{
-				pos: position{line: 1620, col: 31, offset: 62475},
+				pos: position{line: 1685, col: 31, offset: 65439},
 				exprs: []interface{}{
 					&litMatcher{
-						pos:        position{line: 1620, col: 31, offset: 62475},
-						val:        "----",
+						pos:        position{line: 1685, col: 31, offset: 65439},
+						val:        "====",
 						ignoreCase: false,
-						want:       "\"----\"",
+						want:       "\"====\"",
 					},
 					&zeroOrMoreExpr{
-						pos: position{line: 1620, col: 38, offset: 62482},
+						pos: position{line: 1685, col: 38, offset: 65446},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1620, col: 38, offset: 62482},
+							pos:  position{line: 1685, col: 38, offset: 65446},
 							name: "Space",
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1620, col: 45, offset: 62489},
+						pos:  position{line: 1685, col: 45, offset: 65453},
 						name: "EOL",
 					},
 				},
 			},
 		},
 		{
-			name: "ListingBlockEndDelimiter",
-			pos:  position{line: 1622, col: 1, offset: 62496},
+			name: "ExampleBlockEndDelimiter",
+			pos:  position{line: 1687, col: 1, offset: 65458},
 			expr: &choiceExpr{
-				pos: position{line: 1622, col: 29, offset: 62524},
+				pos: position{line: 1687, col: 29, offset: 65486},
 				alternatives: []interface{}{
 					&seqExpr{
-						pos: position{line: 1622, col: 30, offset: 62525},
+						pos: position{line: 1687, col: 30, offset: 65487},
 						exprs: []interface{}{
 							&litMatcher{
-								pos:        position{line: 1622, col: 30, offset: 62525},
-								val:        "----",
+								pos:        position{line: 1687, col: 30, offset: 65487},
+								val:        "====",
 								ignoreCase: false,
-								want:       "\"----\"",
+								want:       "\"====\"",
 							},
 							&zeroOrMoreExpr{
-								pos: position{line: 1622, col: 37, offset: 62532},
+								pos: position{line: 1687, col: 37, offset: 65494},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1622, col: 37, offset: 62532},
+									pos:  position{line: 1687, col: 37, offset: 65494},
 									name: "Space",
 								},
 							},
 							&ruleRefExpr{
-								pos:  position{line: 1622, col: 44, offset: 62539},
+								pos:  position{line: 1687, col: 44, offset: 65501},
 								name: "EOL",
 							},
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1622, col: 51, offset: 62546},
+						pos:  position{line: 1687, col: 51, offset: 65508},
 						name: "EOF",
 					},
 				},
 			},
 		},
 		{
-			name: "ListingBlock",
-			pos:  position{line: 1624, col: 1, offset: 62553},
+			name: "ExampleBlock",
+			pos:  position{line: 1689, col: 1, offset: 65513},
 			expr: &actionExpr{
-				pos: position{line: 1624, col: 17, offset: 62569},
-				run: (*parser).callonListingBlock1,
+				pos: position{line: 1689, col: 17, offset: 65529},
+				run: (*parser).callonExampleBlock1,
 				expr: &seqExpr{
-					pos: position{line: 1624, col: 17, offset: 62569},
+					pos: position{line: 1689, col: 17, offset: 65529},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1624, col: 17, offset: 62569},
+							pos:   position{line: 1689, col: 17, offset: 65529},
 							label: "attributes",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1624, col: 28, offset: 62580},
+								pos: position{line: 1689, col: 28, offset: 65540},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1624, col: 29, offset: 62581},
+									pos:  position{line: 1689, col: 29, offset: 65541},
 									name: "Attributes",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1624, col: 42, offset: 62594},
-							name: "ListingBlockStartDelimiter",
+							pos:  position{line: 1689, col: 42, offset: 65554},
+							name: "ExampleBlockStartDelimiter",
 						},
 						&labeledExpr{
-							pos:   position{line: 1624, col: 69, offset: 62621},
+							pos:   position{line: 1689, col: 69, offset: 65581},
 							label: "content",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1624, col: 78, offset: 62630},
-								name: "ListingBlockVerbatimContent",
+								pos:  position{line: 1689, col: 78, offset: 65590},
+								name: "ExampleBlockVerbatimContent",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1624, col: 107, offset: 62659},
-							name: "ListingBlockEndDelimiter",
+							pos:  position{line: 1689, col: 107, offset: 65619},
+							name: "ExampleBlockEndDelimiter",
 						},
 					},
 				},
 			},
 		},
 		{
-			name: "ListingBlockVerbatimContent",
-			pos:  position{line: 1628, col: 1, offset: 62780},
+			name: "ExampleBlockVerbatimContent",
+			pos:  position{line: 1693, col: 1, offset: 65752},
 			expr: &zeroOrMoreExpr{
-				pos: position{line: 1628, col: 32, offset: 62811},
+				pos: position{line: 1693, col: 32, offset: 65783},
 				expr: &actionExpr{
-					pos: position{line: 1628, col: 33, offset: 62812},
-					run: (*parser).callonListingBlockVerbatimContent2,
+					pos: position{line: 1693, col: 33, offset: 65784},
+					run: (*parser).callonExampleBlockVerbatimContent2,
 					expr: &seqExpr{
-						pos: position{line: 1628, col: 33, offset: 62812},
+						pos: position{line: 1693, col: 33, offset: 65784},
 						exprs: []interface{}{
 							&notExpr{
-								pos: position{line: 1628, col: 33, offset: 62812},
+								pos: position{line: 1693, col: 33, offset: 65784},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1628, col: 34, offset: 62813},
-									name: "ListingBlockEndDelimiter",
+									pos:  position{line: 1693, col: 34, offset: 65785},
+									name: "ExampleBlockEndDelimiter",
 								},
 							},
 							&labeledExpr{
-								pos:   position{line: 1628, col: 59, offset: 62838},
+								pos:   position{line: 1693, col: 59, offset: 65810},
 								label: "content",
 								expr: &ruleRefExpr{
-									pos:  position{line: 1628, col: 68, offset: 62847},
+									pos:  position{line: 1693, col: 68, offset: 65819},
 									name: "VerbatimContent",
 								},
 							},
@@ -11805,155 +12461,155 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "ExampleBlockDelimiter",
-			pos:  position{line: 1635, col: 1, offset: 63191},
+			name: "OpenBlockDelimiter",
+			pos:  position{line: 1700, col: 1, offset: 66153},
 			expr: &seqExpr{
-				pos: position{line: 1635, col: 26, offset: 63216},
+				pos: position{line: 1700, col: 23, offset: 66175},
 				exprs: []interface{}{
 					&litMatcher{
-						pos:        position{line: 1635, col: 26, offset: 63216},
-						val:        "====",
+						pos:        position{line: 1700, col: 23, offset: 66175},
+						val:        "--",
 						ignoreCase: false,
-						want:       "\"====\"",
+						want:       "\"--\"",
 					},
 					&zeroOrMoreExpr{
-						pos: position{line: 1635, col: 33, offset: 63223},
+						pos: position{line: 1700, col: 28, offset: 66180},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1635, col: 33, offset: 63223},
+							pos:  position{line: 1700, col: 28, offset: 66180},
 							name: "Space",
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1635, col: 40, offset: 63230},
+						pos:  position{line: 1700, col: 35, offset: 66187},
 						name: "EOL",
 					},
 				},
 			},
 		},
 		{
-			name: "ExampleBlockStartDelimiter",
-			pos:  position{line: 1637, col: 1, offset: 63237},
+			name: "OpenBlockStartDelimiter",
+			pos:  position{line: 1702, col: 1, offset: 66192},
 			expr: &seqExpr{
-				pos: position{line: 1637, col: 31, offset: 63267},
+				pos: position{line: 1702, col: 28, offset: 66219},
 				exprs: []interface{}{
 					&litMatcher{
-						pos:        position{line: 1637, col: 31, offset: 63267},
-						val:        "====",
+						pos:        position{line: 1702, col: 28, offset: 66219},
+						val:        "--",
 						ignoreCase: false,
-						want:       "\"====\"",
+						want:       "\"--\"",
 					},
 					&zeroOrMoreExpr{
-						pos: position{line: 1637, col: 38, offset: 63274},
+						pos: position{line: 1702, col: 33, offset: 66224},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1637, col: 38, offset: 63274},
+							pos:  position{line: 1702, col: 33, offset: 66224},
 							name: "Space",
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1637, col: 45, offset: 63281},
+						pos:  position{line: 1702, col: 40, offset: 66231},
 						name: "EOL",
 					},
 				},
 			},
 		},
 		{
-			name: "ExampleBlockEndDelimiter",
-			pos:  position{line: 1639, col: 1, offset: 63288},
+			name: "OpenBlockEndDelimiter",
+			pos:  position{line: 1704, col: 1, offset: 66236},
 			expr: &choiceExpr{
-				pos: position{line: 1639, col: 29, offset: 63316},
+				pos: position{line: 1704, col: 26, offset: 66261},
 				alternatives: []interface{}{
 					&seqExpr{
-						pos: position{line: 1639, col: 30, offset: 63317},
+						pos: position{line: 1704, col: 27, offset: 66262},
 						exprs: []interface{}{
 							&litMatcher{
-								pos:        position{line: 1639, col: 30, offset: 63317},
-								val:        "====",
+								pos:        position{line: 1704, col: 27, offset: 66262},
+								val:        "--",
 								ignoreCase: false,
-								want:       "\"====\"",
+								want:       "\"--\"",
 							},
 							&zeroOrMoreExpr{
-								pos: position{line: 1639, col: 37, offset: 63324},
+								pos: position{line: 1704, col: 32, offset: 66267},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1639, col: 37, offset: 63324},
+									pos:  position{line: 1704, col: 32, offset: 66267},
 									name: "Space",
 								},
 							},
 							&ruleRefExpr{
-								pos:  position{line: 1639, col: 44, offset: 63331},
+								pos:  position{line: 1704, col: 39, offset: 66274},
 								name: "EOL",
 							},
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1639, col: 51, offset: 63338},
+						pos:  position{line: 1704, col: 46, offset: 66281},
 						name: "EOF",
 					},
 				},
 			},
 		},
 		{
-			name: "ExampleBlock",
-			pos:  position{line: 1641, col: 1, offset: 63345},
+			name: "OpenBlock",
+			pos:  position{line: 1706, col: 1, offset: 66286},
 			expr: &actionExpr{
-				pos: position{line: 1641, col: 17, offset: 63361},
-				run: (*parser).callonExampleBlock1,
+				pos: position{line: 1706, col: 14, offset: 66299},
+				run: (*parser).callonOpenBlock1,
 				expr: &seqExpr{
-					pos: position{line: 1641, col: 17, offset: 63361},
+					pos: position{line: 1706, col: 14, offset: 66299},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1641, col: 17, offset: 63361},
+							pos:   position{line: 1706, col: 14, offset: 66299},
 							label: "attributes",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1641, col: 28, offset: 63372},
+								pos: position{line: 1706, col: 25, offset: 66310},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1641, col: 29, offset: 63373},
+									pos:  position{line: 1706, col: 26, offset: 66311},
 									name: "Attributes",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1641, col: 42, offset: 63386},
-							name: "ExampleBlockStartDelimiter",
+							pos:  position{line: 1706, col: 39, offset: 66324},
+							name: "OpenBlockStartDelimiter",
 						},
 						&labeledExpr{
-							pos:   position{line: 1641, col: 69, offset: 63413},
+							pos:   position{line: 1706, col: 63, offset: 66348},
 							label: "content",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1641, col: 78, offset: 63422},
-								name: "ExampleBlockVerbatimContent",
+								pos:  position{line: 1706, col: 72, offset: 66357},
+								name: "OpenBlockVerbatimContent",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1641, col: 107, offset: 63451},
-							name: "ExampleBlockEndDelimiter",
+							pos:  position{line: 1706, col: 98, offset: 66383},
+							name: "OpenBlockEndDelimiter",
 						},
 					},
 				},
 			},
 		},
 		{
-			name: "ExampleBlockVerbatimContent",
-			pos:  position{line: 1645, col: 1, offset: 63572},
+			name: "OpenBlockVerbatimContent",
+			pos:  position{line: 1710, col: 1, offset: 66510},
 			expr: &zeroOrMoreExpr{
-				pos: position{line: 1645, col: 32, offset: 63603},
+				pos: position{line: 1710, col: 29, offset: 66538},
 				expr: &actionExpr{
-					pos: position{line: 1645, col: 33, offset: 63604},
-					run: (*parser).callonExampleBlockVerbatimContent2,
+					pos: position{line: 1710, col: 30, offset: 66539},
+					run: (*parser).callonOpenBlockVerbatimContent2,
 					expr: &seqExpr{
-						pos: position{line: 1645, col: 33, offset: 63604},
+						pos: position{line: 1710, col: 30, offset: 66539},
 						exprs: []interface{}{
 							&notExpr{
-								pos: position{line: 1645, col: 33, offset: 63604},
+								pos: position{line: 1710, col: 30, offset: 66539},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1645, col: 34, offset: 63605},
-									name: "ExampleBlockEndDelimiter",
+									pos:  position{line: 1710, col: 31, offset: 66540},
+									name: "OpenBlockEndDelimiter",
 								},
 							},
 							&labeledExpr{
-								pos:   position{line: 1645, col: 59, offset: 63630},
+								pos:   position{line: 1710, col: 53, offset: 66562},
 								label: "content",
 								expr: &ruleRefExpr{
-									pos:  position{line: 1645, col: 68, offset: 63639},
+									pos:  position{line: 1710, col: 62, offset: 66571},
 									name: "VerbatimContent",
 								},
 							},
@@ -11964,25 +12620,25 @@ var g = &grammar{
 		},
 		{
 			name: "QuoteBlockDelimiter",
-			pos:  position{line: 1652, col: 1, offset: 63981},
+			pos:  position{line: 1717, col: 1, offset: 66906},
 			expr: &seqExpr{
-				pos: position{line: 1652, col: 24, offset: 64004},
+				pos: position{line: 1717, col: 24, offset: 66929},
 				exprs: []interface{}{
 					&litMatcher{
-						pos:        position{line: 1652, col: 24, offset: 64004},
+						pos:        position{line: 1717, col: 24, offset: 66929},
 						val:        "____",
 						ignoreCase: false,
 						want:       "\"____\"",
 					},
 					&zeroOrMoreExpr{
-						pos: position{line: 1652, col: 31, offset: 64011},
+						pos: position{line: 1717, col: 31, offset: 66936},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1652, col: 31, offset: 64011},
+							pos:  position{line: 1717, col: 31, offset: 66936},
 							name: "Space",
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1652, col: 38, offset: 64018},
+						pos:  position{line: 1717, col: 38, offset: 66943},
 						name: "EOL",
 					},
 				},
@@ -11990,25 +12646,25 @@ var g = &grammar{
 		},
 		{
 			name: "QuoteBlockStartDelimiter",
-			pos:  position{line: 1654, col: 1, offset: 64050},
+			pos:  position{line: 1719, col: 1, offset: 66973},
 			expr: &seqExpr{
-				pos: position{line: 1654, col: 29, offset: 64078},
+				pos: position{line: 1719, col: 29, offset: 67001},
 				exprs: []interface{}{
 					&litMatcher{
-						pos:        position{line: 1654, col: 29, offset: 64078},
+						pos:        position{line: 1719, col: 29, offset: 67001},
 						val:        "____",
 						ignoreCase: false,
 						want:       "\"____\"",
 					},
 					&zeroOrMoreExpr{
-						pos: position{line: 1654, col: 36, offset: 64085},
+						pos: position{line: 1719, col: 36, offset: 67008},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1654, col: 36, offset: 64085},
+							pos:  position{line: 1719, col: 36, offset: 67008},
 							name: "Space",
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1654, col: 43, offset: 64092},
+						pos:  position{line: 1719, col: 43, offset: 67015},
 						name: "EOL",
 					},
 				},
@@ -12016,34 +12672,34 @@ var g = &grammar{
 		},
 		{
 			name: "QuoteBlockEndDelimiter",
-			pos:  position{line: 1656, col: 1, offset: 64124},
+			pos:  position{line: 1721, col: 1, offset: 67045},
 			expr: &choiceExpr{
-				pos: position{line: 1656, col: 27, offset: 64150},
+				pos: position{line: 1721, col: 27, offset: 67071},
 				alternatives: []interface{}{
 					&seqExpr{
-						pos: position{line: 1656, col: 28, offset: 64151},
+						pos: position{line: 1721, col: 28, offset: 67072},
 						exprs: []interface{}{
 							&litMatcher{
-								pos:        position{line: 1656, col: 28, offset: 64151},
+								pos:        position{line: 1721, col: 28, offset: 67072},
 								val:        "____",
 								ignoreCase: false,
 								want:       "\"____\"",
 							},
 							&zeroOrMoreExpr{
-								pos: position{line: 1656, col: 35, offset: 64158},
+								pos: position{line: 1721, col: 35, offset: 67079},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1656, col: 35, offset: 64158},
+									pos:  position{line: 1721, col: 35, offset: 67079},
 									name: "Space",
 								},
 							},
 							&ruleRefExpr{
-								pos:  position{line: 1656, col: 42, offset: 64165},
+								pos:  position{line: 1721, col: 42, offset: 67086},
 								name: "EOL",
 							},
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1656, col: 49, offset: 64172},
+						pos:  position{line: 1721, col: 49, offset: 67093},
 						name: "EOF",
 					},
 				},
@@ -12051,38 +12707,38 @@ var g = &grammar{
 		},
 		{
 			name: "QuoteBlock",
-			pos:  position{line: 1658, col: 1, offset: 64204},
+			pos:  position{line: 1723, col: 1, offset: 67123},
 			expr: &actionExpr{
-				pos: position{line: 1658, col: 15, offset: 64218},
+				pos: position{line: 1723, col: 15, offset: 67137},
 				run: (*parser).callonQuoteBlock1,
 				expr: &seqExpr{
-					pos: position{line: 1658, col: 15, offset: 64218},
+					pos: position{line: 1723, col: 15, offset: 67137},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1658, col: 15, offset: 64218},
+							pos:   position{line: 1723, col: 15, offset: 67137},
 							label: "attributes",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1658, col: 26, offset: 64229},
+								pos: position{line: 1723, col: 26, offset: 67148},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1658, col: 27, offset: 64230},
+									pos:  position{line: 1723, col: 27, offset: 67149},
 									name: "Attributes",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1658, col: 40, offset: 64243},
+							pos:  position{line: 1723, col: 40, offset: 67162},
 							name: "QuoteBlockStartDelimiter",
 						},
 						&labeledExpr{
-							pos:   position{line: 1658, col: 65, offset: 64268},
+							pos:   position{line: 1723, col: 65, offset: 67187},
 							label: "content",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1658, col: 74, offset: 64277},
+								pos:  position{line: 1723, col: 74, offset: 67196},
 								name: "QuoteBlockVerbatimElement",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1658, col: 101, offset: 64304},
+							pos:  position{line: 1723, col: 101, offset: 67223},
 							name: "QuoteBlockEndDelimiter",
 						},
 					},
@@ -12091,27 +12747,27 @@ var g = &grammar{
 		},
 		{
 			name: "QuoteBlockVerbatimElement",
-			pos:  position{line: 1662, col: 1, offset: 64421},
+			pos:  position{line: 1727, col: 1, offset: 67352},
 			expr: &zeroOrMoreExpr{
-				pos: position{line: 1662, col: 30, offset: 64450},
+				pos: position{line: 1727, col: 30, offset: 67381},
 				expr: &actionExpr{
-					pos: position{line: 1662, col: 31, offset: 64451},
+					pos: position{line: 1727, col: 31, offset: 67382},
 					run: (*parser).callonQuoteBlockVerbatimElement2,
 					expr: &seqExpr{
-						pos: position{line: 1662, col: 31, offset: 64451},
+						pos: position{line: 1727, col: 31, offset: 67382},
 						exprs: []interface{}{
 							&notExpr{
-								pos: position{line: 1662, col: 31, offset: 64451},
+								pos: position{line: 1727, col: 31, offset: 67382},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1662, col: 32, offset: 64452},
+									pos:  position{line: 1727, col: 32, offset: 67383},
 									name: "QuoteBlockEndDelimiter",
 								},
 							},
 							&labeledExpr{
-								pos:   position{line: 1662, col: 55, offset: 64475},
+								pos:   position{line: 1727, col: 55, offset: 67406},
 								label: "content",
 								expr: &ruleRefExpr{
-									pos:  position{line: 1662, col: 64, offset: 64484},
+									pos:  position{line: 1727, col: 64, offset: 67415},
 									name: "VerbatimContent",
 								},
 							},
@@ -12122,39 +12778,39 @@ var g = &grammar{
 		},
 		{
 			name: "VerseBlock",
-			pos:  position{line: 1671, col: 1, offset: 64877},
+			pos:  position{line: 1736, col: 1, offset: 67799},
 			expr: &actionExpr{
-				pos: position{line: 1671, col: 15, offset: 64891},
+				pos: position{line: 1736, col: 15, offset: 67813},
 				run: (*parser).callonVerseBlock1,
 				expr: &seqExpr{
-					pos: position{line: 1671, col: 15, offset: 64891},
+					pos: position{line: 1736, col: 15, offset: 67813},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1671, col: 15, offset: 64891},
+							pos:   position{line: 1736, col: 15, offset: 67813},
 							label: "attributes",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1671, col: 27, offset: 64903},
+								pos:  position{line: 1736, col: 27, offset: 67825},
 								name: "Attributes",
 							},
 						},
 						&andCodeExpr{
-							pos: position{line: 1672, col: 5, offset: 64921},
+							pos: position{line: 1737, col: 5, offset: 67842},
 							run: (*parser).callonVerseBlock5,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1676, col: 5, offset: 65120},
+							pos:  position{line: 1741, col: 5, offset: 68037},
 							name: "QuoteBlockStartDelimiter",
 						},
 						&labeledExpr{
-							pos:   position{line: 1676, col: 30, offset: 65145},
+							pos:   position{line: 1741, col: 30, offset: 68062},
 							label: "content",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1676, col: 39, offset: 65154},
+								pos:  position{line: 1741, col: 39, offset: 68071},
 								name: "VerseBlockVerbatimContent",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1676, col: 66, offset: 65181},
+							pos:  position{line: 1741, col: 66, offset: 68098},
 							name: "QuoteBlockEndDelimiter",
 						},
 					},
@@ -12163,27 +12819,27 @@ var g = &grammar{
 		},
 		{
 			name: "VerseBlockVerbatimContent",
-			pos:  position{line: 1680, col: 1, offset: 65306},
+			pos:  position{line: 1745, col: 1, offset: 68235},
 			expr: &zeroOrMoreExpr{
-				pos: position{line: 1680, col: 30, offset: 65335},
+				pos: position{line: 1745, col: 30, offset: 68264},
 				expr: &actionExpr{
-					pos: position{line: 1680, col: 31, offset: 65336},
+					pos: position{line: 1745, col: 31, offset: 68265},
 					run: (*parser).callonVerseBlockVerbatimContent2,
 					expr: &seqExpr{
-						pos: position{line: 1680, col: 31, offset: 65336},
+						pos: position{line: 1745, col: 31, offset: 68265},
 						exprs: []interface{}{
 							&notExpr{
-								pos: position{line: 1680, col: 31, offset: 65336},
+								pos: position{line: 1745, col: 31, offset: 68265},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1680, col: 32, offset: 65337},
+									pos:  position{line: 1745, col: 32, offset: 68266},
 									name: "QuoteBlockEndDelimiter",
 								},
 							},
 							&labeledExpr{
-								pos:   position{line: 1680, col: 55, offset: 65360},
+								pos:   position{line: 1745, col: 55, offset: 68289},
 								label: "content",
 								expr: &ruleRefExpr{
-									pos:  position{line: 1680, col: 64, offset: 65369},
+									pos:  position{line: 1745, col: 64, offset: 68298},
 									name: "VerbatimContent",
 								},
 							},
@@ -12194,25 +12850,25 @@ var g = &grammar{
 		},
 		{
 			name: "SidebarBlockDelimiter",
-			pos:  position{line: 1687, col: 1, offset: 65713},
+			pos:  position{line: 1752, col: 1, offset: 68635},
 			expr: &seqExpr{
-				pos: position{line: 1687, col: 26, offset: 65738},
+				pos: position{line: 1752, col: 26, offset: 68660},
 				exprs: []interface{}{
 					&litMatcher{
-						pos:        position{line: 1687, col: 26, offset: 65738},
+						pos:        position{line: 1752, col: 26, offset: 68660},
 						val:        "****",
 						ignoreCase: false,
 						want:       "\"****\"",
 					},
 					&zeroOrMoreExpr{
-						pos: position{line: 1687, col: 33, offset: 65745},
+						pos: position{line: 1752, col: 33, offset: 68667},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1687, col: 33, offset: 65745},
+							pos:  position{line: 1752, col: 33, offset: 68667},
 							name: "Space",
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1687, col: 40, offset: 65752},
+						pos:  position{line: 1752, col: 40, offset: 68674},
 						name: "EOL",
 					},
 				},
@@ -12220,25 +12876,25 @@ var g = &grammar{
 		},
 		{
 			name: "SidebarBlockStartDelimiter",
-			pos:  position{line: 1689, col: 1, offset: 65759},
+			pos:  position{line: 1754, col: 1, offset: 68679},
 			expr: &seqExpr{
-				pos: position{line: 1689, col: 31, offset: 65789},
+				pos: position{line: 1754, col: 31, offset: 68709},
 				exprs: []interface{}{
 					&litMatcher{
-						pos:        position{line: 1689, col: 31, offset: 65789},
+						pos:        position{line: 1754, col: 31, offset: 68709},
 						val:        "****",
 						ignoreCase: false,
 						want:       "\"****\"",
 					},
 					&zeroOrMoreExpr{
-						pos: position{line: 1689, col: 38, offset: 65796},
+						pos: position{line: 1754, col: 38, offset: 68716},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1689, col: 38, offset: 65796},
+							pos:  position{line: 1754, col: 38, offset: 68716},
 							name: "Space",
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1689, col: 45, offset: 65803},
+						pos:  position{line: 1754, col: 45, offset: 68723},
 						name: "EOL",
 					},
 				},
@@ -12246,34 +12902,34 @@ var g = &grammar{
 		},
 		{
 			name: "SidebarBlockEndDelimiter",
-			pos:  position{line: 1691, col: 1, offset: 65810},
+			pos:  position{line: 1756, col: 1, offset: 68728},
 			expr: &choiceExpr{
-				pos: position{line: 1691, col: 29, offset: 65838},
+				pos: position{line: 1756, col: 29, offset: 68756},
 				alternatives: []interface{}{
 					&seqExpr{
-						pos: position{line: 1691, col: 30, offset: 65839},
+						pos: position{line: 1756, col: 30, offset: 68757},
 						exprs: []interface{}{
 							&litMatcher{
-								pos:        position{line: 1691, col: 30, offset: 65839},
+								pos:        position{line: 1756, col: 30, offset: 68757},
 								val:        "****",
 								ignoreCase: false,
 								want:       "\"****\"",
 							},
 							&zeroOrMoreExpr{
-								pos: position{line: 1691, col: 37, offset: 65846},
+								pos: position{line: 1756, col: 37, offset: 68764},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1691, col: 37, offset: 65846},
+									pos:  position{line: 1756, col: 37, offset: 68764},
 									name: "Space",
 								},
 							},
 							&ruleRefExpr{
-								pos:  position{line: 1691, col: 44, offset: 65853},
+								pos:  position{line: 1756, col: 44, offset: 68771},
 								name: "EOL",
 							},
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1691, col: 51, offset: 65860},
+						pos:  position{line: 1756, col: 51, offset: 68778},
 						name: "EOF",
 					},
 				},
@@ -12281,38 +12937,38 @@ var g = &grammar{
 		},
 		{
 			name: "SidebarBlock",
-			pos:  position{line: 1693, col: 1, offset: 65867},
+			pos:  position{line: 1758, col: 1, offset: 68783},
 			expr: &actionExpr{
-				pos: position{line: 1693, col: 17, offset: 65883},
+				pos: position{line: 1758, col: 17, offset: 68799},
 				run: (*parser).callonSidebarBlock1,
 				expr: &seqExpr{
-					pos: position{line: 1693, col: 17, offset: 65883},
+					pos: position{line: 1758, col: 17, offset: 68799},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1693, col: 17, offset: 65883},
+							pos:   position{line: 1758, col: 17, offset: 68799},
 							label: "attributes",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1693, col: 28, offset: 65894},
+								pos: position{line: 1758, col: 28, offset: 68810},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1693, col: 29, offset: 65895},
+									pos:  position{line: 1758, col: 29, offset: 68811},
 									name: "Attributes",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1693, col: 42, offset: 65908},
+							pos:  position{line: 1758, col: 42, offset: 68824},
 							name: "SidebarBlockStartDelimiter",
 						},
 						&labeledExpr{
-							pos:   position{line: 1693, col: 69, offset: 65935},
+							pos:   position{line: 1758, col: 69, offset: 68851},
 							label: "content",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1693, col: 78, offset: 65944},
+								pos:  position{line: 1758, col: 78, offset: 68860},
 								name: "SidebarBlockVerbatimContent",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1693, col: 107, offset: 65973},
+							pos:  position{line: 1758, col: 107, offset: 68889},
 							name: "SidebarBlockEndDelimiter",
 						},
 					},
@@ -12321,27 +12977,27 @@ var g = &grammar{
 		},
 		{
 			name: "SidebarBlockVerbatimContent",
-			pos:  position{line: 1697, col: 1, offset: 66094},
+			pos:  position{line: 1762, col: 1, offset: 69022},
 			expr: &zeroOrMoreExpr{
-				pos: position{line: 1697, col: 32, offset: 66125},
+				pos: position{line: 1762, col: 32, offset: 69053},
 				expr: &actionExpr{
-					pos: position{line: 1697, col: 33, offset: 66126},
+					pos: position{line: 1762, col: 33, offset: 69054},
 					run: (*parser).callonSidebarBlockVerbatimContent2,
 					expr: &seqExpr{
-						pos: position{line: 1697, col: 33, offset: 66126},
+						pos: position{line: 1762, col: 33, offset: 69054},
 						exprs: []interface{}{
 							&notExpr{
-								pos: position{line: 1697, col: 33, offset: 66126},
+								pos: position{line: 1762, col: 33, offset: 69054},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1697, col: 34, offset: 66127},
+									pos:  position{line: 1762, col: 34, offset: 69055},
 									name: "SidebarBlockEndDelimiter",
 								},
 							},
 							&labeledExpr{
-								pos:   position{line: 1697, col: 59, offset: 66152},
+								pos:   position{line: 1762, col: 59, offset: 69080},
 								label: "content",
 								expr: &ruleRefExpr{
-									pos:  position{line: 1697, col: 68, offset: 66161},
+									pos:  position{line: 1762, col: 68, offset: 69089},
 									name: "VerbatimContent",
 								},
 							},
@@ -12352,25 +13008,25 @@ var g = &grammar{
 		},
 		{
 			name: "PassthroughBlockDelimiter",
-			pos:  position{line: 1704, col: 1, offset: 66509},
+			pos:  position{line: 1769, col: 1, offset: 69430},
 			expr: &seqExpr{
-				pos: position{line: 1704, col: 30, offset: 66538},
+				pos: position{line: 1769, col: 30, offset: 69459},
 				exprs: []interface{}{
 					&litMatcher{
-						pos:        position{line: 1704, col: 30, offset: 66538},
+						pos:        position{line: 1769, col: 30, offset: 69459},
 						val:        "++++",
 						ignoreCase: false,
 						want:       "\"++++\"",
 					},
 					&zeroOrMoreExpr{
-						pos: position{line: 1704, col: 37, offset: 66545},
+						pos: position{line: 1769, col: 37, offset: 69466},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1704, col: 37, offset: 66545},
+							pos:  position{line: 1769, col: 37, offset: 69466},
 							name: "Space",
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1704, col: 44, offset: 66552},
+						pos:  position{line: 1769, col: 44, offset: 69473},
 						name: "EOL",
 					},
 				},
@@ -12378,25 +13034,25 @@ var g = &grammar{
 		},
 		{
 			name: "PassthroughBlockStartDelimiter",
-			pos:  position{line: 1706, col: 1, offset: 66559},
+			pos:  position{line: 1771, col: 1, offset: 69478},
 			expr: &seqExpr{
-				pos: position{line: 1706, col: 35, offset: 66593},
+				pos: position{line: 1771, col: 35, offset: 69512},
 				exprs: []interface{}{
 					&litMatcher{
-						pos:        position{line: 1706, col: 35, offset: 66593},
+						pos:        position{line: 1771, col: 35, offset: 69512},
 						val:        "++++",
 						ignoreCase: false,
 						want:       "\"++++\"",
 					},
 					&zeroOrMoreExpr{
-						pos: position{line: 1706, col: 42, offset: 66600},
+						pos: position{line: 1771, col: 42, offset: 69519},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1706, col: 42, offset: 66600},
+							pos:  position{line: 1771, col: 42, offset: 69519},
 							name: "Space",
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1706, col: 49, offset: 66607},
+						pos:  position{line: 1771, col: 49, offset: 69526},
 						name: "EOL",
 					},
 				},
@@ -12404,34 +13060,34 @@ var g = &grammar{
 		},
 		{
 			name: "PassthroughBlockEndDelimiter",
-			pos:  position{line: 1708, col: 1, offset: 66614},
+			pos:  position{line: 1773, col: 1, offset: 69531},
 			expr: &choiceExpr{
-				pos: position{line: 1708, col: 33, offset: 66646},
+				pos: position{line: 1773, col: 33, offset: 69563},
 				alternatives: []interface{}{
 					&seqExpr{
-						pos: position{line: 1708, col: 34, offset: 66647},
+						pos: position{line: 1773, col: 34, offset: 69564},
 						exprs: []interface{}{
 							&litMatcher{
-								pos:        position{line: 1708, col: 34, offset: 66647},
+								pos:        position{line: 1773, col: 34, offset: 69564},
 								val:        "++++",
 								ignoreCase: false,
 								want:       "\"++++\"",
 							},
 							&zeroOrMoreExpr{
-								pos: position{line: 1708, col: 41, offset: 66654},
+								pos: position{line: 1773, col: 41, offset: 69571},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1708, col: 41, offset: 66654},
+									pos:  position{line: 1773, col: 41, offset: 69571},
 									name: "Space",
 								},
 							},
 							&ruleRefExpr{
-								pos:  position{line: 1708, col: 48, offset: 66661},
+								pos:  position{line: 1773, col: 48, offset: 69578},
 								name: "EOL",
 							},
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1708, col: 55, offset: 66668},
+						pos:  position{line: 1773, col: 55, offset: 69585},
 						name: "EOF",
 					},
 				},
@@ -12439,38 +13095,38 @@ var g = &grammar{
 		},
 		{
 			name: "PassthroughBlock",
-			pos:  position{line: 1710, col: 1, offset: 66675},
+			pos:  position{line: 1775, col: 1, offset: 69590},
 			expr: &actionExpr{
-				pos: position{line: 1710, col: 21, offset: 66695},
+				pos: position{line: 1775, col: 21, offset: 69610},
 				run: (*parser).callonPassthroughBlock1,
 				expr: &seqExpr{
-					pos: position{line: 1710, col: 21, offset: 66695},
+					pos: position{line: 1775, col: 21, offset: 69610},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1710, col: 21, offset: 66695},
+							pos:   position{line: 1775, col: 21, offset: 69610},
 							label: "attributes",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1710, col: 32, offset: 66706},
+								pos: position{line: 1775, col: 32, offset: 69621},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1710, col: 33, offset: 66707},
+									pos:  position{line: 1775, col: 33, offset: 69622},
 									name: "Attributes",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1710, col: 46, offset: 66720},
+							pos:  position{line: 1775, col: 46, offset: 69635},
 							name: "PassthroughBlockStartDelimiter",
 						},
 						&labeledExpr{
-							pos:   position{line: 1710, col: 77, offset: 66751},
+							pos:   position{line: 1775, col: 77, offset: 69666},
 							label: "content",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1710, col: 86, offset: 66760},
+								pos:  position{line: 1775, col: 86, offset: 69675},
 								name: "PassthroughBlockVerbatimContent",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1710, col: 119, offset: 66793},
+							pos:  position{line: 1775, col: 119, offset: 69708},
 							name: "PassthroughBlockEndDelimiter",
 						},
 					},
@@ -12479,27 +13135,27 @@ var g = &grammar{
 		},
 		{
 			name: "PassthroughBlockVerbatimContent",
-			pos:  position{line: 1714, col: 1, offset: 66922},
+			pos:  position{line: 1779, col: 1, offset: 69849},
 			expr: &zeroOrMoreExpr{
-				pos: position{line: 1714, col: 36, offset: 66957},
+				pos: position{line: 1779, col: 36, offset: 69884},
 				expr: &actionExpr{
-					pos: position{line: 1714, col: 37, offset: 66958},
+					pos: position{line: 1779, col: 37, offset: 69885},
 					run: (*parser).callonPassthroughBlockVerbatimContent2,
 					expr: &seqExpr{
-						pos: position{line: 1714, col: 37, offset: 66958},
+						pos: position{line: 1779, col: 37, offset: 69885},
 						exprs: []interface{}{
 							&notExpr{
-								pos: position{line: 1714, col: 37, offset: 66958},
+								pos: position{line: 1779, col: 37, offset: 69885},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1714, col: 38, offset: 66959},
+									pos:  position{line: 1779, col: 38, offset: 69886},
 									name: "PassthroughBlockEndDelimiter",
 								},
 							},
 							&labeledExpr{
-								pos:   position{line: 1714, col: 67, offset: 66988},
+								pos:   position{line: 1779, col: 67, offset: 69915},
 								label: "content",
 								expr: &ruleRefExpr{
-									pos:  position{line: 1714, col: 76, offset: 66997},
+									pos:  position{line: 1779, col: 76, offset: 69924},
 									name: "VerbatimContent",
 								},
 							},
@@ -12510,87 +13166,87 @@ var g = &grammar{
 		},
 		{
 			name: "NormalBlockContent",
-			pos:  position{line: 1722, col: 1, offset: 67351},
+			pos:  position{line: 1787, col: 1, offset: 70270},
 			expr: &zeroOrMoreExpr{
-				pos: position{line: 1722, col: 23, offset: 67373},
+				pos: position{line: 1787, col: 23, offset: 70292},
 				expr: &ruleRefExpr{
-					pos:  position{line: 1722, col: 23, offset: 67373},
+					pos:  position{line: 1787, col: 23, offset: 70292},
 					name: "NormalBlockElement",
 				},
 			},
 		},
 		{
 			name: "NormalBlockElement",
-			pos:  position{line: 1724, col: 1, offset: 67396},
+			pos:  position{line: 1789, col: 1, offset: 70313},
 			expr: &actionExpr{
-				pos: position{line: 1725, col: 5, offset: 67424},
+				pos: position{line: 1790, col: 5, offset: 70340},
 				run: (*parser).callonNormalBlockElement1,
 				expr: &seqExpr{
-					pos: position{line: 1725, col: 5, offset: 67424},
+					pos: position{line: 1790, col: 5, offset: 70340},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 1725, col: 5, offset: 67424},
+							pos: position{line: 1790, col: 5, offset: 70340},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1725, col: 6, offset: 67425},
+								pos:  position{line: 1790, col: 6, offset: 70341},
 								name: "EOF",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1725, col: 10, offset: 67429},
+							pos:   position{line: 1790, col: 10, offset: 70345},
 							label: "element",
 							expr: &choiceExpr{
-								pos: position{line: 1725, col: 19, offset: 67438},
+								pos: position{line: 1790, col: 19, offset: 70354},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 1725, col: 19, offset: 67438},
+										pos:  position{line: 1790, col: 19, offset: 70354},
 										name: "BlankLine",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1726, col: 15, offset: 67464},
+										pos:  position{line: 1791, col: 15, offset: 70379},
 										name: "FileInclusion",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1727, col: 15, offset: 67493},
+										pos:  position{line: 1792, col: 15, offset: 70407},
 										name: "ImageBlock",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1728, col: 15, offset: 67520},
+										pos:  position{line: 1793, col: 15, offset: 70433},
 										name: "OrderedListItem",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1729, col: 15, offset: 67552},
+										pos:  position{line: 1794, col: 15, offset: 70464},
 										name: "UnorderedListItem",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1730, col: 15, offset: 67586},
+										pos:  position{line: 1795, col: 15, offset: 70497},
 										name: "LabeledListItem",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1731, col: 15, offset: 67618},
+										pos:  position{line: 1796, col: 15, offset: 70528},
 										name: "ContinuedListItemElement",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1732, col: 15, offset: 67658},
+										pos:  position{line: 1797, col: 15, offset: 70567},
 										name: "DelimitedBlock",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1733, col: 15, offset: 67688},
+										pos:  position{line: 1798, col: 15, offset: 70596},
 										name: "LiteralBlock",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1734, col: 15, offset: 67717},
+										pos:  position{line: 1799, col: 15, offset: 70624},
 										name: "AttributeDeclaration",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1735, col: 15, offset: 67754},
+										pos:  position{line: 1800, col: 15, offset: 70660},
 										name: "AttributeReset",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1736, col: 15, offset: 67785},
+										pos:  position{line: 1801, col: 15, offset: 70690},
 										name: "TableOfContentsPlaceHolder",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1737, col: 15, offset: 67827},
+										pos:  position{line: 1802, col: 15, offset: 70731},
 										name: "Paragraph",
 									},
 								},
@@ -12602,43 +13258,43 @@ var g = &grammar{
 		},
 		{
 			name: "VerseBlockContent",
-			pos:  position{line: 1741, col: 1, offset: 67880},
+			pos:  position{line: 1806, col: 1, offset: 70780},
 			expr: &zeroOrMoreExpr{
-				pos: position{line: 1741, col: 22, offset: 67901},
+				pos: position{line: 1806, col: 22, offset: 70801},
 				expr: &ruleRefExpr{
-					pos:  position{line: 1741, col: 22, offset: 67901},
+					pos:  position{line: 1806, col: 22, offset: 70801},
 					name: "VerseBlockElement",
 				},
 			},
 		},
 		{
 			name: "VerseBlockElement",
-			pos:  position{line: 1743, col: 1, offset: 67923},
+			pos:  position{line: 1808, col: 1, offset: 70821},
 			expr: &actionExpr{
-				pos: position{line: 1743, col: 22, offset: 67944},
+				pos: position{line: 1808, col: 22, offset: 70842},
 				run: (*parser).callonVerseBlockElement1,
 				expr: &seqExpr{
-					pos: position{line: 1743, col: 22, offset: 67944},
+					pos: position{line: 1808, col: 22, offset: 70842},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 1743, col: 22, offset: 67944},
+							pos: position{line: 1808, col: 22, offset: 70842},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1743, col: 23, offset: 67945},
+								pos:  position{line: 1808, col: 23, offset: 70843},
 								name: "EOF",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1743, col: 27, offset: 67949},
+							pos:   position{line: 1808, col: 27, offset: 70847},
 							label: "element",
 							expr: &choiceExpr{
-								pos: position{line: 1743, col: 36, offset: 67958},
+								pos: position{line: 1808, col: 36, offset: 70856},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 1743, col: 36, offset: 67958},
+										pos:  position{line: 1808, col: 36, offset: 70856},
 										name: "BlankLine",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1743, col: 48, offset: 67970},
+										pos:  position{line: 1808, col: 48, offset: 70868},
 										name: "VerseBlockParagraph",
 									},
 								},
@@ -12650,17 +13306,17 @@ var g = &grammar{
 		},
 		{
 			name: "VerseBlockParagraph",
-			pos:  position{line: 1747, col: 1, offset: 68024},
+			pos:  position{line: 1812, col: 1, offset: 70918},
 			expr: &actionExpr{
-				pos: position{line: 1747, col: 24, offset: 68047},
+				pos: position{line: 1812, col: 24, offset: 70941},
 				run: (*parser).callonVerseBlockParagraph1,
 				expr: &labeledExpr{
-					pos:   position{line: 1747, col: 24, offset: 68047},
+					pos:   position{line: 1812, col: 24, offset: 70941},
 					label: "lines",
 					expr: &oneOrMoreExpr{
-						pos: position{line: 1747, col: 30, offset: 68053},
+						pos: position{line: 1812, col: 30, offset: 70947},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1747, col: 31, offset: 68054},
+							pos:  position{line: 1812, col: 31, offset: 70948},
 							name: "VerseBlockParagraphLine",
 						},
 					},
@@ -12669,26 +13325,26 @@ var g = &grammar{
 		},
 		{
 			name: "VerseBlockParagraphLine",
-			pos:  position{line: 1751, col: 1, offset: 68148},
+			pos:  position{line: 1816, col: 1, offset: 71054},
 			expr: &actionExpr{
-				pos: position{line: 1751, col: 28, offset: 68175},
+				pos: position{line: 1816, col: 28, offset: 71081},
 				run: (*parser).callonVerseBlockParagraphLine1,
 				expr: &seqExpr{
-					pos: position{line: 1751, col: 28, offset: 68175},
+					pos: position{line: 1816, col: 28, offset: 71081},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1751, col: 28, offset: 68175},
+							pos:   position{line: 1816, col: 28, offset: 71081},
 							label: "elements",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 1751, col: 37, offset: 68184},
+								pos: position{line: 1816, col: 37, offset: 71090},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1751, col: 38, offset: 68185},
+									pos:  position{line: 1816, col: 38, offset: 71091},
 									name: "InlineElement",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1751, col: 54, offset: 68201},
+							pos:  position{line: 1816, col: 54, offset: 71107},
 							name: "EOL",
 						},
 					},
@@ -12697,59 +13353,59 @@ var g = &grammar{
 		},
 		{
 			name: "Table",
-			pos:  position{line: 1758, col: 1, offset: 68450},
+			pos:  position{line: 1823, col: 1, offset: 71366},
 			expr: &actionExpr{
-				pos: position{line: 1758, col: 10, offset: 68459},
+				pos: position{line: 1823, col: 10, offset: 71375},
 				run: (*parser).callonTable1,
 				expr: &seqExpr{
-					pos: position{line: 1758, col: 10, offset: 68459},
+					pos: position{line: 1823, col: 10, offset: 71375},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1758, col: 10, offset: 68459},
+							pos:   position{line: 1823, col: 10, offset: 71375},
 							label: "attributes",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1758, col: 21, offset: 68470},
+								pos: position{line: 1823, col: 21, offset: 71386},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1758, col: 22, offset: 68471},
+									pos:  position{line: 1823, col: 22, offset: 71387},
 									name: "Attributes",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1758, col: 35, offset: 68484},
+							pos:  position{line: 1823, col: 35, offset: 71400},
 							name: "TableDelimiter",
 						},
 						&labeledExpr{
-							pos:   position{line: 1759, col: 5, offset: 68504},
+							pos:   position{line: 1824, col: 5, offset: 71419},
 							label: "header",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1759, col: 12, offset: 68511},
+								pos: position{line: 1824, col: 12, offset: 71426},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1759, col: 13, offset: 68512},
+									pos:  position{line: 1824, col: 13, offset: 71427},
 									name: "TableLineHeader",
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1760, col: 5, offset: 68535},
+							pos:   position{line: 1825, col: 5, offset: 71449},
 							label: "lines",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 1760, col: 11, offset: 68541},
+								pos: position{line: 1825, col: 11, offset: 71455},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1760, col: 12, offset: 68542},
+									pos:  position{line: 1825, col: 12, offset: 71456},
 									name: "TableLine",
 								},
 							},
 						},
 						&choiceExpr{
-							pos: position{line: 1761, col: 6, offset: 68560},
+							pos: position{line: 1826, col: 6, offset: 71473},
 							alternatives: []interface{}{
 								&ruleRefExpr{
-									pos:  position{line: 1761, col: 6, offset: 68560},
+									pos:  position{line: 1826, col: 6, offset: 71473},
 									name: "TableDelimiter",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1761, col: 23, offset: 68577},
+									pos:  position{line: 1826, col: 23, offset: 71490},
 									name: "EOF",
 								},
 							},
@@ -12760,20 +13416,20 @@ var g = &grammar{
 		},
 		{
 			name: "TableCellSeparator",
-			pos:  position{line: 1765, col: 1, offset: 68696},
+			pos:  position{line: 1831, col: 1, offset: 71665},
 			expr: &seqExpr{
-				pos: position{line: 1765, col: 23, offset: 68718},
+				pos: position{line: 1831, col: 23, offset: 71687},
 				exprs: []interface{}{
 					&litMatcher{
-						pos:        position{line: 1765, col: 23, offset: 68718},
+						pos:        position{line: 1831, col: 23, offset: 71687},
 						val:        "|",
 						ignoreCase: false,
 						want:       "\"|\"",
 					},
 					&zeroOrMoreExpr{
-						pos: position{line: 1765, col: 27, offset: 68722},
+						pos: position{line: 1831, col: 27, offset: 71691},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1765, col: 27, offset: 68722},
+							pos:  position{line: 1831, col: 27, offset: 71691},
 							name: "Space",
 						},
 					},
@@ -12782,25 +13438,25 @@ var g = &grammar{
 		},
 		{
 			name: "TableDelimiter",
-			pos:  position{line: 1767, col: 1, offset: 68732},
+			pos:  position{line: 1833, col: 1, offset: 71699},
 			expr: &seqExpr{
-				pos: position{line: 1767, col: 19, offset: 68750},
+				pos: position{line: 1833, col: 19, offset: 71717},
 				exprs: []interface{}{
 					&litMatcher{
-						pos:        position{line: 1767, col: 19, offset: 68750},
+						pos:        position{line: 1833, col: 19, offset: 71717},
 						val:        "|===",
 						ignoreCase: false,
 						want:       "\"|===\"",
 					},
 					&zeroOrMoreExpr{
-						pos: position{line: 1767, col: 26, offset: 68757},
+						pos: position{line: 1833, col: 26, offset: 71724},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1767, col: 26, offset: 68757},
+							pos:  position{line: 1833, col: 26, offset: 71724},
 							name: "Space",
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1767, col: 33, offset: 68764},
+						pos:  position{line: 1833, col: 33, offset: 71731},
 						name: "EOL",
 					},
 				},
@@ -12808,37 +13464,37 @@ var g = &grammar{
 		},
 		{
 			name: "TableLineHeader",
-			pos:  position{line: 1770, col: 1, offset: 68835},
+			pos:  position{line: 1836, col: 1, offset: 71799},
 			expr: &actionExpr{
-				pos: position{line: 1770, col: 20, offset: 68854},
+				pos: position{line: 1836, col: 20, offset: 71818},
 				run: (*parser).callonTableLineHeader1,
 				expr: &seqExpr{
-					pos: position{line: 1770, col: 20, offset: 68854},
+					pos: position{line: 1836, col: 20, offset: 71818},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 1770, col: 20, offset: 68854},
+							pos: position{line: 1836, col: 20, offset: 71818},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1770, col: 21, offset: 68855},
+								pos:  position{line: 1836, col: 21, offset: 71819},
 								name: "TableDelimiter",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1770, col: 36, offset: 68870},
+							pos:   position{line: 1836, col: 36, offset: 71834},
 							label: "cells",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 1770, col: 42, offset: 68876},
+								pos: position{line: 1836, col: 42, offset: 71840},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1770, col: 43, offset: 68877},
+									pos:  position{line: 1836, col: 43, offset: 71841},
 									name: "TableCell",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1770, col: 55, offset: 68889},
+							pos:  position{line: 1836, col: 55, offset: 71853},
 							name: "EOL",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1770, col: 59, offset: 68893},
+							pos:  position{line: 1836, col: 59, offset: 71857},
 							name: "BlankLine",
 						},
 					},
@@ -12847,39 +13503,39 @@ var g = &grammar{
 		},
 		{
 			name: "TableLine",
-			pos:  position{line: 1774, col: 1, offset: 68965},
+			pos:  position{line: 1840, col: 1, offset: 71941},
 			expr: &actionExpr{
-				pos: position{line: 1774, col: 14, offset: 68978},
+				pos: position{line: 1840, col: 14, offset: 71954},
 				run: (*parser).callonTableLine1,
 				expr: &seqExpr{
-					pos: position{line: 1774, col: 14, offset: 68978},
+					pos: position{line: 1840, col: 14, offset: 71954},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 1774, col: 14, offset: 68978},
+							pos: position{line: 1840, col: 14, offset: 71954},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1774, col: 15, offset: 68979},
+								pos:  position{line: 1840, col: 15, offset: 71955},
 								name: "TableDelimiter",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1774, col: 30, offset: 68994},
+							pos:   position{line: 1840, col: 30, offset: 71970},
 							label: "cells",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 1774, col: 36, offset: 69000},
+								pos: position{line: 1840, col: 36, offset: 71976},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1774, col: 37, offset: 69001},
+									pos:  position{line: 1840, col: 37, offset: 71977},
 									name: "TableCell",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1774, col: 49, offset: 69013},
+							pos:  position{line: 1840, col: 49, offset: 71989},
 							name: "EOL",
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 1774, col: 53, offset: 69017},
+							pos: position{line: 1840, col: 53, offset: 71993},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1774, col: 53, offset: 69017},
+								pos:  position{line: 1840, col: 53, offset: 71993},
 								name: "BlankLine",
 							},
 						},
@@ -12889,54 +13545,54 @@ var g = &grammar{
 		},
 		{
 			name: "TableCell",
-			pos:  position{line: 1778, col: 1, offset: 69090},
+			pos:  position{line: 1844, col: 1, offset: 72078},
 			expr: &actionExpr{
-				pos: position{line: 1778, col: 14, offset: 69103},
+				pos: position{line: 1844, col: 14, offset: 72091},
 				run: (*parser).callonTableCell1,
 				expr: &seqExpr{
-					pos: position{line: 1778, col: 14, offset: 69103},
+					pos: position{line: 1844, col: 14, offset: 72091},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 1778, col: 14, offset: 69103},
+							pos:  position{line: 1844, col: 14, offset: 72091},
 							name: "TableCellSeparator",
 						},
 						&labeledExpr{
-							pos:   position{line: 1778, col: 33, offset: 69122},
+							pos:   position{line: 1844, col: 33, offset: 72110},
 							label: "elements",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 1778, col: 42, offset: 69131},
+								pos: position{line: 1844, col: 42, offset: 72119},
 								expr: &seqExpr{
-									pos: position{line: 1778, col: 43, offset: 69132},
+									pos: position{line: 1844, col: 43, offset: 72120},
 									exprs: []interface{}{
 										&notExpr{
-											pos: position{line: 1778, col: 43, offset: 69132},
+											pos: position{line: 1844, col: 43, offset: 72120},
 											expr: &ruleRefExpr{
-												pos:  position{line: 1778, col: 44, offset: 69133},
+												pos:  position{line: 1844, col: 44, offset: 72121},
 												name: "TableCellSeparator",
 											},
 										},
 										&notExpr{
-											pos: position{line: 1778, col: 63, offset: 69152},
+											pos: position{line: 1844, col: 63, offset: 72140},
 											expr: &ruleRefExpr{
-												pos:  position{line: 1778, col: 64, offset: 69153},
+												pos:  position{line: 1844, col: 64, offset: 72141},
 												name: "EOL",
 											},
 										},
 										&zeroOrMoreExpr{
-											pos: position{line: 1778, col: 68, offset: 69157},
+											pos: position{line: 1844, col: 68, offset: 72145},
 											expr: &ruleRefExpr{
-												pos:  position{line: 1778, col: 68, offset: 69157},
+												pos:  position{line: 1844, col: 68, offset: 72145},
 												name: "Space",
 											},
 										},
 										&ruleRefExpr{
-											pos:  position{line: 1778, col: 75, offset: 69164},
+											pos:  position{line: 1844, col: 75, offset: 72152},
 											name: "InlineElement",
 										},
 										&zeroOrMoreExpr{
-											pos: position{line: 1778, col: 89, offset: 69178},
+											pos: position{line: 1844, col: 89, offset: 72166},
 											expr: &ruleRefExpr{
-												pos:  position{line: 1778, col: 89, offset: 69178},
+												pos:  position{line: 1844, col: 89, offset: 72166},
 												name: "Space",
 											},
 										},
@@ -12950,25 +13606,25 @@ var g = &grammar{
 		},
 		{
 			name: "CommentBlockDelimiter",
-			pos:  position{line: 1785, col: 1, offset: 69434},
+			pos:  position{line: 1851, col: 1, offset: 72432},
 			expr: &seqExpr{
-				pos: position{line: 1785, col: 26, offset: 69459},
+				pos: position{line: 1851, col: 26, offset: 72457},
 				exprs: []interface{}{
 					&litMatcher{
-						pos:        position{line: 1785, col: 26, offset: 69459},
+						pos:        position{line: 1851, col: 26, offset: 72457},
 						val:        "////",
 						ignoreCase: false,
 						want:       "\"////\"",
 					},
 					&zeroOrMoreExpr{
-						pos: position{line: 1785, col: 33, offset: 69466},
+						pos: position{line: 1851, col: 33, offset: 72464},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1785, col: 33, offset: 69466},
+							pos:  position{line: 1851, col: 33, offset: 72464},
 							name: "Space",
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1785, col: 40, offset: 69473},
+						pos:  position{line: 1851, col: 40, offset: 72471},
 						name: "EOL",
 					},
 				},
@@ -12976,25 +13632,25 @@ var g = &grammar{
 		},
 		{
 			name: "CommentBlockStartDelimiter",
-			pos:  position{line: 1787, col: 1, offset: 69480},
+			pos:  position{line: 1853, col: 1, offset: 72476},
 			expr: &seqExpr{
-				pos: position{line: 1787, col: 31, offset: 69510},
+				pos: position{line: 1853, col: 31, offset: 72506},
 				exprs: []interface{}{
 					&litMatcher{
-						pos:        position{line: 1787, col: 31, offset: 69510},
+						pos:        position{line: 1853, col: 31, offset: 72506},
 						val:        "////",
 						ignoreCase: false,
 						want:       "\"////\"",
 					},
 					&zeroOrMoreExpr{
-						pos: position{line: 1787, col: 38, offset: 69517},
+						pos: position{line: 1853, col: 38, offset: 72513},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1787, col: 38, offset: 69517},
+							pos:  position{line: 1853, col: 38, offset: 72513},
 							name: "Space",
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1787, col: 45, offset: 69524},
+						pos:  position{line: 1853, col: 45, offset: 72520},
 						name: "EOL",
 					},
 				},
@@ -13002,34 +13658,34 @@ var g = &grammar{
 		},
 		{
 			name: "CommentBlockEndDelimiter",
-			pos:  position{line: 1789, col: 1, offset: 69531},
+			pos:  position{line: 1855, col: 1, offset: 72525},
 			expr: &choiceExpr{
-				pos: position{line: 1789, col: 29, offset: 69559},
+				pos: position{line: 1855, col: 29, offset: 72553},
 				alternatives: []interface{}{
 					&seqExpr{
-						pos: position{line: 1789, col: 30, offset: 69560},
+						pos: position{line: 1855, col: 30, offset: 72554},
 						exprs: []interface{}{
 							&litMatcher{
-								pos:        position{line: 1789, col: 30, offset: 69560},
+								pos:        position{line: 1855, col: 30, offset: 72554},
 								val:        "////",
 								ignoreCase: false,
 								want:       "\"////\"",
 							},
 							&zeroOrMoreExpr{
-								pos: position{line: 1789, col: 37, offset: 69567},
+								pos: position{line: 1855, col: 37, offset: 72561},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1789, col: 37, offset: 69567},
+									pos:  position{line: 1855, col: 37, offset: 72561},
 									name: "Space",
 								},
 							},
 							&ruleRefExpr{
-								pos:  position{line: 1789, col: 44, offset: 69574},
+								pos:  position{line: 1855, col: 44, offset: 72568},
 								name: "EOL",
 							},
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1789, col: 51, offset: 69581},
+						pos:  position{line: 1855, col: 51, offset: 72575},
 						name: "EOF",
 					},
 				},
@@ -13037,27 +13693,27 @@ var g = &grammar{
 		},
 		{
 			name: "CommentBlock",
-			pos:  position{line: 1791, col: 1, offset: 69588},
+			pos:  position{line: 1857, col: 1, offset: 72580},
 			expr: &actionExpr{
-				pos: position{line: 1791, col: 17, offset: 69604},
+				pos: position{line: 1857, col: 17, offset: 72596},
 				run: (*parser).callonCommentBlock1,
 				expr: &seqExpr{
-					pos: position{line: 1791, col: 17, offset: 69604},
+					pos: position{line: 1857, col: 17, offset: 72596},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 1791, col: 17, offset: 69604},
+							pos:  position{line: 1857, col: 17, offset: 72596},
 							name: "CommentBlockStartDelimiter",
 						},
 						&labeledExpr{
-							pos:   position{line: 1791, col: 44, offset: 69631},
+							pos:   position{line: 1857, col: 44, offset: 72623},
 							label: "content",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1791, col: 53, offset: 69640},
+								pos:  position{line: 1857, col: 53, offset: 72632},
 								name: "CommentBlockVerbatimContent",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1791, col: 83, offset: 69670},
+							pos:  position{line: 1857, col: 83, offset: 72662},
 							name: "CommentBlockEndDelimiter",
 						},
 					},
@@ -13066,27 +13722,27 @@ var g = &grammar{
 		},
 		{
 			name: "CommentBlockVerbatimContent",
-			pos:  position{line: 1795, col: 1, offset: 69784},
+			pos:  position{line: 1861, col: 1, offset: 72788},
 			expr: &zeroOrMoreExpr{
-				pos: position{line: 1795, col: 32, offset: 69815},
+				pos: position{line: 1861, col: 32, offset: 72819},
 				expr: &actionExpr{
-					pos: position{line: 1795, col: 33, offset: 69816},
+					pos: position{line: 1861, col: 33, offset: 72820},
 					run: (*parser).callonCommentBlockVerbatimContent2,
 					expr: &seqExpr{
-						pos: position{line: 1795, col: 33, offset: 69816},
+						pos: position{line: 1861, col: 33, offset: 72820},
 						exprs: []interface{}{
 							&notExpr{
-								pos: position{line: 1795, col: 33, offset: 69816},
+								pos: position{line: 1861, col: 33, offset: 72820},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1795, col: 34, offset: 69817},
+									pos:  position{line: 1861, col: 34, offset: 72821},
 									name: "CommentBlockEndDelimiter",
 								},
 							},
 							&labeledExpr{
-								pos:   position{line: 1795, col: 59, offset: 69842},
+								pos:   position{line: 1861, col: 59, offset: 72846},
 								label: "content",
 								expr: &ruleRefExpr{
-									pos:  position{line: 1795, col: 68, offset: 69851},
+									pos:  position{line: 1861, col: 68, offset: 72855},
 									name: "VerbatimContent",
 								},
 							},
@@ -13097,43 +13753,43 @@ var g = &grammar{
 		},
 		{
 			name: "SingleLineComment",
-			pos:  position{line: 1799, col: 1, offset: 69996},
+			pos:  position{line: 1865, col: 1, offset: 72996},
 			expr: &actionExpr{
-				pos: position{line: 1799, col: 22, offset: 70017},
+				pos: position{line: 1865, col: 22, offset: 73017},
 				run: (*parser).callonSingleLineComment1,
 				expr: &seqExpr{
-					pos: position{line: 1799, col: 22, offset: 70017},
+					pos: position{line: 1865, col: 22, offset: 73017},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 1799, col: 22, offset: 70017},
+							pos: position{line: 1865, col: 22, offset: 73017},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1799, col: 23, offset: 70018},
+								pos:  position{line: 1865, col: 23, offset: 73018},
 								name: "CommentBlockDelimiter",
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 1799, col: 45, offset: 70040},
+							pos: position{line: 1865, col: 45, offset: 73040},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1799, col: 45, offset: 70040},
+								pos:  position{line: 1865, col: 45, offset: 73040},
 								name: "Space",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1799, col: 52, offset: 70047},
+							pos:        position{line: 1865, col: 52, offset: 73047},
 							val:        "//",
 							ignoreCase: false,
 							want:       "\"//\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 1799, col: 57, offset: 70052},
+							pos:   position{line: 1865, col: 57, offset: 73052},
 							label: "content",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1799, col: 66, offset: 70061},
+								pos:  position{line: 1865, col: 66, offset: 73061},
 								name: "SingleLineCommentContent",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1799, col: 92, offset: 70087},
+							pos:  position{line: 1865, col: 92, offset: 73087},
 							name: "EOL",
 						},
 					},
@@ -13142,14 +13798,14 @@ var g = &grammar{
 		},
 		{
 			name: "SingleLineCommentContent",
-			pos:  position{line: 1803, col: 1, offset: 70156},
+			pos:  position{line: 1869, col: 1, offset: 73168},
 			expr: &actionExpr{
-				pos: position{line: 1803, col: 29, offset: 70184},
+				pos: position{line: 1869, col: 29, offset: 73196},
 				run: (*parser).callonSingleLineCommentContent1,
 				expr: &zeroOrMoreExpr{
-					pos: position{line: 1803, col: 29, offset: 70184},
+					pos: position{line: 1869, col: 29, offset: 73196},
 					expr: &charClassMatcher{
-						pos:        position{line: 1803, col: 29, offset: 70184},
+						pos:        position{line: 1869, col: 29, offset: 73196},
 						val:        "[^\\r\\n]",
 						chars:      []rune{'\r', '\n'},
 						ignoreCase: false,
@@ -13160,20 +13816,20 @@ var g = &grammar{
 		},
 		{
 			name: "LiteralBlock",
-			pos:  position{line: 1811, col: 1, offset: 70505},
+			pos:  position{line: 1877, col: 1, offset: 73509},
 			expr: &choiceExpr{
-				pos: position{line: 1811, col: 17, offset: 70521},
+				pos: position{line: 1877, col: 17, offset: 73525},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1811, col: 17, offset: 70521},
+						pos:  position{line: 1877, col: 17, offset: 73525},
 						name: "ParagraphWithLiteralAttribute",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1811, col: 49, offset: 70553},
+						pos:  position{line: 1877, col: 49, offset: 73557},
 						name: "ParagraphWithHeadingSpaces",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1811, col: 78, offset: 70582},
+						pos:  position{line: 1877, col: 78, offset: 73586},
 						name: "ParagraphWithLiteralBlockDelimiter",
 					},
 				},
@@ -13181,9 +13837,9 @@ var g = &grammar{
 		},
 		{
 			name: "LiteralBlockDelimiter",
-			pos:  position{line: 1813, col: 1, offset: 70620},
+			pos:  position{line: 1879, col: 1, offset: 73622},
 			expr: &litMatcher{
-				pos:        position{line: 1813, col: 26, offset: 70645},
+				pos:        position{line: 1879, col: 26, offset: 73647},
 				val:        "....",
 				ignoreCase: false,
 				want:       "\"....\"",
@@ -13191,29 +13847,29 @@ var g = &grammar{
 		},
 		{
 			name: "ParagraphWithHeadingSpaces",
-			pos:  position{line: 1816, col: 1, offset: 70720},
+			pos:  position{line: 1882, col: 1, offset: 73719},
 			expr: &actionExpr{
-				pos: position{line: 1816, col: 31, offset: 70750},
+				pos: position{line: 1882, col: 31, offset: 73749},
 				run: (*parser).callonParagraphWithHeadingSpaces1,
 				expr: &seqExpr{
-					pos: position{line: 1816, col: 31, offset: 70750},
+					pos: position{line: 1882, col: 31, offset: 73749},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1816, col: 31, offset: 70750},
+							pos:   position{line: 1882, col: 31, offset: 73749},
 							label: "attributes",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1816, col: 42, offset: 70761},
+								pos: position{line: 1882, col: 42, offset: 73760},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1816, col: 43, offset: 70762},
+									pos:  position{line: 1882, col: 43, offset: 73761},
 									name: "Attributes",
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1816, col: 56, offset: 70775},
+							pos:   position{line: 1882, col: 56, offset: 73774},
 							label: "lines",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1816, col: 63, offset: 70782},
+								pos:  position{line: 1882, col: 63, offset: 73781},
 								name: "ParagraphWithHeadingSpacesLines",
 							},
 						},
@@ -13223,33 +13879,33 @@ var g = &grammar{
 		},
 		{
 			name: "ParagraphWithHeadingSpacesLines",
-			pos:  position{line: 1821, col: 1, offset: 71017},
+			pos:  position{line: 1887, col: 1, offset: 74027},
 			expr: &actionExpr{
-				pos: position{line: 1822, col: 5, offset: 71058},
+				pos: position{line: 1888, col: 5, offset: 74067},
 				run: (*parser).callonParagraphWithHeadingSpacesLines1,
 				expr: &seqExpr{
-					pos: position{line: 1822, col: 5, offset: 71058},
+					pos: position{line: 1888, col: 5, offset: 74067},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1822, col: 5, offset: 71058},
+							pos:   position{line: 1888, col: 5, offset: 74067},
 							label: "firstLine",
 							expr: &actionExpr{
-								pos: position{line: 1822, col: 16, offset: 71069},
+								pos: position{line: 1888, col: 16, offset: 74078},
 								run: (*parser).callonParagraphWithHeadingSpacesLines4,
 								expr: &seqExpr{
-									pos: position{line: 1822, col: 16, offset: 71069},
+									pos: position{line: 1888, col: 16, offset: 74078},
 									exprs: []interface{}{
 										&oneOrMoreExpr{
-											pos: position{line: 1822, col: 16, offset: 71069},
+											pos: position{line: 1888, col: 16, offset: 74078},
 											expr: &ruleRefExpr{
-												pos:  position{line: 1822, col: 16, offset: 71069},
+												pos:  position{line: 1888, col: 16, offset: 74078},
 												name: "Space",
 											},
 										},
 										&oneOrMoreExpr{
-											pos: position{line: 1822, col: 23, offset: 71076},
+											pos: position{line: 1888, col: 23, offset: 74085},
 											expr: &charClassMatcher{
-												pos:        position{line: 1822, col: 23, offset: 71076},
+												pos:        position{line: 1888, col: 23, offset: 74085},
 												val:        "[^\\r\\n]",
 												chars:      []rune{'\r', '\n'},
 												ignoreCase: false,
@@ -13261,37 +13917,37 @@ var g = &grammar{
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1824, col: 8, offset: 71131},
+							pos:  position{line: 1890, col: 8, offset: 74138},
 							name: "EOL",
 						},
 						&labeledExpr{
-							pos:   position{line: 1825, col: 5, offset: 71195},
+							pos:   position{line: 1891, col: 5, offset: 74201},
 							label: "otherLines",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 1825, col: 16, offset: 71206},
+								pos: position{line: 1891, col: 16, offset: 74212},
 								expr: &actionExpr{
-									pos: position{line: 1826, col: 9, offset: 71217},
+									pos: position{line: 1892, col: 9, offset: 74222},
 									run: (*parser).callonParagraphWithHeadingSpacesLines13,
 									expr: &seqExpr{
-										pos: position{line: 1826, col: 9, offset: 71217},
+										pos: position{line: 1892, col: 9, offset: 74222},
 										exprs: []interface{}{
 											&notExpr{
-												pos: position{line: 1826, col: 9, offset: 71217},
+												pos: position{line: 1892, col: 9, offset: 74222},
 												expr: &ruleRefExpr{
-													pos:  position{line: 1826, col: 10, offset: 71218},
+													pos:  position{line: 1892, col: 10, offset: 74223},
 													name: "BlankLine",
 												},
 											},
 											&labeledExpr{
-												pos:   position{line: 1827, col: 9, offset: 71238},
+												pos:   position{line: 1893, col: 9, offset: 74242},
 												label: "otherLine",
 												expr: &actionExpr{
-													pos: position{line: 1827, col: 20, offset: 71249},
+													pos: position{line: 1893, col: 20, offset: 74253},
 													run: (*parser).callonParagraphWithHeadingSpacesLines18,
 													expr: &oneOrMoreExpr{
-														pos: position{line: 1827, col: 20, offset: 71249},
+														pos: position{line: 1893, col: 20, offset: 74253},
 														expr: &charClassMatcher{
-															pos:        position{line: 1827, col: 20, offset: 71249},
+															pos:        position{line: 1893, col: 20, offset: 74253},
 															val:        "[^\\r\\n]",
 															chars:      []rune{'\r', '\n'},
 															ignoreCase: false,
@@ -13301,7 +13957,7 @@ var g = &grammar{
 												},
 											},
 											&ruleRefExpr{
-												pos:  position{line: 1829, col: 12, offset: 71312},
+												pos:  position{line: 1895, col: 12, offset: 74314},
 												name: "EOL",
 											},
 										},
@@ -13315,72 +13971,72 @@ var g = &grammar{
 		},
 		{
 			name: "ParagraphWithLiteralBlockDelimiter",
-			pos:  position{line: 1836, col: 1, offset: 71549},
+			pos:  position{line: 1902, col: 1, offset: 74544},
 			expr: &actionExpr{
-				pos: position{line: 1836, col: 39, offset: 71587},
+				pos: position{line: 1902, col: 39, offset: 74582},
 				run: (*parser).callonParagraphWithLiteralBlockDelimiter1,
 				expr: &seqExpr{
-					pos: position{line: 1836, col: 39, offset: 71587},
+					pos: position{line: 1902, col: 39, offset: 74582},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1836, col: 39, offset: 71587},
+							pos:   position{line: 1902, col: 39, offset: 74582},
 							label: "attributes",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1836, col: 50, offset: 71598},
+								pos: position{line: 1902, col: 50, offset: 74593},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1836, col: 51, offset: 71599},
+									pos:  position{line: 1902, col: 51, offset: 74594},
 									name: "Attributes",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1837, col: 9, offset: 71621},
+							pos:  position{line: 1903, col: 9, offset: 74615},
 							name: "LiteralBlockDelimiter",
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 1837, col: 31, offset: 71643},
+							pos: position{line: 1903, col: 31, offset: 74637},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1837, col: 31, offset: 71643},
+								pos:  position{line: 1903, col: 31, offset: 74637},
 								name: "Space",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1837, col: 38, offset: 71650},
+							pos:  position{line: 1903, col: 38, offset: 74644},
 							name: "Newline",
 						},
 						&labeledExpr{
-							pos:   position{line: 1837, col: 46, offset: 71658},
+							pos:   position{line: 1903, col: 46, offset: 74652},
 							label: "lines",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1837, col: 53, offset: 71665},
+								pos:  position{line: 1903, col: 53, offset: 74659},
 								name: "ParagraphWithLiteralBlockDelimiterLines",
 							},
 						},
 						&choiceExpr{
-							pos: position{line: 1837, col: 95, offset: 71707},
+							pos: position{line: 1903, col: 95, offset: 74701},
 							alternatives: []interface{}{
 								&seqExpr{
-									pos: position{line: 1837, col: 96, offset: 71708},
+									pos: position{line: 1903, col: 96, offset: 74702},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 1837, col: 96, offset: 71708},
+											pos:  position{line: 1903, col: 96, offset: 74702},
 											name: "LiteralBlockDelimiter",
 										},
 										&zeroOrMoreExpr{
-											pos: position{line: 1837, col: 118, offset: 71730},
+											pos: position{line: 1903, col: 118, offset: 74724},
 											expr: &ruleRefExpr{
-												pos:  position{line: 1837, col: 118, offset: 71730},
+												pos:  position{line: 1903, col: 118, offset: 74724},
 												name: "Space",
 											},
 										},
 										&ruleRefExpr{
-											pos:  position{line: 1837, col: 125, offset: 71737},
+											pos:  position{line: 1903, col: 125, offset: 74731},
 											name: "EOL",
 										},
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1837, col: 132, offset: 71744},
+									pos:  position{line: 1903, col: 132, offset: 74738},
 									name: "EOF",
 								},
 							},
@@ -13391,17 +14047,17 @@ var g = &grammar{
 		},
 		{
 			name: "ParagraphWithLiteralBlockDelimiterLines",
-			pos:  position{line: 1842, col: 1, offset: 71908},
+			pos:  position{line: 1908, col: 1, offset: 74913},
 			expr: &actionExpr{
-				pos: position{line: 1842, col: 44, offset: 71951},
+				pos: position{line: 1908, col: 44, offset: 74956},
 				run: (*parser).callonParagraphWithLiteralBlockDelimiterLines1,
 				expr: &labeledExpr{
-					pos:   position{line: 1842, col: 44, offset: 71951},
+					pos:   position{line: 1908, col: 44, offset: 74956},
 					label: "lines",
 					expr: &zeroOrMoreExpr{
-						pos: position{line: 1842, col: 50, offset: 71957},
+						pos: position{line: 1908, col: 50, offset: 74962},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1842, col: 51, offset: 71958},
+							pos:  position{line: 1908, col: 51, offset: 74963},
 							name: "ParagraphWithLiteralBlockDelimiterLine",
 						},
 					},
@@ -13410,33 +14066,33 @@ var g = &grammar{
 		},
 		{
 			name: "ParagraphWithLiteralBlockDelimiterLine",
-			pos:  position{line: 1846, col: 1, offset: 72046},
+			pos:  position{line: 1912, col: 1, offset: 75047},
 			expr: &actionExpr{
-				pos: position{line: 1847, col: 5, offset: 72102},
+				pos: position{line: 1913, col: 5, offset: 75102},
 				run: (*parser).callonParagraphWithLiteralBlockDelimiterLine1,
 				expr: &seqExpr{
-					pos: position{line: 1847, col: 5, offset: 72102},
+					pos: position{line: 1913, col: 5, offset: 75102},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1847, col: 5, offset: 72102},
+							pos:   position{line: 1913, col: 5, offset: 75102},
 							label: "line",
 							expr: &actionExpr{
-								pos: position{line: 1847, col: 11, offset: 72108},
+								pos: position{line: 1913, col: 11, offset: 75108},
 								run: (*parser).callonParagraphWithLiteralBlockDelimiterLine4,
 								expr: &seqExpr{
-									pos: position{line: 1847, col: 11, offset: 72108},
+									pos: position{line: 1913, col: 11, offset: 75108},
 									exprs: []interface{}{
 										&notExpr{
-											pos: position{line: 1847, col: 11, offset: 72108},
+											pos: position{line: 1913, col: 11, offset: 75108},
 											expr: &ruleRefExpr{
-												pos:  position{line: 1847, col: 12, offset: 72109},
+												pos:  position{line: 1913, col: 12, offset: 75109},
 												name: "LiteralBlockDelimiter",
 											},
 										},
 										&zeroOrMoreExpr{
-											pos: position{line: 1847, col: 34, offset: 72131},
+											pos: position{line: 1913, col: 34, offset: 75131},
 											expr: &charClassMatcher{
-												pos:        position{line: 1847, col: 34, offset: 72131},
+												pos:        position{line: 1913, col: 34, offset: 75131},
 												val:        "[^\\r\\n]",
 												chars:      []rune{'\r', '\n'},
 												ignoreCase: false,
@@ -13448,7 +14104,7 @@ var g = &grammar{
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1849, col: 8, offset: 72186},
+							pos:  position{line: 1915, col: 8, offset: 75184},
 							name: "EOL",
 						},
 					},
@@ -13457,33 +14113,33 @@ var g = &grammar{
 		},
 		{
 			name: "ParagraphWithLiteralAttribute",
-			pos:  position{line: 1854, col: 1, offset: 72317},
+			pos:  position{line: 1920, col: 1, offset: 75310},
 			expr: &actionExpr{
-				pos: position{line: 1855, col: 5, offset: 72356},
+				pos: position{line: 1921, col: 5, offset: 75348},
 				run: (*parser).callonParagraphWithLiteralAttribute1,
 				expr: &seqExpr{
-					pos: position{line: 1855, col: 5, offset: 72356},
+					pos: position{line: 1921, col: 5, offset: 75348},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1855, col: 5, offset: 72356},
+							pos:   position{line: 1921, col: 5, offset: 75348},
 							label: "attributes",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1855, col: 16, offset: 72367},
+								pos: position{line: 1921, col: 16, offset: 75359},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1855, col: 17, offset: 72368},
+									pos:  position{line: 1921, col: 17, offset: 75360},
 									name: "Attributes",
 								},
 							},
 						},
 						&andCodeExpr{
-							pos: position{line: 1856, col: 5, offset: 72386},
+							pos: position{line: 1922, col: 5, offset: 75377},
 							run: (*parser).callonParagraphWithLiteralAttribute6,
 						},
 						&labeledExpr{
-							pos:   position{line: 1863, col: 5, offset: 72600},
+							pos:   position{line: 1929, col: 5, offset: 75584},
 							label: "lines",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1863, col: 12, offset: 72607},
+								pos:  position{line: 1929, col: 12, offset: 75591},
 								name: "ParagraphWithLiteralAttributeLines",
 							},
 						},
@@ -13493,12 +14149,12 @@ var g = &grammar{
 		},
 		{
 			name: "LiteralKind",
-			pos:  position{line: 1867, col: 1, offset: 72761},
+			pos:  position{line: 1933, col: 1, offset: 75757},
 			expr: &actionExpr{
-				pos: position{line: 1867, col: 16, offset: 72776},
+				pos: position{line: 1933, col: 16, offset: 75772},
 				run: (*parser).callonLiteralKind1,
 				expr: &litMatcher{
-					pos:        position{line: 1867, col: 16, offset: 72776},
+					pos:        position{line: 1933, col: 16, offset: 75772},
 					val:        "literal",
 					ignoreCase: false,
 					want:       "\"literal\"",
@@ -13507,17 +14163,17 @@ var g = &grammar{
 		},
 		{
 			name: "ParagraphWithLiteralAttributeLines",
-			pos:  position{line: 1872, col: 1, offset: 72864},
+			pos:  position{line: 1938, col: 1, offset: 75855},
 			expr: &actionExpr{
-				pos: position{line: 1872, col: 39, offset: 72902},
+				pos: position{line: 1938, col: 39, offset: 75893},
 				run: (*parser).callonParagraphWithLiteralAttributeLines1,
 				expr: &labeledExpr{
-					pos:   position{line: 1872, col: 39, offset: 72902},
+					pos:   position{line: 1938, col: 39, offset: 75893},
 					label: "lines",
 					expr: &oneOrMoreExpr{
-						pos: position{line: 1872, col: 45, offset: 72908},
+						pos: position{line: 1938, col: 45, offset: 75899},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1872, col: 46, offset: 72909},
+							pos:  position{line: 1938, col: 46, offset: 75900},
 							name: "ParagraphWithLiteralAttributeLine",
 						},
 					},
@@ -13526,30 +14182,30 @@ var g = &grammar{
 		},
 		{
 			name: "ParagraphWithLiteralAttributeLine",
-			pos:  position{line: 1876, col: 1, offset: 72993},
+			pos:  position{line: 1942, col: 1, offset: 75980},
 			expr: &actionExpr{
-				pos: position{line: 1876, col: 38, offset: 73030},
+				pos: position{line: 1942, col: 38, offset: 76017},
 				run: (*parser).callonParagraphWithLiteralAttributeLine1,
 				expr: &seqExpr{
-					pos: position{line: 1876, col: 38, offset: 73030},
+					pos: position{line: 1942, col: 38, offset: 76017},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 1876, col: 38, offset: 73030},
+							pos: position{line: 1942, col: 38, offset: 76017},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1876, col: 39, offset: 73031},
+								pos:  position{line: 1942, col: 39, offset: 76018},
 								name: "BlankLine",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1876, col: 49, offset: 73041},
+							pos:   position{line: 1942, col: 49, offset: 76028},
 							label: "content",
 							expr: &actionExpr{
-								pos: position{line: 1876, col: 58, offset: 73050},
+								pos: position{line: 1942, col: 58, offset: 76037},
 								run: (*parser).callonParagraphWithLiteralAttributeLine6,
 								expr: &oneOrMoreExpr{
-									pos: position{line: 1876, col: 58, offset: 73050},
+									pos: position{line: 1942, col: 58, offset: 76037},
 									expr: &charClassMatcher{
-										pos:        position{line: 1876, col: 58, offset: 73050},
+										pos:        position{line: 1942, col: 58, offset: 76037},
 										val:        "[^\\r\\n]",
 										chars:      []rune{'\r', '\n'},
 										ignoreCase: false,
@@ -13559,7 +14215,7 @@ var g = &grammar{
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1878, col: 4, offset: 73097},
+							pos:  position{line: 1944, col: 4, offset: 76082},
 							name: "EOL",
 						},
 					},
@@ -13568,29 +14224,29 @@ var g = &grammar{
 		},
 		{
 			name: "IndexTerm",
-			pos:  position{line: 1885, col: 1, offset: 73290},
+			pos:  position{line: 1951, col: 1, offset: 76268},
 			expr: &actionExpr{
-				pos: position{line: 1885, col: 14, offset: 73303},
+				pos: position{line: 1951, col: 14, offset: 76281},
 				run: (*parser).callonIndexTerm1,
 				expr: &seqExpr{
-					pos: position{line: 1885, col: 14, offset: 73303},
+					pos: position{line: 1951, col: 14, offset: 76281},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 1885, col: 14, offset: 73303},
+							pos:        position{line: 1951, col: 14, offset: 76281},
 							val:        "((",
 							ignoreCase: false,
 							want:       "\"((\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 1885, col: 19, offset: 73308},
+							pos:   position{line: 1951, col: 19, offset: 76286},
 							label: "term",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1885, col: 25, offset: 73314},
+								pos:  position{line: 1951, col: 25, offset: 76292},
 								name: "IndexTermContent",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1885, col: 43, offset: 73332},
+							pos:        position{line: 1951, col: 43, offset: 76310},
 							val:        "))",
 							ignoreCase: false,
 							want:       "\"))\"",
@@ -13601,51 +14257,51 @@ var g = &grammar{
 		},
 		{
 			name: "IndexTermContent",
-			pos:  position{line: 1889, col: 1, offset: 73401},
+			pos:  position{line: 1955, col: 1, offset: 76391},
 			expr: &actionExpr{
-				pos: position{line: 1889, col: 21, offset: 73421},
+				pos: position{line: 1955, col: 21, offset: 76411},
 				run: (*parser).callonIndexTermContent1,
 				expr: &labeledExpr{
-					pos:   position{line: 1889, col: 21, offset: 73421},
+					pos:   position{line: 1955, col: 21, offset: 76411},
 					label: "elements",
 					expr: &oneOrMoreExpr{
-						pos: position{line: 1889, col: 30, offset: 73430},
+						pos: position{line: 1955, col: 30, offset: 76420},
 						expr: &choiceExpr{
-							pos: position{line: 1889, col: 31, offset: 73431},
+							pos: position{line: 1955, col: 31, offset: 76421},
 							alternatives: []interface{}{
 								&ruleRefExpr{
-									pos:  position{line: 1889, col: 31, offset: 73431},
+									pos:  position{line: 1955, col: 31, offset: 76421},
 									name: "Word",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1889, col: 38, offset: 73438},
+									pos:  position{line: 1955, col: 38, offset: 76428},
 									name: "QuotedText",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1889, col: 51, offset: 73451},
+									pos:  position{line: 1955, col: 51, offset: 76441},
 									name: "QuotedString",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1889, col: 66, offset: 73466},
+									pos:  position{line: 1955, col: 66, offset: 76456},
 									name: "Space",
 								},
 								&actionExpr{
-									pos: position{line: 1889, col: 74, offset: 73474},
+									pos: position{line: 1955, col: 74, offset: 76464},
 									run: (*parser).callonIndexTermContent9,
 									expr: &seqExpr{
-										pos: position{line: 1889, col: 75, offset: 73475},
+										pos: position{line: 1955, col: 75, offset: 76465},
 										exprs: []interface{}{
 											&notExpr{
-												pos: position{line: 1889, col: 75, offset: 73475},
+												pos: position{line: 1955, col: 75, offset: 76465},
 												expr: &litMatcher{
-													pos:        position{line: 1889, col: 76, offset: 73476},
+													pos:        position{line: 1955, col: 76, offset: 76466},
 													val:        "))",
 													ignoreCase: false,
 													want:       "\"))\"",
 												},
 											},
 											&anyMatcher{
-												line: 1889, col: 81, offset: 73481,
+												line: 1955, col: 81, offset: 76471,
 											},
 										},
 									},
@@ -13658,63 +14314,63 @@ var g = &grammar{
 		},
 		{
 			name: "ConcealedIndexTerm",
-			pos:  position{line: 1895, col: 1, offset: 73593},
+			pos:  position{line: 1961, col: 1, offset: 76594},
 			expr: &actionExpr{
-				pos: position{line: 1895, col: 23, offset: 73615},
+				pos: position{line: 1961, col: 23, offset: 76616},
 				run: (*parser).callonConcealedIndexTerm1,
 				expr: &seqExpr{
-					pos: position{line: 1895, col: 23, offset: 73615},
+					pos: position{line: 1961, col: 23, offset: 76616},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 1895, col: 23, offset: 73615},
+							pos:        position{line: 1961, col: 23, offset: 76616},
 							val:        "(((",
 							ignoreCase: false,
 							want:       "\"(((\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 1895, col: 29, offset: 73621},
+							pos:   position{line: 1961, col: 29, offset: 76622},
 							label: "term1",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1895, col: 36, offset: 73628},
+								pos:  position{line: 1961, col: 36, offset: 76629},
 								name: "ConcealedIndexTermContent",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1896, col: 5, offset: 73661},
+							pos:   position{line: 1962, col: 5, offset: 76661},
 							label: "term2",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1896, col: 11, offset: 73667},
+								pos: position{line: 1962, col: 11, offset: 76667},
 								expr: &actionExpr{
-									pos: position{line: 1896, col: 12, offset: 73668},
+									pos: position{line: 1962, col: 12, offset: 76668},
 									run: (*parser).callonConcealedIndexTerm8,
 									expr: &seqExpr{
-										pos: position{line: 1896, col: 12, offset: 73668},
+										pos: position{line: 1962, col: 12, offset: 76668},
 										exprs: []interface{}{
 											&zeroOrMoreExpr{
-												pos: position{line: 1896, col: 12, offset: 73668},
+												pos: position{line: 1962, col: 12, offset: 76668},
 												expr: &ruleRefExpr{
-													pos:  position{line: 1896, col: 12, offset: 73668},
+													pos:  position{line: 1962, col: 12, offset: 76668},
 													name: "Space",
 												},
 											},
 											&litMatcher{
-												pos:        position{line: 1896, col: 19, offset: 73675},
+												pos:        position{line: 1962, col: 19, offset: 76675},
 												val:        ",",
 												ignoreCase: false,
 												want:       "\",\"",
 											},
 											&zeroOrMoreExpr{
-												pos: position{line: 1896, col: 23, offset: 73679},
+												pos: position{line: 1962, col: 23, offset: 76679},
 												expr: &ruleRefExpr{
-													pos:  position{line: 1896, col: 23, offset: 73679},
+													pos:  position{line: 1962, col: 23, offset: 76679},
 													name: "Space",
 												},
 											},
 											&labeledExpr{
-												pos:   position{line: 1896, col: 30, offset: 73686},
+												pos:   position{line: 1962, col: 30, offset: 76686},
 												label: "content",
 												expr: &ruleRefExpr{
-													pos:  position{line: 1896, col: 39, offset: 73695},
+													pos:  position{line: 1962, col: 39, offset: 76695},
 													name: "ConcealedIndexTermContent",
 												},
 											},
@@ -13724,41 +14380,41 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1897, col: 5, offset: 73754},
+							pos:   position{line: 1963, col: 5, offset: 76753},
 							label: "term3",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1897, col: 11, offset: 73760},
+								pos: position{line: 1963, col: 11, offset: 76759},
 								expr: &actionExpr{
-									pos: position{line: 1897, col: 12, offset: 73761},
+									pos: position{line: 1963, col: 12, offset: 76760},
 									run: (*parser).callonConcealedIndexTerm19,
 									expr: &seqExpr{
-										pos: position{line: 1897, col: 12, offset: 73761},
+										pos: position{line: 1963, col: 12, offset: 76760},
 										exprs: []interface{}{
 											&zeroOrMoreExpr{
-												pos: position{line: 1897, col: 12, offset: 73761},
+												pos: position{line: 1963, col: 12, offset: 76760},
 												expr: &ruleRefExpr{
-													pos:  position{line: 1897, col: 12, offset: 73761},
+													pos:  position{line: 1963, col: 12, offset: 76760},
 													name: "Space",
 												},
 											},
 											&litMatcher{
-												pos:        position{line: 1897, col: 19, offset: 73768},
+												pos:        position{line: 1963, col: 19, offset: 76767},
 												val:        ",",
 												ignoreCase: false,
 												want:       "\",\"",
 											},
 											&zeroOrMoreExpr{
-												pos: position{line: 1897, col: 23, offset: 73772},
+												pos: position{line: 1963, col: 23, offset: 76771},
 												expr: &ruleRefExpr{
-													pos:  position{line: 1897, col: 23, offset: 73772},
+													pos:  position{line: 1963, col: 23, offset: 76771},
 													name: "Space",
 												},
 											},
 											&labeledExpr{
-												pos:   position{line: 1897, col: 30, offset: 73779},
+												pos:   position{line: 1963, col: 30, offset: 76778},
 												label: "content",
 												expr: &ruleRefExpr{
-													pos:  position{line: 1897, col: 39, offset: 73788},
+													pos:  position{line: 1963, col: 39, offset: 76787},
 													name: "ConcealedIndexTermContent",
 												},
 											},
@@ -13768,7 +14424,7 @@ var g = &grammar{
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1898, col: 5, offset: 73847},
+							pos:        position{line: 1964, col: 5, offset: 76845},
 							val:        ")))",
 							ignoreCase: false,
 							want:       "\")))\"",
@@ -13779,21 +14435,21 @@ var g = &grammar{
 		},
 		{
 			name: "ConcealedIndexTermContent",
-			pos:  position{line: 1902, col: 1, offset: 73930},
+			pos:  position{line: 1968, col: 1, offset: 76940},
 			expr: &actionExpr{
-				pos: position{line: 1902, col: 30, offset: 73959},
+				pos: position{line: 1968, col: 30, offset: 76969},
 				run: (*parser).callonConcealedIndexTermContent1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 1902, col: 30, offset: 73959},
+					pos: position{line: 1968, col: 30, offset: 76969},
 					expr: &choiceExpr{
-						pos: position{line: 1902, col: 31, offset: 73960},
+						pos: position{line: 1968, col: 31, offset: 76970},
 						alternatives: []interface{}{
 							&ruleRefExpr{
-								pos:  position{line: 1902, col: 31, offset: 73960},
+								pos:  position{line: 1968, col: 31, offset: 76970},
 								name: "Alphanum",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 1902, col: 42, offset: 73971},
+								pos:  position{line: 1968, col: 42, offset: 76981},
 								name: "Space",
 							},
 						},
@@ -13803,29 +14459,29 @@ var g = &grammar{
 		},
 		{
 			name: "BlankLine",
-			pos:  position{line: 1909, col: 1, offset: 74127},
+			pos:  position{line: 1975, col: 1, offset: 77130},
 			expr: &actionExpr{
-				pos: position{line: 1909, col: 14, offset: 74140},
+				pos: position{line: 1975, col: 14, offset: 77143},
 				run: (*parser).callonBlankLine1,
 				expr: &seqExpr{
-					pos: position{line: 1909, col: 14, offset: 74140},
+					pos: position{line: 1975, col: 14, offset: 77143},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 1909, col: 14, offset: 74140},
+							pos: position{line: 1975, col: 14, offset: 77143},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1909, col: 15, offset: 74141},
+								pos:  position{line: 1975, col: 15, offset: 77144},
 								name: "EOF",
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 1909, col: 19, offset: 74145},
+							pos: position{line: 1975, col: 19, offset: 77148},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1909, col: 19, offset: 74145},
+								pos:  position{line: 1975, col: 19, offset: 77148},
 								name: "Space",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1909, col: 26, offset: 74152},
+							pos:  position{line: 1975, col: 26, offset: 77155},
 							name: "EOL",
 						},
 					},
@@ -13834,9 +14490,9 @@ var g = &grammar{
 		},
 		{
 			name: "Alphanum",
-			pos:  position{line: 1916, col: 1, offset: 74306},
+			pos:  position{line: 1982, col: 1, offset: 77318},
 			expr: &charClassMatcher{
-				pos:        position{line: 1916, col: 13, offset: 74318},
+				pos:        position{line: 1982, col: 13, offset: 77330},
 				val:        "[\\pL0-9]",
 				ranges:     []rune{'0', '9'},
 				classes:    []*unicode.RangeTable{rangeTable("L")},
@@ -13846,42 +14502,42 @@ var g = &grammar{
 		},
 		{
 			name: "Parenthesis",
-			pos:  position{line: 1918, col: 1, offset: 74330},
+			pos:  position{line: 1984, col: 1, offset: 77340},
 			expr: &choiceExpr{
-				pos: position{line: 1918, col: 16, offset: 74345},
+				pos: position{line: 1984, col: 16, offset: 77355},
 				alternatives: []interface{}{
 					&litMatcher{
-						pos:        position{line: 1918, col: 16, offset: 74345},
+						pos:        position{line: 1984, col: 16, offset: 77355},
 						val:        "(",
 						ignoreCase: false,
 						want:       "\"(\"",
 					},
 					&litMatcher{
-						pos:        position{line: 1918, col: 22, offset: 74351},
+						pos:        position{line: 1984, col: 22, offset: 77361},
 						val:        ")",
 						ignoreCase: false,
 						want:       "\")\"",
 					},
 					&litMatcher{
-						pos:        position{line: 1918, col: 28, offset: 74357},
+						pos:        position{line: 1984, col: 28, offset: 77367},
 						val:        "[",
 						ignoreCase: false,
 						want:       "\"[\"",
 					},
 					&litMatcher{
-						pos:        position{line: 1918, col: 34, offset: 74363},
+						pos:        position{line: 1984, col: 34, offset: 77373},
 						val:        "]",
 						ignoreCase: false,
 						want:       "\"]\"",
 					},
 					&litMatcher{
-						pos:        position{line: 1918, col: 40, offset: 74369},
+						pos:        position{line: 1984, col: 40, offset: 77379},
 						val:        "{",
 						ignoreCase: false,
 						want:       "\"{\"",
 					},
 					&litMatcher{
-						pos:        position{line: 1918, col: 46, offset: 74375},
+						pos:        position{line: 1984, col: 46, offset: 77385},
 						val:        "}",
 						ignoreCase: false,
 						want:       "\"}\"",
@@ -13891,14 +14547,14 @@ var g = &grammar{
 		},
 		{
 			name: "Alphanums",
-			pos:  position{line: 1920, col: 1, offset: 74383},
+			pos:  position{line: 1986, col: 1, offset: 77391},
 			expr: &actionExpr{
-				pos: position{line: 1920, col: 14, offset: 74396},
+				pos: position{line: 1986, col: 14, offset: 77404},
 				run: (*parser).callonAlphanums1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 1920, col: 14, offset: 74396},
+					pos: position{line: 1986, col: 14, offset: 77404},
 					expr: &charClassMatcher{
-						pos:        position{line: 1920, col: 14, offset: 74396},
+						pos:        position{line: 1986, col: 14, offset: 77404},
 						val:        "[\\pL0-9]",
 						ranges:     []rune{'0', '9'},
 						classes:    []*unicode.RangeTable{rangeTable("L")},
@@ -13910,20 +14566,20 @@ var g = &grammar{
 		},
 		{
 			name: "Word",
-			pos:  position{line: 1924, col: 1, offset: 74446},
+			pos:  position{line: 1990, col: 1, offset: 77450},
 			expr: &choiceExpr{
-				pos: position{line: 1928, col: 5, offset: 74777},
+				pos: position{line: 1994, col: 5, offset: 77777},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 1928, col: 5, offset: 74777},
+						pos: position{line: 1994, col: 5, offset: 77777},
 						run: (*parser).callonWord2,
 						expr: &seqExpr{
-							pos: position{line: 1928, col: 5, offset: 74777},
+							pos: position{line: 1994, col: 5, offset: 77777},
 							exprs: []interface{}{
 								&oneOrMoreExpr{
-									pos: position{line: 1928, col: 5, offset: 74777},
+									pos: position{line: 1994, col: 5, offset: 77777},
 									expr: &charClassMatcher{
-										pos:        position{line: 1928, col: 5, offset: 74777},
+										pos:        position{line: 1994, col: 5, offset: 77777},
 										val:        "[\\pL0-9]",
 										ranges:     []rune{'0', '9'},
 										classes:    []*unicode.RangeTable{rangeTable("L")},
@@ -13932,19 +14588,19 @@ var g = &grammar{
 									},
 								},
 								&andExpr{
-									pos: position{line: 1928, col: 15, offset: 74787},
+									pos: position{line: 1994, col: 15, offset: 77787},
 									expr: &choiceExpr{
-										pos: position{line: 1928, col: 17, offset: 74789},
+										pos: position{line: 1994, col: 17, offset: 77789},
 										alternatives: []interface{}{
 											&charClassMatcher{
-												pos:        position{line: 1928, col: 17, offset: 74789},
+												pos:        position{line: 1994, col: 17, offset: 77789},
 												val:        "[\\r\\n ,\\]]",
 												chars:      []rune{'\r', '\n', ' ', ',', ']'},
 												ignoreCase: false,
 												inverted:   false,
 											},
 											&ruleRefExpr{
-												pos:  position{line: 1928, col: 30, offset: 74802},
+												pos:  position{line: 1994, col: 30, offset: 77802},
 												name: "EOF",
 											},
 										},
@@ -13954,15 +14610,15 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1930, col: 9, offset: 74874},
+						pos: position{line: 1996, col: 9, offset: 77888},
 						run: (*parser).callonWord10,
 						expr: &seqExpr{
-							pos: position{line: 1930, col: 9, offset: 74874},
+							pos: position{line: 1996, col: 9, offset: 77888},
 							exprs: []interface{}{
 								&oneOrMoreExpr{
-									pos: position{line: 1930, col: 9, offset: 74874},
+									pos: position{line: 1996, col: 9, offset: 77888},
 									expr: &charClassMatcher{
-										pos:        position{line: 1930, col: 9, offset: 74874},
+										pos:        position{line: 1996, col: 9, offset: 77888},
 										val:        "[\\pL0-9]",
 										ranges:     []rune{'0', '9'},
 										classes:    []*unicode.RangeTable{rangeTable("L")},
@@ -13971,21 +14627,21 @@ var g = &grammar{
 									},
 								},
 								&oneOrMoreExpr{
-									pos: position{line: 1930, col: 19, offset: 74884},
+									pos: position{line: 1996, col: 19, offset: 77898},
 									expr: &seqExpr{
-										pos: position{line: 1930, col: 20, offset: 74885},
+										pos: position{line: 1996, col: 20, offset: 77899},
 										exprs: []interface{}{
 											&charClassMatcher{
-												pos:        position{line: 1930, col: 20, offset: 74885},
+												pos:        position{line: 1996, col: 20, offset: 77899},
 												val:        "[=*_`]",
 												chars:      []rune{'=', '*', '_', '`'},
 												ignoreCase: false,
 												inverted:   false,
 											},
 											&oneOrMoreExpr{
-												pos: position{line: 1930, col: 27, offset: 74892},
+												pos: position{line: 1996, col: 27, offset: 77906},
 												expr: &charClassMatcher{
-													pos:        position{line: 1930, col: 27, offset: 74892},
+													pos:        position{line: 1996, col: 27, offset: 77906},
 													val:        "[\\pL0-9]",
 													ranges:     []rune{'0', '9'},
 													classes:    []*unicode.RangeTable{rangeTable("L")},
@@ -14004,20 +14660,20 @@ var g = &grammar{
 		},
 		{
 			name: "InlineWord",
-			pos:  position{line: 1934, col: 1, offset: 74972},
+			pos:  position{line: 2000, col: 1, offset: 77998},
 			expr: &choiceExpr{
-				pos: position{line: 1935, col: 5, offset: 75054},
+				pos: position{line: 2001, col: 5, offset: 78079},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 1935, col: 5, offset: 75054},
+						pos: position{line: 2001, col: 5, offset: 78079},
 						run: (*parser).callonInlineWord2,
 						expr: &seqExpr{
-							pos: position{line: 1935, col: 5, offset: 75054},
+							pos: position{line: 2001, col: 5, offset: 78079},
 							exprs: []interface{}{
 								&oneOrMoreExpr{
-									pos: position{line: 1935, col: 5, offset: 75054},
+									pos: position{line: 2001, col: 5, offset: 78079},
 									expr: &charClassMatcher{
-										pos:        position{line: 1935, col: 5, offset: 75054},
+										pos:        position{line: 2001, col: 5, offset: 78079},
 										val:        "[\\pL0-9,.?!;]",
 										chars:      []rune{',', '.', '?', '!', ';'},
 										ranges:     []rune{'0', '9'},
@@ -14027,19 +14683,19 @@ var g = &grammar{
 									},
 								},
 								&andExpr{
-									pos: position{line: 1935, col: 20, offset: 75069},
+									pos: position{line: 2001, col: 20, offset: 78094},
 									expr: &choiceExpr{
-										pos: position{line: 1935, col: 22, offset: 75071},
+										pos: position{line: 2001, col: 22, offset: 78096},
 										alternatives: []interface{}{
 											&charClassMatcher{
-												pos:        position{line: 1935, col: 22, offset: 75071},
+												pos:        position{line: 2001, col: 22, offset: 78096},
 												val:        "[\\r\\n ]",
 												chars:      []rune{'\r', '\n', ' '},
 												ignoreCase: false,
 												inverted:   false,
 											},
 											&ruleRefExpr{
-												pos:  position{line: 1935, col: 32, offset: 75081},
+												pos:  position{line: 2001, col: 32, offset: 78106},
 												name: "EOF",
 											},
 										},
@@ -14049,7 +14705,7 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1937, col: 9, offset: 75153},
+						pos:  position{line: 2003, col: 9, offset: 78192},
 						name: "Word",
 					},
 				},
@@ -14057,12 +14713,12 @@ var g = &grammar{
 		},
 		{
 			name: "AnyChar",
-			pos:  position{line: 1940, col: 1, offset: 75256},
+			pos:  position{line: 2006, col: 1, offset: 78292},
 			expr: &actionExpr{
-				pos: position{line: 1940, col: 12, offset: 75267},
+				pos: position{line: 2006, col: 12, offset: 78303},
 				run: (*parser).callonAnyChar1,
 				expr: &charClassMatcher{
-					pos:        position{line: 1940, col: 12, offset: 75267},
+					pos:        position{line: 2006, col: 12, offset: 78303},
 					val:        "[^\\r\\n]",
 					chars:      []rune{'\r', '\n'},
 					ignoreCase: false,
@@ -14072,24 +14728,24 @@ var g = &grammar{
 		},
 		{
 			name: "FileLocation",
-			pos:  position{line: 1944, col: 1, offset: 75336},
+			pos:  position{line: 2010, col: 1, offset: 78384},
 			expr: &actionExpr{
-				pos: position{line: 1944, col: 17, offset: 75352},
+				pos: position{line: 2010, col: 17, offset: 78400},
 				run: (*parser).callonFileLocation1,
 				expr: &labeledExpr{
-					pos:   position{line: 1944, col: 17, offset: 75352},
+					pos:   position{line: 2010, col: 17, offset: 78400},
 					label: "path",
 					expr: &oneOrMoreExpr{
-						pos: position{line: 1944, col: 22, offset: 75357},
+						pos: position{line: 2010, col: 22, offset: 78405},
 						expr: &choiceExpr{
-							pos: position{line: 1944, col: 23, offset: 75358},
+							pos: position{line: 2010, col: 23, offset: 78406},
 							alternatives: []interface{}{
 								&ruleRefExpr{
-									pos:  position{line: 1944, col: 23, offset: 75358},
+									pos:  position{line: 2010, col: 23, offset: 78406},
 									name: "FILENAME",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1944, col: 34, offset: 75369},
+									pos:  position{line: 2010, col: 34, offset: 78417},
 									name: "AttributeSubstitution",
 								},
 							},
@@ -14100,17 +14756,17 @@ var g = &grammar{
 		},
 		{
 			name: "ResolvedFileLocation",
-			pos:  position{line: 1948, col: 1, offset: 75457},
+			pos:  position{line: 2014, col: 1, offset: 78501},
 			expr: &actionExpr{
-				pos: position{line: 1948, col: 25, offset: 75481},
+				pos: position{line: 2014, col: 25, offset: 78525},
 				run: (*parser).callonResolvedFileLocation1,
 				expr: &labeledExpr{
-					pos:   position{line: 1948, col: 25, offset: 75481},
+					pos:   position{line: 2014, col: 25, offset: 78525},
 					label: "path",
 					expr: &oneOrMoreExpr{
-						pos: position{line: 1948, col: 30, offset: 75486},
+						pos: position{line: 2014, col: 30, offset: 78530},
 						expr: &charClassMatcher{
-							pos:        position{line: 1948, col: 31, offset: 75487},
+							pos:        position{line: 2014, col: 31, offset: 78531},
 							val:        "[^\\r\\n []",
 							chars:      []rune{'\r', '\n', ' ', '['},
 							ignoreCase: false,
@@ -14122,38 +14778,38 @@ var g = &grammar{
 		},
 		{
 			name: "Location",
-			pos:  position{line: 1952, col: 1, offset: 75563},
+			pos:  position{line: 2018, col: 1, offset: 78603},
 			expr: &actionExpr{
-				pos: position{line: 1952, col: 13, offset: 75575},
+				pos: position{line: 2018, col: 13, offset: 78615},
 				run: (*parser).callonLocation1,
 				expr: &seqExpr{
-					pos: position{line: 1952, col: 13, offset: 75575},
+					pos: position{line: 2018, col: 13, offset: 78615},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1952, col: 13, offset: 75575},
+							pos:   position{line: 2018, col: 13, offset: 78615},
 							label: "scheme",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1952, col: 20, offset: 75582},
+								pos: position{line: 2018, col: 20, offset: 78622},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1952, col: 21, offset: 75583},
+									pos:  position{line: 2018, col: 21, offset: 78623},
 									name: "URL_SCHEME",
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1952, col: 34, offset: 75596},
+							pos:   position{line: 2018, col: 34, offset: 78636},
 							label: "path",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 1952, col: 39, offset: 75601},
+								pos: position{line: 2018, col: 39, offset: 78641},
 								expr: &choiceExpr{
-									pos: position{line: 1952, col: 40, offset: 75602},
+									pos: position{line: 2018, col: 40, offset: 78642},
 									alternatives: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 1952, col: 40, offset: 75602},
+											pos:  position{line: 2018, col: 40, offset: 78642},
 											name: "FILENAME",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 1952, col: 51, offset: 75613},
+											pos:  position{line: 2018, col: 51, offset: 78653},
 											name: "AttributeSubstitution",
 										},
 									},
@@ -14166,35 +14822,35 @@ var g = &grammar{
 		},
 		{
 			name: "LocationWithScheme",
-			pos:  position{line: 1956, col: 1, offset: 75705},
+			pos:  position{line: 2022, col: 1, offset: 78741},
 			expr: &actionExpr{
-				pos: position{line: 1956, col: 23, offset: 75727},
+				pos: position{line: 2022, col: 23, offset: 78763},
 				run: (*parser).callonLocationWithScheme1,
 				expr: &seqExpr{
-					pos: position{line: 1956, col: 23, offset: 75727},
+					pos: position{line: 2022, col: 23, offset: 78763},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1956, col: 23, offset: 75727},
+							pos:   position{line: 2022, col: 23, offset: 78763},
 							label: "scheme",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1956, col: 31, offset: 75735},
+								pos:  position{line: 2022, col: 31, offset: 78771},
 								name: "URL_SCHEME",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1956, col: 43, offset: 75747},
+							pos:   position{line: 2022, col: 43, offset: 78783},
 							label: "path",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 1956, col: 48, offset: 75752},
+								pos: position{line: 2022, col: 48, offset: 78788},
 								expr: &choiceExpr{
-									pos: position{line: 1956, col: 49, offset: 75753},
+									pos: position{line: 2022, col: 49, offset: 78789},
 									alternatives: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 1956, col: 49, offset: 75753},
+											pos:  position{line: 2022, col: 49, offset: 78789},
 											name: "FILENAME",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 1956, col: 60, offset: 75764},
+											pos:  position{line: 2022, col: 60, offset: 78800},
 											name: "AttributeSubstitution",
 										},
 									},
@@ -14207,11 +14863,11 @@ var g = &grammar{
 		},
 		{
 			name: "FILENAME",
-			pos:  position{line: 1960, col: 1, offset: 75856},
+			pos:  position{line: 2026, col: 1, offset: 78888},
 			expr: &oneOrMoreExpr{
-				pos: position{line: 1960, col: 13, offset: 75868},
+				pos: position{line: 2026, col: 13, offset: 78900},
 				expr: &charClassMatcher{
-					pos:        position{line: 1960, col: 14, offset: 75869},
+					pos:        position{line: 2026, col: 14, offset: 78901},
 					val:        "[^\\r\\n{}[\\] ]",
 					chars:      []rune{'\r', '\n', '{', '}', '[', ']', ' '},
 					ignoreCase: false,
@@ -14221,26 +14877,26 @@ var g = &grammar{
 		},
 		{
 			name: "ResolvedLocation",
-			pos:  position{line: 1962, col: 1, offset: 76005},
+			pos:  position{line: 2028, col: 1, offset: 79035},
 			expr: &actionExpr{
-				pos: position{line: 1962, col: 21, offset: 76025},
+				pos: position{line: 2028, col: 21, offset: 79055},
 				run: (*parser).callonResolvedLocation1,
 				expr: &seqExpr{
-					pos: position{line: 1962, col: 21, offset: 76025},
+					pos: position{line: 2028, col: 21, offset: 79055},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1962, col: 21, offset: 76025},
+							pos:   position{line: 2028, col: 21, offset: 79055},
 							label: "scheme",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1962, col: 29, offset: 76033},
+								pos:  position{line: 2028, col: 29, offset: 79063},
 								name: "URL_SCHEME",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1962, col: 41, offset: 76045},
+							pos:   position{line: 2028, col: 41, offset: 79075},
 							label: "path",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1962, col: 47, offset: 76051},
+								pos:  position{line: 2028, col: 47, offset: 79081},
 								name: "RESOLVED_FILENAME",
 							},
 						},
@@ -14250,11 +14906,11 @@ var g = &grammar{
 		},
 		{
 			name: "RESOLVED_FILENAME",
-			pos:  position{line: 1967, col: 1, offset: 76304},
+			pos:  position{line: 2033, col: 1, offset: 79329},
 			expr: &oneOrMoreExpr{
-				pos: position{line: 1967, col: 22, offset: 76325},
+				pos: position{line: 2033, col: 22, offset: 79350},
 				expr: &charClassMatcher{
-					pos:        position{line: 1967, col: 23, offset: 76326},
+					pos:        position{line: 2033, col: 23, offset: 79351},
 					val:        "[^\\r\\n[\\] ]",
 					chars:      []rune{'\r', '\n', '[', ']', ' '},
 					ignoreCase: false,
@@ -14264,14 +14920,14 @@ var g = &grammar{
 		},
 		{
 			name: "URL",
-			pos:  position{line: 1969, col: 1, offset: 76460},
+			pos:  position{line: 2035, col: 1, offset: 79483},
 			expr: &actionExpr{
-				pos: position{line: 1969, col: 9, offset: 76468},
+				pos: position{line: 2035, col: 9, offset: 79491},
 				run: (*parser).callonURL1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 1969, col: 9, offset: 76468},
+					pos: position{line: 2035, col: 9, offset: 79491},
 					expr: &charClassMatcher{
-						pos:        position{line: 1969, col: 9, offset: 76468},
+						pos:        position{line: 2035, col: 9, offset: 79491},
 						val:        "[^\\r\\n[\\]]",
 						chars:      []rune{'\r', '\n', '[', ']'},
 						ignoreCase: false,
@@ -14282,36 +14938,36 @@ var g = &grammar{
 		},
 		{
 			name: "URL_SCHEME",
-			pos:  position{line: 1973, col: 1, offset: 76520},
+			pos:  position{line: 2039, col: 1, offset: 79539},
 			expr: &choiceExpr{
-				pos: position{line: 1973, col: 15, offset: 76534},
+				pos: position{line: 2039, col: 15, offset: 79553},
 				alternatives: []interface{}{
 					&litMatcher{
-						pos:        position{line: 1973, col: 15, offset: 76534},
+						pos:        position{line: 2039, col: 15, offset: 79553},
 						val:        "http://",
 						ignoreCase: false,
 						want:       "\"http://\"",
 					},
 					&litMatcher{
-						pos:        position{line: 1973, col: 27, offset: 76546},
+						pos:        position{line: 2039, col: 27, offset: 79565},
 						val:        "https://",
 						ignoreCase: false,
 						want:       "\"https://\"",
 					},
 					&litMatcher{
-						pos:        position{line: 1973, col: 40, offset: 76559},
+						pos:        position{line: 2039, col: 40, offset: 79578},
 						val:        "ftp://",
 						ignoreCase: false,
 						want:       "\"ftp://\"",
 					},
 					&litMatcher{
-						pos:        position{line: 1973, col: 51, offset: 76570},
+						pos:        position{line: 2039, col: 51, offset: 79589},
 						val:        "irc://",
 						ignoreCase: false,
 						want:       "\"irc://\"",
 					},
 					&litMatcher{
-						pos:        position{line: 1973, col: 62, offset: 76581},
+						pos:        position{line: 2039, col: 62, offset: 79600},
 						val:        "mailto:",
 						ignoreCase: false,
 						want:       "\"mailto:\"",
@@ -14321,14 +14977,14 @@ var g = &grammar{
 		},
 		{
 			name: "ID",
-			pos:  position{line: 1975, col: 1, offset: 76594},
+			pos:  position{line: 2041, col: 1, offset: 79611},
 			expr: &actionExpr{
-				pos: position{line: 1975, col: 7, offset: 76600},
+				pos: position{line: 2041, col: 7, offset: 79617},
 				run: (*parser).callonID1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 1975, col: 7, offset: 76600},
+					pos: position{line: 2041, col: 7, offset: 79617},
 					expr: &charClassMatcher{
-						pos:        position{line: 1975, col: 7, offset: 76600},
+						pos:        position{line: 2041, col: 7, offset: 79617},
 						val:        "[^[\\]<>,]",
 						chars:      []rune{'[', ']', '<', '>', ','},
 						ignoreCase: false,
@@ -14339,12 +14995,12 @@ var g = &grammar{
 		},
 		{
 			name: "DIGIT",
-			pos:  position{line: 1979, col: 1, offset: 76729},
+			pos:  position{line: 2045, col: 1, offset: 79742},
 			expr: &actionExpr{
-				pos: position{line: 1979, col: 10, offset: 76738},
+				pos: position{line: 2045, col: 10, offset: 79751},
 				run: (*parser).callonDIGIT1,
 				expr: &charClassMatcher{
-					pos:        position{line: 1979, col: 10, offset: 76738},
+					pos:        position{line: 2045, col: 10, offset: 79751},
 					val:        "[0-9]",
 					ranges:     []rune{'0', '9'},
 					ignoreCase: false,
@@ -14354,26 +15010,26 @@ var g = &grammar{
 		},
 		{
 			name: "NUMBER",
-			pos:  position{line: 1983, col: 1, offset: 76784},
+			pos:  position{line: 2049, col: 1, offset: 79793},
 			expr: &actionExpr{
-				pos: position{line: 1983, col: 11, offset: 76794},
+				pos: position{line: 2049, col: 11, offset: 79803},
 				run: (*parser).callonNUMBER1,
 				expr: &seqExpr{
-					pos: position{line: 1983, col: 11, offset: 76794},
+					pos: position{line: 2049, col: 11, offset: 79803},
 					exprs: []interface{}{
 						&zeroOrOneExpr{
-							pos: position{line: 1983, col: 11, offset: 76794},
+							pos: position{line: 2049, col: 11, offset: 79803},
 							expr: &litMatcher{
-								pos:        position{line: 1983, col: 11, offset: 76794},
+								pos:        position{line: 2049, col: 11, offset: 79803},
 								val:        "-",
 								ignoreCase: false,
 								want:       "\"-\"",
 							},
 						},
 						&oneOrMoreExpr{
-							pos: position{line: 1983, col: 16, offset: 76799},
+							pos: position{line: 2049, col: 16, offset: 79808},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1983, col: 16, offset: 76799},
+								pos:  position{line: 2049, col: 16, offset: 79808},
 								name: "DIGIT",
 							},
 						},
@@ -14383,21 +15039,21 @@ var g = &grammar{
 		},
 		{
 			name: "Space",
-			pos:  position{line: 1987, col: 1, offset: 76855},
+			pos:  position{line: 2053, col: 1, offset: 79860},
 			expr: &choiceExpr{
-				pos: position{line: 1987, col: 10, offset: 76864},
+				pos: position{line: 2053, col: 10, offset: 79869},
 				alternatives: []interface{}{
 					&litMatcher{
-						pos:        position{line: 1987, col: 10, offset: 76864},
+						pos:        position{line: 2053, col: 10, offset: 79869},
 						val:        " ",
 						ignoreCase: false,
 						want:       "\" \"",
 					},
 					&actionExpr{
-						pos: position{line: 1987, col: 16, offset: 76870},
+						pos: position{line: 2053, col: 16, offset: 79875},
 						run: (*parser).callonSpace3,
 						expr: &litMatcher{
-							pos:        position{line: 1987, col: 16, offset: 76870},
+							pos:        position{line: 2053, col: 16, offset: 79875},
 							val:        "\t",
 							ignoreCase: false,
 							want:       "\"\\t\"",
@@ -14408,24 +15064,24 @@ var g = &grammar{
 		},
 		{
 			name: "Newline",
-			pos:  position{line: 1991, col: 1, offset: 76915},
+			pos:  position{line: 2057, col: 1, offset: 79916},
 			expr: &choiceExpr{
-				pos: position{line: 1991, col: 12, offset: 76926},
+				pos: position{line: 2057, col: 12, offset: 79927},
 				alternatives: []interface{}{
 					&litMatcher{
-						pos:        position{line: 1991, col: 12, offset: 76926},
+						pos:        position{line: 2057, col: 12, offset: 79927},
 						val:        "\r\n",
 						ignoreCase: false,
 						want:       "\"\\r\\n\"",
 					},
 					&litMatcher{
-						pos:        position{line: 1991, col: 21, offset: 76935},
+						pos:        position{line: 2057, col: 21, offset: 79936},
 						val:        "\r",
 						ignoreCase: false,
 						want:       "\"\\r\"",
 					},
 					&litMatcher{
-						pos:        position{line: 1991, col: 28, offset: 76942},
+						pos:        position{line: 2057, col: 28, offset: 79943},
 						val:        "\n",
 						ignoreCase: false,
 						want:       "\"\\n\"",
@@ -14435,26 +15091,26 @@ var g = &grammar{
 		},
 		{
 			name: "EOF",
-			pos:  position{line: 1993, col: 1, offset: 76950},
+			pos:  position{line: 2059, col: 1, offset: 79949},
 			expr: &notExpr{
-				pos: position{line: 1993, col: 8, offset: 76957},
+				pos: position{line: 2059, col: 8, offset: 79956},
 				expr: &anyMatcher{
-					line: 1993, col: 9, offset: 76958,
+					line: 2059, col: 9, offset: 79957,
 				},
 			},
 		},
 		{
 			name: "EOL",
-			pos:  position{line: 1995, col: 1, offset: 76963},
+			pos:  position{line: 2061, col: 1, offset: 79960},
 			expr: &choiceExpr{
-				pos: position{line: 1995, col: 8, offset: 76970},
+				pos: position{line: 2061, col: 8, offset: 79967},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1995, col: 8, offset: 76970},
+						pos:  position{line: 2061, col: 8, offset: 79967},
 						name: "Newline",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1995, col: 18, offset: 76980},
+						pos:  position{line: 2061, col: 18, offset: 79977},
 						name: "EOF",
 					},
 				},
@@ -14464,7 +15120,6 @@ var g = &grammar{
 }
 
 func (c *current) onAsciidocDocument1(frontmatter, blocks interface{}) (interface{}, error) {
-
 	return types.NewDraftDocument(frontmatter, blocks.([]interface{}))
 }
 
@@ -14475,7 +15130,6 @@ func (p *parser) callonAsciidocDocument1() (interface{}, error) {
 }
 
 func (c *current) onVerbatimDocument1(blocks interface{}) (interface{}, error) {
-
 	return types.NewDraftDocument(nil, blocks.([]interface{}))
 }
 
@@ -14486,7 +15140,6 @@ func (p *parser) callonVerbatimDocument1() (interface{}, error) {
 }
 
 func (c *current) onTextDocument1(blocks interface{}) (interface{}, error) {
-
 	return types.NewDraftDocument(nil, blocks.([]interface{}))
 }
 
@@ -14497,7 +15150,6 @@ func (p *parser) callonTextDocument1() (interface{}, error) {
 }
 
 func (c *current) onAsciidocDocumentBlocks1(header, others interface{}) (interface{}, error) {
-
 	if header != nil && others != nil {
 		return append([]interface{}{header}, others.([]interface{})...), nil
 	} else if others != nil {
@@ -14513,7 +15165,6 @@ func (p *parser) callonAsciidocDocumentBlocks1() (interface{}, error) {
 }
 
 func (c *current) onDocumentBlockWithinDelimitedBlock1(block interface{}) (interface{}, error) {
-
 	return block, nil
 }
 
@@ -14524,7 +15175,6 @@ func (p *parser) callonDocumentBlockWithinDelimitedBlock1() (interface{}, error)
 }
 
 func (c *current) onTextDocumentBlock1(block interface{}) (interface{}, error) {
-
 	return block, nil
 }
 
@@ -14535,8 +15185,7 @@ func (p *parser) callonTextDocumentBlock1() (interface{}, error) {
 }
 
 func (c *current) onYamlFrontMatter1(content interface{}) (interface{}, error) {
-
-	return types.NewYamlFrontMatter(content.(string))
+	return c.withPosition(types.NewYamlFrontMatter(content.(string)))
 }
 
 func (p *parser) callonYamlFrontMatter1() (interface{}, error) {
@@ -14546,7 +15195,6 @@ func (p *parser) callonYamlFrontMatter1() (interface{}, error) {
 }
 
 func (c *current) onYamlFrontMatterContent1() (interface{}, error) {
-
 	return string(c.text), nil
 }
 
@@ -14557,8 +15205,7 @@ func (p *parser) callonYamlFrontMatterContent1() (interface{}, error) {
 }
 
 func (c *current) onDocumentHeader1(title, id, authors, revision interface{}) (interface{}, error) {
-
-	return types.NewDocumentHeader(title.([]interface{}), authors, revision)
+	return c.withPosition(types.NewDocumentHeader(title.([]interface{}), authors, revision))
 }
 
 func (p *parser) callonDocumentHeader1() (interface{}, error) {
@@ -14568,7 +15215,6 @@ func (p *parser) callonDocumentHeader1() (interface{}, error) {
 }
 
 func (c *current) onDocumentAuthorsInlineForm1(authors interface{}) (interface{}, error) {
-
 	return types.NewDocumentAuthors(authors.([]interface{}))
 }
 
@@ -14579,7 +15225,6 @@ func (p *parser) callonDocumentAuthorsInlineForm1() (interface{}, error) {
 }
 
 func (c *current) onDocumentAuthorsAttributeForm1(author interface{}) (interface{}, error) {
-
 	return []types.DocumentAuthor{author.(types.DocumentAuthor)}, nil
 }
 
@@ -14590,7 +15235,6 @@ func (p *parser) callonDocumentAuthorsAttributeForm1() (interface{}, error) {
 }
 
 func (c *current) onDocumentAuthor1(fullname, email interface{}) (interface{}, error) {
-
 	return types.NewDocumentAuthor(fullname, email)
 }
 
@@ -14601,7 +15245,6 @@ func (p *parser) callonDocumentAuthor1() (interface{}, error) {
 }
 
 func (c *current) onDocumentAuthorName1() (interface{}, error) {
-
 	return string(c.text), nil
 }
 
@@ -14612,7 +15255,6 @@ func (p *parser) callonDocumentAuthorName1() (interface{}, error) {
 }
 
 func (c *current) onDocumentAuthorEmail5() (interface{}, error) {
-
 	return string(c.text), nil
 }
 
@@ -14623,7 +15265,6 @@ func (p *parser) callonDocumentAuthorEmail5() (interface{}, error) {
 }
 
 func (c *current) onDocumentAuthorEmail1(email interface{}) (interface{}, error) {
-
 	return email, nil
 }
 
@@ -14634,7 +15275,6 @@ func (p *parser) callonDocumentAuthorEmail1() (interface{}, error) {
 }
 
 func (c *current) onDocumentRevision9(revnumber, revdate, revremark interface{}) (interface{}, error) {
-
 	return types.NewDocumentRevision(revnumber, revdate, revremark)
 
 }
@@ -14646,7 +15286,6 @@ func (p *parser) callonDocumentRevision9() (interface{}, error) {
 }
 
 func (c *current) onDocumentRevision23(revdate, revremark interface{}) (interface{}, error) {
-
 	return types.NewDocumentRevision(nil, revdate, revremark)
 
 }
@@ -14658,7 +15297,6 @@ func (p *parser) callonDocumentRevision23() (interface{}, error) {
 }
 
 func (c *current) onDocumentRevision1(revision interface{}) (interface{}, error) {
-
 	return revision, nil
 }
 
@@ -14669,7 +15307,6 @@ func (p *parser) callonDocumentRevision1() (interface{}, error) {
 }
 
 func (c *current) onDocumentRevisionNumber2() (interface{}, error) {
-
 	return string(c.text), nil
 }
 
@@ -14680,7 +15317,6 @@ func (p *parser) callonDocumentRevisionNumber2() (interface{}, error) {
 }
 
 func (c *current) onDocumentRevisionNumber8() (interface{}, error) {
-
 	return string(c.text), nil
 }
 
@@ -14691,7 +15327,6 @@ func (p *parser) callonDocumentRevisionNumber8() (interface{}, error) {
 }
 
 func (c *current) onDocumentRevisionDate1() (interface{}, error) {
-
 	return string(c.text), nil
 }
 
@@ -14702,7 +15337,6 @@ func (p *parser) callonDocumentRevisionDate1() (interface{}, error) {
 }
 
 func (c *current) onDocumentRevisionRemark1() (interface{}, error) {
-
 	return string(c.text), nil
 }
 
@@ -14725,8 +15359,7 @@ func (p *parser) callonAttributeDeclaration9() (interface{}, error) {
 }
 
 func (c *current) onAttributeDeclaration1(name, value interface{}) (interface{}, error) {
-
-	return types.NewAttributeDeclaration(name.(string), value)
+	return c.withPosition(types.NewAttributeDeclaration(name.(string), value))
 }
 
 func (p *parser) callonAttributeDeclaration1() (interface{}, error) {
@@ -14736,7 +15369,6 @@ func (p *parser) callonAttributeDeclaration1() (interface{}, error) {
 }
 
 func (c *current) onAttributeName1() (interface{}, error) {
-
 	return string(c.text), nil
 }
 
@@ -14747,7 +15379,6 @@ func (p *parser) callonAttributeName1() (interface{}, error) {
 }
 
 func (c *current) onAttributeDeclarationValue1() (interface{}, error) {
-
 	return string(c.text), nil
 }
 
@@ -14758,8 +15389,7 @@ func (p *parser) callonAttributeDeclarationValue1() (interface{}, error) {
 }
 
 func (c *current) onAttributeReset2(name interface{}) (interface{}, error) {
-
-	return types.NewAttributeReset(name.(string))
+	return c.withPosition(types.NewAttributeReset(name.(string)))
 }
 
 func (p *parser) callonAttributeReset2() (interface{}, error) {
@@ -14769,8 +15399,7 @@ func (p *parser) callonAttributeReset2() (interface{}, error) {
 }
 
 func (c *current) onAttributeReset11(name interface{}) (interface{}, error) {
-
-	return types.NewAttributeReset(name.(string))
+	return c.withPosition(types.NewAttributeReset(name.(string)))
 }
 
 func (p *parser) callonAttributeReset11() (interface{}, error) {
@@ -14780,8 +15409,7 @@ func (p *parser) callonAttributeReset11() (interface{}, error) {
 }
 
 func (c *current) onAttributeSubstitution1(name interface{}) (interface{}, error) {
-
-	return types.NewAttributeSubstitution(name.(string))
+	return c.withPosition(types.NewAttributeSubstitution(name.(string)))
 }
 
 func (p *parser) callonAttributeSubstitution1() (interface{}, error) {
@@ -14791,7 +15419,6 @@ func (p *parser) callonAttributeSubstitution1() (interface{}, error) {
 }
 
 func (c *current) onAttributes1(attrs interface{}) (interface{}, error) {
-
 	return types.NewAttributes(attrs.([]interface{}))
 }
 
@@ -14802,7 +15429,6 @@ func (p *parser) callonAttributes1() (interface{}, error) {
 }
 
 func (c *current) onElementAttribute1(attr interface{}) (interface{}, error) {
-
 	return attr, nil // avoid returning something like `[]interface{}{attr, EOL}`
 }
 
@@ -14812,85 +15438,99 @@ func (p *parser) callonElementAttribute1() (interface{}, error) {
 	return p.cur.onElementAttribute1(stack["attr"])
 }
 
-func (c *current) onElementID2(id interface{}) (interface{}, error) {
-
-	return types.NewElementID(id.(string))
+func (c *current) onElementID1(id, reftext interface{}) (interface{}, error) {
+	return types.NewElementID(id.(string), reftext)
 }
 
-func (p *parser) callonElementID2() (interface{}, error) {
+func (p *parser) callonElementID1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onElementID2(stack["id"])
+	return p.cur.onElementID1(stack["id"], stack["reftext"])
 }
 
-func (c *current) onElementID11(id interface{}) (interface{}, error) {
-
-	return types.NewElementID(id.(string))
+func (c *current) onInlineElementID1(id, reftext interface{}) (interface{}, error) {
+	// no EOL here since there can be multiple InlineElementID on the same line
+	return types.NewInlineElementID(id.(string), reftext)
 }
 
-func (p *parser) callonElementID11() (interface{}, error) {
+func (p *parser) callonInlineElementID1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onElementID11(stack["id"])
+	return p.cur.onInlineElementID1(stack["id"], stack["reftext"])
 }
 
-func (c *current) onInlineElementID1(id interface{}) (interface{}, error) {
-	// no EOL here since there can be multiple InlineElementID on the same line
-	return types.NewInlineElementID(id.(string))
+func (c *current) onInlineAnchor2(id, reftext interface{}) (interface{}, error) {
+	return c.withPosition(types.NewInlineAnchor(id.(string), reftext))
 }
 
-func (p *parser) callonInlineElementID1() (interface{}, error) {
+func (p *parser) callonInlineAnchor2() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onInlineElementID1(stack["id"])
+	return p.cur.onInlineAnchor2(stack["id"], stack["reftext"])
 }
 
-func (c *current) onElementTitle1(title interface{}) (interface{}, error) {
+func (c *current) onInlineAnchor18() (interface{}, error) {
+	return string(c.text), nil
+}
 
-	return types.NewElementTitle(title.(string))
+func (p *parser) callonInlineAnchor18() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onInlineAnchor18()
 }
 
-func (p *parser) callonElementTitle1() (interface{}, error) {
+func (c *current) onInlineAnchor11(id, reftext interface{}) (interface{}, error) {
+	return c.withPosition(types.NewInlineAnchor(id.(string), reftext))
+}
+
+func (p *parser) callonInlineAnchor11() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onElementTitle1(stack["title"])
+	return p.cur.onInlineAnchor11(stack["id"], stack["reftext"])
 }
 
-func (c *current) onElementTitleContent1() (interface{}, error) {
-	// "<" and ">" characters are not allowed as they are used as cross-reference markers
+func (c *current) onAnchorRefText7() (interface{}, error) {
 	return string(c.text), nil
 }
 
-func (p *parser) callonElementTitleContent1() (interface{}, error) {
+func (p *parser) callonAnchorRefText7() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onElementTitleContent1()
+	return p.cur.onAnchorRefText7()
 }
 
-func (c *current) onElementRole5() (interface{}, error) {
-
-	return string(c.text), nil
+func (c *current) onAnchorRefText1(reftext interface{}) (interface{}, error) {
+	return reftext, nil
 }
 
-func (p *parser) callonElementRole5() (interface{}, error) {
+func (p *parser) callonAnchorRefText1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onElementRole5()
+	return p.cur.onAnchorRefText1(stack["reftext"])
 }
 
-func (c *current) onElementRole1(role interface{}) (interface{}, error) {
+func (c *current) onElementTitle1(title interface{}) (interface{}, error) {
+	return types.NewElementTitle(title.(string))
+}
 
-	return types.NewElementRole(role.(string))
+func (p *parser) callonElementTitle1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onElementTitle1(stack["title"])
+}
+
+func (c *current) onElementTitleContent1() (interface{}, error) {
+	// "<" and ">" characters are not allowed as they are used as cross-reference markers
+	return string(c.text), nil
 }
 
-func (p *parser) callonElementRole1() (interface{}, error) {
+func (p *parser) callonElementTitleContent1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onElementRole1(stack["role"])
+	return p.cur.onElementTitleContent1()
 }
 
 func (c *current) onLiteralAttribute1() (interface{}, error) {
-
 	return types.NewLiteralAttribute()
 }
 
@@ -14901,7 +15541,6 @@ func (p *parser) callonLiteralAttribute1() (interface{}, error) {
 }
 
 func (c *current) onPassthroughBlockAttribute1() (interface{}, error) {
-
 	return types.NewPassthroughBlockAttribute()
 }
 
@@ -14912,7 +15551,6 @@ func (p *parser) callonPassthroughBlockAttribute1() (interface{}, error) {
 }
 
 func (c *current) onAdmonitionMarkerAttribute1(k interface{}) (interface{}, error) {
-
 	return types.NewAdmonitionAttribute(k.(types.AdmonitionKind))
 }
 
@@ -14943,7 +15581,6 @@ func (p *parser) callonSourceAttributes14() (interface{}, error) {
 }
 
 func (c *current) onSourceAttributes1(language, others interface{}) (interface{}, error) {
-
 	return types.NewSourceAttributes(language, others.([]interface{})...)
 }
 
@@ -14954,7 +15591,6 @@ func (p *parser) callonSourceAttributes1() (interface{}, error) {
 }
 
 func (c *current) onAttributeGroup1(attributes interface{}) (interface{}, error) {
-
 	return types.NewAttributeGroup(attributes.([]interface{}))
 }
 
@@ -14964,6 +15600,107 @@ func (p *parser) callonAttributeGroup1() (interface{}, error) {
 	return p.cur.onAttributeGroup1(stack["attributes"])
 }
 
+func (c *current) onAttributeGroupEntry1(attr interface{}) (interface{}, error) {
+	return attr, nil
+}
+
+func (p *parser) callonAttributeGroupEntry1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onAttributeGroupEntry1(stack["attr"])
+}
+
+func (c *current) onNamedAttribute1(key, value interface{}) (interface{}, error) {
+	return types.NewNamedAttribute(key.(string), value)
+}
+
+func (p *parser) callonNamedAttribute1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNamedAttribute1(stack["key"], stack["value"])
+}
+
+func (c *current) onNamedAttributeKey1() (interface{}, error) {
+	return string(c.text), nil
+}
+
+func (p *parser) callonNamedAttributeKey1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNamedAttributeKey1()
+}
+
+func (c *current) onPositionalAttribute2(value interface{}) (interface{}, error) {
+	return types.NewPositionalAttribute(value)
+}
+
+func (p *parser) callonPositionalAttribute2() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPositionalAttribute2(stack["value"])
+}
+
+func (c *current) onPositionalAttribute5() (interface{}, error) {
+	// empty positional attribute, eg: the style in `[,go]`
+	return types.NewPositionalAttribute(nil)
+}
+
+func (p *parser) callonPositionalAttribute5() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPositionalAttribute5()
+}
+
+func (c *current) onDoubleQuotedAttributeGroupValue5() (interface{}, error) {
+	return string(c.text), nil
+}
+
+func (p *parser) callonDoubleQuotedAttributeGroupValue5() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onDoubleQuotedAttributeGroupValue5()
+}
+
+func (c *current) onDoubleQuotedAttributeGroupValue1(value interface{}) (interface{}, error) {
+	return types.NewQuotedAttributeValue(value.(string), `"`)
+}
+
+func (p *parser) callonDoubleQuotedAttributeGroupValue1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onDoubleQuotedAttributeGroupValue1(stack["value"])
+}
+
+func (c *current) onSingleQuotedAttributeGroupValue5() (interface{}, error) {
+	return string(c.text), nil
+}
+
+func (p *parser) callonSingleQuotedAttributeGroupValue5() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSingleQuotedAttributeGroupValue5()
+}
+
+func (c *current) onSingleQuotedAttributeGroupValue1(value interface{}) (interface{}, error) {
+	return types.NewQuotedAttributeValue(value.(string), `'`)
+}
+
+func (p *parser) callonSingleQuotedAttributeGroupValue1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSingleQuotedAttributeGroupValue1(stack["value"])
+}
+
+func (c *current) onUnquotedAttributeGroupValue1() (interface{}, error) {
+	return strings.TrimSpace(string(c.text)), nil
+}
+
+func (p *parser) callonUnquotedAttributeGroupValue1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onUnquotedAttributeGroupValue1()
+}
+
 func (c *current) onGenericAttributeWithValue1(key, value interface{}) (interface{}, error) {
 	// value is (optionally) set
 	return types.NewGenericAttribute(key.(string), value)
@@ -14987,7 +15724,6 @@ func (p *parser) callonGenericAttributeWithoutValue1() (interface{}, error) {
 }
 
 func (c *current) onAttributeKey1(key interface{}) (interface{}, error) {
-
 	return string(c.text), nil
 }
 
@@ -15020,7 +15756,6 @@ func (p *parser) callonStandaloneAttributeValue1() (interface{}, error) {
 }
 
 func (c *current) onHorizontalLayout1() (interface{}, error) {
-
 	return types.Attributes{"layout": "horizontal"}, nil
 }
 
@@ -15031,7 +15766,6 @@ func (p *parser) callonHorizontalLayout1() (interface{}, error) {
 }
 
 func (c *current) onQuoteAttributes1(author, title interface{}) (interface{}, error) {
-
 	return types.NewQuoteAttributes("quote", author, title)
 }
 
@@ -15042,7 +15776,6 @@ func (p *parser) callonQuoteAttributes1() (interface{}, error) {
 }
 
 func (c *current) onVerseAttributes1(author, title interface{}) (interface{}, error) {
-
 	return types.NewQuoteAttributes("verse", author.(string), title.(string))
 }
 
@@ -15053,7 +15786,6 @@ func (p *parser) callonVerseAttributes1() (interface{}, error) {
 }
 
 func (c *current) onQuoteAttribute1() (interface{}, error) {
-
 	return string(c.text), nil
 }
 
@@ -15064,7 +15796,6 @@ func (p *parser) callonQuoteAttribute1() (interface{}, error) {
 }
 
 func (c *current) onInlineAttributes1(attrs interface{}) (interface{}, error) {
-
 	return types.NewAttributes(attrs.([]interface{}))
 }
 
@@ -15075,7 +15806,6 @@ func (p *parser) callonInlineAttributes1() (interface{}, error) {
 }
 
 func (c *current) onQuotedTextAttrs1(attrs interface{}) (interface{}, error) {
-
 	return attrs, nil
 }
 
@@ -15086,7 +15816,6 @@ func (p *parser) callonQuotedTextAttrs1() (interface{}, error) {
 }
 
 func (c *current) onQuotedTextRole1(role interface{}) (interface{}, error) {
-
 	return types.NewElementRole(role.(string))
 }
 
@@ -15097,7 +15826,6 @@ func (p *parser) callonQuotedTextRole1() (interface{}, error) {
 }
 
 func (c *current) onQuotedTextRoleWord6() (interface{}, error) {
-
 	return strings.SplitN(string(c.text), ",", 2)[0], nil
 }
 
@@ -15108,7 +15836,6 @@ func (p *parser) callonQuotedTextRoleWord6() (interface{}, error) {
 }
 
 func (c *current) onQuotedTextRoleWord1(role interface{}) (interface{}, error) {
-
 	return role, nil
 }
 
@@ -15119,7 +15846,6 @@ func (p *parser) callonQuotedTextRoleWord1() (interface{}, error) {
 }
 
 func (c *current) onQuotedTextShortHand1(attrs interface{}) (interface{}, error) {
-
 	return attrs, nil
 }
 
@@ -15142,7 +15868,6 @@ func (p *parser) callonQuotedTextShortRole5() (interface{}, error) {
 }
 
 func (c *current) onQuotedTextShortRole1(role interface{}) (interface{}, error) {
-
 	return types.NewElementRole(role.(string))
 }
 
@@ -15153,7 +15878,6 @@ func (p *parser) callonQuotedTextShortRole1() (interface{}, error) {
 }
 
 func (c *current) onQuotedTextShortID5() (interface{}, error) {
-
 	return string(c.text), nil
 
 }
@@ -15165,8 +15889,7 @@ func (p *parser) callonQuotedTextShortID5() (interface{}, error) {
 }
 
 func (c *current) onQuotedTextShortID1(id interface{}) (interface{}, error) {
-
-	return types.NewElementID(id.(string))
+	return types.NewElementID(id.(string), nil)
 }
 
 func (p *parser) callonQuotedTextShortID1() (interface{}, error) {
@@ -15187,8 +15910,7 @@ func (p *parser) callonStandaloneAttributes1() (interface{}, error) {
 }
 
 func (c *current) onSingleQuotedString1(elements interface{}) (interface{}, error) {
-
-	return types.NewQuotedString(types.SingleQuote, elements.([]interface{}))
+	return c.withPosition(types.NewQuotedString(types.SingleQuote, elements.([]interface{})))
 }
 
 func (p *parser) callonSingleQuotedString1() (interface{}, error) {
@@ -15198,8 +15920,7 @@ func (p *parser) callonSingleQuotedString1() (interface{}, error) {
 }
 
 func (c *current) onSingleQuotedStringElements1(elements interface{}) (interface{}, error) {
-
-	return types.NewInlineElements(elements)
+	return c.withPositions(types.NewInlineElements(elements))
 }
 
 func (p *parser) callonSingleQuotedStringElements1() (interface{}, error) {
@@ -15209,7 +15930,6 @@ func (p *parser) callonSingleQuotedStringElements1() (interface{}, error) {
 }
 
 func (c *current) onSingleQuotedStringElement1(element interface{}) (interface{}, error) {
-
 	return element, nil
 }
 
@@ -15220,8 +15940,7 @@ func (p *parser) callonSingleQuotedStringElement1() (interface{}, error) {
 }
 
 func (c *current) onSingleQuotedStringFallbackCharacter3() (interface{}, error) {
-
-	return types.NewStringElement(string(c.text))
+	return c.withPosition(types.NewStringElement(string(c.text)))
 }
 
 func (p *parser) callonSingleQuotedStringFallbackCharacter3() (interface{}, error) {
@@ -15231,8 +15950,7 @@ func (p *parser) callonSingleQuotedStringFallbackCharacter3() (interface{}, erro
 }
 
 func (c *current) onDoubleQuotedString1(elements interface{}) (interface{}, error) {
-
-	return types.NewQuotedString(types.DoubleQuote, elements.([]interface{}))
+	return c.withPosition(types.NewQuotedString(types.DoubleQuote, elements.([]interface{})))
 }
 
 func (p *parser) callonDoubleQuotedString1() (interface{}, error) {
@@ -15242,8 +15960,7 @@ func (p *parser) callonDoubleQuotedString1() (interface{}, error) {
 }
 
 func (c *current) onDoubleQuotedStringElements1(elements interface{}) (interface{}, error) {
-
-	return types.NewInlineElements(elements)
+	return c.withPositions(types.NewInlineElements(elements))
 }
 
 func (p *parser) callonDoubleQuotedStringElements1() (interface{}, error) {
@@ -15253,7 +15970,6 @@ func (p *parser) callonDoubleQuotedStringElements1() (interface{}, error) {
 }
 
 func (c *current) onDoubleQuotedStringElement1(element interface{}) (interface{}, error) {
-
 	return element, nil
 }
 
@@ -15264,8 +15980,7 @@ func (p *parser) callonDoubleQuotedStringElement1() (interface{}, error) {
 }
 
 func (c *current) onDoubleQuotedStringFallbackCharacter1() (interface{}, error) {
-
-	return types.NewStringElement(string(c.text))
+	return c.withPosition(types.NewStringElement(string(c.text)))
 }
 
 func (p *parser) callonDoubleQuotedStringFallbackCharacter1() (interface{}, error) {
@@ -15301,8 +16016,7 @@ func (p *parser) callonSection10() (bool, error) {
 }
 
 func (c *current) onSection1(attributes, level, title, id interface{}) (interface{}, error) {
-
-	return types.NewSection(level.(int), title.([]interface{}), id.([]interface{}), attributes)
+	return c.withPosition(types.NewSection(level.(int), title.([]interface{}), id.([]interface{}), attributes))
 }
 
 func (p *parser) callonSection1() (interface{}, error) {
@@ -15313,7 +16027,7 @@ func (p *parser) callonSection1() (interface{}, error) {
 
 func (c *current) onTitleElements1(elements interface{}) (interface{}, error) {
 	// absorbs heading and trailing spaces
-	return types.NewInlineElements(elements.([]interface{}))
+	return c.withPositions(types.NewInlineElements(elements.([]interface{})))
 }
 
 func (p *parser) callonTitleElements1() (interface{}, error) {
@@ -15323,7 +16037,6 @@ func (p *parser) callonTitleElements1() (interface{}, error) {
 }
 
 func (c *current) onTitleElement1(element interface{}) (interface{}, error) {
-
 	return element, nil
 }
 
@@ -15334,8 +16047,7 @@ func (p *parser) callonTitleElement1() (interface{}, error) {
 }
 
 func (c *current) onUserMacroBlock1(name, value, attrs interface{}) (interface{}, error) {
-
-	return types.NewUserMacroBlock(name.(string), value.(string), attrs, string(c.text))
+	return c.withPosition(types.NewUserMacroBlock(name.(string), value.(string), attrs, string(c.text)))
 }
 
 func (p *parser) callonUserMacroBlock1() (interface{}, error) {
@@ -15345,8 +16057,7 @@ func (p *parser) callonUserMacroBlock1() (interface{}, error) {
 }
 
 func (c *current) onInlineUserMacro1(name, value, attrs interface{}) (interface{}, error) {
-
-	return types.NewInlineUserMacro(name.(string), value.(string), attrs, string(c.text))
+	return c.withPosition(types.NewInlineUserMacro(name.(string), value.(string), attrs, string(c.text)))
 }
 
 func (p *parser) callonInlineUserMacro1() (interface{}, error) {
@@ -15356,7 +16067,6 @@ func (p *parser) callonInlineUserMacro1() (interface{}, error) {
 }
 
 func (c *current) onUserMacroName1() (interface{}, error) {
-
 	return string(c.text), nil
 }
 
@@ -15367,7 +16077,6 @@ func (p *parser) callonUserMacroName1() (interface{}, error) {
 }
 
 func (c *current) onUserMacroValue1() (interface{}, error) {
-
 	return string(c.text), nil
 }
 
@@ -15378,8 +16087,7 @@ func (p *parser) callonUserMacroValue1() (interface{}, error) {
 }
 
 func (c *current) onUserMacroAttributes1(attrs interface{}) (interface{}, error) {
-
-	return types.NewAttributes(attrs.([]interface{}))
+	return attrs, nil
 }
 
 func (p *parser) callonUserMacroAttributes1() (interface{}, error) {
@@ -15390,7 +16098,7 @@ func (p *parser) callonUserMacroAttributes1() (interface{}, error) {
 
 func (c *current) onFileInclusion4(path, inlineAttributes interface{}) (interface{}, error) {
 
-	return types.NewFileInclusion(path.(types.Location), inlineAttributes.(types.Attributes), string(c.text))
+	return c.withPosition(types.NewFileInclusion(path.(types.Location), inlineAttributes.(types.Attributes), string(c.text), c.pos.line))
 
 }
 
@@ -15401,7 +16109,6 @@ func (p *parser) callonFileInclusion4() (interface{}, error) {
 }
 
 func (c *current) onFileInclusion1(incl interface{}) (interface{}, error) {
-
 	return incl.(types.FileInclusion), nil
 }
 
@@ -15412,7 +16119,6 @@ func (p *parser) callonFileInclusion1() (interface{}, error) {
 }
 
 func (c *current) onFileIncludeAttributes1(attrs interface{}) (interface{}, error) {
-
 	return types.NewAttributes(attrs.([]interface{}))
 }
 
@@ -15434,7 +16140,6 @@ func (p *parser) callonLineRangesAttribute1() (interface{}, error) {
 }
 
 func (c *current) onLineRangesAttributeValue1(value interface{}) (interface{}, error) {
-
 	return value, nil
 }
 
@@ -15445,7 +16150,6 @@ func (p *parser) callonLineRangesAttributeValue1() (interface{}, error) {
 }
 
 func (c *current) onMultipleLineRanges9(other interface{}) (interface{}, error) {
-
 	return other, nil
 
 }
@@ -15457,7 +16161,6 @@ func (p *parser) callonMultipleLineRanges9() (interface{}, error) {
 }
 
 func (c *current) onMultipleLineRanges1(first, others interface{}) (interface{}, error) {
-
 	return append([]interface{}{first}, others.([]interface{})...), nil
 
 }
@@ -15469,7 +16172,6 @@ func (p *parser) callonMultipleLineRanges1() (interface{}, error) {
 }
 
 func (c *current) onMultipleQuotedLineRanges10(other interface{}) (interface{}, error) {
-
 	return other, nil
 
 }
@@ -15481,7 +16183,6 @@ func (p *parser) callonMultipleQuotedLineRanges10() (interface{}, error) {
 }
 
 func (c *current) onMultipleQuotedLineRanges1(first, others interface{}) (interface{}, error) {
-
 	return append([]interface{}{first}, others.([]interface{})...), nil
 
 }
@@ -15493,8 +16194,11 @@ func (p *parser) callonMultipleQuotedLineRanges1() (interface{}, error) {
 }
 
 func (c *current) onMultiLineRange1(start, end interface{}) (interface{}, error) {
-	// eg: lines=12..14
-	return types.NewLineRange(start.(int), end.(int))
+	// eg: lines=12..14, or lines=12.. until the end of the file
+	if end, ok := end.(int); ok {
+		return types.NewLineRange(start.(int), end)
+	}
+	return types.NewLineRange(start.(int), -1)
 }
 
 func (p *parser) callonMultiLineRange1() (interface{}, error) {
@@ -15504,8 +16208,11 @@ func (p *parser) callonMultiLineRange1() (interface{}, error) {
 }
 
 func (c *current) onMultiLineQuotedRange1(start, end interface{}) (interface{}, error) {
-	// eg: lines=12..14
-	return types.NewLineRange(start.(int), end.(int))
+	// eg: lines="12..14", or lines="12.." until the end of the file
+	if end, ok := end.(int); ok {
+		return types.NewLineRange(start.(int), end)
+	}
+	return types.NewLineRange(start.(int), -1)
 }
 
 func (p *parser) callonMultiLineQuotedRange1() (interface{}, error) {
@@ -15537,7 +16244,6 @@ func (p *parser) callonSingleLineQuotedRange1() (interface{}, error) {
 }
 
 func (c *current) onUndefinedLineRange1() (interface{}, error) {
-
 	return string(c.text), nil
 }
 
@@ -15559,7 +16265,6 @@ func (p *parser) callonTagRangesAttribute1() (interface{}, error) {
 }
 
 func (c *current) onTagRangesAttributeValue1(value interface{}) (interface{}, error) {
-
 	return value, nil
 }
 
@@ -15570,7 +16275,6 @@ func (p *parser) callonTagRangesAttributeValue1() (interface{}, error) {
 }
 
 func (c *current) onMultipleTagRanges7(other interface{}) (interface{}, error) {
-
 	return other, nil
 
 }
@@ -15582,7 +16286,6 @@ func (p *parser) callonMultipleTagRanges7() (interface{}, error) {
 }
 
 func (c *current) onMultipleTagRanges1(first, others interface{}) (interface{}, error) {
-
 	return append([]interface{}{first}, others.([]interface{})...), nil
 
 }
@@ -15594,7 +16297,6 @@ func (p *parser) callonMultipleTagRanges1() (interface{}, error) {
 }
 
 func (c *current) onTagRange2(tag interface{}) (interface{}, error) {
-
 	return types.NewTagRange(tag.(string), true)
 }
 
@@ -15605,7 +16307,6 @@ func (p *parser) callonTagRange2() (interface{}, error) {
 }
 
 func (c *current) onTagRange7(tag interface{}) (interface{}, error) {
-
 	return types.NewTagRange(tag.(string), false)
 }
 
@@ -15616,7 +16317,6 @@ func (p *parser) callonTagRange7() (interface{}, error) {
 }
 
 func (c *current) onTagWildcard4() (interface{}, error) {
-
 	return string(c.text), nil
 
 }
@@ -15641,7 +16341,6 @@ func (p *parser) callonTagWildcard7() (bool, error) {
 }
 
 func (c *current) onTagWildcard1(stars interface{}) (interface{}, error) {
-
 	return stars, nil
 }
 
@@ -15664,8 +16363,7 @@ func (p *parser) callonVerbatimFileLine6() (interface{}, error) {
 }
 
 func (c *current) onVerbatimFileLine1(content interface{}) (interface{}, error) {
-
-	return types.NewVerbatimFileLine(content.(string))
+	return c.withPosition(types.NewVerbatimFileLine(content.(string)))
 }
 
 func (p *parser) callonVerbatimFileLine1() (interface{}, error) {
@@ -15685,7 +16383,6 @@ func (p *parser) callonIncludedFileLine8() (interface{}, error) {
 }
 
 func (c *current) onIncludedFileLine1(content interface{}) (interface{}, error) {
-
 	return types.NewIncludedFileLine(content.([]interface{}))
 }
 
@@ -15706,7 +16403,6 @@ func (p *parser) callonIncludedFileStartTag5() (interface{}, error) {
 }
 
 func (c *current) onIncludedFileStartTag1(tag interface{}) (interface{}, error) {
-
 	return types.NewIncludedFileStartTag(tag.(string))
 }
 
@@ -15727,7 +16423,6 @@ func (p *parser) callonIncludedFileEndTag5() (interface{}, error) {
 }
 
 func (c *current) onIncludedFileEndTag1(tag interface{}) (interface{}, error) {
-
 	return types.NewIncludedFileEndTag(tag.(string))
 }
 
@@ -15738,7 +16433,6 @@ func (p *parser) callonIncludedFileEndTag1() (interface{}, error) {
 }
 
 func (c *current) onListParagraph2(comment interface{}) (interface{}, error) {
-
 	return comment, nil
 
 }
@@ -15750,8 +16444,7 @@ func (p *parser) callonListParagraph2() (interface{}, error) {
 }
 
 func (c *current) onListParagraph5(lines interface{}) (interface{}, error) {
-
-	return types.NewParagraph(lines.([]interface{}), nil)
+	return c.withPosition(types.NewParagraph(lines.([]interface{}), nil))
 
 }
 
@@ -15763,7 +16456,7 @@ func (p *parser) callonListParagraph5() (interface{}, error) {
 
 func (c *current) onListParagraphLine24(elements interface{}) (interface{}, error) {
 
-	return types.NewInlineElements(elements.([]interface{}))
+	return c.withPositions(types.NewInlineElements(elements.([]interface{})))
 
 }
 
@@ -15786,8 +16479,7 @@ func (p *parser) callonListParagraphLine1() (interface{}, error) {
 }
 
 func (c *current) onContinuedListItemElement1(element interface{}) (interface{}, error) {
-
-	return types.NewContinuedListItemElement(element) // offset is negative
+	return c.withPosition(types.NewContinuedListItemElement(element)) // offset is negative
 }
 
 func (p *parser) callonContinuedListItemElement1() (interface{}, error) {
@@ -15797,7 +16489,6 @@ func (p *parser) callonContinuedListItemElement1() (interface{}, error) {
 }
 
 func (c *current) onContinuedListItemContent1(content interface{}) (interface{}, error) {
-
 	return content, nil
 }
 
@@ -15808,8 +16499,7 @@ func (p *parser) callonContinuedListItemContent1() (interface{}, error) {
 }
 
 func (c *current) onOrderedListItem1(attributes, prefix, content interface{}) (interface{}, error) {
-
-	return types.NewOrderedListItem(prefix.(types.OrderedListItemPrefix), content.([]interface{}), attributes)
+	return c.withPosition(types.NewOrderedListItem(prefix.(types.OrderedListItemPrefix), content.([]interface{}), attributes))
 }
 
 func (p *parser) callonOrderedListItem1() (interface{}, error) {
@@ -15845,7 +16535,6 @@ func (p *parser) callonOrderedListItemPrefix13() (bool, error) {
 }
 
 func (c *current) onOrderedListItemPrefix7(depth interface{}) (interface{}, error) {
-
 	switch depth.(int) {
 	case 1:
 		return types.NewOrderedListItemPrefix(types.Arabic, 1)
@@ -15928,7 +16617,6 @@ func (p *parser) callonOrderedListItemPrefix32() (interface{}, error) {
 }
 
 func (c *current) onOrderedListItemPrefix1(prefix interface{}) (interface{}, error) {
-
 	return prefix, nil
 }
 
@@ -15939,7 +16627,6 @@ func (p *parser) callonOrderedListItemPrefix1() (interface{}, error) {
 }
 
 func (c *current) onOrderedListItemContent1(elements interface{}) (interface{}, error) {
-
 	return types.NewListItemContent(elements.([]interface{}))
 }
 
@@ -15950,8 +16637,7 @@ func (p *parser) callonOrderedListItemContent1() (interface{}, error) {
 }
 
 func (c *current) onUnorderedListItem1(attributes, prefix, checkstyle, content interface{}) (interface{}, error) {
-
-	return types.NewUnorderedListItem(prefix.(types.UnorderedListItemPrefix), checkstyle, content.([]interface{}), attributes)
+	return c.withPosition(types.NewUnorderedListItem(prefix.(types.UnorderedListItemPrefix), checkstyle, content.([]interface{}), attributes))
 }
 
 func (p *parser) callonUnorderedListItem1() (interface{}, error) {
@@ -15987,7 +16673,6 @@ func (p *parser) callonUnorderedListItemPrefix13() (bool, error) {
 }
 
 func (c *current) onUnorderedListItemPrefix7(depth interface{}) (interface{}, error) {
-
 	switch depth.(int) {
 	case 1:
 		return types.NewUnorderedListItemPrefix(types.OneAsterisk, 1)
@@ -16010,7 +16695,6 @@ func (p *parser) callonUnorderedListItemPrefix7() (interface{}, error) {
 }
 
 func (c *current) onUnorderedListItemPrefix15() (interface{}, error) {
-
 	return types.NewUnorderedListItemPrefix(types.Dash, 1)
 
 }
@@ -16022,7 +16706,6 @@ func (p *parser) callonUnorderedListItemPrefix15() (interface{}, error) {
 }
 
 func (c *current) onUnorderedListItemPrefix1(prefix interface{}) (interface{}, error) {
-
 	return prefix, nil
 }
 
@@ -16063,7 +16746,6 @@ func (p *parser) callonUnorderedListItemCheckStyle11() (interface{}, error) {
 }
 
 func (c *current) onUnorderedListItemCheckStyle1(style interface{}) (interface{}, error) {
-
 	return style, nil
 
 }
@@ -16086,8 +16768,7 @@ func (p *parser) callonUnorderedListItemContent1() (interface{}, error) {
 }
 
 func (c *current) onLabeledListItem1(attributes, term, separator, description interface{}) (interface{}, error) {
-
-	return types.NewLabeledListItem(len(separator.(string))-1, term.([]interface{}), description, attributes)
+	return c.withPosition(types.NewLabeledListItem(len(separator.(string))-1, term.([]interface{}), description, attributes))
 }
 
 func (p *parser) callonLabeledListItem1() (interface{}, error) {
@@ -16097,8 +16778,7 @@ func (p *parser) callonLabeledListItem1() (interface{}, error) {
 }
 
 func (c *current) onVerbatimLabeledListItemTerm3() (interface{}, error) {
-
-	return types.NewStringElement(strings.TrimSpace(string(c.text)))
+	return c.withPosition(types.NewStringElement(strings.TrimSpace(string(c.text))))
 }
 
 func (p *parser) callonVerbatimLabeledListItemTerm3() (interface{}, error) {
@@ -16108,8 +16788,7 @@ func (p *parser) callonVerbatimLabeledListItemTerm3() (interface{}, error) {
 }
 
 func (c *current) onVerbatimLabeledListItemTerm1(content interface{}) (interface{}, error) {
-
-	return types.NewInlineElements(content)
+	return c.withPositions(types.NewInlineElements(content))
 }
 
 func (p *parser) callonVerbatimLabeledListItemTerm1() (interface{}, error) {
@@ -16120,7 +16799,7 @@ func (p *parser) callonVerbatimLabeledListItemTerm1() (interface{}, error) {
 
 func (c *current) onLabeledListItemTerm1(elements interface{}) (interface{}, error) {
 	// rule as an extra entrypoint
-	return types.NewInlineElements(elements.([]interface{}))
+	return c.withPositions(types.NewInlineElements(elements.([]interface{})))
 }
 
 func (p *parser) callonLabeledListItemTerm1() (interface{}, error) {
@@ -16130,7 +16809,6 @@ func (p *parser) callonLabeledListItemTerm1() (interface{}, error) {
 }
 
 func (c *current) onLabeledListItemTermElement1(element interface{}) (interface{}, error) {
-
 	return element, nil
 }
 
@@ -16166,7 +16844,6 @@ func (p *parser) callonLabeledListItemSeparator7() (bool, error) {
 }
 
 func (c *current) onLabeledListItemSeparator1(separator interface{}) (interface{}, error) {
-
 	return separator, nil
 }
 
@@ -16188,7 +16865,6 @@ func (p *parser) callonLabeledListItemDescription1() (interface{}, error) {
 }
 
 func (c *current) onAdmonitionKind2() (interface{}, error) {
-
 	return types.Tip, nil
 
 }
@@ -16200,7 +16876,6 @@ func (p *parser) callonAdmonitionKind2() (interface{}, error) {
 }
 
 func (c *current) onAdmonitionKind4() (interface{}, error) {
-
 	return types.Note, nil
 
 }
@@ -16212,7 +16887,6 @@ func (p *parser) callonAdmonitionKind4() (interface{}, error) {
 }
 
 func (c *current) onAdmonitionKind6() (interface{}, error) {
-
 	return types.Important, nil
 
 }
@@ -16224,7 +16898,6 @@ func (p *parser) callonAdmonitionKind6() (interface{}, error) {
 }
 
 func (c *current) onAdmonitionKind8() (interface{}, error) {
-
 	return types.Warning, nil
 
 }
@@ -16236,7 +16909,6 @@ func (p *parser) callonAdmonitionKind8() (interface{}, error) {
 }
 
 func (c *current) onAdmonitionKind10() (interface{}, error) {
-
 	return types.Caution, nil
 }
 
@@ -16248,7 +16920,7 @@ func (p *parser) callonAdmonitionKind10() (interface{}, error) {
 
 func (c *current) onParagraph2(attributes, t, lines interface{}) (interface{}, error) {
 
-	return types.NewAdmonitionParagraph(lines.([]interface{}), t.(types.AdmonitionKind), attributes)
+	return c.withPosition(types.NewAdmonitionParagraph(lines.([]interface{}), t.(types.AdmonitionKind), attributes))
 
 }
 
@@ -16259,8 +16931,7 @@ func (p *parser) callonParagraph2() (interface{}, error) {
 }
 
 func (c *current) onParagraph13(attributes, content interface{}) (interface{}, error) {
-
-	return types.NewDelimitedBlock(types.MarkdownQuote, content.([]interface{}), attributes)
+	return c.withPosition(types.NewDelimitedBlock(types.MarkdownQuote, content.([]interface{}), attributes))
 
 }
 
@@ -16289,7 +16960,7 @@ func (p *parser) callonParagraph26() (bool, error) {
 
 func (c *current) onParagraph21(attributes, content interface{}) (interface{}, error) {
 
-	return types.NewDelimitedBlock(types.Passthrough, content.([]interface{}), attributes)
+	return c.withPosition(types.NewDelimitedBlock(types.Passthrough, content.([]interface{}), attributes))
 
 }
 
@@ -16301,7 +16972,7 @@ func (p *parser) callonParagraph21() (interface{}, error) {
 
 func (c *current) onParagraph31(attributes, lines interface{}) (interface{}, error) {
 
-	return types.NewParagraph(lines.([]interface{}), attributes)
+	return c.withPosition(types.NewParagraph(lines.([]interface{}), attributes))
 
 }
 
@@ -16323,7 +16994,6 @@ func (p *parser) callonMarkdownQuoteBlockVerbatimContent2() (interface{}, error)
 }
 
 func (c *current) onMarkdownQuoteBlockAttribution5() (interface{}, error) {
-
 	return string(c.text), nil
 
 }
@@ -16335,7 +17005,6 @@ func (p *parser) callonMarkdownQuoteBlockAttribution5() (interface{}, error) {
 }
 
 func (c *current) onMarkdownQuoteBlockAttribution1(author interface{}) (interface{}, error) {
-
 	return author, nil
 }
 
@@ -16357,7 +17026,6 @@ func (p *parser) callonOpenPassthroughParagraphContent2() (interface{}, error) {
 }
 
 func (c *current) onSimpleParagraph6(attributes interface{}) (bool, error) {
-
 	// skip if the '[literal]' attribute was set on this paragraph
 	if attrs, ok := attributes.(types.Attributes); ok {
 		kind, exists := attrs[types.AttrKind]
@@ -16375,7 +17043,7 @@ func (p *parser) callonSimpleParagraph6() (bool, error) {
 
 func (c *current) onSimpleParagraph1(attributes, firstLine, otherLines interface{}) (interface{}, error) {
 
-	return types.NewParagraph(append([]interface{}{firstLine}, otherLines.([]interface{})...), attributes)
+	return c.withPosition(types.NewParagraph(append([]interface{}{firstLine}, otherLines.([]interface{})...), attributes))
 }
 
 func (p *parser) callonSimpleParagraph1() (interface{}, error) {
@@ -16386,7 +17054,7 @@ func (p *parser) callonSimpleParagraph1() (interface{}, error) {
 
 func (c *current) onFirstParagraphLine1(elements interface{}) (interface{}, error) {
 
-	return types.NewInlineElements(elements.([]interface{}))
+	return c.withPositions(types.NewInlineElements(elements.([]interface{})))
 }
 
 func (p *parser) callonFirstParagraphLine1() (interface{}, error) {
@@ -16396,7 +17064,6 @@ func (p *parser) callonFirstParagraphLine1() (interface{}, error) {
 }
 
 func (c *current) onOtherParagraphLine1(elements interface{}) (interface{}, error) {
-
 	return elements, nil
 }
 
@@ -16408,7 +17075,7 @@ func (p *parser) callonOtherParagraphLine1() (interface{}, error) {
 
 func (c *current) onContinuedParagraph2(attributes, t, lines interface{}) (interface{}, error) {
 
-	return types.NewAdmonitionParagraph(lines.([]interface{}), t.(types.AdmonitionKind), attributes)
+	return c.withPosition(types.NewAdmonitionParagraph(lines.([]interface{}), t.(types.AdmonitionKind), attributes))
 
 }
 
@@ -16420,7 +17087,7 @@ func (p *parser) callonContinuedParagraph2() (interface{}, error) {
 
 func (c *current) onContinuedParagraph12(attributes, lines interface{}) (interface{}, error) {
 
-	return types.NewParagraph(lines.([]interface{}), attributes)
+	return c.withPosition(types.NewParagraph(lines.([]interface{}), attributes))
 }
 
 func (p *parser) callonContinuedParagraph12() (interface{}, error) {
@@ -16430,7 +17097,6 @@ func (p *parser) callonContinuedParagraph12() (interface{}, error) {
 }
 
 func (c *current) onContinuedParagraphLines1(firstLine, otherLines interface{}) (interface{}, error) {
-
 	return append([]interface{}{firstLine}, otherLines.([]interface{})...), nil
 }
 
@@ -16441,7 +17107,6 @@ func (p *parser) callonContinuedParagraphLines1() (interface{}, error) {
 }
 
 func (c *current) onVerseParagraph7(attributes interface{}) (bool, error) {
-
 	if attrs, ok := attributes.(types.Attributes); ok {
 		kind, ok := attrs[types.AttrKind]
 		return ok && kind == types.Verse, nil
@@ -16458,7 +17123,7 @@ func (p *parser) callonVerseParagraph7() (bool, error) {
 
 func (c *current) onVerseParagraph2(attributes, t, lines interface{}) (interface{}, error) {
 
-	return types.NewAdmonitionParagraph(lines.([]interface{}), t.(types.AdmonitionKind), attributes)
+	return c.withPosition(types.NewAdmonitionParagraph(lines.([]interface{}), t.(types.AdmonitionKind), attributes))
 
 }
 
@@ -16469,7 +17134,6 @@ func (p *parser) callonVerseParagraph2() (interface{}, error) {
 }
 
 func (c *current) onVerseParagraph19(attributes interface{}) (bool, error) {
-
 	if attrs, ok := attributes.(types.Attributes); ok {
 		kind, ok := attrs[types.AttrKind]
 		return ok && kind == types.Verse, nil
@@ -16486,7 +17150,7 @@ func (p *parser) callonVerseParagraph19() (bool, error) {
 
 func (c *current) onVerseParagraph14(attributes, lines interface{}) (interface{}, error) {
 
-	return types.NewParagraph(lines.([]interface{}), attributes)
+	return c.withPosition(types.NewParagraph(lines.([]interface{}), attributes))
 
 }
 
@@ -16497,8 +17161,7 @@ func (p *parser) callonVerseParagraph14() (interface{}, error) {
 }
 
 func (c *current) onInlineElements7(comment interface{}) (interface{}, error) {
-
-	return types.NewInlineElements([]interface{}{comment})
+	return c.withPositions(types.NewInlineElements([]interface{}{comment}))
 
 }
 
@@ -16510,7 +17173,7 @@ func (p *parser) callonInlineElements7() (interface{}, error) {
 
 func (c *current) onInlineElements10(elements interface{}) (interface{}, error) {
 
-	return types.NewInlineElements(elements.([]interface{}))
+	return c.withPositions(types.NewInlineElements(elements.([]interface{})))
 
 }
 
@@ -16521,7 +17184,6 @@ func (p *parser) callonInlineElements10() (interface{}, error) {
 }
 
 func (c *current) onInlineElements1(elements interface{}) (interface{}, error) {
-
 	return elements, nil
 
 }
@@ -16533,7 +17195,6 @@ func (p *parser) callonInlineElements1() (interface{}, error) {
 }
 
 func (c *current) onInlineElement1(element interface{}) (interface{}, error) {
-
 	return element, nil
 }
 
@@ -16544,8 +17205,7 @@ func (p *parser) callonInlineElement1() (interface{}, error) {
 }
 
 func (c *current) onLineBreak1() (interface{}, error) {
-
-	return types.NewLineBreak()
+	return c.withPosition(types.NewLineBreak())
 }
 
 func (p *parser) callonLineBreak1() (interface{}, error) {
@@ -16577,7 +17237,6 @@ func (p *parser) callonSubscriptOrSuperscriptPrefix3() (interface{}, error) {
 }
 
 func (c *current) onOneOrMoreBackslashes1() (interface{}, error) {
-
 	return string(c.text), nil
 }
 
@@ -16588,7 +17247,6 @@ func (p *parser) callonOneOrMoreBackslashes1() (interface{}, error) {
 }
 
 func (c *current) onTwoOrMoreBackslashes1() (interface{}, error) {
-
 	return string(c.text), nil
 }
 
@@ -16599,8 +17257,7 @@ func (p *parser) callonTwoOrMoreBackslashes1() (interface{}, error) {
 }
 
 func (c *current) onDoubleQuoteBoldText1(attrs, elements interface{}) (interface{}, error) {
-
-	return types.NewQuotedText(types.Bold, attrs, elements.([]interface{}))
+	return c.withPosition(types.NewQuotedText(types.Bold, attrs, elements.([]interface{})))
 }
 
 func (p *parser) callonDoubleQuoteBoldText1() (interface{}, error) {
@@ -16611,7 +17268,7 @@ func (p *parser) callonDoubleQuoteBoldText1() (interface{}, error) {
 
 func (c *current) onDoubleQuoteBoldTextStringElement1() (interface{}, error) {
 
-	return types.NewStringElement(string(c.text))
+	return c.withPosition(types.NewStringElement(string(c.text)))
 }
 
 func (p *parser) callonDoubleQuoteBoldTextStringElement1() (interface{}, error) {
@@ -16622,7 +17279,7 @@ func (p *parser) callonDoubleQuoteBoldTextStringElement1() (interface{}, error)
 
 func (c *current) onDoubleQuoteBoldTextFallbackCharacter3() (interface{}, error) {
 	// or a bold delimiter when immediately followed by an alphanum (ie, in the middle of some text)
-	return types.NewStringElement(string(c.text))
+	return c.withPosition(types.NewStringElement(string(c.text)))
 }
 
 func (p *parser) callonDoubleQuoteBoldTextFallbackCharacter3() (interface{}, error) {
@@ -16633,7 +17290,7 @@ func (p *parser) callonDoubleQuoteBoldTextFallbackCharacter3() (interface{}, err
 
 func (c *current) onSingleQuoteBoldText2(attrs, elements interface{}) (interface{}, error) {
 	// single punctuation cannot be followed by a character (needs '**' to emphazise a portion of a word)
-	return types.NewQuotedText(types.Bold, attrs, elements.([]interface{}))
+	return c.withPosition(types.NewQuotedText(types.Bold, attrs, elements.([]interface{})))
 }
 
 func (p *parser) callonSingleQuoteBoldText2() (interface{}, error) {
@@ -16644,7 +17301,7 @@ func (p *parser) callonSingleQuoteBoldText2() (interface{}, error) {
 
 func (c *current) onSingleQuoteBoldText19(attrs, elements interface{}) (interface{}, error) {
 	// unbalanced `**` vs `*` punctuation.
-	return types.NewQuotedText(types.Bold, attrs, elements.([]interface{})) // include the second heading `*` as a regular StringElement in the bold content
+	return c.withPosition(types.NewQuotedText(types.Bold, attrs, elements.([]interface{}))) // include the second heading `*` as a regular StringElement in the bold content
 }
 
 func (p *parser) callonSingleQuoteBoldText19() (interface{}, error) {
@@ -16655,7 +17312,7 @@ func (p *parser) callonSingleQuoteBoldText19() (interface{}, error) {
 
 func (c *current) onSingleQuoteBoldTextStringElement1() (interface{}, error) {
 	// anything except EOL, space, bold and subscript/superscript delimiters and brackets. Excludes curly brackets to match with AttributeSubstitution elements
-	return types.NewStringElement(string(c.text))
+	return c.withPosition(types.NewStringElement(string(c.text)))
 }
 
 func (p *parser) callonSingleQuoteBoldTextStringElement1() (interface{}, error) {
@@ -16666,7 +17323,7 @@ func (p *parser) callonSingleQuoteBoldTextStringElement1() (interface{}, error)
 
 func (c *current) onSingleQuoteBoldTextFallbackCharacter3() (interface{}, error) {
 	// or a bold delimiter when immediately followed by an alphanum (ie, in the middle of some text)
-	return types.NewStringElement(string(c.text))
+	return c.withPosition(types.NewStringElement(string(c.text)))
 }
 
 func (p *parser) callonSingleQuoteBoldTextFallbackCharacter3() (interface{}, error) {
@@ -16713,7 +17370,7 @@ func (p *parser) callonEscapedBoldText18() (interface{}, error) {
 
 func (c *current) onDoubleQuoteItalicText1(attrs, elements interface{}) (interface{}, error) {
 	// double punctuation must be evaluated first
-	return types.NewQuotedText(types.Italic, attrs, elements.([]interface{}))
+	return c.withPosition(types.NewQuotedText(types.Italic, attrs, elements.([]interface{})))
 }
 
 func (p *parser) callonDoubleQuoteItalicText1() (interface{}, error) {
@@ -16724,7 +17381,7 @@ func (p *parser) callonDoubleQuoteItalicText1() (interface{}, error) {
 
 func (c *current) onDoubleQuoteItalicTextStringElement1() (interface{}, error) {
 
-	return types.NewStringElement(string(c.text))
+	return c.withPosition(types.NewStringElement(string(c.text)))
 }
 
 func (p *parser) callonDoubleQuoteItalicTextStringElement1() (interface{}, error) {
@@ -16735,7 +17392,7 @@ func (p *parser) callonDoubleQuoteItalicTextStringElement1() (interface{}, error
 
 func (c *current) onDoubleQuoteItalicTextFallbackCharacter3() (interface{}, error) {
 	// or a italic delimiter when immediately followed by an alphanum (ie, in the middle of some text)
-	return types.NewStringElement(string(c.text))
+	return c.withPosition(types.NewStringElement(string(c.text)))
 }
 
 func (p *parser) callonDoubleQuoteItalicTextFallbackCharacter3() (interface{}, error) {
@@ -16746,7 +17403,7 @@ func (p *parser) callonDoubleQuoteItalicTextFallbackCharacter3() (interface{}, e
 
 func (c *current) onSingleQuoteItalicText2(attrs, elements interface{}) (interface{}, error) {
 	// single punctuation cannot be followed by a character (needs '__' to emphazise a portion of a word)
-	return types.NewQuotedText(types.Italic, attrs, elements.([]interface{}))
+	return c.withPosition(types.NewQuotedText(types.Italic, attrs, elements.([]interface{})))
 }
 
 func (p *parser) callonSingleQuoteItalicText2() (interface{}, error) {
@@ -16757,7 +17414,7 @@ func (p *parser) callonSingleQuoteItalicText2() (interface{}, error) {
 
 func (c *current) onSingleQuoteItalicText16(attrs, elements interface{}) (interface{}, error) {
 	// unbalanced `__` vs `_` punctuation.
-	return types.NewQuotedText(types.Italic, attrs, elements.([]interface{})) // include the second heading `_` as a regular StringElement in the italic content
+	return c.withPosition(types.NewQuotedText(types.Italic, attrs, elements.([]interface{}))) // include the second heading `_` as a regular StringElement in the italic content
 }
 
 func (p *parser) callonSingleQuoteItalicText16() (interface{}, error) {
@@ -16768,7 +17425,7 @@ func (p *parser) callonSingleQuoteItalicText16() (interface{}, error) {
 
 func (c *current) onSingleQuoteItalicTextStringElement1() (interface{}, error) {
 	// anything except EOL, space, bold and subscript/superscript delimiters and brackets. Excludes curly brackets to match with AttributeSubstitution elements
-	return types.NewStringElement(string(c.text))
+	return c.withPosition(types.NewStringElement(string(c.text)))
 }
 
 func (p *parser) callonSingleQuoteItalicTextStringElement1() (interface{}, error) {
@@ -16779,7 +17436,7 @@ func (p *parser) callonSingleQuoteItalicTextStringElement1() (interface{}, error
 
 func (c *current) onSingleQuoteItalicTextFallbackCharacter3() (interface{}, error) {
 	// or an italic delimiter when immediately followed by an alphanum (ie, in the middle of some text)
-	return types.NewStringElement(string(c.text))
+	return c.withPosition(types.NewStringElement(string(c.text)))
 }
 
 func (p *parser) callonSingleQuoteItalicTextFallbackCharacter3() (interface{}, error) {
@@ -16826,7 +17483,7 @@ func (p *parser) callonEscapedItalicText18() (interface{}, error) {
 
 func (c *current) onDoubleQuoteMonospaceText1(attrs, elements interface{}) (interface{}, error) {
 	// double punctuation must be evaluated first
-	return types.NewQuotedText(types.Monospace, attrs, elements.([]interface{}))
+	return c.withPosition(types.NewQuotedText(types.Monospace, attrs, elements.([]interface{})))
 }
 
 func (p *parser) callonDoubleQuoteMonospaceText1() (interface{}, error) {
@@ -16837,7 +17494,7 @@ func (p *parser) callonDoubleQuoteMonospaceText1() (interface{}, error) {
 
 func (c *current) onDoubleQuoteMonospaceTextStringElement1() (interface{}, error) {
 
-	return types.NewStringElement(string(c.text))
+	return c.withPosition(types.NewStringElement(string(c.text)))
 }
 
 func (p *parser) callonDoubleQuoteMonospaceTextStringElement1() (interface{}, error) {
@@ -16848,7 +17505,7 @@ func (p *parser) callonDoubleQuoteMonospaceTextStringElement1() (interface{}, er
 
 func (c *current) onDoubleQuoteMonospaceTextFallbackCharacter3() (interface{}, error) {
 	// or a monospace delimiter when immediately followed by an alphanum (ie, in the middle of some text)
-	return types.NewStringElement(string(c.text))
+	return c.withPosition(types.NewStringElement(string(c.text)))
 }
 
 func (p *parser) callonDoubleQuoteMonospaceTextFallbackCharacter3() (interface{}, error) {
@@ -16859,7 +17516,7 @@ func (p *parser) callonDoubleQuoteMonospaceTextFallbackCharacter3() (interface{}
 
 func (c *current) onSingleQuoteMonospaceText2(attrs, elements interface{}) (interface{}, error) {
 	// single punctuation cannot be followed by a character (needs "``" to emphazise a portion of a word)
-	return types.NewQuotedText(types.Monospace, attrs, elements.([]interface{}))
+	return c.withPosition(types.NewQuotedText(types.Monospace, attrs, elements.([]interface{})))
 }
 
 func (p *parser) callonSingleQuoteMonospaceText2() (interface{}, error) {
@@ -16870,7 +17527,7 @@ func (p *parser) callonSingleQuoteMonospaceText2() (interface{}, error) {
 
 func (c *current) onSingleQuoteMonospaceText16(attrs, elements interface{}) (interface{}, error) {
 	// unbalanced "``" vs "`" punctuation.
-	return types.NewQuotedText(types.Monospace, attrs, elements.([]interface{})) // include the second heading "`" as a regular StringElement in the monospace content
+	return c.withPosition(types.NewQuotedText(types.Monospace, attrs, elements.([]interface{}))) // include the second heading "`" as a regular StringElement in the monospace content
 }
 
 func (p *parser) callonSingleQuoteMonospaceText16() (interface{}, error) {
@@ -16881,7 +17538,7 @@ func (p *parser) callonSingleQuoteMonospaceText16() (interface{}, error) {
 
 func (c *current) onSingleQuoteMonospaceTextStringElement1() (interface{}, error) {
 	// anything except EOL, space, monospace and subscript/superscript delimiters. Excludes curly brackets to match with AttributeSubstitution elements
-	return types.NewStringElement(string(c.text))
+	return c.withPosition(types.NewStringElement(string(c.text)))
 }
 
 func (p *parser) callonSingleQuoteMonospaceTextStringElement1() (interface{}, error) {
@@ -16892,7 +17549,7 @@ func (p *parser) callonSingleQuoteMonospaceTextStringElement1() (interface{}, er
 
 func (c *current) onSingleQuoteMonospaceTextFallbackCharacter3() (interface{}, error) {
 	// or an monospace delimiter when immediately followed by an alphanum (ie, in the middle of some text)
-	return types.NewStringElement(string(c.text))
+	return c.withPosition(types.NewStringElement(string(c.text)))
 }
 
 func (p *parser) callonSingleQuoteMonospaceTextFallbackCharacter3() (interface{}, error) {
@@ -16939,7 +17596,7 @@ func (p *parser) callonEscapedMonospaceText18() (interface{}, error) {
 
 func (c *current) onDoubleQuoteMarkedText1(attrs, elements interface{}) (interface{}, error) {
 	// double punctuation must be evaluated first
-	return types.NewQuotedText(types.Marked, attrs, elements.([]interface{}))
+	return c.withPosition(types.NewQuotedText(types.Marked, attrs, elements.([]interface{})))
 }
 
 func (p *parser) callonDoubleQuoteMarkedText1() (interface{}, error) {
@@ -16949,8 +17606,7 @@ func (p *parser) callonDoubleQuoteMarkedText1() (interface{}, error) {
 }
 
 func (c *current) onDoubleQuoteMarkedTextStringElement1() (interface{}, error) {
-
-	return types.NewStringElement(string(c.text))
+	return c.withPosition(types.NewStringElement(string(c.text)))
 }
 
 func (p *parser) callonDoubleQuoteMarkedTextStringElement1() (interface{}, error) {
@@ -16961,7 +17617,7 @@ func (p *parser) callonDoubleQuoteMarkedTextStringElement1() (interface{}, error
 
 func (c *current) onDoubleQuoteMarkedTextFallbackCharacter3() (interface{}, error) {
 	// or a marked delimiter when immediately followed by an alphanum (ie, in the middle of some text)
-	return types.NewStringElement(string(c.text))
+	return c.withPosition(types.NewStringElement(string(c.text)))
 }
 
 func (p *parser) callonDoubleQuoteMarkedTextFallbackCharacter3() (interface{}, error) {
@@ -16972,7 +17628,7 @@ func (p *parser) callonDoubleQuoteMarkedTextFallbackCharacter3() (interface{}, e
 
 func (c *current) onSingleQuoteMarkedText2(attrs, elements interface{}) (interface{}, error) {
 	// single punctuation cannot be followed by a character (needs '##' to emphazise a portion of a word)
-	return types.NewQuotedText(types.Marked, attrs, elements.([]interface{}))
+	return c.withPosition(types.NewQuotedText(types.Marked, attrs, elements.([]interface{})))
 }
 
 func (p *parser) callonSingleQuoteMarkedText2() (interface{}, error) {
@@ -16983,7 +17639,7 @@ func (p *parser) callonSingleQuoteMarkedText2() (interface{}, error) {
 
 func (c *current) onSingleQuoteMarkedText16(attrs, elements interface{}) (interface{}, error) {
 	// unbalanced `##` vs `#` punctuation.
-	return types.NewQuotedText(types.Marked, attrs, elements.([]interface{})) // include the second heading `_` as a regular StringElement in the italic content
+	return c.withPosition(types.NewQuotedText(types.Marked, attrs, elements.([]interface{}))) // include the second heading `_` as a regular StringElement in the italic content
 }
 
 func (p *parser) callonSingleQuoteMarkedText16() (interface{}, error) {
@@ -16994,7 +17650,7 @@ func (p *parser) callonSingleQuoteMarkedText16() (interface{}, error) {
 
 func (c *current) onSingleQuoteMarkedTextStringElement1() (interface{}, error) {
 	// anything except EOL, space, bold and subscript/superscript delimiters and brackets. Excludes curly brackets to match with AttributeSubstitution elements
-	return types.NewStringElement(string(c.text))
+	return c.withPosition(types.NewStringElement(string(c.text)))
 }
 
 func (p *parser) callonSingleQuoteMarkedTextStringElement1() (interface{}, error) {
@@ -17005,7 +17661,7 @@ func (p *parser) callonSingleQuoteMarkedTextStringElement1() (interface{}, error
 
 func (c *current) onSingleQuoteMarkedTextFallbackCharacter3() (interface{}, error) {
 	// or a mark delimiter when immediately followed by an alphanum (ie, in the middle of some text)
-	return types.NewStringElement(string(c.text))
+	return c.withPosition(types.NewStringElement(string(c.text)))
 }
 
 func (p *parser) callonSingleQuoteMarkedTextFallbackCharacter3() (interface{}, error) {
@@ -17052,7 +17708,7 @@ func (p *parser) callonEscapedMarkedText18() (interface{}, error) {
 
 func (c *current) onSubscriptText1(attrs, element interface{}) (interface{}, error) {
 	// wraps a single word
-	return types.NewQuotedText(types.Subscript, attrs, element)
+	return c.withPosition(types.NewQuotedText(types.Subscript, attrs, element))
 }
 
 func (p *parser) callonSubscriptText1() (interface{}, error) {
@@ -17085,7 +17741,7 @@ func (p *parser) callonEscapedSubscriptText1() (interface{}, error) {
 
 func (c *current) onSuperscriptText1(attrs, element interface{}) (interface{}, error) {
 	// wraps a single word
-	return types.NewQuotedText(types.Superscript, attrs, element)
+	return c.withPosition(types.NewQuotedText(types.Superscript, attrs, element))
 }
 
 func (p *parser) callonSuperscriptText1() (interface{}, error) {
@@ -17117,8 +17773,7 @@ func (p *parser) callonEscapedSuperscriptText1() (interface{}, error) {
 }
 
 func (c *current) onSinglePlusPassthrough1(content interface{}) (interface{}, error) {
-
-	return types.NewInlinePassthrough(types.SinglePlusPassthrough, []interface{}{content})
+	return c.withPosition(types.NewInlinePassthrough(types.SinglePlusPassthrough, []interface{}{content}))
 }
 
 func (p *parser) callonSinglePlusPassthrough1() (interface{}, error) {
@@ -17129,7 +17784,7 @@ func (p *parser) callonSinglePlusPassthrough1() (interface{}, error) {
 
 func (c *current) onSinglePlusPassthroughContent2() (interface{}, error) {
 	// no space in the first or last position of the content, but allowed elsewhere
-	return types.NewStringElement(string(c.text))
+	return c.withPosition(types.NewStringElement(string(c.text)))
 }
 
 func (p *parser) callonSinglePlusPassthroughContent2() (interface{}, error) {
@@ -17140,7 +17795,7 @@ func (p *parser) callonSinglePlusPassthroughContent2() (interface{}, error) {
 
 func (c *current) onSinglePlusPassthroughContent24() (interface{}, error) {
 	// a single character
-	return types.NewStringElement(string(c.text))
+	return c.withPosition(types.NewStringElement(string(c.text)))
 }
 
 func (p *parser) callonSinglePlusPassthroughContent24() (interface{}, error) {
@@ -17150,8 +17805,7 @@ func (p *parser) callonSinglePlusPassthroughContent24() (interface{}, error) {
 }
 
 func (c *current) onTriplePlusPassthrough1(content interface{}) (interface{}, error) {
-
-	return types.NewInlinePassthrough(types.TriplePlusPassthrough, []interface{}{content})
+	return c.withPosition(types.NewInlinePassthrough(types.TriplePlusPassthrough, []interface{}{content}))
 }
 
 func (p *parser) callonTriplePlusPassthrough1() (interface{}, error) {
@@ -17162,7 +17816,7 @@ func (p *parser) callonTriplePlusPassthrough1() (interface{}, error) {
 
 func (c *current) onTriplePlusPassthroughContent2() (interface{}, error) {
 	// spaces and newlines are also allowed in the first or last position of the content and elsewhere too
-	return types.NewStringElement(string(c.text))
+	return c.withPosition(types.NewStringElement(string(c.text)))
 }
 
 func (p *parser) callonTriplePlusPassthroughContent2() (interface{}, error) {
@@ -17173,7 +17827,7 @@ func (p *parser) callonTriplePlusPassthroughContent2() (interface{}, error) {
 
 func (c *current) onTriplePlusPassthroughContent8() (interface{}, error) {
 	// a single character
-	return types.NewStringElement(string(c.text))
+	return c.withPosition(types.NewStringElement(string(c.text)))
 }
 
 func (p *parser) callonTriplePlusPassthroughContent8() (interface{}, error) {
@@ -17183,8 +17837,7 @@ func (p *parser) callonTriplePlusPassthroughContent8() (interface{}, error) {
 }
 
 func (c *current) onPassthroughMacro2(content interface{}) (interface{}, error) {
-
-	return types.NewInlinePassthrough(types.PassthroughMacro, []interface{}{content})
+	return c.withPosition(types.NewInlinePassthrough(types.PassthroughMacro, []interface{}{content}))
 }
 
 func (p *parser) callonPassthroughMacro2() (interface{}, error) {
@@ -17194,8 +17847,7 @@ func (p *parser) callonPassthroughMacro2() (interface{}, error) {
 }
 
 func (c *current) onPassthroughMacro9(content interface{}) (interface{}, error) {
-
-	return types.NewInlinePassthrough(types.PassthroughMacro, content.([]interface{}))
+	return c.withPosition(types.NewInlinePassthrough(types.PassthroughMacro, content.([]interface{})))
 }
 
 func (p *parser) callonPassthroughMacro9() (interface{}, error) {
@@ -17205,8 +17857,7 @@ func (p *parser) callonPassthroughMacro9() (interface{}, error) {
 }
 
 func (c *current) onPassthroughMacroCharacter1() (interface{}, error) {
-
-	return types.NewStringElement(string(c.text))
+	return c.withPosition(types.NewStringElement(string(c.text)))
 }
 
 func (p *parser) callonPassthroughMacroCharacter1() (interface{}, error) {
@@ -17216,8 +17867,7 @@ func (p *parser) callonPassthroughMacroCharacter1() (interface{}, error) {
 }
 
 func (c *current) onInternalCrossReference2(id, label interface{}) (interface{}, error) {
-
-	return types.NewInternalCrossReference(id.(string), label.(string))
+	return c.withPosition(types.NewInternalCrossReference(id.(string), label.(string)))
 }
 
 func (p *parser) callonInternalCrossReference2() (interface{}, error) {
@@ -17227,8 +17877,7 @@ func (p *parser) callonInternalCrossReference2() (interface{}, error) {
 }
 
 func (c *current) onInternalCrossReference13(id interface{}) (interface{}, error) {
-
-	return types.NewInternalCrossReference(id.(string), nil)
+	return c.withPosition(types.NewInternalCrossReference(id.(string), nil))
 }
 
 func (p *parser) callonInternalCrossReference13() (interface{}, error) {
@@ -17238,8 +17887,7 @@ func (p *parser) callonInternalCrossReference13() (interface{}, error) {
 }
 
 func (c *current) onExternalCrossReference1(url, inlineAttributes interface{}) (interface{}, error) {
-
-	return types.NewExternalCrossReference(url.(types.Location), inlineAttributes.(types.Attributes))
+	return c.withPosition(types.NewExternalCrossReference(url.(types.Location), inlineAttributes.(types.Attributes)))
 }
 
 func (p *parser) callonExternalCrossReference1() (interface{}, error) {
@@ -17249,8 +17897,7 @@ func (p *parser) callonExternalCrossReference1() (interface{}, error) {
 }
 
 func (c *current) onRelativeLink1(url, inlineAttributes interface{}) (interface{}, error) {
-
-	return types.NewInlineLink(url.(types.Location), inlineAttributes.(types.Attributes))
+	return c.withPosition(types.NewInlineLink(url.(types.Location), inlineAttributes.(types.Attributes)))
 }
 
 func (p *parser) callonRelativeLink1() (interface{}, error) {
@@ -17260,8 +17907,7 @@ func (p *parser) callonRelativeLink1() (interface{}, error) {
 }
 
 func (c *current) onExternalLink1(url, inlineAttributes interface{}) (interface{}, error) {
-
-	return types.NewInlineLink(url.(types.Location), inlineAttributes)
+	return c.withPosition(types.NewInlineLink(url.(types.Location), inlineAttributes))
 }
 
 func (p *parser) callonExternalLink1() (interface{}, error) {
@@ -17271,7 +17917,6 @@ func (p *parser) callonExternalLink1() (interface{}, error) {
 }
 
 func (c *current) onLinkAttributes1(firstAttr, otherattrs interface{}) (interface{}, error) {
-
 	return types.NewInlineLinkAttributes(append(firstAttr.([]interface{}), otherattrs.([]interface{})...))
 }
 
@@ -17282,8 +17927,7 @@ func (p *parser) callonLinkAttributes1() (interface{}, error) {
 }
 
 func (c *current) onFirstLinkAttributeElement4(elements interface{}) (interface{}, error) {
-
-	return types.NewInlineElements(elements.([]interface{}))
+	return c.withPositions(types.NewInlineElements(elements.([]interface{})))
 
 }
 
@@ -17294,8 +17938,7 @@ func (p *parser) callonFirstLinkAttributeElement4() (interface{}, error) {
 }
 
 func (c *current) onFirstLinkAttributeElement19(elements interface{}) (interface{}, error) {
-
-	return types.NewInlineElements(elements.([]interface{}))
+	return c.withPositions(types.NewInlineElements(elements.([]interface{})))
 
 }
 
@@ -17306,7 +17949,6 @@ func (p *parser) callonFirstLinkAttributeElement19() (interface{}, error) {
 }
 
 func (c *current) onFirstLinkAttributeElement1(element interface{}) (interface{}, error) {
-
 	return element, nil
 }
 
@@ -17318,7 +17960,7 @@ func (p *parser) callonFirstLinkAttributeElement1() (interface{}, error) {
 
 func (c *current) onAttributeChar1() (interface{}, error) {
 	// excludes comma
-	return types.NewStringElement(string(c.text))
+	return c.withPosition(types.NewStringElement(string(c.text)))
 }
 
 func (p *parser) callonAttributeChar1() (interface{}, error) {
@@ -17329,7 +17971,7 @@ func (p *parser) callonAttributeChar1() (interface{}, error) {
 
 func (c *current) onQuotedAttributeChar1() (interface{}, error) {
 	// does not exclude comma
-	return types.NewStringElement(string(c.text))
+	return c.withPosition(types.NewStringElement(string(c.text)))
 }
 
 func (p *parser) callonQuotedAttributeChar1() (interface{}, error) {
@@ -17340,7 +17982,7 @@ func (p *parser) callonQuotedAttributeChar1() (interface{}, error) {
 
 func (c *current) onUnquotedAttributeChar1() (interface{}, error) {
 	// excludes comma
-	return types.NewStringElement(string(c.text))
+	return c.withPosition(types.NewStringElement(string(c.text)))
 }
 
 func (p *parser) callonUnquotedAttributeChar1() (interface{}, error) {
@@ -17350,8 +17992,7 @@ func (p *parser) callonUnquotedAttributeChar1() (interface{}, error) {
 }
 
 func (c *current) onInlineLinks1(elements interface{}) (interface{}, error) {
-
-	return types.NewInlineElements(elements.([]interface{}))
+	return c.withPositions(types.NewInlineElements(elements.([]interface{})))
 }
 
 func (p *parser) callonInlineLinks1() (interface{}, error) {
@@ -17361,8 +18002,7 @@ func (p *parser) callonInlineLinks1() (interface{}, error) {
 }
 
 func (c *current) onResolvedRelativeLink1(url, inlineAttributes interface{}) (interface{}, error) {
-
-	return types.NewInlineLink(url.(types.Location), inlineAttributes.(types.Attributes))
+	return c.withPosition(types.NewInlineLink(url.(types.Location), inlineAttributes.(types.Attributes)))
 }
 
 func (p *parser) callonResolvedRelativeLink1() (interface{}, error) {
@@ -17372,8 +18012,7 @@ func (p *parser) callonResolvedRelativeLink1() (interface{}, error) {
 }
 
 func (c *current) onResolvedExternalLink1(url, inlineAttributes interface{}) (interface{}, error) {
-
-	return types.NewInlineLink(url.(types.Location), inlineAttributes)
+	return c.withPosition(types.NewInlineLink(url.(types.Location), inlineAttributes))
 }
 
 func (p *parser) callonResolvedExternalLink1() (interface{}, error) {
@@ -17383,8 +18022,7 @@ func (p *parser) callonResolvedExternalLink1() (interface{}, error) {
 }
 
 func (c *current) onImageBlock1(attributes, path, inlineAttributes interface{}) (interface{}, error) {
-
-	return types.NewImageBlock(path.(types.Location), inlineAttributes.(types.Attributes), attributes)
+	return c.withPosition(types.NewImageBlock(path.(types.Location), inlineAttributes.(types.Attributes), attributes))
 }
 
 func (p *parser) callonImageBlock1() (interface{}, error) {
@@ -17394,8 +18032,7 @@ func (p *parser) callonImageBlock1() (interface{}, error) {
 }
 
 func (c *current) onInlineImage1(path, inlineAttributes interface{}) (interface{}, error) {
-
-	return types.NewInlineImage(path.(types.Location), inlineAttributes.(types.Attributes))
+	return c.withPosition(types.NewInlineImage(path.(types.Location), inlineAttributes.(types.Attributes)))
 }
 
 func (p *parser) callonInlineImage1() (interface{}, error) {
@@ -17405,7 +18042,6 @@ func (p *parser) callonInlineImage1() (interface{}, error) {
 }
 
 func (c *current) onImageAttributes1(alt, width, height, otherattrs interface{}) (interface{}, error) {
-
 	return types.NewImageAttributes(alt, width, height, otherattrs.([]interface{}))
 }
 
@@ -17426,8 +18062,7 @@ func (p *parser) callonInlineIcon5() (interface{}, error) {
 }
 
 func (c *current) onInlineIcon1(iconClass, inlineAttributes interface{}) (interface{}, error) {
-
-	return types.NewIcon(iconClass.(string), inlineAttributes.(types.Attributes))
+	return c.withPosition(types.NewIcon(iconClass.(string), inlineAttributes.(types.Attributes)))
 }
 
 func (p *parser) callonInlineIcon1() (interface{}, error) {
@@ -17437,7 +18072,6 @@ func (p *parser) callonInlineIcon1() (interface{}, error) {
 }
 
 func (c *current) onIconAttributes1(size, others interface{}) (interface{}, error) {
-
 	return types.NewIconAttributes(size, others.([]interface{}))
 }
 
@@ -17448,8 +18082,7 @@ func (p *parser) callonIconAttributes1() (interface{}, error) {
 }
 
 func (c *current) onInlineFootnote2(content interface{}) (interface{}, error) {
-
-	return types.NewFootnote("", content.([]interface{}))
+	return c.withPosition(types.NewFootnote("", content.([]interface{})))
 }
 
 func (p *parser) callonInlineFootnote2() (interface{}, error) {
@@ -17459,8 +18092,7 @@ func (p *parser) callonInlineFootnote2() (interface{}, error) {
 }
 
 func (c *current) onInlineFootnote8(ref, content interface{}) (interface{}, error) {
-
-	return types.NewFootnote(ref.(string), content)
+	return c.withPosition(types.NewFootnote(ref.(string), content))
 }
 
 func (p *parser) callonInlineFootnote8() (interface{}, error) {
@@ -17471,7 +18103,7 @@ func (p *parser) callonInlineFootnote8() (interface{}, error) {
 
 func (c *current) onFootnoteContent1(elements interface{}) (interface{}, error) {
 	// footnote content may span multiple lines
-	return types.NewInlineElements(elements.([]interface{}))
+	return c.withPositions(types.NewInlineElements(elements.([]interface{})))
 }
 
 func (p *parser) callonFootnoteContent1() (interface{}, error) {
@@ -17481,7 +18113,6 @@ func (p *parser) callonFootnoteContent1() (interface{}, error) {
 }
 
 func (c *current) onDelimitedBlock1(block interface{}) (interface{}, error) {
-
 	return block, nil
 }
 
@@ -17492,8 +18123,7 @@ func (p *parser) callonDelimitedBlock1() (interface{}, error) {
 }
 
 func (c *current) onVerbatimLine1(content, callouts interface{}) (interface{}, error) {
-
-	return types.NewVerbatimLine(content.(string), callouts)
+	return c.withPosition(types.NewVerbatimLine(content.(string), callouts))
 }
 
 func (p *parser) callonVerbatimLine1() (interface{}, error) {
@@ -17524,8 +18154,7 @@ func (p *parser) callonCallout5() (interface{}, error) {
 }
 
 func (c *current) onCallout1(ref interface{}) (interface{}, error) {
-
-	return types.NewCallout(ref.(int))
+	return c.withPosition(types.NewCallout(ref.(int)))
 }
 
 func (p *parser) callonCallout1() (interface{}, error) {
@@ -17535,8 +18164,7 @@ func (p *parser) callonCallout1() (interface{}, error) {
 }
 
 func (c *current) onCalloutListItem1(ref, description interface{}) (interface{}, error) {
-
-	return types.NewCalloutListItem(ref.(int), description.([]interface{}))
+	return c.withPosition(types.NewCalloutListItem(ref.(int), description.([]interface{})))
 }
 
 func (p *parser) callonCalloutListItem1() (interface{}, error) {
@@ -17556,7 +18184,6 @@ func (p *parser) callonCalloutListItemPrefix5() (interface{}, error) {
 }
 
 func (c *current) onCalloutListItemPrefix1(ref interface{}) (interface{}, error) {
-
 	return ref, nil
 }
 
@@ -17567,8 +18194,7 @@ func (p *parser) callonCalloutListItemPrefix1() (interface{}, error) {
 }
 
 func (c *current) onFencedBlock1(attributes, content interface{}) (interface{}, error) {
-
-	return types.NewDelimitedBlock(types.Fenced, content.([]interface{}), attributes)
+	return c.withPosition(types.NewDelimitedBlock(types.Fenced, content.([]interface{}), attributes))
 }
 
 func (p *parser) callonFencedBlock1() (interface{}, error) {
@@ -17589,8 +18215,7 @@ func (p *parser) callonFencedBlockVerbatimContent2() (interface{}, error) {
 }
 
 func (c *current) onListingBlock1(attributes, content interface{}) (interface{}, error) {
-
-	return types.NewDelimitedBlock(types.Listing, content.([]interface{}), attributes)
+	return c.withPosition(types.NewDelimitedBlock(types.Listing, content.([]interface{}), attributes))
 }
 
 func (p *parser) callonListingBlock1() (interface{}, error) {
@@ -17611,8 +18236,7 @@ func (p *parser) callonListingBlockVerbatimContent2() (interface{}, error) {
 }
 
 func (c *current) onExampleBlock1(attributes, content interface{}) (interface{}, error) {
-
-	return types.NewDelimitedBlock(types.Example, content.([]interface{}), attributes)
+	return c.withPosition(types.NewDelimitedBlock(types.Example, content.([]interface{}), attributes))
 }
 
 func (p *parser) callonExampleBlock1() (interface{}, error) {
@@ -17632,9 +18256,29 @@ func (p *parser) callonExampleBlockVerbatimContent2() (interface{}, error) {
 	return p.cur.onExampleBlockVerbatimContent2(stack["content"])
 }
 
-func (c *current) onQuoteBlock1(attributes, content interface{}) (interface{}, error) {
+func (c *current) onOpenBlock1(attributes, content interface{}) (interface{}, error) {
+	return c.withPosition(types.NewDelimitedBlock(types.Open, content.([]interface{}), attributes))
+}
+
+func (p *parser) callonOpenBlock1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onOpenBlock1(stack["attributes"], stack["content"])
+}
+
+func (c *current) onOpenBlockVerbatimContent2(content interface{}) (interface{}, error) {
+	// at this stage, content is a mix of FileInclusions and lines of text (i.e., StringElement)
+	return content, nil
+}
+
+func (p *parser) callonOpenBlockVerbatimContent2() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onOpenBlockVerbatimContent2(stack["content"])
+}
 
-	return types.NewDelimitedBlock(types.Quote, content.([]interface{}), attributes)
+func (c *current) onQuoteBlock1(attributes, content interface{}) (interface{}, error) {
+	return c.withPosition(types.NewDelimitedBlock(types.Quote, content.([]interface{}), attributes))
 }
 
 func (p *parser) callonQuoteBlock1() (interface{}, error) {
@@ -17668,8 +18312,7 @@ func (p *parser) callonVerseBlock5() (bool, error) {
 }
 
 func (c *current) onVerseBlock1(attributes, content interface{}) (interface{}, error) {
-
-	return types.NewDelimitedBlock(types.Verse, content.([]interface{}), attributes)
+	return c.withPosition(types.NewDelimitedBlock(types.Verse, content.([]interface{}), attributes))
 
 }
 
@@ -17691,8 +18334,7 @@ func (p *parser) callonVerseBlockVerbatimContent2() (interface{}, error) {
 }
 
 func (c *current) onSidebarBlock1(attributes, content interface{}) (interface{}, error) {
-
-	return types.NewDelimitedBlock(types.Sidebar, content.([]interface{}), attributes)
+	return c.withPosition(types.NewDelimitedBlock(types.Sidebar, content.([]interface{}), attributes))
 }
 
 func (p *parser) callonSidebarBlock1() (interface{}, error) {
@@ -17713,8 +18355,7 @@ func (p *parser) callonSidebarBlockVerbatimContent2() (interface{}, error) {
 }
 
 func (c *current) onPassthroughBlock1(attributes, content interface{}) (interface{}, error) {
-
-	return types.NewDelimitedBlock(types.Passthrough, content.([]interface{}), attributes)
+	return c.withPosition(types.NewDelimitedBlock(types.Passthrough, content.([]interface{}), attributes))
 }
 
 func (p *parser) callonPassthroughBlock1() (interface{}, error) {
@@ -17735,7 +18376,6 @@ func (p *parser) callonPassthroughBlockVerbatimContent2() (interface{}, error) {
 }
 
 func (c *current) onNormalBlockElement1(element interface{}) (interface{}, error) {
-
 	return element, nil
 
 }
@@ -17747,7 +18387,6 @@ func (p *parser) callonNormalBlockElement1() (interface{}, error) {
 }
 
 func (c *current) onVerseBlockElement1(element interface{}) (interface{}, error) {
-
 	return element, nil
 }
 
@@ -17758,8 +18397,7 @@ func (p *parser) callonVerseBlockElement1() (interface{}, error) {
 }
 
 func (c *current) onVerseBlockParagraph1(lines interface{}) (interface{}, error) {
-
-	return types.NewParagraph(lines.([]interface{}), nil)
+	return c.withPosition(types.NewParagraph(lines.([]interface{}), nil))
 }
 
 func (p *parser) callonVerseBlockParagraph1() (interface{}, error) {
@@ -17769,8 +18407,7 @@ func (p *parser) callonVerseBlockParagraph1() (interface{}, error) {
 }
 
 func (c *current) onVerseBlockParagraphLine1(elements interface{}) (interface{}, error) {
-
-	return types.NewInlineElements(elements)
+	return c.withPositions(types.NewInlineElements(elements))
 }
 
 func (p *parser) callonVerseBlockParagraphLine1() (interface{}, error) {
@@ -17781,7 +18418,8 @@ func (p *parser) callonVerseBlockParagraphLine1() (interface{}, error) {
 
 func (c *current) onTable1(attributes, header, lines interface{}) (interface{}, error) {
 	// end delimiter or end of file
-	return types.NewTable(header, lines.([]interface{}), attributes)
+	c.checkTable(header, lines.([]interface{}))
+	return c.withPosition(types.NewTable(header, lines.([]interface{}), attributes))
 }
 
 func (p *parser) callonTable1() (interface{}, error) {
@@ -17791,8 +18429,7 @@ func (p *parser) callonTable1() (interface{}, error) {
 }
 
 func (c *current) onTableLineHeader1(cells interface{}) (interface{}, error) {
-
-	return types.NewTableLine(cells.([]interface{}))
+	return c.withPosition(types.NewTableLine(cells.([]interface{})))
 }
 
 func (p *parser) callonTableLineHeader1() (interface{}, error) {
@@ -17802,8 +18439,7 @@ func (p *parser) callonTableLineHeader1() (interface{}, error) {
 }
 
 func (c *current) onTableLine1(cells interface{}) (interface{}, error) {
-
-	return types.NewTableLine(cells.([]interface{}))
+	return c.withPosition(types.NewTableLine(cells.([]interface{})))
 }
 
 func (p *parser) callonTableLine1() (interface{}, error) {
@@ -17813,8 +18449,7 @@ func (p *parser) callonTableLine1() (interface{}, error) {
 }
 
 func (c *current) onTableCell1(elements interface{}) (interface{}, error) {
-
-	return types.NewInlineElements(elements)
+	return c.withPositions(types.NewInlineElements(elements))
 }
 
 func (p *parser) callonTableCell1() (interface{}, error) {
@@ -17824,8 +18459,7 @@ func (p *parser) callonTableCell1() (interface{}, error) {
 }
 
 func (c *current) onCommentBlock1(content interface{}) (interface{}, error) {
-
-	return types.NewDelimitedBlock(types.Comment, content.([]interface{}), nil)
+	return c.withPosition(types.NewDelimitedBlock(types.Comment, content.([]interface{}), nil))
 }
 
 func (p *parser) callonCommentBlock1() (interface{}, error) {
@@ -17846,8 +18480,7 @@ func (p *parser) callonCommentBlockVerbatimContent2() (interface{}, error) {
 }
 
 func (c *current) onSingleLineComment1(content interface{}) (interface{}, error) {
-
-	return types.NewSingleLineComment(content.(string))
+	return c.withPosition(types.NewSingleLineComment(content.(string)))
 }
 
 func (p *parser) callonSingleLineComment1() (interface{}, error) {
@@ -17857,7 +18490,6 @@ func (p *parser) callonSingleLineComment1() (interface{}, error) {
 }
 
 func (c *current) onSingleLineCommentContent1() (interface{}, error) {
-
 	return string(c.text), nil
 }
 
@@ -17868,8 +18500,7 @@ func (p *parser) callonSingleLineCommentContent1() (interface{}, error) {
 }
 
 func (c *current) onParagraphWithHeadingSpaces1(attributes, lines interface{}) (interface{}, error) {
-
-	return types.NewLiteralBlock(types.LiteralBlockWithSpacesOnFirstLine, lines.([]interface{}), attributes)
+	return c.withPosition(types.NewLiteralBlock(types.LiteralBlockWithSpacesOnFirstLine, lines.([]interface{}), attributes))
 }
 
 func (p *parser) callonParagraphWithHeadingSpaces1() (interface{}, error) {
@@ -17879,7 +18510,6 @@ func (p *parser) callonParagraphWithHeadingSpaces1() (interface{}, error) {
 }
 
 func (c *current) onParagraphWithHeadingSpacesLines4() (interface{}, error) {
-
 	return string(c.text), nil
 
 }
@@ -17891,7 +18521,6 @@ func (p *parser) callonParagraphWithHeadingSpacesLines4() (interface{}, error) {
 }
 
 func (c *current) onParagraphWithHeadingSpacesLines18() (interface{}, error) {
-
 	return string(c.text), nil
 
 }
@@ -17903,7 +18532,6 @@ func (p *parser) callonParagraphWithHeadingSpacesLines18() (interface{}, error)
 }
 
 func (c *current) onParagraphWithHeadingSpacesLines13(otherLine interface{}) (interface{}, error) {
-
 	return otherLine, nil // do not include the trailing 'EOL'
 
 }
@@ -17926,8 +18554,7 @@ func (p *parser) callonParagraphWithHeadingSpacesLines1() (interface{}, error) {
 }
 
 func (c *current) onParagraphWithLiteralBlockDelimiter1(attributes, lines interface{}) (interface{}, error) {
-
-	return types.NewLiteralBlock(types.LiteralBlockWithDelimiter, lines.([]interface{}), attributes)
+	return c.withPosition(types.NewLiteralBlock(types.LiteralBlockWithDelimiter, lines.([]interface{}), attributes))
 }
 
 func (p *parser) callonParagraphWithLiteralBlockDelimiter1() (interface{}, error) {
@@ -17937,7 +18564,6 @@ func (p *parser) callonParagraphWithLiteralBlockDelimiter1() (interface{}, error
 }
 
 func (c *current) onParagraphWithLiteralBlockDelimiterLines1(lines interface{}) (interface{}, error) {
-
 	return lines.([]interface{}), nil
 }
 
@@ -17948,7 +18574,6 @@ func (p *parser) callonParagraphWithLiteralBlockDelimiterLines1() (interface{},
 }
 
 func (c *current) onParagraphWithLiteralBlockDelimiterLine4() (interface{}, error) {
-
 	return string(c.text), nil
 
 }
@@ -17971,7 +18596,6 @@ func (p *parser) callonParagraphWithLiteralBlockDelimiterLine1() (interface{}, e
 }
 
 func (c *current) onParagraphWithLiteralAttribute6(attributes interface{}) (bool, error) {
-
 	if attrs, ok := attributes.(types.Attributes); ok {
 		kind, ok := attrs[types.AttrKind]
 		return ok && kind == types.Literal, nil
@@ -17987,8 +18611,7 @@ func (p *parser) callonParagraphWithLiteralAttribute6() (bool, error) {
 }
 
 func (c *current) onParagraphWithLiteralAttribute1(attributes, lines interface{}) (interface{}, error) {
-
-	return types.NewLiteralBlock(types.LiteralBlockWithAttribute, lines.([]interface{}), attributes)
+	return c.withPosition(types.NewLiteralBlock(types.LiteralBlockWithAttribute, lines.([]interface{}), attributes))
 
 }
 
@@ -17999,7 +18622,6 @@ func (p *parser) callonParagraphWithLiteralAttribute1() (interface{}, error) {
 }
 
 func (c *current) onLiteralKind1() (interface{}, error) {
-
 	return string(c.text), nil
 }
 
@@ -18021,7 +18643,6 @@ func (p *parser) callonParagraphWithLiteralAttributeLines1() (interface{}, error
 }
 
 func (c *current) onParagraphWithLiteralAttributeLine6() (interface{}, error) {
-
 	return string(c.text), nil
 }
 
@@ -18032,7 +18653,6 @@ func (p *parser) callonParagraphWithLiteralAttributeLine6() (interface{}, error)
 }
 
 func (c *current) onParagraphWithLiteralAttributeLine1(content interface{}) (interface{}, error) {
-
 	return content.(string), nil // do not include the trailing 'EOL'
 }
 
@@ -18043,8 +18663,7 @@ func (p *parser) callonParagraphWithLiteralAttributeLine1() (interface{}, error)
 }
 
 func (c *current) onIndexTerm1(term interface{}) (interface{}, error) {
-
-	return types.NewIndexTerm(term.([]interface{}))
+	return c.withPosition(types.NewIndexTerm(term.([]interface{})))
 }
 
 func (p *parser) callonIndexTerm1() (interface{}, error) {
@@ -18054,7 +18673,6 @@ func (p *parser) callonIndexTerm1() (interface{}, error) {
 }
 
 func (c *current) onIndexTermContent9() (interface{}, error) {
-
 	return string(c.text), nil
 }
 
@@ -18065,8 +18683,7 @@ func (p *parser) callonIndexTermContent9() (interface{}, error) {
 }
 
 func (c *current) onIndexTermContent1(elements interface{}) (interface{}, error) {
-
-	return types.NewInlineElements(elements.([]interface{}))
+	return c.withPositions(types.NewInlineElements(elements.([]interface{})))
 }
 
 func (p *parser) callonIndexTermContent1() (interface{}, error) {
@@ -18096,8 +18713,7 @@ func (p *parser) callonConcealedIndexTerm19() (interface{}, error) {
 }
 
 func (c *current) onConcealedIndexTerm1(term1, term2, term3 interface{}) (interface{}, error) {
-
-	return types.NewConcealedIndexTerm(term1, term2, term3)
+	return c.withPosition(types.NewConcealedIndexTerm(term1, term2, term3))
 
 }
 
@@ -18108,7 +18724,6 @@ func (p *parser) callonConcealedIndexTerm1() (interface{}, error) {
 }
 
 func (c *current) onConcealedIndexTermContent1() (interface{}, error) {
-
 	return string(c.text), nil
 }
 
@@ -18119,8 +18734,7 @@ func (p *parser) callonConcealedIndexTermContent1() (interface{}, error) {
 }
 
 func (c *current) onBlankLine1() (interface{}, error) {
-
-	return types.NewBlankLine()
+	return c.withPosition(types.NewBlankLine())
 }
 
 func (p *parser) callonBlankLine1() (interface{}, error) {
@@ -18130,7 +18744,6 @@ func (p *parser) callonBlankLine1() (interface{}, error) {
 }
 
 func (c *current) onAlphanums1() (interface{}, error) {
-
 	return string(c.text), nil
 }
 
@@ -18142,7 +18755,7 @@ func (p *parser) callonAlphanums1() (interface{}, error) {
 
 func (c *current) onWord2() (interface{}, error) {
 
-	return types.NewStringElement(string(c.text))
+	return c.withPosition(types.NewStringElement(string(c.text)))
 
 }
 
@@ -18154,7 +18767,7 @@ func (p *parser) callonWord2() (interface{}, error) {
 
 func (c *current) onWord10() (interface{}, error) {
 
-	return types.NewStringElement(string(c.text))
+	return c.withPosition(types.NewStringElement(string(c.text)))
 
 }
 
@@ -18166,7 +18779,7 @@ func (p *parser) callonWord10() (interface{}, error) {
 
 func (c *current) onInlineWord2() (interface{}, error) {
 
-	return types.NewStringElement(string(c.text))
+	return c.withPosition(types.NewStringElement(string(c.text)))
 
 }
 
@@ -18178,7 +18791,7 @@ func (p *parser) callonInlineWord2() (interface{}, error) {
 
 func (c *current) onAnyChar1() (interface{}, error) {
 
-	return types.NewStringElement(string(c.text))
+	return c.withPosition(types.NewStringElement(string(c.text)))
 }
 
 func (p *parser) callonAnyChar1() (interface{}, error) {
@@ -18188,7 +18801,6 @@ func (p *parser) callonAnyChar1() (interface{}, error) {
 }
 
 func (c *current) onFileLocation1(path interface{}) (interface{}, error) {
-
 	return types.NewLocation("", path.([]interface{}))
 }
 
@@ -18199,7 +18811,6 @@ func (p *parser) callonFileLocation1() (interface{}, error) {
 }
 
 func (c *current) onResolvedFileLocation1(path interface{}) (interface{}, error) {
-
 	return types.NewLocation("", path.([]interface{}))
 }
 
@@ -18210,7 +18821,6 @@ func (p *parser) callonResolvedFileLocation1() (interface{}, error) {
 }
 
 func (c *current) onLocation1(scheme, path interface{}) (interface{}, error) {
-
 	return types.NewLocation(scheme, path.([]interface{}))
 }
 
@@ -18221,7 +18831,6 @@ func (p *parser) callonLocation1() (interface{}, error) {
 }
 
 func (c *current) onLocationWithScheme1(scheme, path interface{}) (interface{}, error) {
-
 	return types.NewLocation(scheme, path.([]interface{}))
 }
 
@@ -18232,7 +18841,6 @@ func (p *parser) callonLocationWithScheme1() (interface{}, error) {
 }
 
 func (c *current) onResolvedLocation1(scheme, path interface{}) (interface{}, error) {
-
 	return types.NewLocation(scheme, path.([]interface{}))
 }
 
@@ -18243,7 +18851,6 @@ func (p *parser) callonResolvedLocation1() (interface{}, error) {
 }
 
 func (c *current) onURL1() (interface{}, error) {
-
 	return string(c.text), nil
 }
 
@@ -18265,7 +18872,6 @@ func (p *parser) callonID1() (interface{}, error) {
 }
 
 func (c *current) onDIGIT1() (interface{}, error) {
-
 	return string(c.text), nil
 }
 
@@ -18276,7 +18882,6 @@ func (p *parser) callonDIGIT1() (interface{}, error) {
 }
 
 func (c *current) onNUMBER1() (interface{}, error) {
-
 	return strconv.Atoi(string(c.text))
 }
 
@@ -18287,7 +18892,6 @@ func (p *parser) callonNUMBER1() (interface{}, error) {
 }
 
 func (c *current) onSpace3() (interface{}, error) {
-
 	return string(c.text), nil
 }
 
@@ -18349,6 +18953,62 @@ func Entrypoint(ruleName string) Option {
 	}
 }
 
+// Statistics adds a user provided Stats struct to the parser to allow
+// the user to process the results after the parsing has finished.
+// Also the key for the "no match" counter is set.
+//
+// Example usage:
+//
+//	input := "input"
+//	stats := Stats{}
+//	_, err := Parse("input-file", []byte(input), Statistics(&stats, "no match"))
+//	if err != nil {
+//	    log.Panicln(err)
+//	}
+//	b, err := json.MarshalIndent(stats.ChoiceAltCnt, "", "  ")
+//	if err != nil {
+//	    log.Panicln(err)
+//	}
+//	fmt.Println(string(b))
+func Statistics(stats *Stats, choiceNoMatch string) Option {
+	return func(p *parser) Option {
+		oldStats := p.Stats
+		p.Stats = stats
+		oldChoiceNoMatch := p.choiceNoMatch
+		p.choiceNoMatch = choiceNoMatch
+		if p.Stats.ChoiceAltCnt == nil {
+			p.Stats.ChoiceAltCnt = make(map[string]map[string]int)
+		}
+		return Statistics(oldStats, oldChoiceNoMatch)
+	}
+}
+
+// Debug creates an Option to set the debug flag to b. When set to true,
+// debugging information is printed to stdout while parsing.
+//
+// The default is false.
+func Debug(b bool) Option {
+	return func(p *parser) Option {
+		old := p.debug
+		p.debug = b
+		return Debug(old)
+	}
+}
+
+// Memoize creates an Option to set the memoize flag to b. When set to true,
+// the parser will cache all results so each expression is evaluated only
+// once. This guarantees linear parsing time even for pathological cases,
+// at the expense of more memory and slower times for typical cases.
+//
+// The default is false.
+func Memoize(b bool) Option {
+	return func(p *parser) Option {
+		old := p.memoize
+		p.memoize = b
+		return Memoize(old)
+	}
+}
+
 // AllowInvalidUTF8 creates an Option to allow invalid UTF-8 bytes.
 // Every invalid UTF-8 byte is treated as a utf8.RuneError (U+FFFD)
 // by character class matchers and is matched by the any matcher.
@@ -18387,6 +19047,16 @@ func GlobalStore(key string, value interface{}) Option {
 	}
 }
 
+// InitState creates an Option to set a key to a certain value in
+// the global "state" store.
+func InitState(key string, value interface{}) Option {
+	return func(p *parser) Option {
+		old := p.cur.state[key]
+		p.cur.state[key] = value
+		return InitState(key, old)
+	}
+}
+
 // ParseFile parses the file identified by filename.
 func ParseFile(filename string, opts ...Option) (i interface{}, err error) {
 	f, err := os.Open(filename)
@@ -18439,6 +19109,11 @@ type current struct {
 	pos  position // start position of the match
 	text []byte   // raw text of the match
 
+	// state is a store for arbitrary key,value pairs that the user wants to be
+	// tied to the backtracking of the parser.
+	// This is always rolled back if a parsing rule fails.
+	state storeDict
+
 	// globalStore is a general store for the user to store arbitrary key-value
 	// pairs that they need to manage and that they do not want tied to the
 	// backtracking of the parser. This is only modified by the user and never
@@ -18513,6 +19188,11 @@ type ruleRefExpr struct {
 	name string
 }
 
+type stateCodeExpr struct {
+	pos position
+	run func(*parser) error
+}
+
 type andCodeExpr struct {
 	pos position
 	run func(*parser) (bool, error)
@@ -18616,6 +19296,7 @@ func newParser(filename string, b []byte, opts ...Option) *parser {
 		pt:       savepoint{position: position{line: 1}},
 		recover:  true,
 		cur: current{
+			state:       make(storeDict),
 			globalStore: make(storeDict),
 		},
 		maxFailPos:      position{col: 1, line: 1},
@@ -18680,6 +19361,12 @@ type parser struct {
 
 	depth   int
 	recover bool
+	debug   bool
+
+	memoize bool
+	// memoization table for the packrat algorithm:
+	// map[offset in source] map[expression or rule] {value, match}
+	memo map[int]map[interface{}]resultTuple
 
 	// rules table, maps the rule identifier to the rule node
 	rules map[string]*rule
@@ -18764,6 +19451,26 @@ func (p *parser) popRecovery() {
 	p.recoveryStack = p.recoveryStack[:len(p.recoveryStack)-1]
 }
 
+func (p *parser) print(prefix, s string) string {
+	if !p.debug {
+		return s
+	}
+
+	fmt.Printf("%s %d:%d:%d: %s [%#U]\n",
+		prefix, p.pt.line, p.pt.col, p.pt.offset, s, p.pt.rn)
+	return s
+}
+
+func (p *parser) in(s string) string {
+	p.depth++
+	return p.print(strings.Repeat(" ", p.depth)+">", s)
+}
+
+func (p *parser) out(s string) string {
+	p.depth--
+	return p.print(strings.Repeat(" ", p.depth)+"<", s)
+}
+
 func (p *parser) addErr(err error) {
 	p.addErrAt(err, p.pt.position, []string{})
 }
@@ -18832,17 +19539,93 @@ func (p *parser) read() {
 
 // restore parser position to the savepoint pt.
 func (p *parser) restore(pt savepoint) {
+	if p.debug {
+		defer p.out(p.in("restore"))
+	}
 	if pt.offset == p.pt.offset {
 		return
 	}
 	p.pt = pt
 }
 
+// Cloner is implemented by any value that has a Clone method, which returns a
+// copy of the value. This is mainly used for types which are not passed by
+// value (e.g map, slice, chan) or structs that contain such types.
+//
+// This is used in conjunction with the global state feature to create proper
+// copies of the state to allow the parser to properly restore the state in
+// the case of backtracking.
+type Cloner interface {
+	Clone() interface{}
+}
+
+var statePool = &sync.Pool{
+	New: func() interface{} { return make(storeDict) },
+}
+
+func (sd storeDict) Discard() {
+	for k := range sd {
+		delete(sd, k)
+	}
+	statePool.Put(sd)
+}
+
+// clone and return parser current state.
+func (p *parser) cloneState() storeDict {
+	if p.debug {
+		defer p.out(p.in("cloneState"))
+	}
+
+	state := statePool.Get().(storeDict)
+	for k, v := range p.cur.state {
+		if c, ok := v.(Cloner); ok {
+			state[k] = c.Clone()
+		} else {
+			state[k] = v
+		}
+	}
+	return state
+}
+
+// restore parser current state to the state storeDict.
+// every restoreState should applied only one time for every cloned state
+func (p *parser) restoreState(state storeDict) {
+	if p.debug {
+		defer p.out(p.in("restoreState"))
+	}
+	p.cur.state.Discard()
+	p.cur.state = state
+}
+
 // get the slice of bytes from the savepoint start to the current position.
 func (p *parser) sliceFrom(start savepoint) []byte {
 	return p.data[start.position.offset:p.pt.position.offset]
 }
 
+func (p *parser) getMemoized(node interface{}) (resultTuple, bool) {
+	if len(p.memo) == 0 {
+		return resultTuple{}, false
+	}
+	m := p.memo[p.pt.offset]
+	if len(m) == 0 {
+		return resultTuple{}, false
+	}
+	res, ok := m[node]
+	return res, ok
+}
+
+func (p *parser) setMemoized(pt savepoint, node interface{}, tuple resultTuple) {
+	if p.memo == nil {
+		p.memo = make(map[int]map[interface{}]resultTuple)
+	}
+	m := p.memo[pt.offset]
+	if m == nil {
+		m = make(map[interface{}]resultTuple)
+		p.memo[pt.offset] = m
+	}
+	m[node] = tuple
+}
+
 func (p *parser) buildRulesTable(g *grammar) {
 	p.rules = make(map[string]*rule, len(g.rules))
 	for _, r := range g.rules {
@@ -18864,6 +19647,9 @@ func (p *parser) parse(g *grammar) (val interface{}, err error) {
 		// and return the panic as an error.
 		defer func() {
 			if e := recover(); e != nil {
+				if p.debug {
+					defer p.out(p.in("panic handler"))
+				}
 				val = nil
 				switch e := e.(type) {
 				case error:
@@ -18925,15 +19711,45 @@ func listJoin(list []string, sep string, lastSep string) string {
 }
 
 func (p *parser) parseRule(rule *rule) (interface{}, bool) {
+	if p.debug {
+		defer p.out(p.in("parseRule " + rule.name))
+	}
+
+	if p.memoize {
+		res, ok := p.getMemoized(rule)
+		if ok {
+			p.restore(res.end)
+			return res.v, res.b
+		}
+	}
+
+	start := p.pt
 	p.rstack = append(p.rstack, rule)
 	p.pushV()
 	val, ok := p.parseExpr(rule.expr)
 	p.popV()
 	p.rstack = p.rstack[:len(p.rstack)-1]
+	if ok && p.debug {
+		p.print(strings.Repeat(" ", p.depth)+"MATCH", string(p.sliceFrom(start)))
+	}
+
+	if p.memoize {
+		p.setMemoized(start, rule, resultTuple{val, ok, p.pt})
+	}
 	return val, ok
 }
 
 func (p *parser) parseExpr(expr interface{}) (interface{}, bool) {
+	var pt savepoint
+
+	if p.memoize {
+		res, ok := p.getMemoized(expr)
+		if ok {
+			p.restore(res.end)
+			return res.v, res.b
+		}
+		pt = p.pt
+	}
 
 	p.ExprCnt++
 	if p.ExprCnt > p.maxExprCnt {
@@ -18971,6 +19787,8 @@ func (p *parser) parseExpr(expr interface{}) (interface{}, bool) {
 		val, ok = p.parseRuleRefExpr(expr)
 	case *seqExpr:
 		val, ok = p.parseSeqExpr(expr)
+	case *stateCodeExpr:
+		val, ok = p.parseStateCodeExpr(expr)
 	case *throwExpr:
 		val, ok = p.parseThrowExpr(expr)
 	case *zeroOrMoreExpr:
@@ -18980,46 +19798,74 @@ func (p *parser) parseExpr(expr interface{}) (interface{}, bool) {
 	default:
 		panic(fmt.Sprintf("unknown expression type %T", expr))
 	}
+	if p.memoize {
+		p.setMemoized(pt, expr, resultTuple{val, ok, p.pt})
+	}
 	return val, ok
 }
 
 func (p *parser) parseActionExpr(act *actionExpr) (interface{}, bool) {
+	if p.debug {
+		defer p.out(p.in("parseActionExpr"))
+	}
+
 	start := p.pt
 	val, ok := p.parseExpr(act.expr)
 	if ok {
 		p.cur.pos = start.position
 		p.cur.text = p.sliceFrom(start)
+		state := p.cloneState()
 		actVal, err := act.run(p)
 		if err != nil {
 			p.addErrAt(err, start.position, []string{})
 		}
+		p.restoreState(state)
 
 		val = actVal
 	}
+	if ok && p.debug {
+		p.print(strings.Repeat(" ", p.depth)+"MATCH", string(p.sliceFrom(start)))
+	}
 	return val, ok
 }
 
 func (p *parser) parseAndCodeExpr(and *andCodeExpr) (interface{}, bool) {
+	if p.debug {
+		defer p.out(p.in("parseAndCodeExpr"))
+	}
+
+	state := p.cloneState()
 
 	ok, err := and.run(p)
 	if err != nil {
 		p.addErr(err)
 	}
+	p.restoreState(state)
 
 	return nil, ok
 }
 
 func (p *parser) parseAndExpr(and *andExpr) (interface{}, bool) {
+	if p.debug {
+		defer p.out(p.in("parseAndExpr"))
+	}
+
 	pt := p.pt
+	state := p.cloneState()
 	p.pushV()
 	_, ok := p.parseExpr(and.expr)
 	p.popV()
+	p.restoreState(state)
 	p.restore(pt)
 
 	return nil, ok
 }
 
 func (p *parser) parseAnyMatcher(any *anyMatcher) (interface{}, bool) {
+	if p.debug {
+		defer p.out(p.in("parseAnyMatcher"))
+	}
+
 	if p.pt.rn == utf8.RuneError && p.pt.w == 0 {
 		// EOF - see utf8.DecodeRune
 		p.failAt(false, p.pt.position, ".")
@@ -19032,6 +19878,10 @@ func (p *parser) parseAnyMatcher(any *anyMatcher) (interface{}, bool) {
 }
 
 func (p *parser) parseCharClassMatcher(chr *charClassMatcher) (interface{}, bool) {
+	if p.debug {
+		defer p.out(p.in("parseCharClassMatcher"))
+	}
+
 	cur := p.pt.rn
 	start := p.pt
 
@@ -19093,22 +19943,50 @@ func (p *parser) parseCharClassMatcher(chr *charClassMatcher) (interface{}, bool
 	return nil, false
 }
 
+func (p *parser) incChoiceAltCnt(ch *choiceExpr, altI int) {
+	choiceIdent := fmt.Sprintf("%s %d:%d", p.rstack[len(p.rstack)-1].name, ch.pos.line, ch.pos.col)
+	m := p.ChoiceAltCnt[choiceIdent]
+	if m == nil {
+		m = make(map[string]int)
+		p.ChoiceAltCnt[choiceIdent] = m
+	}
+	// We increment altI by 1, so the keys do not start at 0
+	alt := strconv.Itoa(altI + 1)
+	if altI == choiceNoMatch {
+		alt = p.choiceNoMatch
+	}
+	m[alt]++
+}
+
 func (p *parser) parseChoiceExpr(ch *choiceExpr) (interface{}, bool) {
+	if p.debug {
+		defer p.out(p.in("parseChoiceExpr"))
+	}
+
 	for altI, alt := range ch.alternatives {
 		// dummy assignment to prevent compile error if optimized
 		_ = altI
 
+		state := p.cloneState()
+
 		p.pushV()
 		val, ok := p.parseExpr(alt)
 		p.popV()
 		if ok {
+			p.incChoiceAltCnt(ch, altI)
 			return val, ok
 		}
+		p.restoreState(state)
 	}
+	p.incChoiceAltCnt(ch, choiceNoMatch)
 	return nil, false
 }
 
 func (p *parser) parseLabeledExpr(lab *labeledExpr) (interface{}, bool) {
+	if p.debug {
+		defer p.out(p.in("parseLabeledExpr"))
+	}
+
 	p.pushV()
 	val, ok := p.parseExpr(lab.expr)
 	p.popV()
@@ -19120,6 +19998,10 @@ func (p *parser) parseLabeledExpr(lab *labeledExpr) (interface{}, bool) {
 }
 
 func (p *parser) parseLitMatcher(lit *litMatcher) (interface{}, bool) {
+	if p.debug {
+		defer p.out(p.in("parseLitMatcher"))
+	}
+
 	start := p.pt
 	for _, want := range lit.val {
 		cur := p.pt.rn
@@ -19138,27 +20020,44 @@ func (p *parser) parseLitMatcher(lit *litMatcher) (interface{}, bool) {
 }
 
 func (p *parser) parseNotCodeExpr(not *notCodeExpr) (interface{}, bool) {
+	if p.debug {
+		defer p.out(p.in("parseNotCodeExpr"))
+	}
+
+	state := p.cloneState()
+
 	ok, err := not.run(p)
 	if err != nil {
 		p.addErr(err)
 	}
+	p.restoreState(state)
 
 	return nil, !ok
 }
 
 func (p *parser) parseNotExpr(not *notExpr) (interface{}, bool) {
+	if p.debug {
+		defer p.out(p.in("parseNotExpr"))
+	}
+
 	pt := p.pt
+	state := p.cloneState()
 	p.pushV()
 	p.maxFailInvertExpected = !p.maxFailInvertExpected
 	_, ok := p.parseExpr(not.expr)
 	p.maxFailInvertExpected = !p.maxFailInvertExpected
 	p.popV()
+	p.restoreState(state)
 	p.restore(pt)
 
 	return nil, !ok
 }
 
 func (p *parser) parseOneOrMoreExpr(expr *oneOrMoreExpr) (interface{}, bool) {
+	if p.debug {
+		defer p.out(p.in("parseOneOrMoreExpr"))
+	}
+
 	var vals []interface{}
 
 	for {
@@ -19177,6 +20076,9 @@ func (p *parser) parseOneOrMoreExpr(expr *oneOrMoreExpr) (interface{}, bool) {
 }
 
 func (p *parser) parseRecoveryExpr(recover *recoveryExpr) (interface{}, bool) {
+	if p.debug {
+		defer p.out(p.in("parseRecoveryExpr (" + strings.Join(recover.failureLabel, ",") + ")"))
+	}
 
 	p.pushRecovery(recover.failureLabel, recover.recoverExpr)
 	val, ok := p.parseExpr(recover.expr)
@@ -19186,6 +20088,10 @@ func (p *parser) parseRecoveryExpr(recover *recoveryExpr) (interface{}, bool) {
 }
 
 func (p *parser) parseRuleRefExpr(ref *ruleRefExpr) (interface{}, bool) {
+	if p.debug {
+		defer p.out(p.in("parseRuleRefExpr " + ref.name))
+	}
+
 	if ref.name == "" {
 		panic(fmt.Sprintf("%s: invalid rule: missing name", ref.pos))
 	}
@@ -19199,12 +20105,18 @@ func (p *parser) parseRuleRefExpr(ref *ruleRefExpr) (interface{}, bool) {
 }
 
 func (p *parser) parseSeqExpr(seq *seqExpr) (interface{}, bool) {
+	if p.debug {
+		defer p.out(p.in("parseSeqExpr"))
+	}
+
 	vals := make([]interface{}, 0, len(seq.exprs))
 
 	pt := p.pt
+	state := p.cloneState()
 	for _, expr := range seq.exprs {
 		val, ok := p.parseExpr(expr)
 		if !ok {
+			p.restoreState(state)
 			p.restore(pt)
 			return nil, false
 		}
@@ -19213,7 +20125,22 @@ func (p *parser) parseSeqExpr(seq *seqExpr) (interface{}, bool) {
 	return vals, true
 }
 
+func (p *parser) parseStateCodeExpr(state *stateCodeExpr) (interface{}, bool) {
+	if p.debug {
+		defer p.out(p.in("parseStateCodeExpr"))
+	}
+
+	err := state.run(p)
+	if err != nil {
+		p.addErr(err)
+	}
+	return nil, true
+}
+
 func (p *parser) parseThrowExpr(expr *throwExpr) (interface{}, bool) {
+	if p.debug {
+		defer p.out(p.in("parseThrowExpr"))
+	}
 
 	for i := len(p.recoveryStack) - 1; i >= 0; i-- {
 		if recoverExpr, ok := p.recoveryStack[i][expr.label]; ok {
@@ -19227,6 +20154,10 @@ func (p *parser) parseThrowExpr(expr *throwExpr) (interface{}, bool) {
 }
 
 func (p *parser) parseZeroOrMoreExpr(expr *zeroOrMoreExpr) (interface{}, bool) {
+	if p.debug {
+		defer p.out(p.in("parseZeroOrMoreExpr"))
+	}
+
 	var vals []interface{}
 
 	for {
@@ -19241,6 +20172,10 @@ func (p *parser) parseZeroOrMoreExpr(expr *zeroOrMoreExpr) (interface{}, bool) {
 }
 
 func (p *parser) parseZeroOrOneExpr(expr *zeroOrOneExpr) (interface{}, bool) {
+	if p.debug {
+		defer p.out(p.in("parseZeroOrOneExpr"))
+	}
+
 	p.pushV()
 	val, _ := p.parseExpr(expr.expr)
 	p.popV()
@@ -0,0 +1,255 @@
+package parser
+
+import (
+	"bufio"
+	"io"
+	"path"
+	"path/filepath"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// PreprocessedLine a line of a document once the preprocessing directives have been applied,
+// along with its location in the file from which it was read
+type PreprocessedLine struct {
+	Content  string
+	Location types.SourceLocation
+}
+
+// Preprocess reads the given document line by line and replaces the `include::` directives with the lines
+// of the files to include (recursively), before any block is parsed. As in Asciidoctor, the included lines
+// are spliced in the document, so the result is the same as if their content had been written in the document.
+// Each line retains the file and the line number from which it was read.
+func Preprocess(r io.Reader, config configuration.Configuration) ([]PreprocessedLine, error) {
+	lines, err := readLines(r, config.Filename)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to read '%s'", config.Filename)
+	}
+	if config.SafeMode >= configuration.Safe && config.BaseDir == "" {
+		// the base directory is the directory of the main document, so it is resolved once for all
+		// the nested inclusions
+		if config.FS != nil {
+			config.BaseDir = path.Dir(filepath.ToSlash(config.Filename))
+		} else {
+			baseDir, err := filepath.Abs(filepath.Dir(config.Filename))
+			if err != nil {
+				return nil, errors.Wrap(err, "unable to resolve base directory")
+			}
+			config.BaseDir = baseDir
+		}
+	}
+	p := &preprocessor{
+		attrs:      types.NewAttributesWithOverrides(config.AttributeOverrides),
+		delimiters: []string{},
+		result:     make([]PreprocessedLine, 0, len(lines)),
+	}
+	err = p.process(lines, &includeFrame{
+		config:       config,
+		chain:        newIncludeChain().withMainDocument(config),
+		levelOffsets: []levelOffset{},
+		asciidoc:     true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return p.result, nil
+}
+
+// preprocessor the state of the preprocessing, which spans over the main document and the included files
+type preprocessor struct {
+	attrs      types.AttributesWithOverrides // the attributes declared so far, which may be used in the location of the files to include
+	delimiters []string                      // the delimiters of the blocks which contain the current line
+	result     []PreprocessedLine
+}
+
+// includeFrame the context in which the lines of the main document or of a file to include are processed
+type includeFrame struct {
+	config       configuration.Configuration // the configuration, in which `Filename` is the document being processed
+	chain        includeChain
+	levelOffsets []levelOffset
+	asciidoc     bool
+}
+
+// process appends the given lines to the result, after replacing the `include::` directives
+func (p *preprocessor) process(lines []PreprocessedLine, frame *includeFrame) error {
+	for _, l := range lines {
+		// the non-asciidoc files are included as-is
+		if !frame.asciidoc || !strings.HasPrefix(l.Content, "include::") {
+			p.append(l, frame)
+			continue
+		}
+		incl, err := Parse(l.Location.Filename, []byte(l.Content), Entrypoint("FileInclusion"))
+		if err != nil {
+			// not a valid directive, so the line is retained as-is
+			log.Debugf("invalid file inclusion directive: '%s'", l.Content)
+			p.append(l, frame)
+			continue
+		}
+		f, ok := incl.(types.FileInclusion)
+		if !ok {
+			return errors.Errorf("unexpected type of file inclusion: %T", incl)
+		}
+		f.Line = l.Location.Line
+		f.Position = linePosition(l)
+		err = p.include(f, frame)
+		if errr, ok := err.(FileInclusionError); ok {
+			// do not fail but replace the directive with the error message
+			log.Errorf("failed to include content of '%s' in %s", f.Location, errr.Trace())
+			frame.config.Diagnostics.Errorf(types.UnresolvedInclude, f.Position, "%s", errr.Reason)
+			// as in Asciidoctor, the message is spliced in the document (eg: in the surrounding paragraph)
+			p.append(PreprocessedLine{
+				Content:  errr.Error(),
+				Location: l.Location,
+			}, frame)
+		} else if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// linePosition returns the position of the whole given line
+func linePosition(l PreprocessedLine) types.Position {
+	start := l.Location
+	if start.Column == 0 {
+		start.Column = 1
+	}
+	end := start
+	end.Column += utf8.RuneCountInString(l.Content)
+	return types.Position{
+		Start: start,
+		End:   end,
+	}
+}
+
+// append appends the given line to the result, while keeping track of the delimited blocks and of the
+// attribute declarations. Also, applies the level offsets on the section titles of the included files
+func (p *preprocessor) append(l PreprocessedLine, frame *includeFrame) {
+	if d, ok := blockDelimiter(l.Content); ok {
+		p.trackDelimiter(d)
+	} else if len(p.delimiters) == 0 {
+		if strings.HasPrefix(l.Content, ":") {
+			// may be needed if there's an attribute substitution in the path of a file to include
+			if d, err := Parse(l.Location.Filename, []byte(l.Content), Entrypoint("AttributeDeclaration")); err == nil {
+				if d, ok := d.(types.AttributeDeclaration); ok {
+					p.attrs.Set(d.Name, d.Value)
+				}
+			}
+		} else if frame.asciidoc && len(frame.levelOffsets) > 0 && p.atBlockBoundary() {
+			l.Content = frame.offsetSectionTitle(l.Content)
+		}
+	}
+	p.result = append(p.result, l)
+}
+
+// delimiters of the blocks, and whether their content is verbatim (ie, it may not contain other delimited blocks)
+var blockDelimiters = map[string]bool{
+	"```":  true,  // fenced
+	"----": true,  // listing
+	"....": true,  // literal
+	"++++": true,  // passthrough
+	"////": true,  // comment
+	"====": false, // example
+	"****": false, // sidebar
+	"____": false, // quote and verse
+	"|===": false, // table
+	"--":   false, // open
+}
+
+// blockDelimiter returns the delimiter of a block if the given line is a block delimiter
+func blockDelimiter(line string) (string, bool) {
+	d := strings.TrimRight(line, " \t")
+	_, ok := blockDelimiters[d]
+	return d, ok
+}
+
+// trackDelimiter opens or closes a block with the given delimiter, unless the current block is verbatim
+func (p *preprocessor) trackDelimiter(d string) {
+	if n := len(p.delimiters); n > 0 {
+		if p.delimiters[n-1] == d {
+			p.delimiters = p.delimiters[:n-1]
+			return
+		}
+		if blockDelimiters[p.delimiters[n-1]] {
+			// within a verbatim block
+			return
+		}
+	}
+	p.delimiters = append(p.delimiters, d)
+}
+
+// atBlockBoundary returns true if the next line can be the start of a new block,
+// ie, if it is the first line, or if it follows a blank line or a block attributes line
+func (p *preprocessor) atBlockBoundary() bool {
+	if len(p.result) == 0 {
+		return true
+	}
+	previous := strings.TrimSpace(p.result[len(p.result)-1].Content)
+	return previous == "" ||
+		(strings.HasPrefix(previous, "[") && strings.HasSuffix(previous, "]")) ||
+		(strings.HasPrefix(previous, ".") && len(previous) > 1 && previous[1] != '.' && previous[1] != ' ')
+}
+
+// offsetSectionTitle applies the level offsets on the given line if it is a section title
+func (f *includeFrame) offsetSectionTitle(line string) string {
+	markers := len(line) - len(strings.TrimLeft(line, "="))
+	// `=` (level 0) to `======` (level 5), followed by spaces and the title
+	if markers == 0 || markers > 6 || len(line) == markers || (line[markers] != ' ' && line[markers] != '\t') || strings.TrimSpace(line[markers:]) == "" {
+		return line
+	}
+	level := markers - 1
+	for _, offset := range f.levelOffsets {
+		oldLevel := level
+		level = offset.apply(level)
+		// replace the absolute when the first section is processed with a relative offset
+		// which is based on the actual level offset that resulted in the application of the absolute offset
+		if offset.absolute {
+			f.levelOffsets = []levelOffset{
+				relativeOffset(level - oldLevel),
+			}
+		}
+	}
+	if level < 0 {
+		level = 0
+	}
+	return strings.Repeat("=", level+1) + line[markers:]
+}
+
+// readLines reads all the lines of the given file, without their line terminators
+func readLines(r io.Reader, filename string) ([]PreprocessedLine, error) {
+	lines := []PreprocessedLine{}
+	reader := bufio.NewReader(r)
+	for {
+		l, err := reader.ReadString('\n')
+		if len(l) > 0 {
+			lines = append(lines, PreprocessedLine{
+				Content: strings.TrimSuffix(strings.TrimSuffix(l, "\n"), "\r"),
+				Location: types.SourceLocation{
+					Filename: filename,
+					Line:     len(lines) + 1,
+				},
+			})
+		}
+		if err == io.EOF {
+			return lines, nil
+		} else if err != nil {
+			return nil, err
+		}
+	}
+}
+
+// joinLines returns the content of the given lines, with a line terminator after each line
+func joinLines(lines []PreprocessedLine) io.Reader {
+	result := &strings.Builder{}
+	for _, l := range lines {
+		result.WriteString(l.Content)
+		result.WriteString("\n")
+	}
+	return strings.NewReader(result.String())
+}
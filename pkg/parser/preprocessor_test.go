@@ -0,0 +1,168 @@
+package parser_test
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+	"github.com/bytesparadise/libasciidoc/pkg/parser"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo" //nolint golint
+	. "github.com/onsi/gomega" //nolint golint
+)
+
+var _ = Describe("preprocessing", func() {
+
+	preprocess := func(source string) ([]parser.PreprocessedLine, error) {
+		return parser.Preprocess(strings.NewReader(source), configuration.NewConfiguration(configuration.WithFilename("test.adoc")))
+	}
+
+	Context("line locations", func() {
+
+		It("should retain the location of the lines of the document", func() {
+			source := "first line\r\n\r\nsecond line"
+			Expect(preprocess(source)).To(Equal([]parser.PreprocessedLine{
+				{
+					Content:  "first line",
+					Location: types.SourceLocation{Filename: "test.adoc", Line: 1},
+				},
+				{
+					Content:  "",
+					Location: types.SourceLocation{Filename: "test.adoc", Line: 2},
+				},
+				{
+					Content:  "second line",
+					Location: types.SourceLocation{Filename: "test.adoc", Line: 3},
+				},
+			}))
+		})
+
+		It("should retain the location of the included lines", func() {
+			source := `first line
+include::../../test/includes/grandchild-include.adoc[lines=3..5]
+last line`
+			grandchild, err := filepath.Abs("../../test/includes/grandchild-include.adoc")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(preprocess(source)).To(Equal([]parser.PreprocessedLine{
+				{
+					Content:  "first line",
+					Location: types.SourceLocation{Filename: "test.adoc", Line: 1},
+				},
+				{
+					Content:  "first line of grandchild",
+					Location: types.SourceLocation{Filename: grandchild, Line: 3},
+				},
+				{
+					Content:  "",
+					Location: types.SourceLocation{Filename: grandchild, Line: 4},
+				},
+				{
+					Content:  "last line of grandchild",
+					Location: types.SourceLocation{Filename: grandchild, Line: 5},
+				},
+				{
+					Content:  "last line",
+					Location: types.SourceLocation{Filename: "test.adoc", Line: 3},
+				},
+			}))
+		})
+
+		It("should retain the location of the unresolved directive", func() {
+			source := `first line
+include::../../test/includes/unknown.adoc[]`
+			Expect(preprocess(source)).To(Equal([]parser.PreprocessedLine{
+				{
+					Content:  "first line",
+					Location: types.SourceLocation{Filename: "test.adoc", Line: 1},
+				},
+				{
+					Content:  "Unresolved directive in test.adoc - include::../../test/includes/unknown.adoc[]",
+					Location: types.SourceLocation{Filename: "test.adoc", Line: 2},
+				},
+			}))
+		})
+	})
+
+	Context("spliced lines", func() {
+
+		It("should join included line with next line of document", func() {
+			source := `include::../../test/includes/paragraph.adoc[]
+next line`
+			expected, err := ParseDraftDocument(`a paragraph
+next line`)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ParseDraftDocument(source)).To(MatchDraftDocument(expected.(types.DraftDocument)))
+		})
+
+		It("should retain blank line at end of included file", func() {
+			source := `include::../../test/includes/paragraph-with-blank-line.adoc[]
+next line`
+			expected, err := ParseDraftDocument(`a paragraph
+
+next line`)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ParseDraftDocument(source)).To(MatchDraftDocument(expected.(types.DraftDocument)))
+		})
+
+		It("should include lines in the middle of a paragraph", func() {
+			source := `first line
+include::../../test/includes/paragraph.adoc[]
+last line`
+			expected, err := ParseDraftDocument(`first line
+a paragraph
+last line`)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ParseDraftDocument(source)).To(MatchDraftDocument(expected.(types.DraftDocument)))
+		})
+
+		It("should not apply level offset on lines of listing block", func() {
+			source := `include::../../test/includes/section-with-listing.adoc[leveloffset=+1]`
+			expected, err := ParseDraftDocument(`=== section
+
+----
+== not a section
+----`)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ParseDraftDocument(source)).To(MatchDraftDocument(expected.(types.DraftDocument)))
+		})
+
+		It("should include lines in an open block", func() {
+			source := `--
+include::../../test/includes/paragraph.adoc[]
+--`
+			expected, err := ParseDraftDocument(`--
+a paragraph
+--`)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ParseDraftDocument(source)).To(MatchDraftDocument(expected.(types.DraftDocument)))
+		})
+
+		It("should not apply level offset on lines of an open block", func() {
+			source := `--
+a paragraph
+
+include::../../test/includes/section-with-listing.adoc[leveloffset=+1]
+--`
+			lines, err := preprocess(source)
+			Expect(err).NotTo(HaveOccurred())
+			contents := make([]string, len(lines))
+			for i, l := range lines {
+				contents[i] = l.Content
+			}
+			// a section title is not allowed in a delimited block, so the line is retained as-is
+			Expect(contents).To(Equal([]string{
+				"--",
+				"a paragraph",
+				"",
+				"== section",
+				"",
+				"----",
+				"== not a section",
+				"----",
+				"--",
+			}))
+		})
+	})
+})
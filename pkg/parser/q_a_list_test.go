@@ -22,7 +22,8 @@ What is the answer to the Ultimate Question?:: 42`
 				types.LabeledList{
 					Attributes: types.Attributes{
 						types.AttrTitle: "Q&A",
-						types.AttrQandA: nil,
+						types.AttrStyle: types.AttrQandA,
+						"positional-1":  types.AttrQandA,
 					},
 					Items: []types.LabeledListItem{
 						{
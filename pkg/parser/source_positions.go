@@ -0,0 +1,123 @@
+package parser
+
+import (
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+)
+
+// the key of the position mapper in the global store of the parser
+const positionMapperKey = "position_mapper"
+
+// positionMapper maps the positions in the content being parsed to the locations in the source files,
+// since the content may be a combination of lines read from several files (after the file inclusions),
+// or the content of an element which was already parsed (eg: the lines of a delimited block)
+type positionMapper struct {
+	lines []types.SourceLocation // the location of the first character of each line of the content
+}
+
+// withSourcePositions returns the option to record the position of the elements in the source files,
+// given the location of each line of the content to parse
+func withSourcePositions(lines []types.SourceLocation) Option {
+	return GlobalStore(positionMapperKey, &positionMapper{
+		lines: lines,
+	})
+}
+
+// locate returns the location in the source files of the given line and column of the parsed content
+func (m *positionMapper) locate(line, col int) types.SourceLocation {
+	if len(m.lines) == 0 {
+		return types.SourceLocation{}
+	}
+	if line < 1 {
+		line = 1
+	}
+	if col < 1 {
+		col = 1
+	}
+	var l types.SourceLocation
+	if line > len(m.lines) {
+		// after the last line (ie, at the end of the content)
+		l = m.lines[len(m.lines)-1]
+		l.Line += line - len(m.lines)
+		l.Column = 1
+	} else {
+		l = m.lines[line-1]
+	}
+	if l.Column == 0 {
+		l.Column = 1
+	}
+	l.Column += col - 1
+	return l
+}
+
+// position returns the position of the given text which starts at the given position in the parsed content
+func (m *positionMapper) position(start position, text []byte) types.Position {
+	// exclude the trailing line terminator(s)
+	content := strings.TrimRight(string(text), "\r\n")
+	line, col := start.line, start.col
+	if col == 0 {
+		// pigeon sets the column of a '\n' character to 0 (on the next line)
+		line, col = line-1, 1
+	}
+	endLine, endCol := line, col
+	for _, r := range content {
+		if r == '\n' {
+			endLine++
+			endCol = 1
+		} else {
+			endCol++
+		}
+	}
+	return types.Position{
+		Start: m.locate(line, col),
+		End:   m.locate(endLine, endCol),
+	}
+}
+
+// withPosition sets the position of the text matched by the current rule on the given element,
+// if the source positions are recorded
+func (c *current) withPosition(element interface{}, err error) (interface{}, error) {
+	if err != nil {
+		return element, err
+	}
+	if m, ok := c.globalStore[positionMapperKey].(*positionMapper); ok {
+		return types.WithPosition(element, m.position(c.pos, c.text)), nil
+	}
+	return element, nil
+}
+
+// withPositions sets the position of the string elements (which have no position yet) in the given elements,
+// within the text matched by the current rule, if the source positions are recorded
+func (c *current) withPositions(elements []interface{}, err error) (interface{}, error) {
+	if err != nil {
+		return elements, err
+	}
+	if m, ok := c.globalStore[positionMapperKey].(*positionMapper); ok {
+		types.FillPositions(elements, m.position(c.pos, c.text))
+	}
+	return elements, nil
+}
+
+// sourcePositionsOf returns the options to record the source positions while parsing the given lines
+func sourcePositionsOf(lines []PreprocessedLine) Option {
+	locations := make([]types.SourceLocation, len(lines))
+	for i, l := range lines {
+		locations[i] = l.Location
+	}
+	return withSourcePositions(locations)
+}
+
+// sourcePositionsOfElements returns the options to record the source positions while parsing the content
+// of the given (verbatim) elements, and `false` if any of the elements has no position
+func sourcePositionsOfElements(elements []interface{}) (Option, bool) {
+	locations := make([]types.SourceLocation, len(elements))
+	for i, e := range elements {
+		p, ok := types.PositionOf(e)
+		if !ok || p.Start.IsZero() {
+			return nil, false
+		}
+		locations[i] = p.Start
+	}
+	return withSourcePositions(locations), true
+}
@@ -0,0 +1,141 @@
+package parser_test
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+	"github.com/bytesparadise/libasciidoc/pkg/parser"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	. "github.com/onsi/ginkgo" //nolint golint
+	. "github.com/onsi/gomega" //nolint golint
+)
+
+var _ = Describe("source positions", func() {
+
+	parseDocument := func(source string, settings ...configuration.Setting) types.Document {
+		settings = append([]configuration.Setting{
+			configuration.WithFilename("test.adoc"),
+			configuration.WithSourcePositions(true),
+		}, settings...)
+		doc, err := parser.ParseDocument(strings.NewReader(source), configuration.NewConfiguration(settings...))
+		Expect(err).NotTo(HaveOccurred())
+		return doc
+	}
+
+	position := func(filename string, startLine, startCol, endLine, endCol int) types.Position {
+		return types.Position{
+			Start: types.SourceLocation{Filename: filename, Line: startLine, Column: startCol},
+			End:   types.SourceLocation{Filename: filename, Line: endLine, Column: endCol},
+		}
+	}
+
+	It("should not record positions by default", func() {
+		doc, err := parser.ParseDocument(strings.NewReader("a paragraph"), configuration.NewConfiguration())
+		Expect(err).NotTo(HaveOccurred())
+		p := doc.Elements[0].(types.Paragraph)
+		Expect(p.Position).To(Equal(types.Position{}))
+		Expect(p.Lines[0][0].(types.StringElement).Position).To(Equal(types.Position{}))
+	})
+
+	It("should record positions of paragraph and its inline elements", func() {
+		source := `:foo: bar
+
+a *bold* {foo} text
+second _line_ https://example.com[link]`
+		doc := parseDocument(source)
+		p := doc.Elements[0].(types.Paragraph)
+		Expect(p.Position).To(Equal(position("test.adoc", 3, 1, 4, 40)))
+		Expect(p.Lines[0]).To(HaveLen(3))
+		Expect(p.Lines[0][0].(types.StringElement).Position).To(Equal(position("test.adoc", 3, 1, 3, 3)))
+		bold := p.Lines[0][1].(types.QuotedText)
+		Expect(bold.Position).To(Equal(position("test.adoc", 3, 3, 3, 9)))
+		Expect(bold.Elements[0].(types.StringElement).Position).To(Equal(position("test.adoc", 3, 4, 3, 8)))
+		// the substituted attribute is merged with the surrounding text
+		Expect(p.Lines[0][2].(types.StringElement).Content).To(Equal(" bar text"))
+		Expect(p.Lines[0][2].(types.StringElement).Position).To(Equal(position("test.adoc", 3, 9, 3, 20)))
+		Expect(p.Lines[1]).To(HaveLen(4))
+		Expect(p.Lines[1][1].(types.QuotedText).Position).To(Equal(position("test.adoc", 4, 8, 4, 14)))
+		Expect(p.Lines[1][3].(types.InlineLink).Position).To(Equal(position("test.adoc", 4, 15, 4, 40)))
+	})
+
+	It("should record positions of sections", func() {
+		source := `= Title
+
+== Section A
+
+some content
+
+== Section B`
+		doc := parseDocument(source)
+		header := doc.Elements[0].(types.Section)
+		// the position of the section spans over its elements
+		Expect(header.Position).To(Equal(position("test.adoc", 1, 1, 7, 13)))
+		Expect(header.Title[0].(types.StringElement).Position).To(Equal(position("test.adoc", 1, 3, 1, 8)))
+		sectionA := header.Elements[0].(types.Section)
+		Expect(sectionA.Position).To(Equal(position("test.adoc", 3, 1, 5, 13)))
+		Expect(sectionA.Elements[0].(types.Paragraph).Position).To(Equal(position("test.adoc", 5, 1, 5, 13)))
+		sectionB := header.Elements[1].(types.Section)
+		Expect(sectionB.Position).To(Equal(position("test.adoc", 7, 1, 7, 13)))
+	})
+
+	It("should record positions of lists", func() {
+		source := `* item 1
+** nested item
+* item 2`
+		doc := parseDocument(source)
+		list := doc.Elements[0].(types.UnorderedList)
+		Expect(list.Position).To(Equal(position("test.adoc", 1, 1, 3, 9)))
+		Expect(list.Items[0].Position).To(Equal(position("test.adoc", 1, 1, 2, 15)))
+		Expect(list.Items[0].Elements[0].(types.Paragraph).Position).To(Equal(position("test.adoc", 1, 3, 1, 9)))
+		Expect(list.Items[0].Elements[1].(types.UnorderedList).Position).To(Equal(position("test.adoc", 2, 1, 2, 15)))
+		Expect(list.Items[1].Position).To(Equal(position("test.adoc", 3, 1, 3, 9)))
+	})
+
+	It("should record positions of labeled list", func() {
+		source := `term:: description
+*other* term:: description`
+		doc := parseDocument(source)
+		list := doc.Elements[0].(types.LabeledList)
+		Expect(list.Position).To(Equal(position("test.adoc", 1, 1, 2, 27)))
+		Expect(list.Items[0].Elements[0].(types.Paragraph).Position).To(Equal(position("test.adoc", 1, 8, 1, 19)))
+		// the terms are parsed again, but retain their position
+		Expect(list.Items[0].Term[0].(types.StringElement).Position).To(Equal(position("test.adoc", 1, 1, 1, 5)))
+		Expect(list.Items[1].Term[0].(types.QuotedText).Position).To(Equal(position("test.adoc", 2, 1, 2, 8)))
+		Expect(list.Items[1].Term[1].(types.StringElement).Position).To(Equal(position("test.adoc", 2, 8, 2, 13)))
+	})
+
+	It("should record positions of elements in delimited blocks", func() {
+		source := `====
+an example
+
+* item
+====`
+		doc := parseDocument(source)
+		b := doc.Elements[0].(types.DelimitedBlock)
+		Expect(b.Position).To(Equal(position("test.adoc", 1, 1, 5, 5)))
+		Expect(b.Elements[0].(types.Paragraph).Position).To(Equal(position("test.adoc", 2, 1, 2, 11)))
+		Expect(b.Elements[2].(types.UnorderedList).Position).To(Equal(position("test.adoc", 4, 1, 4, 7)))
+	})
+
+	It("should record positions of elements in included file", func() {
+		source := `first line
+
+include::../../test/includes/grandchild-include.adoc[]
+
+last line`
+		grandchild, err := filepath.Abs("../../test/includes/grandchild-include.adoc")
+		Expect(err).NotTo(HaveOccurred())
+		doc := parseDocument(source)
+		preamble := doc.Elements[0].(types.Preamble)
+		Expect(preamble.Position).To(Equal(position("test.adoc", 1, 1, 1, 11)))
+		Expect(preamble.Elements[0].(types.Paragraph).Position).To(Equal(position("test.adoc", 1, 1, 1, 11)))
+		section := doc.Elements[1].(types.Section)
+		// the section in the included file does not span over the content of the document
+		Expect(section.Position).To(Equal(position(grandchild, 1, 1, 5, 24)))
+		Expect(section.Title[0].(types.StringElement).Position).To(Equal(position(grandchild, 1, 4, 1, 20)))
+		Expect(section.Elements[0].(types.Paragraph).Position).To(Equal(position(grandchild, 3, 1, 3, 25)))
+		Expect(section.Elements[2].(types.Paragraph).Position).To(Equal(position("test.adoc", 5, 1, 5, 10)))
+	})
+})
@@ -1468,6 +1468,12 @@ paragraph attached to parent list item`
 							},
 						},
 					},
+					ElementReferences: types.ElementReferences{
+						"listID": types.ElementReference{
+							Kind:  types.BlockReference,
+							Title: "mytitle",
+						},
+					},
 				}
 				Expect(ParseDocument(source)).To(MatchDocument(expected))
 			})
@@ -98,6 +98,27 @@ var _ = Describe("user macros", func() {
 			}
 			Expect(ParseDocumentBlock(source)).To(Equal(expected))
 		})
+
+		It("inline user macro with positional and named attributes", func() {
+			source := `jira:PROJ-123["a, b", c, role=x]`
+			expected := types.Paragraph{
+				Lines: [][]interface{}{
+					{
+						types.UserMacro{
+							Kind:        types.InlineMacro,
+							Name:        "jira",
+							Value:       "PROJ-123",
+							Positionals: []string{"a, b", "c"},
+							Attributes: types.Attributes{
+								"role": "x",
+							},
+							RawText: `jira:PROJ-123["a, b", c, role=x]`,
+						},
+					},
+				},
+			}
+			Expect(ParseDocumentBlock(source)).To(Equal(expected))
+		})
 	})
 
 	Context("user macros", func() {
@@ -154,5 +175,20 @@ var _ = Describe("user macros", func() {
 			Expect(ParseDocumentBlock(source)).To(Equal(expected))
 		})
 
+		It("user macro block with positional attributes", func() {
+			source := `note::important[Fix this, 'and that', key=value]`
+			expected := types.UserMacro{
+				Kind:        types.BlockMacro,
+				Name:        "note",
+				Value:       "important",
+				Positionals: []string{"Fix this", "and that"},
+				Attributes: types.Attributes{
+					"key": "value",
+				},
+				RawText: `note::important[Fix this, 'and that', key=value]`,
+			}
+			Expect(ParseDocumentBlock(source)).To(Equal(expected))
+		})
+
 	})
 })
@@ -0,0 +1,410 @@
+// Package preview serves the HTML rendering of the documents over HTTP, and reloads them in the browser
+// when their source files (or the files they include) change.
+package preview
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"html/template"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bytesparadise/libasciidoc"
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// EventsPath the path of the stream of the reload events (Server-Sent Events)
+const EventsPath = "/_libasciidoc/events"
+
+// Server an HTTP handler which converts the documents on demand and serves them, along with the other files
+// (eg: images) of the directory of the documents. The pages contain a script which reloads them when
+// the source of their document, or any file included by this document (transitively) changes.
+type Server struct {
+	root     string // the served document, or the directory of the served documents
+	dir      bool   // true if the root is a directory
+	settings []configuration.Setting
+	mu       sync.Mutex
+	files    map[string]fileState       // the state of the watched files, indexed by path
+	deps     map[string]map[string]bool // the files read to convert each document (including the document itself), indexed by document
+	clients  map[chan struct{}]string   // the channels of the pages to reload, with the path of their document
+}
+
+// fileState the state of a watched file, to detect its changes
+type fileState struct {
+	exists  bool
+	modTime time.Time
+	size    int64
+}
+
+// NewServer returns a new server of the given document, or of all the documents in the given directory
+// (which are converted with the given settings)
+func NewServer(root string, settings ...configuration.Setting) (*Server, error) {
+	root, err := filepath.Abs(root)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to serve '%s'", root)
+	}
+	info, err := os.Stat(root)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to serve '%s'", root)
+	}
+	return &Server{
+		root:     root,
+		dir:      info.IsDir(),
+		settings: settings,
+		files:    map[string]fileState{},
+		deps:     map[string]map[string]bool{},
+		clients:  map[chan struct{}]string{},
+	}, nil
+}
+
+// baseDir returns the directory of the served documents and files
+func (s *Server) baseDir() string {
+	if s.dir {
+		return s.root
+	}
+	return filepath.Dir(s.root)
+}
+
+// ServeHTTP serves the rendering of the documents, the other files and the stream of reload events
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == EventsPath {
+		s.serveEvents(w, r)
+		return
+	}
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if r.URL.Path == "/" && s.dir {
+		s.serveIndex(w)
+		return
+	}
+	if doc, found := s.documentOf(r.URL.Path); found {
+		s.serveDocument(w, doc)
+		return
+	}
+	http.FileServer(http.Dir(s.baseDir())).ServeHTTP(w, r)
+}
+
+// documentOf returns the path of the document served at the given URL path, if any: the document itself
+// (or its `.html` rendering) in the directory, or the served document at the root
+func (s *Server) documentOf(urlPath string) (string, bool) {
+	if urlPath == "/" && !s.dir {
+		return s.root, true
+	}
+	name := filepath.Join(s.baseDir(), filepath.FromSlash(path.Clean("/"+urlPath)))
+	if isDocument(name) {
+		if info, err := os.Stat(name); err == nil && !info.IsDir() {
+			return name, true
+		}
+		return "", false
+	}
+	if strings.HasSuffix(name, ".html") {
+		// the cross references to other documents are rendered as links to their `.html` file
+		for _, ext := range documentExtensions {
+			doc := strings.TrimSuffix(name, ".html") + ext
+			if info, err := os.Stat(doc); err == nil && !info.IsDir() {
+				return doc, true
+			}
+		}
+	}
+	return "", false
+}
+
+var documentExtensions = []string{".adoc", ".asciidoc", ".asc"}
+
+// isDocument returns true if the given file is an AsciiDoc document, based on its extension
+func isDocument(name string) bool {
+	ext := strings.ToLower(filepath.Ext(name))
+	for _, e := range documentExtensions {
+		if ext == e {
+			return true
+		}
+	}
+	return false
+}
+
+// serveDocument converts the given document, and serves the result with the script which reloads the page
+func (s *Server) serveDocument(w http.ResponseWriter, doc string) {
+	output, err := s.convert(doc)
+	if err != nil {
+		// the page is still reloaded when the problem is fixed
+		log.WithError(err).Errorf("failed to convert '%s'", doc)
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.WriteHeader(http.StatusInternalServerError)
+		if err := errorTemplate.Execute(w, struct {
+			Message string
+			Script  template.HTML
+		}{
+			Message: err.Error(),
+			Script:  template.HTML(reloadScript), // nolint: gosec
+		}); err != nil {
+			log.WithError(err).Error("failed to write error page")
+		}
+		return
+	}
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if i := bytes.LastIndex(output, []byte("</body>")); i >= 0 {
+		output = append(output[:i], append([]byte(reloadScript), output[i:]...)...)
+	} else {
+		output = append(output, reloadScript...)
+	}
+	w.Write(output) // nolint: errcheck
+}
+
+// convert converts the given document, and watches the files that were read to convert it
+func (s *Server) convert(doc string) ([]byte, error) {
+	fsys := &recordingFS{
+		names: map[string]bool{},
+	}
+	settings := append([]configuration.Setting{}, s.settings...)
+	settings = append(settings,
+		configuration.WithFilename(doc),
+		configuration.WithFS(fsys),
+		configuration.WithHeaderFooter(true),
+	)
+	out := &bytes.Buffer{}
+	_, err := libasciidoc.ConvertFile(out, configuration.NewConfiguration(settings...))
+	s.watch(doc, fsys.files())
+	if _, ok := err.(libasciidoc.FailureError); ok {
+		err = nil // the problems were logged, but the document was converted
+	}
+	return out.Bytes(), err
+}
+
+// watch watches the given files, which were read to convert the given document
+func (s *Server) watch(doc string, files []string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	deps := map[string]bool{
+		doc: true, // in case the document could not be read
+	}
+	for _, f := range files {
+		deps[f] = true
+	}
+	s.deps[doc] = deps
+	for f := range deps {
+		if _, found := s.files[f]; !found {
+			s.files[f] = stateOf(f)
+		}
+	}
+}
+
+// stateOf returns the current state of the given file
+func stateOf(name string) fileState {
+	info, err := os.Stat(name)
+	if err != nil {
+		return fileState{}
+	}
+	return fileState{
+		exists:  true,
+		modTime: info.ModTime(),
+		size:    info.Size(),
+	}
+}
+
+// differsFrom returns true if the file was created, deleted or modified since the given state
+func (s fileState) differsFrom(other fileState) bool {
+	return s.exists != other.exists || !s.modTime.Equal(other.modTime) || s.size != other.size
+}
+
+// Watch checks the watched files at the given interval, and reloads the pages whose document or included
+// files changed, until the given context is done
+func (s *Server) Watch(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.check()
+		}
+	}
+}
+
+// check checks the watched files, and notifies the pages whose document or included files changed
+func (s *Server) check() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	changed := map[string]bool{}
+	for f, state := range s.files {
+		if current := stateOf(f); current.differsFrom(state) {
+			log.Infof("'%s' changed", f)
+			s.files[f] = current
+			changed[f] = true
+		}
+	}
+	if len(changed) == 0 {
+		return
+	}
+	for c, doc := range s.clients {
+		if s.dependsOn(doc, changed) {
+			select {
+			case c <- struct{}{}:
+			default: // a reload is already pending
+			}
+		}
+	}
+}
+
+// dependsOn returns true if the given document depends on one of the given files
+func (s *Server) dependsOn(doc string, files map[string]bool) bool {
+	for f := range s.deps[doc] {
+		if files[f] {
+			return true
+		}
+	}
+	return false
+}
+
+// serveEvents sends a `reload` event to the page when its document or included files change
+// (see https://html.spec.whatwg.org/multipage/server-sent-events.html)
+func (s *Server) serveEvents(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming not supported", http.StatusInternalServerError)
+		return
+	}
+	doc, found := s.documentOf(r.URL.Query().Get("path"))
+	if !found {
+		http.NotFound(w, r)
+		return
+	}
+	c := make(chan struct{}, 1)
+	s.mu.Lock()
+	s.clients[c] = doc
+	s.mu.Unlock()
+	defer func() {
+		s.mu.Lock()
+		delete(s.clients, c)
+		s.mu.Unlock()
+	}()
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprint(w, ": watching\n\n") // sends the headers to the browser
+	flusher.Flush()
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-c:
+			fmt.Fprint(w, "event: reload\ndata: {}\n\n")
+			flusher.Flush()
+		}
+	}
+}
+
+// serveIndex serves the list of the documents in the directory
+func (s *Server) serveIndex(w http.ResponseWriter) {
+	docs := []string{}
+	err := filepath.Walk(s.root, func(name string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() && name != s.root && strings.HasPrefix(info.Name(), ".") {
+			return filepath.SkipDir // eg: `.git`
+		}
+		if !info.IsDir() && isDocument(name) {
+			rel, err := filepath.Rel(s.root, name)
+			if err != nil {
+				return err
+			}
+			docs = append(docs, filepath.ToSlash(rel))
+		}
+		return nil
+	})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	sort.Strings(docs)
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if err := indexTemplate.Execute(w, docs); err != nil {
+		log.WithError(err).Error("failed to write index page")
+	}
+}
+
+// recordingFS a file system which reads the local files, and records the names of the files which were read
+// (or which could not be read, in case they are created later)
+type recordingFS struct {
+	mu    sync.Mutex
+	names map[string]bool
+}
+
+var _ configuration.FS = &recordingFS{}
+
+// Open opens the file with the given name (see `configuration.FS`)
+func (fsys *recordingFS) Open(name string) (configuration.File, error) {
+	filename := filepath.Clean(filepath.FromSlash(name))
+	if abs, err := filepath.Abs(filename); err == nil {
+		filename = abs
+	}
+	fsys.mu.Lock()
+	fsys.names[filename] = true
+	fsys.mu.Unlock()
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err // avoid returning a non-nil `File` with a nil `*os.File`
+	}
+	return f, nil
+}
+
+// files returns the names of the files which were read
+func (fsys *recordingFS) files() []string {
+	fsys.mu.Lock()
+	defer fsys.mu.Unlock()
+	result := make([]string, 0, len(fsys.names))
+	for name := range fsys.names {
+		result = append(result, name)
+	}
+	return result
+}
+
+// reloadScript the script which reloads the page when a `reload` event is received
+const reloadScript = `<script>
+(function() {
+  var events = new EventSource("` + EventsPath + `?path=" + encodeURIComponent(window.location.pathname));
+  events.addEventListener("reload", function() {
+    window.location.reload();
+  });
+})();
+</script>
+`
+
+var indexTemplate = template.Must(template.New("index").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="UTF-8">
+<title>Documents</title>
+</head>
+<body>
+<ul>
+{{ range . }}<li><a href="/{{ . }}">{{ . }}</a></li>
+{{ end }}</ul>
+</body>
+</html>
+`))
+
+var errorTemplate = template.Must(template.New("error").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="UTF-8">
+<title>Error</title>
+</head>
+<body>
+<pre>{{ .Message }}</pre>
+{{ .Script }}</body>
+</html>
+`))
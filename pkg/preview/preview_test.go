@@ -0,0 +1,211 @@
+package preview_test
+
+import (
+	"bufio"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/bytesparadise/libasciidoc/pkg/preview"
+
+	. "github.com/onsi/ginkgo" //nolint golint
+	. "github.com/onsi/gomega" //nolint golint
+)
+
+var _ = Describe("preview server", func() {
+
+	var dir string
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "libasciidoc-preview")
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	writeFile := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		Expect(os.MkdirAll(filepath.Dir(path), 0755)).To(Succeed())
+		Expect(ioutil.WriteFile(path, []byte(content), 0644)).To(Succeed())
+		return path
+	}
+
+	// serve starts a server of the given document or directory, which checks the files every 10ms
+	serve := func(root string) (*httptest.Server, func()) {
+		s, err := preview.NewServer(root)
+		Expect(err).NotTo(HaveOccurred())
+		ctx, cancel := context.WithCancel(context.Background())
+		go s.Watch(ctx, 10*time.Millisecond)
+		ts := httptest.NewServer(s)
+		return ts, func() {
+			cancel()
+			ts.Close()
+		}
+	}
+
+	get := func(u string) (int, string) {
+		resp, err := http.Get(u)
+		Expect(err).NotTo(HaveOccurred())
+		defer resp.Body.Close()
+		body, err := ioutil.ReadAll(resp.Body)
+		Expect(err).NotTo(HaveOccurred())
+		return resp.StatusCode, string(body)
+	}
+
+	// events opens the stream of reload events of the given page, and returns the channel of the received events
+	events := func(ts *httptest.Server, page string) (<-chan string, func()) {
+		req, err := http.NewRequest(http.MethodGet, ts.URL+preview.EventsPath+"?path="+url.QueryEscape(page), nil)
+		Expect(err).NotTo(HaveOccurred())
+		ctx, cancel := context.WithCancel(context.Background())
+		resp, err := http.DefaultClient.Do(req.WithContext(ctx))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resp.StatusCode).To(Equal(http.StatusOK))
+		Expect(resp.Header.Get("Content-Type")).To(Equal("text/event-stream"))
+		result := make(chan string, 10)
+		go func() {
+			defer close(result)
+			scanner := bufio.NewScanner(resp.Body)
+			for scanner.Scan() {
+				if line := scanner.Text(); strings.HasPrefix(line, "event: ") {
+					result <- strings.TrimPrefix(line, "event: ")
+				}
+			}
+		}()
+		return result, func() {
+			cancel()
+			resp.Body.Close()
+		}
+	}
+
+	Context("single document", func() {
+
+		It("should serve the converted document with reload script", func() {
+			doc := writeFile("doc.adoc", "= Title\n\ninclude::chapter.adoc[]\n")
+			writeFile("chapter.adoc", "== Chapter\n\ncontent\n")
+			ts, stop := serve(doc)
+			defer stop()
+			status, body := get(ts.URL + "/")
+			Expect(status).To(Equal(http.StatusOK))
+			Expect(body).To(ContainSubstring("<h1>Title</h1>"))
+			Expect(body).To(ContainSubstring(`<h2 id="_chapter">Chapter</h2>`))
+			Expect(body).To(ContainSubstring(`new EventSource("/_libasciidoc/events?path="`))
+			Expect(strings.Index(body, "EventSource")).To(BeNumerically("<", strings.Index(body, "</body>")))
+		})
+
+		It("should serve the other files of the directory", func() {
+			doc := writeFile("doc.adoc", "image::images/foo.png[]\n")
+			writeFile("images/foo.png", "not really an image")
+			ts, stop := serve(doc)
+			defer stop()
+			status, body := get(ts.URL + "/images/foo.png")
+			Expect(status).To(Equal(http.StatusOK))
+			Expect(body).To(Equal("not really an image"))
+		})
+
+		It("should reload the page when the document changes", func() {
+			doc := writeFile("doc.adoc", "= Title\n")
+			ts, stop := serve(doc)
+			defer stop()
+			get(ts.URL + "/")
+			reloads, close := events(ts, "/")
+			defer close()
+			writeFile("doc.adoc", "= Another title\n")
+			Eventually(reloads, 5*time.Second).Should(Receive(Equal("reload")))
+			_, body := get(ts.URL + "/")
+			Expect(body).To(ContainSubstring("<h1>Another title</h1>"))
+		})
+
+		It("should reload the page when a transitively included file changes", func() {
+			doc := writeFile("doc.adoc", "= Title\n\ninclude::chapters/chapter.adoc[]\n")
+			writeFile("chapters/chapter.adoc", "== Chapter\n\ninclude::section.adoc[]\n")
+			writeFile("chapters/section.adoc", "=== Section\n")
+			ts, stop := serve(doc)
+			defer stop()
+			get(ts.URL + "/")
+			reloads, close := events(ts, "/")
+			defer close()
+			writeFile("chapters/section.adoc", "=== Another section\n")
+			Eventually(reloads, 5*time.Second).Should(Receive(Equal("reload")))
+		})
+
+		It("should reload the page when a missing included file is created", func() {
+			doc := writeFile("doc.adoc", "= Title\n\ninclude::chapter.adoc[]\n")
+			ts, stop := serve(doc)
+			defer stop()
+			get(ts.URL + "/")
+			reloads, close := events(ts, "/")
+			defer close()
+			writeFile("chapter.adoc", "== Chapter\n")
+			Eventually(reloads, 5*time.Second).Should(Receive(Equal("reload")))
+		})
+
+		It("should not reload the page when another file changes", func() {
+			doc := writeFile("doc.adoc", "= Title\n")
+			other := writeFile("other.adoc", "= Other\n")
+			ts, stop := serve(dir)
+			defer stop()
+			get(ts.URL + "/doc.adoc")
+			get(ts.URL + "/other.adoc")
+			reloads, close := events(ts, "/doc.adoc")
+			defer close()
+			writeFile(filepath.Base(other), "= Another title\n")
+			Consistently(reloads, 200*time.Millisecond).ShouldNot(Receive())
+			writeFile(filepath.Base(doc), "= Another title\n")
+			Eventually(reloads, 5*time.Second).Should(Receive(Equal("reload")))
+		})
+	})
+
+	Context("directory", func() {
+
+		It("should list the documents", func() {
+			writeFile("doc.adoc", "= Title\n")
+			writeFile("guides/guide.asciidoc", "= Guide\n")
+			writeFile("notes.txt", "notes")
+			writeFile(".git/README.adoc", "= Hidden\n")
+			ts, stop := serve(dir)
+			defer stop()
+			status, body := get(ts.URL + "/")
+			Expect(status).To(Equal(http.StatusOK))
+			Expect(body).To(ContainSubstring(`<li><a href="/doc.adoc">doc.adoc</a></li>
+<li><a href="/guides/guide.asciidoc">guides/guide.asciidoc</a></li>
+</ul>`))
+			Expect(body).NotTo(ContainSubstring("notes.txt"))
+			Expect(body).NotTo(ContainSubstring("README.adoc"))
+		})
+
+		It("should serve the documents referenced by their HTML file", func() {
+			writeFile("doc.adoc", "= Title\n\nSee xref:guides/guide.adoc[the guide].\n")
+			writeFile("guides/guide.adoc", "= Guide\n")
+			ts, stop := serve(dir)
+			defer stop()
+			_, body := get(ts.URL + "/doc.adoc")
+			Expect(body).To(ContainSubstring(`<a href="guides/guide.html">the guide</a>`))
+			status, body := get(ts.URL + "/guides/guide.html")
+			Expect(status).To(Equal(http.StatusOK))
+			Expect(body).To(ContainSubstring("<h1>Guide</h1>"))
+		})
+
+		It("should not serve missing documents", func() {
+			ts, stop := serve(dir)
+			defer stop()
+			status, _ := get(ts.URL + "/unknown.adoc")
+			Expect(status).To(Equal(http.StatusNotFound))
+			status, _ = get(ts.URL + "/../../etc/passwd")
+			Expect(status).To(Equal(http.StatusNotFound))
+		})
+	})
+
+	It("should fail to serve missing root", func() {
+		_, err := preview.NewServer(filepath.Join(dir, "unknown.adoc"))
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -1,6 +1,8 @@
 package renderer
 
 import (
+	"strconv"
+
 	"github.com/bytesparadise/libasciidoc/pkg/configuration"
 	"github.com/bytesparadise/libasciidoc/pkg/types"
 )
@@ -15,11 +17,13 @@ type Context struct {
 	IncludeBlankLine     bool
 	WithinDelimitedBlock bool
 	WithinList           int
-	counters             map[string]int
 	Attributes           types.Attributes
 	Footnotes            []types.Footnote
 	ElementReferences    types.ElementReferences
-	HasHeader            bool
+	// SectionNumbers the numbers of the sections, indexed by their ID, which are used in the labels of the cross references
+	// (only when the `:sectnums:` attribute is set)
+	SectionNumbers map[string]string
+	HasHeader      bool
 }
 
 // NewContext returns a new rendering context for the given document.
@@ -27,41 +31,51 @@ func NewContext(doc types.Document, config configuration.Configuration) *Context
 	_, hasHeader := doc.Header()
 	return &Context{
 		Config:            config,
-		counters:          make(map[string]int),
 		Attributes:        doc.Attributes,
 		ElementReferences: doc.ElementReferences,
 		Footnotes:         doc.Footnotes,
+		SectionNumbers:    newSectionNumbers(doc),
 		HasHeader:         hasHeader,
 	}
 }
 
-const tableCounter = "tableCounter"
-
-// GetAndIncrementTableCounter returns the current value for the table counter after internally incrementing it.
-func (ctx *Context) GetAndIncrementTableCounter() int {
-	return ctx.getAndIncrementCounter(tableCounter)
-}
-
-const imageCounter = "imageCounter"
-
-// GetAndIncrementImageCounter returns the current value for the image counter after internally incrementing it.
-func (ctx *Context) GetAndIncrementImageCounter() int {
-	return ctx.getAndIncrementCounter(imageCounter)
-}
-
-const exampleBlockCounter = "exampleBlockCounter"
-
-// GetAndIncrementExampleBlockCounter returns the current value for the example block counter after internally incrementing it.
-func (ctx *Context) GetAndIncrementExampleBlockCounter() int {
-	return ctx.getAndIncrementCounter(exampleBlockCounter)
+// newSectionNumbers computes the numbers of the sections of the given document,
+// if the `:sectnums:` attribute is set. Sections of level 0 are never numbered, and
+// sections deeper than the `:sectnumlevels:` (3 by default) are not numbered either.
+func newSectionNumbers(doc types.Document) map[string]string {
+	if !doc.Attributes.Has(types.AttrSectionNumbering) {
+		return nil
+	}
+	levels := 3
+	if l, found := doc.Attributes.GetAsString(types.AttrSectionNumberLevels); found {
+		if l, err := strconv.Atoi(l); err == nil {
+			levels = l
+		}
+	}
+	numbers := map[string]string{}
+	numberSections(doc.Elements, "", levels, numbers)
+	return numbers
 }
 
-// getAndIncrementCounter returns the current value for the  counter after internally incrementing it.
-func (ctx *Context) getAndIncrementCounter(name string) int {
-	if _, found := ctx.counters[name]; !found {
-		ctx.counters[name] = 1
-		return 1
+func numberSections(elements []interface{}, prefix string, levels int, numbers map[string]string) {
+	count := 0
+	for _, e := range elements {
+		s, ok := e.(types.Section)
+		if !ok {
+			continue
+		}
+		if s.Level == 0 {
+			numberSections(s.Elements, prefix, levels, numbers)
+			continue
+		}
+		if s.Level > levels {
+			continue
+		}
+		count++
+		number := prefix + strconv.Itoa(count)
+		if id, found := s.Attributes.GetAsString(types.AttrID); found {
+			numbers[id] = number
+		}
+		numberSections(s.Elements, number+".", levels, numbers)
 	}
-	ctx.counters[name]++
-	return ctx.counters[name]
 }
@@ -20,7 +20,7 @@ func (r *sgmlRenderer) renderCalloutList(ctx *renderer.Context, l types.CalloutL
 		}{
 			ID:    r.renderElementID(l.Attributes),
 			Title: l.Attributes.GetAsStringWithDefault(types.AttrTitle, ""),
-			Role:  l.Attributes.GetAsStringWithDefault(types.AttrRole, ""),
+			Role:  r.renderElementRoles(l.Attributes),
 			Items: l.Items,
 		},
 	})
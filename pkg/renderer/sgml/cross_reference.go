@@ -3,6 +3,7 @@ package sgml
 import (
 	"bytes"
 	"path/filepath"
+	"strings"
 
 	"github.com/bytesparadise/libasciidoc/pkg/renderer"
 	"github.com/bytesparadise/libasciidoc/pkg/types"
@@ -10,30 +11,44 @@ import (
 	log "github.com/sirupsen/logrus"
 )
 
+func (r *sgmlRenderer) renderInlineAnchor(anchor types.InlineAnchor) ([]byte, error) {
+	result := &bytes.Buffer{}
+	err := r.inlineAnchor.Execute(result, struct {
+		ID string
+	}{
+		ID: anchor.ID,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render inline anchor")
+	}
+	return result.Bytes(), nil
+}
+
 func (r *sgmlRenderer) renderInternalCrossReference(ctx *renderer.Context, xref types.InternalCrossReference) ([]byte, error) {
 	log.Debugf("rendering cross reference with ID: %s", xref.ID)
 	result := &bytes.Buffer{}
+	href := "#" + xref.ID
 	var label string
-	if xref.Label != "" {
-		label = xref.Label
+	if path, fragment, ok := splitInterDocumentReference(xref.ID); ok {
+		// reference to another document (eg: `<<other.adoc#id>>`)
+		href = getRelativeFileLocation(ctx, path, fragment)
+		label = href
 	} else if target, found := ctx.ElementReferences[xref.ID]; found {
-		if t, ok := target.([]interface{}); ok {
-			renderedContent, err := r.renderElement(ctx, t)
-			if err != nil {
-				return nil, errors.Wrapf(err, "error while rendering internal cross reference")
-			}
-			label = string(renderedContent)
-		} else {
-			return nil, errors.Errorf("unable to process internal cross reference to element of type %T", target)
+		var err error
+		if label, err = r.renderCrossReferenceLabel(ctx, xref.ID, target); err != nil {
+			return nil, errors.Wrapf(err, "error while rendering internal cross reference")
 		}
 	} else {
 		label = "[" + xref.ID + "]"
 	}
+	if xref.Label != "" {
+		label = xref.Label
+	}
 	err := r.internalCrossReference.Execute(result, struct {
 		Href  string
 		Label string
 	}{
-		Href:  xref.ID,
+		Href:  href,
 		Label: label,
 	})
 	if err != nil {
@@ -42,6 +57,55 @@ func (r *sgmlRenderer) renderInternalCrossReference(ctx *renderer.Context, xref
 	return result.Bytes(), nil
 }
 
+// renderCrossReferenceLabel renders the label of a cross reference to the given target, according
+// to the `xrefstyle` document attribute:
+// - `full`: the caption (or section number) followed by the quoted title (eg: `Figure 1, “A title”`)
+// - `short`: the caption (or section number) only (eg: `Figure 1`)
+// - `basic` (or no value): the title only
+// In all cases, the `reftext` of the target takes precedence.
+func (r *sgmlRenderer) renderCrossReferenceLabel(ctx *renderer.Context, id string, target interface{}) (string, error) {
+	xrefstyle, _ := ctx.Attributes.GetAsString(types.AttrXRefStyle)
+	switch target := target.(type) {
+	case []interface{}: // section title
+		renderedContent, err := r.renderElement(ctx, target)
+		if err != nil {
+			return "", err
+		}
+		title := strings.TrimSpace(string(renderedContent))
+		number, numbered := ctx.SectionNumbers[id]
+		if !numbered {
+			return title, nil
+		}
+		return formatCrossReferenceLabel(xrefstyle, ctx.Attributes.GetLabel(types.AttrSectionRefSig)+" "+number, title), nil
+	case types.ElementReference:
+		if target.RefText != "" {
+			return EscapeString(target.RefText), nil
+		}
+		if target.Title == "" {
+			return "[" + id + "]", nil
+		}
+		title := EscapeString(target.Title)
+		caption := strings.TrimSuffix(strings.TrimSpace(target.Caption), ".")
+		if caption == "" {
+			return title, nil
+		}
+		return formatCrossReferenceLabel(xrefstyle, EscapeString(caption), title), nil
+	default:
+		return "", errors.Errorf("unable to process internal cross reference to element of type %T", target)
+	}
+}
+
+func formatCrossReferenceLabel(xrefstyle, signifier, title string) string {
+	switch xrefstyle {
+	case "full":
+		return signifier + ", &#8220;" + title + "&#8221;"
+	case "short":
+		return signifier
+	default:
+		return title
+	}
+}
+
 func (r *sgmlRenderer) renderExternalCrossReference(ctx *renderer.Context, xref types.ExternalCrossReference) ([]byte, error) {
 	log.Debugf("rendering cross reference with ID: %s", xref.Location)
 	result := &bytes.Buffer{}
@@ -49,11 +113,15 @@ func (r *sgmlRenderer) renderExternalCrossReference(ctx *renderer.Context, xref
 	if err != nil {
 		return nil, errors.Wrapf(err, "unable to render external cross reference")
 	}
+	href := getCrossReferenceLocation(ctx, xref)
+	if len(label) == 0 {
+		label = []byte(href)
+	}
 	err = r.externalCrossReference.Execute(result, struct {
 		Href  string
 		Label string
 	}{
-		Href:  getCrossReferenceLocation(xref),
+		Href:  href,
 		Label: string(label),
 	})
 	if err != nil {
@@ -62,9 +130,48 @@ func (r *sgmlRenderer) renderExternalCrossReference(ctx *renderer.Context, xref
 	return result.Bytes(), nil
 }
 
-func getCrossReferenceLocation(xref types.ExternalCrossReference) string {
+// getCrossReferenceLocation returns the location of the document referred by the given cross reference,
+// in which the file extension is replaced by the value of the `relfilesuffix` document attribute.
+// The fragment of the location, if any (eg: `other.adoc#id`), is retained.
+func getCrossReferenceLocation(ctx *renderer.Context, xref types.ExternalCrossReference) string {
 	loc := xref.Location.String()
-	ext := filepath.Ext(xref.Location.String())
-	log.Debugf("ext of '%s': '%s'", loc, ext)
-	return loc[:len(loc)-len(ext)] + ".html" // TODO output extension
+	var fragment string
+	if i := strings.Index(loc, "#"); i >= 0 {
+		loc, fragment = loc[:i], loc[i+1:]
+	}
+	if loc == "" {
+		return "#" + fragment
+	}
+	return getRelativeFileLocation(ctx, loc, fragment)
+}
+
+// splitInterDocumentReference splits the given ID in a path and a fragment if it
+// refers to an element in another document (eg: `other.adoc#id`)
+func splitInterDocumentReference(id string) (string, string, bool) {
+	path := id
+	var fragment string
+	if i := strings.Index(id, "#"); i >= 0 {
+		path, fragment = id[:i], id[i+1:]
+	}
+	if filepath.Ext(path) != ".adoc" {
+		return "", "", false
+	}
+	return path, fragment, true
+}
+
+func getRelativeFileLocation(ctx *renderer.Context, path, fragment string) string {
+	ext := filepath.Ext(path)
+	log.Debugf("ext of '%s': '%s'", path, ext)
+	suffix := ".html"
+	if s, found := ctx.Attributes.GetAsString(types.AttrOutFileSuffix); found {
+		suffix = s
+	}
+	if s, found := ctx.Attributes.GetAsString(types.AttrRelFileSuffix); found {
+		suffix = s
+	}
+	result := path[:len(path)-len(ext)] + suffix
+	if fragment != "" {
+		result += "#" + fragment
+	}
+	return result
 }
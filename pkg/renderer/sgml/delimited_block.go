@@ -2,7 +2,6 @@ package sgml
 
 import (
 	"bytes"
-	"strconv"
 
 	"github.com/alecthomas/chroma"
 	"github.com/alecthomas/chroma/formatters/html"
@@ -16,7 +15,6 @@ import (
 
 func (r *sgmlRenderer) renderDelimitedBlock(ctx *renderer.Context, b types.DelimitedBlock) ([]byte, error) {
 	log.Debugf("rendering delimited block of kind '%v'", b.Attributes[types.AttrKind])
-	var err error
 	kind := b.Kind
 	switch kind {
 	case types.Fenced:
@@ -27,6 +25,8 @@ func (r *sgmlRenderer) renderDelimitedBlock(ctx *renderer.Context, b types.Delim
 		return r.renderSourceBlock(ctx, b)
 	case types.Example:
 		return r.renderExampleBlock(ctx, b)
+	case types.Open:
+		return r.renderOpenBlock(ctx, b)
 	case types.Quote, types.MarkdownQuote:
 		return r.renderQuoteBlock(ctx, b)
 	case types.Verse:
@@ -36,7 +36,7 @@ func (r *sgmlRenderer) renderDelimitedBlock(ctx *renderer.Context, b types.Delim
 	case types.Passthrough:
 		return r.renderPassthrough(ctx, b)
 	default:
-		return nil, errors.Wrapf(err, "unable to render delimited block")
+		return nil, errors.Errorf("unable to render delimited block of kind '%s'", kind)
 	}
 }
 
@@ -83,7 +83,7 @@ func (r *sgmlRenderer) renderListingBlock(ctx *renderer.Context, b types.Delimit
 			Elements []interface{}
 		}{
 			ID:       r.renderElementID(b.Attributes),
-			Title:    r.renderElementTitle(b.Attributes),
+			Title:    r.renderElementCaptionedTitle(b.Attributes),
 			Elements: discardTrailingBlankLines(b.Elements),
 		},
 	})
@@ -139,7 +139,7 @@ func (r *sgmlRenderer) renderSourceBlock(ctx *renderer.Context, b types.Delimite
 			options = append(options, html.WithClasses(true))
 		}
 		// extra option: line numbers
-		if b.Attributes.Has(types.AttrLineNums) {
+		if b.Attributes.Has(types.AttrLineNums) || b.Attributes.HasOption(types.AttrLineNums) {
 			options = append(options, html.WithLineNumbers(true))
 		}
 		err = html.New(options...).Format(contentBuf, style, iterator)
@@ -158,7 +158,7 @@ func (r *sgmlRenderer) renderSourceBlock(ctx *renderer.Context, b types.Delimite
 		Content           string
 	}{
 		ID:                r.renderElementID(b.Attributes),
-		Title:             r.renderElementTitle(b.Attributes),
+		Title:             r.renderElementCaptionedTitle(b.Attributes),
 		SyntaxHighlighter: highlighter,
 		Language:          language,
 		Content:           content,
@@ -166,36 +166,38 @@ func (r *sgmlRenderer) renderSourceBlock(ctx *renderer.Context, b types.Delimite
 	return result.Bytes(), err
 }
 
-func (r *sgmlRenderer) renderExampleBlock(ctx *renderer.Context, b types.DelimitedBlock) ([]byte, error) {
+func (r *sgmlRenderer) renderAdmonitionBlock(ctx *renderer.Context, b types.DelimitedBlock, k types.AdmonitionKind) ([]byte, error) {
 	result := &bytes.Buffer{}
+	icon, err := r.renderIcon(ctx, types.Icon{Class: string(k)}, true)
+	if err != nil {
+		return nil, err
+	}
+	err = r.admonitionBlock.Execute(result, ContextualPipeline{
+		Context: ctx,
+		Data: struct {
+			ID       string
+			Class    string
+			Title    string
+			Icon     sanitized
+			Elements []interface{}
+		}{
+			ID:       r.renderElementID(b.Attributes),
+			Class:    renderClass(k),
+			Icon:     icon,
+			Title:    r.renderElementTitle(b.Attributes),
+			Elements: discardTrailingBlankLines(b.Elements),
+		},
+	})
+	return result.Bytes(), err
+}
+
+func (r *sgmlRenderer) renderExampleBlock(ctx *renderer.Context, b types.DelimitedBlock) ([]byte, error) {
 	if k, ok := b.Attributes[types.AttrAdmonitionKind].(types.AdmonitionKind); ok {
-		icon, err := r.renderIcon(ctx, types.Icon{Class: string(k)}, true)
-		if err != nil {
-			return nil, err
-		}
-		err = r.admonitionBlock.Execute(result, ContextualPipeline{
-			Context: ctx,
-			Data: struct {
-				ID       string
-				Class    string
-				Title    string
-				Icon     sanitized
-				Elements []interface{}
-			}{
-				ID:       r.renderElementID(b.Attributes),
-				Class:    renderClass(k),
-				Icon:     icon,
-				Title:    r.renderElementTitle(b.Attributes),
-				Elements: discardTrailingBlankLines(b.Elements),
-			},
-		})
-		return result.Bytes(), err
+		return r.renderAdmonitionBlock(ctx, b, k)
 	}
 	// default, example block
-	var title string
-	if b.Attributes.Has(types.AttrTitle) {
-		title = "Example " + strconv.Itoa(ctx.GetAndIncrementExampleBlockCounter()) + ". " + r.renderElementTitle(b.Attributes)
-	}
+	result := &bytes.Buffer{}
+	title := r.renderElementCaptionedTitle(b.Attributes)
 	err := r.exampleBlock.Execute(result, ContextualPipeline{
 		Context: ctx,
 		Data: struct {
@@ -211,6 +213,26 @@ func (r *sgmlRenderer) renderExampleBlock(ctx *renderer.Context, b types.Delimit
 	return result.Bytes(), err
 }
 
+func (r *sgmlRenderer) renderOpenBlock(ctx *renderer.Context, b types.DelimitedBlock) ([]byte, error) {
+	if k, ok := b.Attributes[types.AttrAdmonitionKind].(types.AdmonitionKind); ok {
+		return r.renderAdmonitionBlock(ctx, b, k)
+	}
+	result := &bytes.Buffer{}
+	err := r.openBlock.Execute(result, ContextualPipeline{
+		Context: ctx,
+		Data: struct {
+			ID       string
+			Title    string
+			Elements []interface{}
+		}{
+			ID:       r.renderElementID(b.Attributes),
+			Title:    r.renderElementTitle(b.Attributes),
+			Elements: discardTrailingBlankLines(b.Elements),
+		},
+	})
+	return result.Bytes(), err
+}
+
 func (r *sgmlRenderer) renderQuoteBlock(ctx *renderer.Context, b types.DelimitedBlock) ([]byte, error) {
 	result := &bytes.Buffer{}
 	err := r.quoteBlock.Execute(result, ContextualPipeline{
@@ -297,15 +319,31 @@ func (r *sgmlRenderer) renderSidebarBlock(ctx *renderer.Context, b types.Delimit
 }
 
 func (r *sgmlRenderer) renderPassthrough(ctx *renderer.Context, b types.DelimitedBlock) ([]byte, error) {
+	// the lines of the block are written as-is, ie, without any HTML escaping
+	content := &bytes.Buffer{}
+	for i, element := range discardTrailingBlankLines(b.Elements) {
+		if i > 0 {
+			content.WriteString("\n")
+		}
+		if l, ok := element.(types.VerbatimLine); ok {
+			content.WriteString(l.Content)
+			continue
+		}
+		renderedElement, err := r.renderElement(ctx, element)
+		if err != nil {
+			return nil, err
+		}
+		content.Write(renderedElement)
+	}
 	result := &bytes.Buffer{}
 	err := r.passthroughBlock.Execute(result, ContextualPipeline{
 		Context: ctx,
 		Data: struct {
-			ID       string
-			Elements []interface{}
+			ID      string
+			Content sanitized
 		}{
-			ID:       r.renderElementID(b.Attributes),
-			Elements: discardTrailingBlankLines(b.Elements),
+			ID:      r.renderElementID(b.Attributes),
+			Content: sanitized(content.String()),
 		},
 	})
 	return result.Bytes(), err
@@ -99,6 +99,8 @@ func (r *sgmlRenderer) renderElement(ctx *renderer.Context, element interface{})
 		return r.renderCalloutList(ctx, e)
 	case types.Paragraph:
 		return r.renderParagraph(ctx, e)
+	case types.InlineAnchor:
+		return r.renderInlineAnchor(e)
 	case types.InternalCrossReference:
 		return r.renderInternalCrossReference(ctx, e)
 	case types.ExternalCrossReference:
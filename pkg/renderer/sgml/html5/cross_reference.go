@@ -1,6 +1,7 @@
 package html5
 
 const (
-	internalCrossReferenceTmpl = `<a href="#{{ .Href }}">{{ .Label }}</a>`
+	internalCrossReferenceTmpl = `<a href="{{ .Href }}">{{ .Label }}</a>`
 	externalCrossReferenceTmpl = `<a href="{{ .Href }}">{{ .Label }}</a>`
+	inlineAnchorTmpl           = `<a id="{{ .ID }}"></a>`
 )
@@ -29,6 +29,13 @@ const (
 <div class="content">
 {{ renderElements $ctx .Elements | printf "%s" }}
 </div>
+</div>{{ end }}`
+
+	openBlockTmpl = `{{ $ctx := .Context }}{{ with .Data }}<div {{ if .ID }}id="{{ .ID }}" {{ end }}class="openblock">{{ if .Title }}
+<div class="title">{{ escape .Title }}</div>{{ end }}
+<div class="content">
+{{ renderElements $ctx .Elements | printf "%s" }}
+</div>
 </div>{{ end }}`
 
 	quoteBlockTmpl = `{{ $ctx := .Context }}{{ with .Data }}<div {{ if .ID }}id="{{ .ID }}" {{ end }}class="quoteblock">{{ if .Title }}
@@ -75,5 +82,5 @@ const (
 </div>{{ end }}`
 
 	// the name here is weird because "pass" as a prefix triggers a false security warning
-	pssThroughBlock = `{{ $ctx := .Context }}{{ with .Data }}{{ render $ctx .Elements | printf "%s" }}{{ end }}`
+	pssThroughBlock = `{{ with .Data }}{{ .Content }}{{ end }}`
 )
@@ -2,7 +2,7 @@ package html5
 
 const (
 	articleTmpl = `<!DOCTYPE html>
-<html lang="en">
+<html lang="{{ .Lang }}">
 <head>
 <meta charset="UTF-8">
 <meta http-equiv="X-UA-Compatible" content="IE=edge">
@@ -19,8 +19,8 @@ const (
 </div>{{ if .IncludeFooter }}
 <div id="footer">
 <div id="footer-text">{{ if .RevNumber }}
-Version {{ .RevNumber }}<br>{{ end }}
-Last updated {{ .LastUpdated }}
+{{ .VersionLabel }} {{ .RevNumber }}<br>{{ end }}
+{{ .LastUpdatedLabel }} {{ .LastUpdated }}
 </div>
 </div>{{ end }}
 </body>
@@ -32,7 +32,7 @@ Last updated {{ .LastUpdated }}
 </div>`
 
 	manpageHeaderTmpl = `{{ if .IncludeH1 }}<div id="header">
-<h1>{{ .Header }} Manual Page</h1>
+<h1>{{ .Header }} {{ .Label }}</h1>
 {{ end }}<h2 id="_name">{{ .Name }}</h2>
 <div class="sectionbody">
 {{ .Content }}
@@ -0,0 +1,267 @@
+package html5_test
+
+import (
+	"time"
+
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo" //nolint golint
+	. "github.com/onsi/gomega" //nolint golint
+)
+
+var _ = Describe("localization", func() {
+
+	lastUpdated := time.Date(2020, time.March, 15, 10, 30, 0, 0, time.UTC)
+
+	Context("document header and footer", func() {
+
+		It("should localize document in german", func() {
+			source := `= Titel
+John Doe
+v1.0
+:lang: de`
+			expected := `<!DOCTYPE html>
+<html lang="de">
+<head>
+<meta charset="UTF-8">
+<meta http-equiv="X-UA-Compatible" content="IE=edge">
+<meta name="viewport" content="width=device-width, initial-scale=1.0">
+<meta name="generator" content="libasciidoc">
+<meta name="author" content="John Doe">
+<title>Titel</title>
+</head>
+<body class="article">
+<div id="header">
+<h1>Titel</h1>
+<div class="details">
+<span id="author" class="author">John Doe</span><br>
+<span id="revnumber">version 1.0</span>
+</div>
+</div>
+<div id="content">
+
+</div>
+<div id="footer">
+<div id="footer-text">
+Version 1.0<br>
+Zuletzt aktualisiert 15.03.2020 10:30:00 +0000
+</div>
+</div>
+</body>
+</html>`
+			Expect(RenderHTML(source, configuration.WithHeaderFooter(true),
+				configuration.WithLastUpdated(lastUpdated))).
+				To(MatchHTML(expected))
+		})
+
+		It("should localize document in japanese with custom labels", func() {
+			source := `= タイトル
+John Doe
+v1.0
+:lang: ja
+:version-label: 版`
+			expected := `<!DOCTYPE html>
+<html lang="ja">
+<head>
+<meta charset="UTF-8">
+<meta http-equiv="X-UA-Compatible" content="IE=edge">
+<meta name="viewport" content="width=device-width, initial-scale=1.0">
+<meta name="generator" content="libasciidoc">
+<meta name="author" content="John Doe">
+<title>タイトル</title>
+</head>
+<body class="article">
+<div id="header">
+<h1>タイトル</h1>
+<div class="details">
+<span id="author" class="author">John Doe</span><br>
+<span id="revnumber">version 1.0</span>
+</div>
+</div>
+<div id="content">
+
+</div>
+<div id="footer">
+<div id="footer-text">
+版 1.0<br>
+最終更新 2020年03月15日 10:30:00 +0000
+</div>
+</div>
+</body>
+</html>`
+			Expect(RenderHTML(source, configuration.WithHeaderFooter(true),
+				configuration.WithLastUpdated(lastUpdated))).
+				To(MatchHTML(expected))
+		})
+
+		It("should fallback to english with unknown language", func() {
+			source := `= Title
+:lang: eo`
+			expected := `<!DOCTYPE html>
+<html lang="eo">
+<head>
+<meta charset="UTF-8">
+<meta http-equiv="X-UA-Compatible" content="IE=edge">
+<meta name="viewport" content="width=device-width, initial-scale=1.0">
+<meta name="generator" content="libasciidoc">
+<title>Title</title>
+</head>
+<body class="article">
+<div id="header">
+<h1>Title</h1>
+</div>
+<div id="content">
+
+</div>
+<div id="footer">
+<div id="footer-text">
+Last updated 2020-03-15 10:30:00 +0000
+</div>
+</div>
+</body>
+</html>`
+			Expect(RenderHTML(source, configuration.WithHeaderFooter(true),
+				configuration.WithLastUpdated(lastUpdated))).
+				To(MatchHTML(expected))
+		})
+	})
+
+	Context("table of contents", func() {
+
+		It("should localize table of contents title in french", func() {
+			source := `= Titre
+:toc:
+:lang: fr
+
+== Section A`
+			expected := `<div id="toc" class="toc">
+<div id="toctitle">Table des matières</div>
+<ul class="sectlevel1">
+<li><a href="#_section_a">Section A</a></li>
+</ul>
+</div>
+<div class="sect1">
+<h2 id="_section_a">Section A</h2>
+<div class="sectionbody">
+</div>
+</div>`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("should use custom table of contents title", func() {
+			source := `= Titre
+:toc:
+:lang: fr
+:toc-title: Sommaire
+
+== Section A`
+			expected := `<div id="toc" class="toc">
+<div id="toctitle">Sommaire</div>
+<ul class="sectlevel1">
+<li><a href="#_section_a">Section A</a></li>
+</ul>
+</div>
+<div class="sect1">
+<h2 id="_section_a">Section A</h2>
+<div class="sectionbody">
+</div>
+</div>`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+	})
+
+	Context("admonitions", func() {
+
+		It("should localize admonition caption in spanish with region", func() {
+			source := `:lang: es-MX
+
+WARNING: cuidado`
+			expected := `<div class="admonitionblock warning">
+<table>
+<tr>
+<td class="icon">
+<div class="title">Aviso</div>
+</td>
+<td class="content">
+cuidado
+</td>
+</tr>
+</table>
+</div>`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("should use custom admonition caption", func() {
+			source := `:lang: zh_CN
+:note-caption: 注释
+
+NOTE: 内容`
+			expected := `<div class="admonitionblock note">
+<table>
+<tr>
+<td class="icon">
+<div class="title">注释</div>
+</td>
+<td class="content">
+内容
+</td>
+</tr>
+</table>
+</div>`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+	})
+
+	Context("captions", func() {
+
+		It("should localize figure and table captions in german", func() {
+			source := `:lang: de
+
+.Ein Bild
+image::foo.png[]
+
+.Eine Tabelle
+|===
+| foo
+|===`
+			expected := `<div class="imageblock">
+<div class="content">
+<img src="foo.png" alt="foo">
+</div>
+<div class="title">Abbildung 1. Ein Bild</div>
+</div>
+<table class="tableblock frame-all grid-all stretch">
+<caption class="title">Tabelle 1. Eine Tabelle</caption>
+<colgroup>
+<col style="width: 100%;">
+</colgroup>
+<tbody>
+<tr>
+<td class="tableblock halign-left valign-top"><p class="tableblock">foo</p></td>
+</tr>
+</tbody>
+</table>`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("should use custom example caption over localized caption", func() {
+			source := `:lang: fr
+:example-caption: Exemple de code
+
+.Bonjour
+====
+le monde
+====`
+			expected := `<div class="exampleblock">
+<div class="title">Exemple de code 1. Bonjour</div>
+<div class="content">
+<div class="paragraph">
+<p>le monde</p>
+</div>
+</div>
+</div>`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+	})
+})
@@ -84,6 +84,15 @@ some content`
 			Expect(RenderHTML(source)).To(MatchHTML(expected))
 
 		})
+
+		It("paragraph with short-hand id and multiple roles", func() {
+			source := `[#intro.text-left.lead]
+some content`
+			expected := `<div id="intro" class="paragraph text-left lead">
+<p>some content</p>
+</div>`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
 	})
 
 	Context("paragraphs with line break", func() {
@@ -1,7 +1,7 @@
 package html5
 
 const (
-	tableTmpl = `{{ $ctx := .Context }}{{ with .Data }}<table class="tableblock frame-all grid-all stretch">{{ if .Lines }}
+	tableTmpl = `{{ $ctx := .Context }}{{ with .Data }}<table{{ if .ID }} id="{{ .ID }}"{{ end }} class="tableblock frame-all grid-all stretch">{{ if .Lines }}
 {{ if .Title }}<caption class="title">{{ escape .Title }}</caption>
 {{ end }}<colgroup>
 {{ $cellWidths := .CellWidths }}{{ range $index, $width := $cellWidths }}<col style="width: {{ $width }}%;">{{ includeNewline $ctx $index $cellWidths }}{{ end }}
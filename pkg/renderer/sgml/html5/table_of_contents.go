@@ -2,8 +2,8 @@ package html5
 
 const (
 	tocRootTmpl = `<div id="toc" class="toc">
-<div id="toctitle">Table of Contents</div>
-{{ . }}
+<div id="toctitle">{{ escape .Title }}</div>
+{{ .Sections }}
 </div>`
 
 	tocSectionTmpl = `{{ $ctx := .Context }}{{ with .Data }}<ul class="sectlevel{{ .Level }}">
@@ -30,6 +30,7 @@ var templates = sgml.Templates{
 	IconFont:                iconFontTmpl,
 	IconImage:               iconImageTmpl,
 	IconText:                iconTextTmpl,
+	InlineAnchor:            inlineAnchorTmpl,
 	InlineIcon:              inlineIconTmpl,
 	InlineImage:             inlineImageTmpl,
 	InternalCrossReference:  internalCrossReferenceTmpl,
@@ -45,6 +46,7 @@ var templates = sgml.Templates{
 	ManpageNameParagraph:    manpageNameParagraphTmpl,
 	MarkedText:              markedTextTmpl,
 	MonospaceText:           monospaceTextTmpl,
+	OpenBlock:               openBlockTmpl,
 	OrderedList:             orderedListTmpl,
 	PassthroughBlock:        pssThroughBlock,
 	Paragraph:               paragraphTmpl,
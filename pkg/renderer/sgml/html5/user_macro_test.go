@@ -1,10 +1,12 @@
 package html5_test
 
 import (
+	"fmt"
 	texttemplate "text/template"
 
 	"github.com/bytesparadise/libasciidoc/pkg/configuration"
 	"github.com/bytesparadise/libasciidoc/pkg/renderer/sgml"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
 	. "github.com/bytesparadise/libasciidoc/testsupport"
 
 	. "github.com/onsi/ginkgo" //nolint golint
@@ -61,7 +63,7 @@ var _ = Describe("user macros", func() {
 
 		It("user macro block with value and attributes", func() {
 
-			source := `hello::JohnDoe[prefix="Hi ",suffix="!!"]`
+			source := `hello::JohnDoe[prefix="Hi",suffix="!!"]`
 			expected := `<div class="helloblock">
 <div class="content">
 <span>Hi JohnDoe!!</span>
@@ -108,7 +110,7 @@ var _ = Describe("user macros", func() {
 
 		It("inline macro with value and attributes", func() {
 
-			source := `AAA hello:JohnDoe[prefix="Hi ",suffix="!!"]`
+			source := `AAA hello:JohnDoe[prefix="Hi",suffix="!!"]`
 			expected := `<div class="paragraph">
 <p>AAA <span>Hi JohnDoe!!</span></p>
 </div>`
@@ -118,6 +120,141 @@ var _ = Describe("user macros", func() {
 	})
 })
 
+var _ = Describe("user macro processors", func() {
+
+	// replaces the `jira:PROJ-123[]` macros with links to the issues
+	jira := func(ctx configuration.MacroContext, macro types.UserMacro) ([]interface{}, error) {
+		url, _ := ctx.Attributes.GetAsString("jira-url")
+		text := macro.Value
+		if positionals := macro.Positionals; len(positionals) > 0 {
+			text = positionals[0]
+		}
+		return []interface{}{
+			types.InlineLink{
+				Location: types.Location{
+					Scheme: "https://",
+					Path: []interface{}{
+						types.StringElement{Content: url + "/browse/" + macro.Value},
+					},
+				},
+				Attributes: types.Attributes{
+					"positional-1": []interface{}{
+						types.StringElement{Content: text},
+					},
+				},
+			},
+		}, nil
+	}
+
+	// replaces the `note::text[]` macros with an admonition paragraph
+	note := func(ctx configuration.MacroContext, macro types.UserMacro) ([]interface{}, error) {
+		return []interface{}{
+			types.Paragraph{
+				Attributes: types.Attributes{
+					types.AttrAdmonitionKind: types.Note,
+				},
+				Lines: [][]interface{}{
+					{
+						types.StringElement{Content: macro.Value},
+					},
+				},
+			},
+		}, nil
+	}
+
+	It("inline macro processor", func() {
+		source := `:jira-url: jira.example.com
+
+see jira:PROJ-123[] and jira:PROJ-456["the other issue", role=x]`
+		expected := `<div class="paragraph">
+<p>see <a href="https://jira.example.com/browse/PROJ-123">PROJ-123</a> and <a href="https://jira.example.com/browse/PROJ-456">the other issue</a></p>
+</div>`
+		Expect(RenderHTML(source, configuration.WithInlineMacroProcessor("jira", jira))).To(MatchHTML(expected))
+	})
+
+	It("inline macro processor in list item", func() {
+		source := `* see jira:PROJ-123[]`
+		expected := `<div class="ulist">
+<ul>
+<li>
+<p>see <a href="https://jira.example.com/browse/PROJ-123">PROJ-123</a></p>
+</li>
+</ul>
+</div>`
+		Expect(RenderHTML(source,
+			configuration.WithAttribute("jira-url", "jira.example.com"),
+			configuration.WithInlineMacroProcessor("jira", jira))).To(MatchHTML(expected))
+	})
+
+	It("inline macro processor over template", func() {
+		source := `see jira:PROJ-123[]`
+		expected := `<div class="paragraph">
+<p>see <a href="https://jira.example.com/browse/PROJ-123">PROJ-123</a></p>
+</div>`
+		Expect(RenderHTML(source,
+			configuration.WithAttribute("jira-url", "jira.example.com"),
+			configuration.WithMacroTemplate("jira", helloMacroTmpl),
+			configuration.WithInlineMacroProcessor("jira", jira))).To(MatchHTML(expected))
+	})
+
+	It("block macro processor", func() {
+		source := `== Section
+
+note::important[]`
+		expected := `<div class="sect1">
+<h2 id="_section">Section</h2>
+<div class="sectionbody">
+<div class="admonitionblock note">
+<table>
+<tr>
+<td class="icon">
+<div class="title">Note</div>
+</td>
+<td class="content">
+important
+</td>
+</tr>
+</table>
+</div>
+</div>
+</div>`
+		Expect(RenderHTML(source, configuration.WithBlockMacroProcessor("note", note))).To(MatchHTML(expected))
+	})
+
+	It("block macro processor does not process inline macro", func() {
+		source := `a note:important[] macro`
+		expected := `<div class="paragraph">
+<p>a note:important[] macro</p>
+</div>`
+		Expect(RenderHTML(source, configuration.WithBlockMacroProcessor("note", note))).To(MatchHTML(expected))
+	})
+
+	It("inline macro processor with the state of the rendering", func() {
+		source := `* depth:item[]`
+		depth := func(ctx configuration.MacroContext, macro types.UserMacro) ([]interface{}, error) {
+			return []interface{}{
+				types.StringElement{Content: fmt.Sprintf("%s at depth %d", macro.Value, ctx.WithinList)},
+			}, nil
+		}
+		expected := `<div class="ulist">
+<ul>
+<li>
+<p>item at depth 1</p>
+</li>
+</ul>
+</div>`
+		Expect(RenderHTML(source, configuration.WithInlineMacroProcessor("depth", depth))).To(MatchHTML(expected))
+	})
+
+	It("failing macro processor", func() {
+		source := `see jira:PROJ-123[]`
+		_, err := RenderHTML(source, configuration.WithInlineMacroProcessor("jira", func(ctx configuration.MacroContext, macro types.UserMacro) ([]interface{}, error) {
+			return nil, fmt.Errorf("mock error")
+		}))
+		Expect(err).To(MatchError(ContainSubstring("unable to process the 'jira' macro: mock error")))
+	})
+})
+
 func init() {
 	t := texttemplate.New("hello")
 	t.Funcs(texttemplate.FuncMap{
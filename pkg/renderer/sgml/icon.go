@@ -55,9 +55,12 @@ func (r *sgmlRenderer) renderIcon(ctx *renderer.Context, icon types.Icon, admoni
 	default:
 		return "", fmt.Errorf("unsupported icon type %s", icons)
 	}
+	alt := strings.Title(icon.Class)
 	title := ""
 	if admonition {
-		title = strings.Title(icon.Class)
+		// caption of the admonition (eg: `note-caption`), in the language of the document
+		alt = ctx.Attributes.GetLabel(icon.Class + "-caption")
+		title = alt
 	}
 	s := &strings.Builder{}
 	err := template.Execute(s, struct {
@@ -75,7 +78,7 @@ func (r *sgmlRenderer) renderIcon(ctx *renderer.Context, icon types.Icon, admoni
 		Admonition bool
 	}{
 		Class:      icon.Class,
-		Alt:        icon.Attributes.GetAsStringWithDefault(types.AttrImageAlt, strings.Title(icon.Class)),
+		Alt:        icon.Attributes.GetAsStringWithDefault(types.AttrImageAlt, alt),
 		Title:      icon.Attributes.GetAsStringWithDefault(types.AttrImageTitle, title),
 		Width:      icon.Attributes.GetAsStringWithDefault(types.AttrImageWidth, ""),
 		Height:     icon.Attributes.GetAsStringWithDefault(types.AttrImageHeight, ""),
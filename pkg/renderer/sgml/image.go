@@ -2,7 +2,6 @@ package sgml
 
 import (
 	"bytes"
-	"strconv"
 
 	"github.com/bytesparadise/libasciidoc/pkg/renderer"
 	"github.com/bytesparadise/libasciidoc/pkg/types"
@@ -11,10 +10,7 @@ import (
 
 func (r *sgmlRenderer) renderImageBlock(ctx *renderer.Context, img types.ImageBlock) ([]byte, error) {
 	result := &bytes.Buffer{}
-	title := ""
-	if t, found := img.Attributes.GetAsString(types.AttrTitle); found {
-		title = "Figure " + strconv.Itoa(ctx.GetAndIncrementImageCounter()) + ". " + EscapeString(t)
-	}
+	title := EscapeString(r.renderElementCaptionedTitle(img.Attributes))
 	err := r.blockImage.Execute(result, struct {
 		ID     string
 		Title  string
@@ -27,7 +23,7 @@ func (r *sgmlRenderer) renderImageBlock(ctx *renderer.Context, img types.ImageBl
 	}{
 		ID:     img.Attributes.GetAsStringWithDefault(types.AttrID, ""),
 		Title:  title,
-		Role:   img.Attributes.GetAsStringWithDefault(types.AttrRole, ""),
+		Role:   r.renderElementRoles(img.Attributes),
 		Href:   img.Attributes.GetAsStringWithDefault(types.AttrInlineLink, ""),
 		Alt:    img.Attributes.GetAsStringWithDefault(types.AttrImageAlt, ""),
 		Width:  img.Attributes.GetAsStringWithDefault(types.AttrImageWidth, ""),
@@ -54,7 +50,7 @@ func (r *sgmlRenderer) renderInlineImage(img types.InlineImage) ([]byte, error)
 		Path   string
 	}{
 		Title:  r.renderElementTitle(img.Attributes),
-		Role:   img.Attributes.GetAsStringWithDefault(types.AttrRole, ""),
+		Role:   r.renderElementRoles(img.Attributes),
 		Alt:    img.Attributes.GetAsStringWithDefault(types.AttrImageAlt, ""),
 		Width:  img.Attributes.GetAsStringWithDefault(types.AttrImageWidth, ""),
 		Height: img.Attributes.GetAsStringWithDefault(types.AttrImageHeight, ""),
@@ -26,7 +26,7 @@ func (r *sgmlRenderer) renderLabeledList(ctx *renderer.Context, l types.LabeledL
 		}{
 			ID:    r.renderElementID(l.Attributes),
 			Title: r.renderElementTitle(l.Attributes),
-			Role:  l.Attributes.GetAsStringWithDefault(types.AttrRole, ""),
+			Role:  r.renderElementRoles(l.Attributes),
 			Items: l.Items,
 		},
 	})
@@ -46,7 +46,7 @@ func (r *sgmlRenderer) getLabeledListTmpl(l types.LabeledList) (*textTemplate, e
 			return nil, errors.Errorf("unsupported labeled list layout: %s", layout)
 		}
 	}
-	if l.Attributes.Has(types.AttrQandA) {
+	if l.Attributes.GetStyle() == types.AttrQandA {
 		return r.qAndAList, nil
 	}
 	return r.labeledList, nil
@@ -23,7 +23,7 @@ func (r *sgmlRenderer) renderOrderedList(ctx *renderer.Context, l types.OrderedL
 		}{
 			ID:             r.renderElementID(l.Attributes),
 			Title:          l.Attributes.GetAsStringWithDefault(types.AttrTitle, ""),
-			Role:           l.Attributes.GetAsStringWithDefault(types.AttrRole, ""),
+			Role:           r.renderElementRoles(l.Attributes),
 			NumberingStyle: getNumberingStyle(l),
 			ListStyle:      r.numberingType(getNumberingStyle(l)),
 			Start:          l.Attributes.GetAsStringWithDefault(types.AttrStart, ""),
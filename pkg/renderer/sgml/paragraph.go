@@ -44,7 +44,7 @@ func (r *sgmlRenderer) renderParagraph(ctx *renderer.Context, p types.Paragraph)
 				Class:      getParagraphClass(p),
 				Title:      r.renderElementTitle(p.Attributes),
 				Lines:      p.Lines,
-				HardBreaks: r.withHardBreaks(p.Attributes.Has(types.AttrHardBreaks) || ctx.Attributes.Has(types.DocumentAttrHardBreaks)),
+				HardBreaks: r.withHardBreaks(p.Attributes.HasOption(types.AttrHardBreaks) || ctx.Attributes.Has(types.DocumentAttrHardBreaks)),
 			},
 		})
 	}
@@ -211,8 +211,11 @@ func renderClass(kind types.AdmonitionKind) string {
 
 func getParagraphClass(p types.Paragraph) string {
 	result := "paragraph"
-	if role, found := p.Attributes.GetAsString(types.AttrRole); found {
+	switch role := p.Attributes[types.AttrRole].(type) {
+	case string:
 		result = result + " " + role
+	case []string:
+		result = result + " " + strings.Join(role, " ")
 	}
 	return result
 }
@@ -224,6 +227,16 @@ func (r *sgmlRenderer) renderElementTitle(attrs types.Attributes) string {
 	return ""
 }
 
+// renderElementCaptionedTitle returns the title of the element, prefixed with its caption (eg: `Figure 1. `), if any
+func (r *sgmlRenderer) renderElementCaptionedTitle(attrs types.Attributes) string {
+	title := r.renderElementTitle(attrs)
+	if title == "" {
+		return ""
+	}
+	caption, _ := attrs.GetAsString(types.AttrCaption)
+	return caption + title
+}
+
 // RenderLinesConfig the config to use when rendering paragraph lines
 type RenderLinesConfig struct {
 	render     renderFunc
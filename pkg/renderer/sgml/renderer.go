@@ -7,7 +7,6 @@ import (
 	"strings"
 	texttemplate "text/template"
 
-	"github.com/bytesparadise/libasciidoc/pkg/configuration"
 	"github.com/bytesparadise/libasciidoc/pkg/renderer"
 	"github.com/bytesparadise/libasciidoc/pkg/types"
 
@@ -115,35 +114,42 @@ func (r *sgmlRenderer) Render(ctx *renderer.Context, doc types.Document, output
 	if err != nil {
 		return md, errors.Wrapf(err, "unable to render full document")
 	}
+	lastUpdated := ctx.Config.LastUpdated.Format(types.LastUpdatedFormat(doc.Attributes.GetAsStringWithDefault(types.AttrLang, types.DefaultLang)))
 
 	if ctx.Config.IncludeHeaderFooter {
 		log.Debugf("Rendering full document...")
 		err = r.article.Execute(output, struct {
-			Generator     string
-			Doctype       string
-			Title         string
-			Authors       string
-			Header        string
-			Role          string
-			Content       sanitized
-			RevNumber     string
-			LastUpdated   string
-			CSS           string
-			IncludeHeader bool
-			IncludeFooter bool
+			Generator        string
+			Lang             string
+			Doctype          string
+			Title            string
+			Authors          string
+			Header           string
+			Role             string
+			Content          sanitized
+			RevNumber        string
+			VersionLabel     string
+			LastUpdated      string
+			LastUpdatedLabel string
+			CSS              string
+			IncludeHeader    bool
+			IncludeFooter    bool
 		}{
-			Generator:     "libasciidoc", // TODO: externalize this value and include the lib version ?
-			Doctype:       doc.Attributes.GetAsStringWithDefault(types.AttrDocType, "article"),
-			Title:         string(renderedTitle),
-			Authors:       r.renderAuthors(doc),
-			Header:        string(renderedHeader),
-			Role:          documentRole(doc),
-			Content:       sanitized(renderedContent), //nolint: gosec
-			RevNumber:     doc.Attributes.GetAsStringWithDefault("revnumber", ""),
-			LastUpdated:   ctx.Config.LastUpdated.Format(configuration.LastUpdatedFormat),
-			CSS:           ctx.Config.CSS,
-			IncludeHeader: !doc.Attributes.Has(types.AttrNoHeader),
-			IncludeFooter: !doc.Attributes.Has(types.AttrNoFooter),
+			Generator:        "libasciidoc", // TODO: externalize this value and include the lib version ?
+			Lang:             doc.Attributes.GetAsStringWithDefault(types.AttrLang, types.DefaultLang),
+			Doctype:          doc.Attributes.GetAsStringWithDefault(types.AttrDocType, "article"),
+			Title:            string(renderedTitle),
+			Authors:          r.renderAuthors(doc),
+			Header:           string(renderedHeader),
+			Role:             documentRole(doc),
+			Content:          sanitized(renderedContent), //nolint: gosec
+			RevNumber:        doc.Attributes.GetAsStringWithDefault("revnumber", ""),
+			VersionLabel:     doc.Attributes.GetLabel(types.AttrVersionLabel),
+			LastUpdated:      lastUpdated,
+			LastUpdatedLabel: doc.Attributes.GetLabel(types.AttrLastUpdateLabel),
+			CSS:              ctx.Config.CSS,
+			IncludeHeader:    !doc.Attributes.Has(types.AttrNoHeader),
+			IncludeFooter:    !doc.Attributes.Has(types.AttrNoFooter),
 		})
 		if err != nil {
 			return md, errors.Wrapf(err, "unable to render full document")
@@ -157,7 +163,7 @@ func (r *sgmlRenderer) Render(ctx *renderer.Context, doc types.Document, output
 	// generate the metadata to be returned to the caller
 	md.Title = string(renderedTitle)
 	// arguably this should be a time.Time for use in Go
-	md.LastUpdated = ctx.Config.LastUpdated.Format(configuration.LastUpdatedFormat)
+	md.LastUpdated = lastUpdated
 	md.TableOfContents = ctx.TableOfContents
 	return md, err
 }
@@ -305,11 +311,13 @@ func (r *sgmlRenderer) renderManpageHeader(ctx *renderer.Context, header types.S
 	output := &bytes.Buffer{}
 	err = r.manpageHeader.Execute(output, struct {
 		Header    string
+		Label     string
 		Name      string
 		Content   sanitized
 		IncludeH1 bool
 	}{
 		Header:    string(renderedHeader),
+		Label:     ctx.Attributes.GetLabel(types.AttrManpageLabel),
 		Name:      string(renderedName),
 		Content:   sanitized(renderedContent), //nolint: gosec
 		IncludeH1: len(renderedHeader) > 0,
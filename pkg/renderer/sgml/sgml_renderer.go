@@ -22,6 +22,7 @@ type sgmlRenderer struct {
 	documentAuthorDetails   *textTemplate
 	externalCrossReference  *textTemplate
 	exampleBlock            *textTemplate
+	openBlock               *textTemplate
 	fencedBlock             *textTemplate
 	footnote                *textTemplate
 	footnoteRef             *textTemplate
@@ -30,6 +31,7 @@ type sgmlRenderer struct {
 	iconFont                *textTemplate
 	iconImage               *textTemplate
 	iconText                *textTemplate
+	inlineAnchor            *textTemplate
 	inlineIcon              *textTemplate
 	inlineImage             *textTemplate
 	internalCrossReference  *textTemplate
@@ -88,6 +90,7 @@ func (r *sgmlRenderer) prepareTemplates() error {
 		r.documentDetails, err = r.newTemplate("document-details", tmpls.DocumentDetails, err)
 		r.documentAuthorDetails, err = r.newTemplate("document-author-details", tmpls.DocumentAuthorDetails, err)
 		r.exampleBlock, err = r.newTemplate("example-block", tmpls.ExampleBlock, err)
+		r.openBlock, err = r.newTemplate("open-block", tmpls.OpenBlock, err)
 		r.externalCrossReference, err = r.newTemplate("external-xref", tmpls.ExternalCrossReference, err)
 		r.fencedBlock, err = r.newTemplate("fenced-block", tmpls.FencedBlock, err)
 		r.footnote, err = r.newTemplate("footnote", tmpls.Footnote, err)
@@ -97,6 +100,7 @@ func (r *sgmlRenderer) prepareTemplates() error {
 		r.iconFont, err = r.newTemplate("icon-font", tmpls.IconFont, err)
 		r.iconImage, err = r.newTemplate("icon-image", tmpls.IconImage, err)
 		r.iconText, err = r.newTemplate("icon-text", tmpls.IconText, err)
+		r.inlineAnchor, err = r.newTemplate("inline-anchor", tmpls.InlineAnchor, err)
 		r.inlineIcon, err = r.newTemplate("inline-icon", tmpls.InlineIcon, err)
 		r.inlineImage, err = r.newTemplate("inline-image", tmpls.InlineImage, err)
 		r.internalCrossReference, err = r.newTemplate("internal-xref", tmpls.InternalCrossReference, err)
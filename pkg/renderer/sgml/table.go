@@ -32,18 +32,17 @@ func (r *sgmlRenderer) renderTable(ctx *renderer.Context, t types.Table) ([]byte
 		widths[n-1] = formatColumnWidth(100-total, lastColumn()) // make sure the last width as the upper rounded value
 		log.Debugf("current total width: %v -> %v", total, widths[n-1])
 	}
-	var title string
-	if titleAttr, ok := t.Attributes[types.AttrTitle].(string); ok {
-		title = fmt.Sprintf("Table %d. %s", ctx.GetAndIncrementTableCounter(), EscapeString(titleAttr))
-	}
+	title := EscapeString(r.renderElementCaptionedTitle(t.Attributes))
 	err := r.table.Execute(result, ContextualPipeline{
 		Context: ctx,
 		Data: struct {
+			ID         string
 			Title      string
 			CellWidths []string
 			Header     types.TableLine
 			Lines      []types.TableLine
 		}{
+			ID:         r.renderElementID(t.Attributes),
 			Title:      title,
 			CellWidths: widths,
 			Header:     t.Header,
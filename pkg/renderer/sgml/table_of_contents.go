@@ -21,7 +21,13 @@ func (r *sgmlRenderer) renderTableOfContents(ctx *renderer.Context, toc types.Ta
 		return []byte{}, nil
 	}
 	result := &bytes.Buffer{}
-	err = r.tocRoot.Execute(result, renderedSections)
+	err = r.tocRoot.Execute(result, struct {
+		Title    string
+		Sections sanitized
+	}{
+		Title:    ctx.Attributes.GetLabel(types.AttrTableOfContentsTitle),
+		Sections: renderedSections,
+	})
 	if err != nil {
 		return nil, errors.Wrapf(err, "error while rendering table of contents")
 	}
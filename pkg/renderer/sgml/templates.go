@@ -23,6 +23,7 @@ type Templates struct {
 	IconFont                string
 	IconImage               string
 	IconText                string
+	InlineAnchor            string
 	InlineIcon              string
 	InlineImage             string
 	InternalCrossReference  string
@@ -38,6 +39,7 @@ type Templates struct {
 	ManpageNameParagraph    string
 	MarkedText              string
 	MonospaceText           string
+	OpenBlock               string
 	OrderedList             string
 	Paragraph               string
 	PassthroughBlock        string
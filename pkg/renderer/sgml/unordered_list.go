@@ -29,7 +29,7 @@ func (r *sgmlRenderer) renderUnorderedList(ctx *renderer.Context, l types.Unorde
 		}{
 			ID:        r.renderElementID(l.Attributes),
 			Title:     r.renderElementTitle(l.Attributes),
-			Role:      l.Attributes.GetAsStringWithDefault(types.AttrRole, ""),
+			Role:      r.renderElementRoles(l.Attributes),
 			Checklist: checkList,
 			Items:     l.Items,
 		},
@@ -3,11 +3,23 @@ package sgml
 import (
 	"bytes"
 
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
 	"github.com/bytesparadise/libasciidoc/pkg/renderer"
 	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
 )
 
 func (r *sgmlRenderer) renderUserMacro(ctx *renderer.Context, um types.UserMacro) ([]byte, error) {
+	if elements, found, err := processUserMacro(ctx, um); err != nil {
+		return nil, err
+	} else if found {
+		if um.Kind == types.BlockMacro {
+			return r.renderElements(ctx, elements)
+		}
+		return r.renderInlineElements(ctx, elements)
+	}
 	buf := &bytes.Buffer{}
 	macro, err := ctx.Config.MacroTemplate(um.Name)
 	if err != nil {
@@ -31,3 +43,36 @@ func (r *sgmlRenderer) renderUserMacro(ctx *renderer.Context, um types.UserMacro
 	return buf.Bytes(), nil
 
 }
+
+// processUserMacro calls the processor of the given macro, if defined in the configuration,
+// and returns the elements to render in place of the macro
+func processUserMacro(ctx *renderer.Context, um types.UserMacro) ([]interface{}, bool, error) {
+	macroCtx := configuration.MacroContext{
+		Config:               ctx.Config,
+		Attributes:           ctx.Attributes,
+		ElementReferences:    ctx.ElementReferences,
+		WithinDelimitedBlock: ctx.WithinDelimitedBlock,
+		WithinList:           ctx.WithinList,
+	}
+	var elements []interface{}
+	var err error
+	switch um.Kind {
+	case types.BlockMacro:
+		p, found := ctx.Config.BlockMacroProcessor(um.Name)
+		if !found {
+			return nil, false, nil
+		}
+		elements, err = p(macroCtx, um)
+	default:
+		p, found := ctx.Config.InlineMacroProcessor(um.Name)
+		if !found {
+			return nil, false, nil
+		}
+		elements, err = p(macroCtx, um)
+	}
+	if err != nil {
+		return nil, false, errors.Wrapf(err, "unable to process the '%s' macro", um.Name)
+	}
+	log.Debugf("rendering the '%s' macro with %d element(s)", um.Name, len(elements))
+	return elements, true, nil
+}
@@ -57,6 +57,187 @@ with some content linked to <<thewrongtitle>>!`
 <p>with some content linked to <a href="#thewrongtitle">[thewrongtitle]</a>!</p>
 </div>
 </div>
+</div>`
+			Expect(RenderXHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("cross reference to inline anchors", func() {
+			source := `a paragraph with an [[first,First Anchor]]anchor, an anchor:second[Second Anchor] and a [[third]]third one.
+
+see <<first>>, <<second>> and <<third>>`
+			expected := `<div class="paragraph">
+<p>a paragraph with an <a id="first"></a>anchor, an <a id="second"></a> and a <a id="third"></a>third one.</p>
+</div>
+<div class="paragraph">
+<p>see <a href="#first">First Anchor</a>, <a href="#second">Second Anchor</a> and <a href="#third">[third]</a></p>
+</div>`
+			Expect(RenderXHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("cross reference to section with reftext", func() {
+			source := `[[thetitle,The Title]]
+== a title
+
+with some content linked to <<thetitle>>!`
+			expected := `<div class="sect1">
+<h2 id="thetitle">a title</h2>
+<div class="sectionbody">
+<div class="paragraph">
+<p>with some content linked to <a href="#thetitle">The Title</a>!</p>
+</div>
+</div>
+</div>`
+			Expect(RenderXHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("cross reference to numbered section with full style", func() {
+			source := `:sectnums:
+:xrefstyle: full
+
+== first section
+
+=== a subsection
+
+see <<_a_subsection>>`
+			expected := `<div class="sect1">
+<h2 id="_first_section">first section</h2>
+<div class="sectionbody">
+<div class="sect2">
+<h3 id="_a_subsection">a subsection</h3>
+<div class="paragraph">
+<p>see <a href="#_a_subsection">Section 1.1, &#8220;a subsection&#8221;</a></p>
+</div>
+</div>
+</div>
+</div>`
+			Expect(RenderXHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("cross reference to numbered section with short style", func() {
+			source := `:sectnums:
+:xrefstyle: short
+
+== first section
+
+see <<_first_section>>`
+			expected := `<div class="sect1">
+<h2 id="_first_section">first section</h2>
+<div class="sectionbody">
+<div class="paragraph">
+<p>see <a href="#_first_section">Section 1</a></p>
+</div>
+</div>
+</div>`
+			Expect(RenderXHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("cross reference to numbered section in the language of the document", func() {
+			source := `:sectnums:
+:xrefstyle: short
+:lang: de
+
+== first section
+
+see <<_first_section>>`
+			expected := `<div class="sect1">
+<h2 id="_first_section">first section</h2>
+<div class="sectionbody">
+<div class="paragraph">
+<p>see <a href="#_first_section">Abschnitt 1</a></p>
+</div>
+</div>
+</div>`
+			Expect(RenderXHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("cross reference to unnumbered section with full style", func() {
+			source := `:xrefstyle: full
+
+== first section
+
+see <<_first_section>>`
+			expected := `<div class="sect1">
+<h2 id="_first_section">first section</h2>
+<div class="sectionbody">
+<div class="paragraph">
+<p>see <a href="#_first_section">first section</a></p>
+</div>
+</div>
+</div>`
+			Expect(RenderXHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("cross references to figure and table with full style", func() {
+			source := `:xrefstyle: full
+
+see <<table>> and <<figure>>
+
+[#figure]
+.a figure
+image::foo.png[]
+
+[#table]
+.a table
+|===
+| cell
+|===`
+			expected := `<div class="paragraph">
+<p>see <a href="#table">Table 1, &#8220;a table&#8221;</a> and <a href="#figure">Figure 1, &#8220;a figure&#8221;</a></p>
+</div>
+<div id="figure" class="imageblock">
+<div class="content">
+<img src="foo.png" alt="foo"/>
+</div>
+<div class="title">Figure 1. a figure</div>
+</div>
+<table id="table" class="tableblock frame-all grid-all stretch">
+<caption class="title">Table 1. a table</caption>
+<colgroup>
+<col style="width: 100%;"/>
+</colgroup>
+<tbody>
+<tr>
+<td class="tableblock halign-left valign-top"><p class="tableblock">cell</p></td>
+</tr>
+</tbody>
+</table>`
+			Expect(RenderXHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("cross reference to figure with short style", func() {
+			source := `:xrefstyle: short
+
+[#figure]
+.a figure
+image::foo.png[]
+
+see <<figure>>`
+			expected := `<div id="figure" class="imageblock">
+<div class="content">
+<img src="foo.png" alt="foo"/>
+</div>
+<div class="title">Figure 1. a figure</div>
+</div>
+<div class="paragraph">
+<p>see <a href="#figure">Figure 1</a></p>
+</div>`
+			Expect(RenderXHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("cross reference to figure with basic style", func() {
+			source := `[#figure]
+.a figure
+image::foo.png[]
+
+see <<figure>>`
+			expected := `<div id="figure" class="imageblock">
+<div class="content">
+<img src="foo.png" alt="foo"/>
+</div>
+<div class="title">Figure 1. a figure</div>
+</div>
+<div class="paragraph">
+<p>see <a href="#figure">a figure</a></p>
 </div>`
 			Expect(RenderXHTML(source)).To(MatchHTML(expected))
 		})
@@ -77,6 +258,31 @@ with some content linked to <<thewrongtitle>>!`
 some content linked to xref:{foo}.adoc[another_doc()]!`
 			expected := `<div class="paragraph">
 <p>some content linked to <a href="foo-doc.html">another_doc()</a>!</p>
+</div>`
+			Expect(RenderXHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("external cross reference to element in other doc", func() {
+			source := `some content linked to xref:another-doc.adoc#section_a[]!`
+			expected := `<div class="paragraph">
+<p>some content linked to <a href="another-doc.html#section_a">another-doc.html#section_a</a>!</p>
+</div>`
+			Expect(RenderXHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("external cross reference to element in other doc with relfilesuffix", func() {
+			source := `:relfilesuffix: /
+some content linked to xref:another-doc.adoc#section_a[another doc]!`
+			expected := `<div class="paragraph">
+<p>some content linked to <a href="another-doc/#section_a">another doc</a>!</p>
+</div>`
+			Expect(RenderXHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("inter-document cross reference with angled brackets", func() {
+			source := `some content linked to <<another-doc.adoc#section_a,another doc>>!`
+			expected := `<div class="paragraph">
+<p>some content linked to <a href="another-doc.html#section_a">another doc</a>!</p>
 </div>`
 			Expect(RenderXHTML(source)).To(MatchHTML(expected))
 		})
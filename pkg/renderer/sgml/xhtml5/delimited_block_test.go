@@ -1,6 +1,11 @@
 package xhtml5_test
 
 import (
+	"fmt"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
 	. "github.com/bytesparadise/libasciidoc/testsupport"
 
 	. "github.com/onsi/ginkgo" //nolint golint
@@ -215,6 +220,34 @@ import <a>
 </div>`
 			Expect(RenderXHTML(source)).To(MatchHTML(expected))
 		})
+		It("with title and listing caption", func() {
+			source := `:listing-caption: Listing
+
+.first title
+----
+some source code
+----
+
+[source,go]
+.second title
+----
+package main
+----`
+			expected := `<div class="listingblock">
+<div class="title">Listing 1. first title</div>
+<div class="content">
+<pre>some source code</pre>
+</div>
+</div>
+<div class="listingblock">
+<div class="title">Listing 2. second title</div>
+<div class="content">
+<pre class="highlight"><code class="language-go" data-lang="go">package main</code></pre>
+</div>
+</div>`
+			Expect(RenderXHTML(source)).To(MatchHTML(expected))
+		})
+
 	})
 
 	Context("source blocks", func() {
@@ -475,6 +508,84 @@ foo
 <p>foo</p>
 </div>
 </div>
+</div>`
+			Expect(RenderXHTML(source)).To(MatchHTML(expected))
+		})
+		It("example blocks with example caption disabled in-between", func() {
+			source := `.first title
+====
+foo
+====
+
+:example-caption!:
+
+.second title
+====
+bar
+====`
+			expected := `<div class="exampleblock">
+<div class="title">Example 1. first title</div>
+<div class="content">
+<div class="paragraph">
+<p>foo</p>
+</div>
+</div>
+</div>
+<div class="exampleblock">
+<div class="title">second title</div>
+<div class="content">
+<div class="paragraph">
+<p>bar</p>
+</div>
+</div>
+</div>`
+			Expect(RenderXHTML(source)).To(MatchHTML(expected))
+		})
+
+	})
+
+	Context("open blocks", func() {
+
+		It("open block with paragraphs", func() {
+			source := `[#open]
+.Open block
+--
+some *content*
+
+another paragraph
+--`
+			expected := `<div id="open" class="openblock">
+<div class="title">Open block</div>
+<div class="content">
+<div class="paragraph">
+<p>some <strong>content</strong></p>
+</div>
+<div class="paragraph">
+<p>another paragraph</p>
+</div>
+</div>
+</div>`
+			Expect(RenderXHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("open block with admonition", func() {
+			source := `[NOTE]
+--
+a note
+--`
+			expected := `<div class="admonitionblock note">
+<table>
+<tr>
+<td class="icon">
+<div class="title">Note</div>
+</td>
+<td class="content">
+<div class="paragraph">
+<p>a note</p>
+</div>
+</td>
+</tr>
+</table>
 </div>`
 			Expect(RenderXHTML(source)).To(MatchHTML(expected))
 		})
@@ -1274,6 +1385,18 @@ _foo_
 			Expect(RenderXHTML(source)).To(MatchHTML(expected))
 		})
 
+		It("with html content", func() {
+			source := `++++
+<video src="video.mp4" controls>
+  your browser does not support the <code>video</code> tag &amp; more.
+</video>
+++++`
+			expected := `<video src="video.mp4" controls>
+  your browser does not support the <code>video</code> tag &amp; more.
+</video>`
+			Expect(RenderXHTML(source)).To(MatchHTML(expected))
+		})
+
 	})
 
 	Context("passthrough open block", func() {
@@ -1293,3 +1416,117 @@ another paragraph`
 		})
 	})
 })
+
+var _ = Describe("delimited block processors", func() {
+
+	// renders the lines of the block in a `<pre>` element, along with the kind of block and its attributes
+	diagram := func(ctx configuration.BlockContext, lines []string, attributes types.Attributes) ([]interface{}, error) {
+		format, _ := attributes.GetAsString(types.AttrPositional + "3")
+		return []interface{}{
+			types.NewPassthroughBlock(
+				fmt.Sprintf(`<pre class="diagram %s %s">`, ctx.Kind, format),
+				strings.Join(lines, "\n"),
+				`</pre>`,
+			),
+		}, nil
+	}
+
+	// replaces the block with a paragraph whose text is the upper case content of the block
+	shout := func(ctx configuration.BlockContext, lines []string, attributes types.Attributes) ([]interface{}, error) {
+		return []interface{}{
+			types.Paragraph{
+				Attributes: types.Attributes{},
+				Lines: [][]interface{}{
+					{
+						types.StringElement{Content: strings.ToUpper(strings.Join(lines, " "))},
+					},
+				},
+			},
+		}, nil
+	}
+
+	It("listing block with rendered output", func() {
+		source := `[graphviz, diagram, svg]
+----
+digraph { a -> b }
+----`
+		expected := `<pre class="diagram listing svg">
+digraph { a -> b }
+</pre>`
+		Expect(RenderXHTML(source, configuration.WithBlockProcessor("graphviz", diagram))).To(MatchHTML(expected))
+	})
+
+	It("literal block with rendered output", func() {
+		source := `[graphviz]
+....
+digraph { a -> b }
+....`
+		expected := `<pre class="diagram literal ">
+digraph { a -> b }
+</pre>`
+		Expect(RenderXHTML(source, configuration.WithBlockProcessor("graphviz", diagram))).To(MatchHTML(expected))
+	})
+
+	It("example block with raw lines replaced by a paragraph", func() {
+		source := `== Section
+
+[shout]
+====
+some *raw* content
+with {unknown} attribute
+====`
+		expected := `<div class="sect1">
+<h2 id="_section">Section</h2>
+<div class="sectionbody">
+<div class="paragraph">
+<p>SOME *RAW* CONTENT WITH {UNKNOWN} ATTRIBUTE</p>
+</div>
+</div>
+</div>`
+		Expect(RenderXHTML(source, configuration.WithBlockProcessor("shout", shout))).To(MatchHTML(expected))
+	})
+
+	It("open block in list item", func() {
+		source := `* an item
++
+[shout]
+--
+some content
+--`
+		expected := `<div class="ulist">
+<ul>
+<li>
+<p>an item</p>
+<div class="paragraph">
+<p>SOME CONTENT</p>
+</div>
+</li>
+</ul>
+</div>`
+		Expect(RenderXHTML(source, configuration.WithBlockProcessor("shout", shout))).To(MatchHTML(expected))
+	})
+
+	It("block without processor", func() {
+		source := `[graphviz]
+----
+digraph { a -> b }
+----`
+		expected := `<div class="listingblock">
+<div class="content">
+<pre>digraph { a -&gt; b }</pre>
+</div>
+</div>`
+		Expect(RenderXHTML(source, configuration.WithBlockProcessor("plantuml", diagram))).To(MatchHTML(expected))
+	})
+
+	It("failing block processor", func() {
+		source := `[graphviz]
+----
+digraph { a -> b }
+----`
+		_, err := RenderXHTML(source, configuration.WithBlockProcessor("graphviz", func(ctx configuration.BlockContext, lines []string, attributes types.Attributes) ([]interface{}, error) {
+			return nil, fmt.Errorf("mock error")
+		}))
+		Expect(err).To(MatchError("unable to process the 'graphviz' block: mock error"))
+	})
+})
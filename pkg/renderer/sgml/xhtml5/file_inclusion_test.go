@@ -935,7 +935,7 @@ last line of grandchild</pre>
 </div>`
 				Expect(RenderXHTML(source, configuration.WithFilename("test.adoc"))).To(Equal(expected))
 				// verify error in logs
-				Expect(console).To(ContainMessageWithLevel(log.ErrorLevel, "failed to include content of '../../../test/includes/unknown.adoc' in 'test.adoc'"))
+				Expect(console).To(ContainMessageWithLevel(log.ErrorLevel, "failed to include content of '../../../test/includes/unknown.adoc' in 'test.adoc:1'"))
 			})
 
 			It("should replace with error message if file with attribute in path is not resolved", func() {
@@ -949,7 +949,7 @@ last line of grandchild</pre>
 </div>`
 				Expect(RenderXHTML(source, configuration.WithFilename("test.adoc"))).To(Equal(expected))
 				// verify error in logs
-				Expect(console).To(ContainMessageWithLevel(log.ErrorLevel, "failed to include content of '{includedir}/unknown.adoc' in 'test.adoc'"))
+				Expect(console).To(ContainMessageWithLevel(log.ErrorLevel, "failed to include content of '{includedir}/unknown.adoc' in 'test.adoc:1'"))
 			})
 		})
 
@@ -970,7 +970,7 @@ include::../../../test/includes/unknown.adoc[leveloffset=+1]
 </div>`
 				Expect(RenderXHTML(source, configuration.WithFilename("test.adoc"))).To(MatchHTML(expected))
 				// verify error in logs
-				Expect(console).To(ContainMessageWithLevel(log.ErrorLevel, "failed to include content of '../../../test/includes/unknown.adoc' in 'test.adoc'"))
+				Expect(console).To(ContainMessageWithLevel(log.ErrorLevel, "failed to include content of '../../../test/includes/unknown.adoc' in 'test.adoc:2'"))
 			})
 
 			It("should replace with error message if file with attribute in path is not resolved", func() {
@@ -988,7 +988,7 @@ include::{includedir}/unknown.adoc[leveloffset=+1]
 </div>`
 				Expect(RenderXHTML(source, configuration.WithFilename("test.adoc"))).To(Equal(expected))
 				// verify error in logs
-				Expect(console).To(ContainMessageWithLevel(log.ErrorLevel, "failed to include content of '{includedir}/unknown.adoc' in 'test.adoc'"))
+				Expect(console).To(ContainMessageWithLevel(log.ErrorLevel, "failed to include content of '{includedir}/unknown.adoc' in 'test.adoc:2'"))
 			})
 		})
 	})
@@ -1,6 +1,8 @@
 package xhtml5_test
 
 import (
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
 	. "github.com/bytesparadise/libasciidoc/testsupport"
 
 	. "github.com/onsi/ginkgo" //nolint golint
@@ -101,6 +103,111 @@ image::appa.png[]`
 
 	})
 
+	Context("captions", func() {
+
+		It("block image with custom figure caption", func() {
+			source := `:figure-caption: Abbildung
+
+.Ein Titel
+image::foo.png[]`
+			expected := `<div class="imageblock">
+<div class="content">
+<img src="foo.png" alt="foo"/>
+</div>
+<div class="title">Abbildung 1. Ein Titel</div>
+</div>`
+			Expect(RenderXHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("block image with figure caption disabled", func() {
+			source := `:figure-caption!:
+
+.A title
+image::foo.png[]`
+			expected := `<div class="imageblock">
+<div class="content">
+<img src="foo.png" alt="foo"/>
+</div>
+<div class="title">A title</div>
+</div>`
+			Expect(RenderXHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("block image with figure caption disabled by an override", func() {
+			source := `.A title
+image::foo.png[]`
+			expected := `<div class="imageblock">
+<div class="content">
+<img src="foo.png" alt="foo"/>
+</div>
+<div class="title">A title</div>
+</div>`
+			Expect(RenderXHTML(source, configuration.WithAttributeUnset(types.AttrFigureCaption))).To(MatchHTML(expected))
+		})
+
+		It("block images with custom captions", func() {
+			source := `[caption="Exhibit A: "]
+.A title
+image::foo.png[]
+
+[caption=""]
+.Another title
+image::bar.png[]
+
+.The last title
+image::baz.png[]`
+			expected := `<div class="imageblock">
+<div class="content">
+<img src="foo.png" alt="foo"/>
+</div>
+<div class="title">Exhibit A: A title</div>
+</div>
+<div class="imageblock">
+<div class="content">
+<img src="bar.png" alt="bar"/>
+</div>
+<div class="title">Another title</div>
+</div>
+<div class="imageblock">
+<div class="content">
+<img src="baz.png" alt="baz"/>
+</div>
+<div class="title">Figure 1. The last title</div>
+</div>`
+			Expect(RenderXHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("cross reference to block image with custom figure caption", func() {
+			source := `:figure-caption: Abbildung
+:xrefstyle: short
+
+.Ein Titel
+image::foo.png[]
+
+[#bar]
+.Noch ein Titel
+image::bar.png[]
+
+siehe <<bar>>`
+			expected := `<div class="imageblock">
+<div class="content">
+<img src="foo.png" alt="foo"/>
+</div>
+<div class="title">Abbildung 1. Ein Titel</div>
+</div>
+<div id="bar" class="imageblock">
+<div class="content">
+<img src="bar.png" alt="bar"/>
+</div>
+<div class="title">Abbildung 2. Noch ein Titel</div>
+</div>
+<div class="paragraph">
+<p>siehe <a href="#bar">Abbildung 2</a></p>
+</div>`
+			Expect(RenderXHTML(source)).To(MatchHTML(expected))
+		})
+	})
+
 	Context("inline images", func() {
 
 		Context("valid inline Images", func() {
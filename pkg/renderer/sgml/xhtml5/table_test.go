@@ -170,4 +170,45 @@ var _ = Describe("tables", func() {
 		Expect(RenderXHTML(source)).To(MatchHTML(expected))
 	})
 
+	It("table with custom table caption", func() {
+		source := `:table-caption: Tabelle
+
+.Titel
+|===
+| cell
+|===`
+		expected := `<table class="tableblock frame-all grid-all stretch">
+<caption class="title">Tabelle 1. Titel</caption>
+<colgroup>
+<col style="width: 100%;"/>
+</colgroup>
+<tbody>
+<tr>
+<td class="tableblock halign-left valign-top"><p class="tableblock">cell</p></td>
+</tr>
+</tbody>
+</table>`
+		Expect(RenderXHTML(source)).To(MatchHTML(expected))
+	})
+
+	It("table with table caption disabled", func() {
+		source := `:table-caption!:
+
+.title
+|===
+| cell
+|===`
+		expected := `<table class="tableblock frame-all grid-all stretch">
+<caption class="title">title</caption>
+<colgroup>
+<col style="width: 100%;"/>
+</colgroup>
+<tbody>
+<tr>
+<td class="tableblock halign-left valign-top"><p class="tableblock">cell</p></td>
+</tr>
+</tbody>
+</table>`
+		Expect(RenderXHTML(source)).To(MatchHTML(expected))
+	})
 })
@@ -2,7 +2,7 @@ package xhtml5
 
 const (
 	articleTmpl = `<!DOCTYPE html>
-<html xmlns="http://www.w3.org/1999/xhtml" lang="en">
+<html xmlns="http://www.w3.org/1999/xhtml" lang="{{ .Lang }}">
 <head>
 <meta charset="UTF-8"/>
 <meta http-equiv="X-UA-Compatible" content="IE=edge"/>
@@ -19,8 +19,8 @@ const (
 </div>{{ if .IncludeFooter }}
 <div id="footer">
 <div id="footer-text">{{ if .RevNumber }}
-Version {{ .RevNumber }}<br/>{{ end }}
-Last updated {{ .LastUpdated }}
+{{ .VersionLabel }} {{ .RevNumber }}<br/>{{ end }}
+{{ .LastUpdatedLabel }} {{ .LastUpdated }}
 </div>
 </div>{{ end }}
 </body>
@@ -71,6 +71,14 @@ const (
 	AttrLineRanges = "lines"
 	// AttrTagRanges the `tag`/`tags` attribute used in file inclusions
 	AttrTagRanges = "tags"
+	// AttrIndent the `indent` attribute used in file inclusions, to re-indent the included content
+	AttrIndent = "indent"
+	// AttrEncoding the `encoding` attribute used in file inclusions, to specify the encoding of the file to include
+	AttrEncoding = "encoding"
+	// AttrMaxIncludeDepth the `max-include-depth` attribute, which limits the depth of nested file inclusions
+	AttrMaxIncludeDepth = "max-include-depth"
+	// AttrAllowURIRead the `allow-uri-read` attribute which allows the inclusion of remote files (only when set via the API or the CLI)
+	AttrAllowURIRead = "allow-uri-read"
 	// AttrLastUpdated the "last updated" data in the document, i.e., the output/generation time
 	AttrLastUpdated = "LastUpdated"
 	// AttrImageAlt the image `alt` attribute
@@ -89,21 +97,62 @@ const (
 	AttrIconRotate = "rotate"
 	// AttrIconFlip the icon `flip` attribute, and if set can be "horizontal" or "vertical"
 	AttrIconFlip = "flip"
+	// AttrStyle the key to retrieve the style of an element, i.e., the first positional attribute (eg: `[appendix]`)
+	AttrStyle = "style"
+	// AttrPositional the prefix of the keys to retrieve the positional attributes (eg: `positional-2`)
+	AttrPositional = "positional-"
+	// AttrOptions the key to retrieve the options of an element, set with the `%option` short-hand syntax
+	// or with the `options` (or `opts`) attribute
+	AttrOptions = "options"
+	// AttrOpts the short name of the `options` attribute
+	AttrOpts = "opts"
+	// AttrRefText the text to use when referring to an element in a cross reference without label
+	AttrRefText = "reftext"
+	// AttrXRefStyle the document attribute which specifies the style of the labels of the cross references without label (`full`, `short` or `basic`)
+	AttrXRefStyle = "xrefstyle"
+	// AttrSectionNumbering the document attribute which enables the numbering of the sections
+	AttrSectionNumbering = "sectnums"
+	// AttrSectionNumberLevels the document attribute which specifies the number of section levels to number
+	AttrSectionNumberLevels = "sectnumlevels"
+	// AttrRelFileSuffix the document attribute which specifies the file extension of the documents referred by inter-document cross references
+	AttrRelFileSuffix = "relfilesuffix"
+	// AttrOutFileSuffix the document attribute which specifies the file extension of the output documents
+	AttrOutFileSuffix = "outfilesuffix"
+	// AttrCaption the caption of a block (eg: `Figure 1. `), which is displayed before its title
+	AttrCaption = "caption"
+	// AttrFigureCaption the document attribute which specifies the label of the captions of the figures
+	AttrFigureCaption = "figure-caption"
+	// AttrTableCaption the document attribute which specifies the label of the captions of the tables
+	AttrTableCaption = "table-caption"
+	// AttrExampleCaption the document attribute which specifies the label of the captions of the example blocks
+	AttrExampleCaption = "example-caption"
+	// AttrListingCaption the document attribute which specifies the label of the captions of the listing and source blocks
+	AttrListingCaption = "listing-caption"
 )
 
 // NewElementID initializes a new attribute map with a single entry for the ID using the given value
-func NewElementID(id string) (Attributes, error) {
+// (and an extra entry for the reftext, if provided)
+func NewElementID(id string, reftext interface{}) (Attributes, error) {
 	// log.Debugf("initializing a new ElementID with ID=%s", id)
-	return Attributes{
+	attrs := Attributes{
 		AttrID:       id,
 		AttrCustomID: true,
-	}, nil
+	}
+	if reftext, ok := reftext.(string); ok {
+		attrs[AttrRefText] = strings.TrimSpace(reftext)
+	}
+	return attrs, nil
 }
 
 // NewInlineElementID initializes a new attribute map with a single entry for the ID using the given value
-func NewInlineElementID(id string) (Attributes, error) {
+// (and an extra entry for the reftext, if provided)
+func NewInlineElementID(id string, reftext interface{}) (Attributes, error) {
 	log.Debugf("initializing a new inline ElementID with ID=%s", id)
-	return Attributes{AttrID: id}, nil
+	attrs := Attributes{AttrID: id}
+	if reftext, ok := reftext.(string); ok {
+		attrs[AttrRefText] = strings.TrimSpace(reftext)
+	}
+	return attrs, nil
 }
 
 // NewElementTitle initializes a new attribute map with a single entry for the title using the given value
@@ -127,23 +176,203 @@ func NewAdmonitionAttribute(k AdmonitionKind) (Attributes, error) {
 	return Attributes{AttrAdmonitionKind: k}, nil
 }
 
-// NewAttributeGroup initializes a group of attributes from the given generic attributes.
+// NewAttributeGroup initializes a group of attributes from the given positional and named attributes.
+// The first positional attribute may use the short-hand syntax (`style#id.role1.role2%option1%option2`).
+// Some styles (such as `source`, `quote`, `verse`, etc.) are resolved into the same attributes as their
+// dedicated syntax (eg: `[source#main,go]` is equivalent to `[source,go]` with a custom ID)
 func NewAttributeGroup(attributes []interface{}) (Attributes, error) {
 	// log.Debugf("initializing a new AttributeGroup with %v", attributes)
 	result := make(Attributes)
+	positionals := []PositionalAttribute{}
+	style := ""
 	for _, a := range attributes {
 		// log.Debugf("processing attribute element of type %T", a)
-		if a, ok := a.(Attributes); ok {
+		switch a := a.(type) {
+		case PositionalAttribute:
+			if len(positionals) == 0 {
+				style = a.Value
+				if !a.Quoted {
+					style = result.addShortHandAttributes(style)
+				}
+			}
+			positionals = append(positionals, a)
+		case Attributes:
 			for k, v := range a {
 				// log.Debugf("adding attribute %v='%v'", k, v)
-				result[k] = v
+				result.addNamedAttribute(k, v)
 			}
-		} else {
+		default:
 			return result, errors.Errorf("unable to process element of type '%[1]T': '%[1]s'", a)
 		}
 	}
 	// log.Debugf("initialized a new AttributeGroup: %v", result)
-	return result, nil
+	return result.addStyleAttributes(style, positionals)
+}
+
+// PositionalAttribute a positional attribute in an attribute group
+type PositionalAttribute struct {
+	Value  string
+	Quoted bool
+}
+
+// NewPositionalAttribute initializes a new PositionalAttribute from the given (optional) value
+func NewPositionalAttribute(value interface{}) (PositionalAttribute, error) {
+	switch v := value.(type) {
+	case QuotedAttributeValue:
+		return PositionalAttribute{
+			Value:  v.Value,
+			Quoted: true,
+		}, nil
+	case string:
+		return PositionalAttribute{
+			Value: v,
+		}, nil
+	default:
+		return PositionalAttribute{}, nil
+	}
+}
+
+// NewNamedAttribute initializes a new attribute map with a single entry for the given key and (optional) value
+func NewNamedAttribute(key string, value interface{}) (Attributes, error) {
+	switch v := value.(type) {
+	case QuotedAttributeValue:
+		return Attributes{key: v.Value}, nil
+	case string:
+		return Attributes{key: v}, nil
+	default:
+		return Attributes{key: ""}, nil
+	}
+}
+
+// QuotedAttributeValue the value of an attribute which was wrapped in single or double quotes
+type QuotedAttributeValue struct {
+	Value string
+}
+
+// NewQuotedAttributeValue initializes a new QuotedAttributeValue in which the escaped quotes are replaced
+func NewQuotedAttributeValue(value, quote string) (QuotedAttributeValue, error) {
+	return QuotedAttributeValue{
+		Value: strings.ReplaceAll(value, `\`+quote, quote),
+	}, nil
+}
+
+// addNamedAttribute adds the given key/value, with a special handling for the `id`, `role` and `options` keys
+func (a Attributes) addNamedAttribute(key string, value interface{}) {
+	switch key {
+	case AttrID:
+		a[AttrID] = value
+		a[AttrCustomID] = true
+	case AttrRole:
+		if v, ok := value.(string); ok {
+			a.AppendString(AttrRole, v)
+		}
+	case AttrOptions, AttrOpts:
+		if v, ok := value.(string); ok {
+			for _, o := range strings.Split(v, ",") {
+				a.AddOption(strings.TrimSpace(o))
+			}
+		}
+	default:
+		a[key] = value
+	}
+}
+
+// addShortHandAttributes adds the id, roles and options declared with the short-hand syntax
+// in the given value, and returns the remaining style (which may be empty)
+// eg: `source#main.wide%linenums` gives the `source` style, the `main` ID, the `wide` role and the `linenums` option
+func (a Attributes) addShortHandAttributes(value string) string {
+	i := strings.IndexAny(value, "#.%")
+	if i < 0 {
+		return value
+	}
+	style := value[:i]
+	value = value[i:]
+	for len(value) > 0 {
+		marker := value[0]
+		value = value[1:]
+		var v string
+		if j := strings.IndexAny(value, "#.%"); j >= 0 {
+			v, value = value[:j], value[j:]
+		} else {
+			v, value = value, ""
+		}
+		if v == "" {
+			continue
+		}
+		switch marker {
+		case '#':
+			a[AttrID] = v
+			a[AttrCustomID] = true
+		case '.':
+			a.AppendString(AttrRole, v)
+		case '%':
+			a.AddOption(v)
+		}
+	}
+	return style
+}
+
+// addStyleAttributes adds the attributes for the given style and positional attributes
+// nolint: gocyclo
+func (a Attributes) addStyleAttributes(style string, positionals []PositionalAttribute) (Attributes, error) {
+	positional := func(i int) interface{} {
+		if len(positionals) > i && positionals[i].Value != "" {
+			return positionals[i].Value
+		}
+		return nil
+	}
+	switch style {
+	case "source":
+		others := []interface{}{}
+		for i := 2; i < len(positionals); i++ {
+			others = append(others, Attributes{positionals[i].Value: nil})
+		}
+		attrs, err := NewSourceAttributes(positional(1), others...)
+		if err != nil {
+			return nil, err
+		}
+		return a.Add(attrs), nil
+	case "quote", "verse":
+		attrs, err := NewQuoteAttributes(style, positional(1), positional(2))
+		if err != nil {
+			return nil, err
+		}
+		return a.Add(Attributes(attrs)), nil
+	case "literal":
+		a[AttrKind] = Literal
+		return a, nil
+	case "pass":
+		a[AttrKind] = Passthrough
+		return a, nil
+	case "horizontal":
+		a["layout"] = "horizontal"
+		return a, nil
+	case "TIP":
+		a[AttrAdmonitionKind] = Tip
+		return a, nil
+	case "NOTE":
+		a[AttrAdmonitionKind] = Note
+		return a, nil
+	case "IMPORTANT":
+		a[AttrAdmonitionKind] = Important
+		return a, nil
+	case "WARNING":
+		a[AttrAdmonitionKind] = Warning
+		return a, nil
+	case "CAUTION":
+		a[AttrAdmonitionKind] = Caution
+		return a, nil
+	}
+	if style != "" {
+		a[AttrStyle] = style
+		a[AttrPositional+"1"] = style
+	}
+	for i := 1; i < len(positionals); i++ {
+		if positionals[i].Value != "" {
+			a[AttrPositional+strconv.Itoa(i+1)] = positionals[i].Value
+		}
+	}
+	return a, nil
 }
 
 // NewGenericAttribute initializes a new ElementAttribute from the given key and optional value
@@ -269,6 +498,37 @@ func (a Attributes) AppendString(key string, value string) {
 	}
 }
 
+// AddOption adds the given option in the `options` entry, unless it was already set
+func (a Attributes) AddOption(option string) {
+	if option == "" || a.HasOption(option) {
+		return
+	}
+	options, _ := a[AttrOptions].([]string)
+	a[AttrOptions] = append(options, option)
+}
+
+// HasOption returns `true` if the given option was set in the `options` entry
+// (eg: `[%header]` or `[options="header"]`)
+func (a Attributes) HasOption(option string) bool {
+	if options, ok := a[AttrOptions].([]string); ok {
+		for _, o := range options {
+			if o == option {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// GetStyle returns the style of the element (i.e., the first positional attribute),
+// or an empty string if none was set
+func (a Attributes) GetStyle() string {
+	if style, ok := a[AttrStyle].(string); ok {
+		return style
+	}
+	return ""
+}
+
 // NilSafeSet sets the key/value pair unless the value is nil or empty
 func (a Attributes) NilSafeSet(key string, value interface{}) {
 	if value != nil && value != "" {
@@ -0,0 +1,146 @@
+package types
+
+import (
+	"fmt"
+	"sync"
+)
+
+// DiagnosticSeverity the severity of a diagnostic
+type DiagnosticSeverity string
+
+const (
+	// DiagnosticWarning the severity of the problems which do not prevent the conversion of the document,
+	// but which may lead to an unexpected output
+	DiagnosticWarning DiagnosticSeverity = "warning"
+	// DiagnosticError the severity of the problems for which some content of the document could not be converted
+	DiagnosticError DiagnosticSeverity = "error"
+)
+
+// level returns the level of the severity, to compare it with other severities (0 if unknown)
+func (s DiagnosticSeverity) level() int {
+	switch s {
+	case DiagnosticWarning:
+		return 1
+	case DiagnosticError:
+		return 2
+	default:
+		return 0
+	}
+}
+
+// AtLeast returns true if this severity is the same as, or higher than the given severity
+func (s DiagnosticSeverity) AtLeast(severity DiagnosticSeverity) bool {
+	return severity.level() > 0 && s.level() >= severity.level()
+}
+
+// DiagnosticsAtLeast returns the diagnostics whose severity is the same as, or higher than the given severity
+func DiagnosticsAtLeast(diagnostics []Diagnostic, severity DiagnosticSeverity) []Diagnostic {
+	var result []Diagnostic
+	for _, d := range diagnostics {
+		if d.Severity.AtLeast(severity) {
+			result = append(result, d)
+		}
+	}
+	return result
+}
+
+// DiagnosticCode the code which identifies the kind of problem reported by a diagnostic
+type DiagnosticCode string
+
+const (
+	// UnresolvedInclude the code of the diagnostics for the files which could not be included
+	UnresolvedInclude DiagnosticCode = "unresolved-include"
+	// IncludeNotAllowed the code of the diagnostics for the files which are not included because of the safe mode
+	// or because reading remote content is not allowed
+	IncludeNotAllowed DiagnosticCode = "include-not-allowed"
+	// InvalidInclude the code of the diagnostics for the `include::` directives with invalid attributes
+	// which were ignored
+	InvalidInclude DiagnosticCode = "invalid-include"
+	// UnresolvedIncludeTag the code of the diagnostics for the tags which were not found in a file to include
+	UnresolvedIncludeTag DiagnosticCode = "unresolved-include-tag"
+	// UnresolvedCrossReference the code of the diagnostics for the cross references to an unknown element
+	UnresolvedCrossReference DiagnosticCode = "unresolved-xref"
+	// MissingAttribute the code of the diagnostics for the substitutions of an attribute which is not set
+	MissingAttribute DiagnosticCode = "missing-attribute"
+	// DuplicateID the code of the diagnostics for the IDs which are assigned to more than one element
+	DuplicateID DiagnosticCode = "duplicate-id"
+	// InvalidTable the code of the diagnostics for the tables whose cells do not fit in their columns
+	InvalidTable DiagnosticCode = "invalid-table"
+	// InvalidDocument the code of the diagnostics for the documents which do not have the structure
+	// required by their doctype (eg: manpage)
+	InvalidDocument DiagnosticCode = "invalid-document"
+	// FailedDiagram the code of the diagnostics for the diagrams whose image could not be generated
+	FailedDiagram DiagnosticCode = "failed-diagram"
+)
+
+// Diagnostic a problem found while converting a document
+type Diagnostic struct {
+	Severity DiagnosticSeverity
+	Code     DiagnosticCode
+	Message  string
+	Position Position // the position of the offending element (may be empty if the positions were not recorded)
+}
+
+func (d Diagnostic) String() string {
+	if d.Position.Start.IsZero() {
+		return fmt.Sprintf("%s: %s [%s]", d.Severity, d.Message, d.Code)
+	}
+	return fmt.Sprintf("%s: %s: %s [%s]", d.Position.Start, d.Severity, d.Message, d.Code)
+}
+
+// Diagnostics collects the problems found while converting a document.
+// All methods can be safely called on a nil collector, in which case the diagnostics are discarded.
+type Diagnostics struct {
+	mu    sync.Mutex
+	items []Diagnostic
+}
+
+// NewDiagnostics returns a new, empty collector of diagnostics
+func NewDiagnostics() *Diagnostics {
+	return &Diagnostics{}
+}
+
+// Add adds the given diagnostic
+func (d *Diagnostics) Add(diagnostic Diagnostic) {
+	if d == nil {
+		return
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.items = append(d.items, diagnostic)
+}
+
+// Warnf adds a diagnostic with the `warning` severity
+func (d *Diagnostics) Warnf(code DiagnosticCode, position Position, format string, args ...interface{}) {
+	d.Add(Diagnostic{
+		Severity: DiagnosticWarning,
+		Code:     code,
+		Message:  fmt.Sprintf(format, args...),
+		Position: position,
+	})
+}
+
+// Errorf adds a diagnostic with the `error` severity
+func (d *Diagnostics) Errorf(code DiagnosticCode, position Position, format string, args ...interface{}) {
+	d.Add(Diagnostic{
+		Severity: DiagnosticError,
+		Code:     code,
+		Message:  fmt.Sprintf(format, args...),
+		Position: position,
+	})
+}
+
+// All returns all the diagnostics collected so far, in the order in which they were added
+func (d *Diagnostics) All() []Diagnostic {
+	if d == nil {
+		return nil
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if len(d.items) == 0 {
+		return nil
+	}
+	result := make([]Diagnostic, len(d.items))
+	copy(result, d.items)
+	return result
+}
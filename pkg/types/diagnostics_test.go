@@ -0,0 +1,65 @@
+package types_test
+
+import (
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	. "github.com/onsi/ginkgo" //nolint golint
+	. "github.com/onsi/gomega" //nolint golint
+)
+
+var _ = Describe("diagnostics", func() {
+
+	It("should collect the diagnostics in order", func() {
+		diagnostics := types.NewDiagnostics()
+		diagnostics.Warnf(types.MissingAttribute, types.Position{}, "unable to find attribute '%s'", "foo")
+		diagnostics.Errorf(types.UnresolvedInclude, types.Position{}, "unable to read '%s'", "foo.adoc")
+		Expect(diagnostics.All()).To(Equal([]types.Diagnostic{
+			{
+				Severity: types.DiagnosticWarning,
+				Code:     types.MissingAttribute,
+				Message:  "unable to find attribute 'foo'",
+			},
+			{
+				Severity: types.DiagnosticError,
+				Code:     types.UnresolvedInclude,
+				Message:  "unable to read 'foo.adoc'",
+			},
+		}))
+	})
+
+	It("should discard the diagnostics without collector", func() {
+		var diagnostics *types.Diagnostics
+		diagnostics.Warnf(types.MissingAttribute, types.Position{}, "unable to find attribute '%s'", "foo")
+		Expect(diagnostics.All()).To(BeNil())
+	})
+
+	It("should compare the severities", func() {
+		Expect(types.DiagnosticWarning.AtLeast(types.DiagnosticWarning)).To(BeTrue())
+		Expect(types.DiagnosticError.AtLeast(types.DiagnosticWarning)).To(BeTrue())
+		Expect(types.DiagnosticWarning.AtLeast(types.DiagnosticError)).To(BeFalse())
+		// no failure level
+		Expect(types.DiagnosticError.AtLeast("")).To(BeFalse())
+	})
+
+	It("should format diagnostic with position", func() {
+		d := types.Diagnostic{
+			Severity: types.DiagnosticWarning,
+			Code:     types.UnresolvedCrossReference,
+			Message:  "possible invalid reference: 'foo'",
+			Position: types.Position{
+				Start: types.SourceLocation{Filename: "test.adoc", Line: 3, Column: 5},
+				End:   types.SourceLocation{Filename: "test.adoc", Line: 3, Column: 12},
+			},
+		}
+		Expect(d.String()).To(Equal("test.adoc:3:5: warning: possible invalid reference: 'foo' [unresolved-xref]"))
+	})
+
+	It("should format diagnostic without position", func() {
+		d := types.Diagnostic{
+			Severity: types.DiagnosticError,
+			Code:     types.InvalidDocument,
+			Message:  "manpage document is missing a header",
+		}
+		Expect(d.String()).To(Equal("error: manpage document is missing a header [invalid-document]"))
+	})
+})
@@ -1,11 +1,82 @@
 package types
 
+import "strings"
+
 // AttributesWithOverrides the document attributes with some overrides provided by the CLI (for example)
+//
+// Overrides follow the Asciidoctor precedence rules:
+// - `name` (or `name=value`) is a hard set: the document cannot change the value
+// - `name@` (or `name=value@`) is a soft set: the value is a default that the document can change
+// - `!name` or `name!` is a hard unset: the document cannot set the attribute
+// - `!name@` or `name!@` is a soft unset: the attribute is unset unless the document sets it
 type AttributesWithOverrides struct {
 	Content   map[string]interface{}
 	Overrides map[string]string
 }
 
+// NewAttributesWithOverrides returns a new AttributesWithOverrides in which the
+// content is initialized with the values of the soft-set overrides
+func NewAttributesWithOverrides(overrides map[string]string) AttributesWithOverrides {
+	a := AttributesWithOverrides{
+		Content:   map[string]interface{}{},
+		Overrides: overrides,
+	}
+	if a.Overrides == nil {
+		a.Overrides = map[string]string{}
+	}
+	for k, v := range a.Overrides {
+		if o := NewAttributeOverride(k, v); o.Soft && !o.Unset {
+			a.Content[o.Name] = o.Value
+		}
+	}
+	return a
+}
+
+// AttributeOverride an attribute override, as provided via the API or the CLI
+type AttributeOverride struct {
+	Name  string
+	Value string
+	Unset bool // `true` if the attribute is unset
+	Soft  bool // `true` if the document can change the attribute
+}
+
+// NewAttributeOverride returns a new AttributeOverride for the given key and value,
+// in which the `!` and `@` markers have been processed
+func NewAttributeOverride(key, value string) AttributeOverride {
+	o := AttributeOverride{
+		Name:  key,
+		Value: value,
+	}
+	if strings.HasSuffix(o.Name, "@") {
+		o.Name = strings.TrimSuffix(o.Name, "@")
+		o.Soft = true
+	}
+	if strings.HasSuffix(o.Value, "@") {
+		o.Value = strings.TrimSuffix(o.Value, "@")
+		o.Soft = true
+	}
+	if strings.HasPrefix(o.Name, "!") {
+		o.Name = strings.TrimPrefix(o.Name, "!")
+		o.Unset = true
+	} else if strings.HasSuffix(o.Name, "!") {
+		o.Name = strings.TrimSuffix(o.Name, "!")
+		o.Unset = true
+	}
+	return o
+}
+
+// override returns the hard override for the given attribute name (if any)
+func (a AttributesWithOverrides) override(name string) (AttributeOverride, bool) {
+	for _, k := range []string{name, "!" + name, name + "!"} {
+		if v, found := a.Overrides[k]; found {
+			if o := NewAttributeOverride(k, v); !o.Soft {
+				return o, true
+			}
+		}
+	}
+	return AttributeOverride{}, false
+}
+
 // All returns all attributes
 func (a AttributesWithOverrides) All() Attributes {
 	result := Attributes{}
@@ -13,7 +84,15 @@ func (a AttributesWithOverrides) All() Attributes {
 		result[k] = v
 	}
 	for k, v := range a.Overrides {
-		result[k] = v
+		o := NewAttributeOverride(k, v)
+		switch {
+		case o.Soft:
+			continue
+		case o.Unset:
+			delete(result, o.Name)
+		default:
+			result[o.Name] = o.Value
+		}
 	}
 	return result
 }
@@ -35,19 +114,37 @@ func (a AttributesWithOverrides) Delete(key string) {
 	delete(a.Content, key)
 }
 
+// IsUnset returns `true` if the given attribute was unset by an override, i.e.,
+// if it was hard unset, or if it was soft unset and the document did not set it
+func (a AttributesWithOverrides) IsUnset(key string) bool {
+	if o, found := a.override(key); found {
+		return o.Unset
+	}
+	if _, found := a.Content[key]; found {
+		return false
+	}
+	for k, v := range a.Overrides {
+		if o := NewAttributeOverride(k, v); o.Name == key && o.Unset {
+			return true
+		}
+	}
+	return false
+}
+
 // GetAsString gets the string value for the given key (+ `true`),
 // or empty string (+ `false`) if none was found
 func (a AttributesWithOverrides) GetAsString(key string) (string, bool) {
+	o, overridden := a.override(key)
 	// if value is overridden
-	if value, found := a.Overrides[key]; found {
-		return value, true
+	if overridden && !o.Unset {
+		return o.Value, true
 	}
 	// check in predefined attributes
 	if value, found := Predefined[key]; found {
 		return value, true
 	}
 	// if value is reset
-	if _, found := a.Overrides["!"+key]; found {
+	if overridden && o.Unset {
 		return "", false
 	}
 	if value, found := a.Content[key].(string); found {
@@ -60,16 +157,8 @@ func (a AttributesWithOverrides) GetAsString(key string) (string, bool) {
 // GetAsStringWithDefault gets the string value for the given key,
 // or returns the given default value
 func (a AttributesWithOverrides) GetAsStringWithDefault(key, defaultValue string) string {
-	if value, found := a.Overrides[key]; found {
+	if value, found := a.GetAsString(key); found {
 		return value
 	}
-	// check in predefined attributes
-	if value, found := Predefined[key]; found {
-		return value
-	}
-	if value, found := a.Content[key].(string); found {
-		return value
-	}
-	// TODO: raise a warning if there was no entry found
 	return defaultValue
 }
@@ -3,6 +3,7 @@ package types_test
 import (
 	"github.com/bytesparadise/libasciidoc/pkg/types"
 
+	. "github.com/onsi/ginkgo" //nolint golint
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega" //nolint golint
 )
@@ -20,6 +21,9 @@ var _ = DescribeTable("document attribute overrides",
 				"!bar":     "",
 				"baz":      "",
 				"override": "overridden",
+				"qux!":     "",
+				"soft@":    "ignored",
+				"soft2":    "ignored@",
 			},
 		}
 		// when
@@ -31,10 +35,48 @@ var _ = DescribeTable("document attribute overrides",
 	Entry("normal", "normal", "ok", true),
 	Entry("override", "override", "overridden", true), // entry is overridden
 	Entry("foo", "foo", "cheesecake", true),
-	Entry("!bar", "bar", "", false), // entry is reset
-	Entry("baz", "baz", "", true),   // entry exists but its value is empty
+	Entry("!bar", "bar", "", false),    // entry is reset
+	Entry("baz", "baz", "", true),      // entry exists but its value is empty
+	Entry("qux!", "qux", "", false),    // entry is reset with the suffix notation
+	Entry("soft@", "soft", "", false),  // soft-set entries are not overrides
+	Entry("soft2", "soft2", "", false), // soft-set entries are not overrides
 )
 
+var _ = DescribeTable("new attribute override",
+	func(key, value string, expected types.AttributeOverride) {
+		Expect(types.NewAttributeOverride(key, value)).To(Equal(expected))
+	},
+	Entry("hard set", "foo", "bar", types.AttributeOverride{Name: "foo", Value: "bar"}),
+	Entry("soft set with key suffix", "foo@", "bar", types.AttributeOverride{Name: "foo", Value: "bar", Soft: true}),
+	Entry("soft set with value suffix", "foo", "bar@", types.AttributeOverride{Name: "foo", Value: "bar", Soft: true}),
+	Entry("hard unset with prefix", "!foo", "", types.AttributeOverride{Name: "foo", Unset: true}),
+	Entry("hard unset with suffix", "foo!", "", types.AttributeOverride{Name: "foo", Unset: true}),
+	Entry("soft unset with prefix", "!foo@", "", types.AttributeOverride{Name: "foo", Unset: true, Soft: true}),
+	Entry("soft unset with suffix", "foo!@", "", types.AttributeOverride{Name: "foo", Unset: true, Soft: true}),
+)
+
+var _ = Describe("new attributes with overrides", func() {
+
+	It("should initialize content with soft-set overrides", func() {
+		// when
+		attributes := types.NewAttributesWithOverrides(map[string]string{
+			"hard":   "ok",
+			"soft@":  "default",
+			"soft2":  "default2@",
+			"!unset": "",
+		})
+		attributes.Set("soft", "changed")
+		attributes.Set("hard", "changed")
+		attributes.Set("unset", "changed")
+		// then
+		Expect(attributes.All()).To(Equal(types.Attributes{
+			"hard":  "ok",
+			"soft":  "changed",
+			"soft2": "default2",
+		}))
+	})
+})
+
 var _ = DescribeTable("document attribute overrides with default",
 	func(key string, expectedValue string) {
 		// given
@@ -61,3 +103,33 @@ var _ = DescribeTable("document attribute overrides with default",
 	Entry("!bar", "bar", "default"), // entry is reset, default is returned
 	Entry("baz", "baz", ""),         // entry exists but its value is empty
 )
+
+var _ = DescribeTable("document attribute unset by overrides",
+	func(key string, expected bool) {
+		// given
+		attributes := types.AttributesWithOverrides{
+			Content: map[string]interface{}{
+				"normal": "ok",
+				"soft":   "set in document",
+			},
+			Overrides: map[string]string{
+				"foo":    "cheesecake",
+				"!bar":   "",
+				"baz!":   "",
+				"!soft@": "",
+				"qux!@":  "",
+			},
+		}
+		// when
+		unset := attributes.IsUnset(key)
+		// then
+		Expect(unset).To(Equal(expected))
+	},
+	Entry("normal", "normal", false),
+	Entry("unknown", "unknown", false),
+	Entry("foo", "foo", false),
+	Entry("!bar", "bar", true),     // hard unset with prefix
+	Entry("baz!", "baz", true),     // hard unset with suffix
+	Entry("!soft@", "soft", false), // soft unset, but set in the document
+	Entry("qux!@", "qux", true),    // soft unset, and not set in the document
+)
@@ -1,6 +1,33 @@
 package types
 
-// ElementReferences the element references in the document
+// ElementReferences the element references in the document.
+// The key is the ID of the element, the value is either the title of the section (`[]interface{}`)
+// or an `ElementReference` for all other elements (and for the sections with a `reftext` attribute)
 type ElementReferences map[string]interface{}
 
-// ElementReferencesCollector the visitor that traverses the whole document structure in search for elements with an ID
+// ElementReference the reference to an element with an ID, which is used to compute the label
+// of a cross reference that does not have one
+type ElementReference struct {
+	Kind    string // the kind of element (eg: `figure`, `table`, `anchor`, etc.)
+	Caption string // the caption of the element (eg: `Figure 1. `), if any
+	Title   string // the title of the element, if any
+	RefText string // the `reftext` attribute of the element, if any
+}
+
+// Kinds of referenced elements
+const (
+	// SectionReference a reference to a section
+	SectionReference = "section"
+	// AnchorReference a reference to an inline anchor
+	AnchorReference = "anchor"
+	// FigureReference a reference to a block image
+	FigureReference = "figure"
+	// TableReference a reference to a table
+	TableReference = "table"
+	// ExampleReference a reference to an example block
+	ExampleReference = "example"
+	// ListingReference a reference to a listing or source block
+	ListingReference = "listing"
+	// BlockReference a reference to any other kind of block
+	BlockReference = "block"
+)
@@ -0,0 +1,173 @@
+package types
+
+import "strings"
+
+const (
+	// AttrLang the `lang` attribute, which specifies the language of the document (`en` by default)
+	AttrLang = "lang"
+	// AttrVersionLabel the `version-label` attribute, which specifies the label in front of the revision number in the footer
+	AttrVersionLabel = "version-label"
+	// AttrLastUpdateLabel the `last-update-label` attribute, which specifies the label in front of the last update date in the footer
+	AttrLastUpdateLabel = "last-update-label"
+	// AttrTableOfContentsTitle the `toc-title` attribute, which specifies the title of the table of contents
+	AttrTableOfContentsTitle = "toc-title"
+	// AttrManpageLabel the `manpage-label` attribute, which specifies the label after the title of a manpage
+	AttrManpageLabel = "manpage-label"
+	// AttrNoteCaption the `note-caption` attribute, which specifies the caption of the NOTE admonitions
+	AttrNoteCaption = "note-caption"
+	// AttrTipCaption the `tip-caption` attribute, which specifies the caption of the TIP admonitions
+	AttrTipCaption = "tip-caption"
+	// AttrImportantCaption the `important-caption` attribute, which specifies the caption of the IMPORTANT admonitions
+	AttrImportantCaption = "important-caption"
+	// AttrWarningCaption the `warning-caption` attribute, which specifies the caption of the WARNING admonitions
+	AttrWarningCaption = "warning-caption"
+	// AttrCautionCaption the `caution-caption` attribute, which specifies the caption of the CAUTION admonitions
+	AttrCautionCaption = "caution-caption"
+	// AttrSectionRefSig the `section-refsig` attribute, which specifies the signifier in front of the section number
+	// in the cross references (eg: `Section 1.2`)
+	AttrSectionRefSig = "section-refsig"
+)
+
+// DefaultLang the language used when the `lang` attribute is not set, or when there is no translation for its value
+const DefaultLang = "en"
+
+// labels the built-in translations of the labels, indexed by language and by the name of the attribute
+// which can be used to override them in a document
+var labels = map[string]map[string]string{
+	"en": {
+		AttrFigureCaption:        "Figure",
+		AttrTableCaption:         "Table",
+		AttrExampleCaption:       "Example",
+		AttrVersionLabel:         "Version",
+		AttrLastUpdateLabel:      "Last updated",
+		AttrTableOfContentsTitle: "Table of Contents",
+		AttrManpageLabel:         "Manual Page",
+		AttrNoteCaption:          "Note",
+		AttrTipCaption:           "Tip",
+		AttrImportantCaption:     "Important",
+		AttrWarningCaption:       "Warning",
+		AttrCautionCaption:       "Caution",
+		AttrSectionRefSig:        "Section",
+	},
+	"de": {
+		AttrFigureCaption:        "Abbildung",
+		AttrTableCaption:         "Tabelle",
+		AttrExampleCaption:       "Beispiel",
+		AttrVersionLabel:         "Version",
+		AttrLastUpdateLabel:      "Zuletzt aktualisiert",
+		AttrTableOfContentsTitle: "Inhaltsverzeichnis",
+		AttrManpageLabel:         "Handbuchseite",
+		AttrNoteCaption:          "Anmerkung",
+		AttrTipCaption:           "Hinweis",
+		AttrImportantCaption:     "Wichtig",
+		AttrWarningCaption:       "Warnung",
+		AttrCautionCaption:       "Achtung",
+		AttrSectionRefSig:        "Abschnitt",
+	},
+	"fr": {
+		AttrFigureCaption:        "Figure",
+		AttrTableCaption:         "Tableau",
+		AttrExampleCaption:       "Exemple",
+		AttrVersionLabel:         "Version",
+		AttrLastUpdateLabel:      "Dernière mise à jour",
+		AttrTableOfContentsTitle: "Table des matières",
+		AttrManpageLabel:         "Page de manuel",
+		AttrNoteCaption:          "Note",
+		AttrTipCaption:           "Astuce",
+		AttrImportantCaption:     "Important",
+		AttrWarningCaption:       "Attention",
+		AttrCautionCaption:       "Avertissement",
+		AttrSectionRefSig:        "Section",
+	},
+	"es": {
+		AttrFigureCaption:        "Figura",
+		AttrTableCaption:         "Tabla",
+		AttrExampleCaption:       "Ejemplo",
+		AttrVersionLabel:         "Versión",
+		AttrLastUpdateLabel:      "Última actualización",
+		AttrTableOfContentsTitle: "Tabla de Contenido",
+		AttrManpageLabel:         "Página de manual",
+		AttrNoteCaption:          "Nota",
+		AttrTipCaption:           "Sugerencia",
+		AttrImportantCaption:     "Importante",
+		AttrWarningCaption:       "Aviso",
+		AttrCautionCaption:       "Precaución",
+		AttrSectionRefSig:        "Sección",
+	},
+	"ja": {
+		AttrFigureCaption:        "図",
+		AttrTableCaption:         "表",
+		AttrExampleCaption:       "例",
+		AttrVersionLabel:         "バージョン",
+		AttrLastUpdateLabel:      "最終更新",
+		AttrTableOfContentsTitle: "目次",
+		AttrManpageLabel:         "マニュアルページ",
+		AttrNoteCaption:          "注記",
+		AttrTipCaption:           "ヒント",
+		AttrImportantCaption:     "重要",
+		AttrWarningCaption:       "警告",
+		AttrCautionCaption:       "注意",
+		AttrSectionRefSig:        "節",
+	},
+	"zh": {
+		AttrFigureCaption:        "图表",
+		AttrTableCaption:         "表格",
+		AttrExampleCaption:       "示例",
+		AttrVersionLabel:         "版本",
+		AttrLastUpdateLabel:      "最后更新",
+		AttrTableOfContentsTitle: "目录",
+		AttrManpageLabel:         "手册页",
+		AttrNoteCaption:          "笔记",
+		AttrTipCaption:           "提示",
+		AttrImportantCaption:     "重要",
+		AttrWarningCaption:       "警告",
+		AttrCautionCaption:       "注意",
+		AttrSectionRefSig:        "小节",
+	},
+}
+
+// lastUpdatedFormats the layouts of the last update date, indexed by language
+var lastUpdatedFormats = map[string]string{
+	"en": "2006-01-02 15:04:05 -0700",
+	"de": "02.01.2006 15:04:05 -0700",
+	"fr": "02/01/2006 15:04:05 -0700",
+	"es": "02/01/2006 15:04:05 -0700",
+	"ja": "2006年01月02日 15:04:05 -0700",
+	"zh": "2006年01月02日 15:04:05 -0700",
+}
+
+// normalizeLang returns the language with a built-in translation which matches the given `lang`
+// attribute value (eg: `de-DE` or `zh_CN` are matched by `de` and `zh`), or the default language.
+func normalizeLang(lang string) string {
+	lang = strings.ToLower(strings.TrimSpace(lang))
+	if _, found := labels[lang]; found {
+		return lang
+	}
+	if i := strings.IndexAny(lang, "-_"); i > 0 {
+		if _, found := labels[lang[:i]]; found {
+			return lang[:i]
+		}
+	}
+	return DefaultLang
+}
+
+// DefaultLabel returns the built-in label for the given attribute (eg: `figure-caption`) in the given language,
+// or in English if there is no translation for this language. Returns an empty string if there is no such label.
+func DefaultLabel(lang, name string) string {
+	return labels[normalizeLang(lang)][name]
+}
+
+// LastUpdatedFormat returns the layout of the last update date in the given language,
+// or the English layout if there is no translation for this language.
+func LastUpdatedFormat(lang string) string {
+	return lastUpdatedFormats[normalizeLang(lang)]
+}
+
+// GetLabel returns the value of the given label attribute (eg: `toc-title`), or the built-in
+// label for the language specified by the `lang` attribute if the attribute is not set.
+func (a Attributes) GetLabel(name string) string {
+	if label, found := a.GetAsString(name); found {
+		return label
+	}
+	return DefaultLabel(a.GetAsStringWithDefault(AttrLang, DefaultLang), name)
+}
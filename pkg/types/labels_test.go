@@ -0,0 +1,35 @@
+package types_test
+
+import (
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	. "github.com/onsi/ginkgo"                  //nolint golint
+	. "github.com/onsi/ginkgo/extensions/table" //nolint golint
+	. "github.com/onsi/gomega"                  //nolint golint
+)
+
+var _ = Describe("labels", func() {
+
+	DescribeTable("default labels",
+		func(lang, name, expected string) {
+			Expect(types.DefaultLabel(lang, name)).To(Equal(expected))
+		},
+		Entry("english", "en", types.AttrFigureCaption, "Figure"),
+		Entry("german", "de", types.AttrTableCaption, "Tabelle"),
+		Entry("german with region", "de-AT", types.AttrTableCaption, "Tabelle"),
+		Entry("spanish section signifier", "es", types.AttrSectionRefSig, "Sección"),
+		Entry("chinese with region", "zh_CN", types.AttrTableOfContentsTitle, "目录"),
+		Entry("unknown language", "eo", types.AttrNoteCaption, "Note"),
+		Entry("no label", "fr", types.AttrListingCaption, ""),
+	)
+
+	It("should use attribute over default label", func() {
+		attrs := types.Attributes{
+			types.AttrLang:       "fr",
+			types.AttrTipCaption: "Conseil",
+		}
+		Expect(attrs.GetLabel(types.AttrTipCaption)).To(Equal("Conseil"))
+		Expect(attrs.GetLabel(types.AttrNoteCaption)).To(Equal("Note"))
+		Expect(attrs.GetLabel(types.AttrCautionCaption)).To(Equal("Avertissement"))
+	})
+})
@@ -0,0 +1,149 @@
+package types
+
+import (
+	"reflect"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+// LosslessDocument a document parsed without losing the content of its source (see `parser.ParseLosslessDocument()`).
+// Besides the usual elements, its `Document` retains the blank lines, the comments, the attribute declarations
+// and the `include::` directives (as `FileInclusion` elements), while the attribute substitutions, the footnotes
+// and the block processors are not applied. All elements have their position in the source, whose `Lines`
+// allow for retrieving the exact text of any element (see `Text()`), including the details which are not retained
+// in the elements, such as the original spelling of the attribute lists and the delimiters of the blocks (see `Trivia()`).
+type LosslessDocument struct {
+	Document
+	Lines []string // the lines of the source, without their line terminator
+}
+
+// SourceText a text of the source, with its position
+type SourceText struct {
+	Text string
+	Position
+}
+
+// BlockTrivia the lines of the source of a block which are not retained as elements of the document
+type BlockTrivia struct {
+	Attributes []SourceText // the attribute lists and the title of the block, as written in the source (eg: `[source, go]` or `.Title`)
+	Delimiters []SourceText // the opening and closing delimiters of a delimited block or a table (eg: `----`)
+}
+
+// Text returns the text of the source at the given position, or an empty string if the position is unknown
+func (d LosslessDocument) Text(p Position) string {
+	if p.Start.Line < 1 || p.End.Line < p.Start.Line || p.End.Line > len(d.Lines) {
+		return ""
+	}
+	buf := &strings.Builder{}
+	for l := p.Start.Line; l <= p.End.Line; l++ {
+		line := d.Lines[l-1]
+		start, end := 0, len(line)
+		if l == p.Start.Line && p.Start.Column > 0 {
+			start = columnOffset(line, p.Start.Column)
+		}
+		if l == p.End.Line && p.End.Column > 0 {
+			end = columnOffset(line, p.End.Column)
+		}
+		if l > p.Start.Line {
+			buf.WriteString("\n")
+		}
+		if start < end {
+			buf.WriteString(line[start:end])
+		}
+	}
+	return buf.String()
+}
+
+// TextOf returns the text of the source of the given element, or an empty string if its position is unknown
+func (d LosslessDocument) TextOf(element interface{}) string {
+	p, _ := PositionOf(element)
+	return d.Text(p)
+}
+
+// columnOffset returns the offset (in bytes) of the given column (in characters, starting at 1) in the given line
+func columnOffset(line string, column int) int {
+	c := 1
+	for i := range line {
+		if c == column {
+			return i
+		}
+		c++
+	}
+	return len(line)
+}
+
+var (
+	attributeListRegexp = regexp.MustCompile(`^\[.*\]\s*$`)
+	blockTitleRegexp    = regexp.MustCompile(`^\.[^\s.].*$`)
+)
+
+// Trivia returns the lines of the source of the given block which are not retained as elements of the document:
+// its attribute lists and title (if the block has some attributes), and its delimiters (if it is a delimited block or a table)
+func (d LosslessDocument) Trivia(block interface{}) BlockTrivia {
+	result := BlockTrivia{}
+	p, ok := PositionOf(block)
+	if !ok || p.Start.Line < 1 || p.End.Line < p.Start.Line || p.End.Line > len(d.Lines) {
+		return result
+	}
+	first, last := p.Start.Line, p.End.Line
+	if hasAttributes(block) {
+		for ; first < last; first++ {
+			line := d.Lines[first-1]
+			if !attributeListRegexp.MatchString(line) && !blockTitleRegexp.MatchString(line) {
+				break
+			}
+			result.Attributes = append(result.Attributes, d.sourceLine(p.Start.Filename, first))
+		}
+	}
+	switch block.(type) {
+	case DelimitedBlock, Table:
+		if first < last && isDelimiterPair(d.Lines[first-1], d.Lines[last-1]) {
+			result.Delimiters = []SourceText{
+				d.sourceLine(p.Start.Filename, first),
+				d.sourceLine(p.Start.Filename, last),
+			}
+		}
+	}
+	return result
+}
+
+// sourceLine returns the given line of the source, with its position
+func (d LosslessDocument) sourceLine(filename string, line int) SourceText {
+	text := d.Lines[line-1]
+	return SourceText{
+		Text: text,
+		Position: Position{
+			Start: SourceLocation{
+				Filename: filename,
+				Line:     line,
+				Column:   1,
+			},
+			End: SourceLocation{
+				Filename: filename,
+				Line:     line,
+				Column:   utf8.RuneCountInString(text) + 1,
+			},
+		},
+	}
+}
+
+// isDelimiterPair returns true if the given lines are the opening and closing delimiters of a block
+// (eg: `----` and `----`, or "```go" and "```")
+func isDelimiterPair(opening, closing string) bool {
+	opening, closing = strings.TrimSpace(opening), strings.TrimSpace(closing)
+	if strings.HasPrefix(opening, "```") {
+		return closing == "```"
+	}
+	return opening == closing
+}
+
+// hasAttributes returns true if the given element has some attributes
+func hasAttributes(element interface{}) bool {
+	v := reflect.ValueOf(element)
+	if v.Kind() != reflect.Struct {
+		return false
+	}
+	attributes := v.FieldByName("Attributes")
+	return attributes.IsValid() && attributes.Kind() == reflect.Map && attributes.Len() > 0
+}
@@ -0,0 +1,66 @@
+package types_test
+
+import (
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	. "github.com/onsi/ginkgo" //nolint golint
+	. "github.com/onsi/gomega" //nolint golint
+)
+
+var _ = Describe("lossless documents", func() {
+
+	position := func(startLine, startCol, endLine, endCol int) types.Position {
+		return types.Position{
+			Start: types.SourceLocation{Filename: "test.adoc", Line: startLine, Column: startCol},
+			End:   types.SourceLocation{Filename: "test.adoc", Line: endLine, Column: endCol},
+		}
+	}
+
+	doc := types.LosslessDocument{
+		Lines: []string{
+			"a *bold* text",
+			"ünïcode _text_",
+			"last line",
+		},
+	}
+
+	It("should get text on a single line", func() {
+		Expect(doc.Text(position(1, 3, 1, 9))).To(Equal("*bold*"))
+	})
+
+	It("should get text with multibyte characters", func() {
+		Expect(doc.Text(position(2, 9, 2, 15))).To(Equal("_text_"))
+	})
+
+	It("should get text on multiple lines", func() {
+		Expect(doc.Text(position(1, 10, 3, 5))).To(Equal("text\nünïcode _text_\nlast"))
+	})
+
+	It("should get text of element", func() {
+		Expect(doc.TextOf(types.StringElement{
+			Content:  "last line",
+			Position: position(3, 1, 3, 10),
+		})).To(Equal("last line"))
+	})
+
+	It("should not get text of unknown position", func() {
+		Expect(doc.Text(types.Position{})).To(BeEmpty())
+		Expect(doc.Text(position(3, 1, 4, 1))).To(BeEmpty())
+		Expect(doc.TextOf(types.StringElement{})).To(BeEmpty())
+	})
+
+	It("should get the delimiters of a fenced block", func() {
+		doc := types.LosslessDocument{
+			Lines: []string{"```go", "func main() {}", "```"},
+		}
+		Expect(doc.Trivia(types.DelimitedBlock{
+			Kind:     types.Fenced,
+			Position: position(1, 1, 3, 4),
+		})).To(Equal(types.BlockTrivia{
+			Delimiters: []types.SourceText{
+				{Text: "```go", Position: position(1, 1, 1, 6)},
+				{Text: "```", Position: position(3, 1, 3, 4)},
+			},
+		}))
+	})
+})
@@ -0,0 +1,215 @@
+package types
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"unicode/utf8"
+)
+
+// SourceLocation the location of a line (and optionally, of a column) in a source file
+// (eg: the line of an `include::` directive)
+type SourceLocation struct {
+	Filename string
+	Line     int
+	Column   int // the column (in characters) in the line, or 0 if unknown
+}
+
+func (l SourceLocation) String() string {
+	if l.Column > 0 {
+		return fmt.Sprintf("%s:%d:%d", l.Filename, l.Line, l.Column)
+	}
+	return fmt.Sprintf("%s:%d", l.Filename, l.Line)
+}
+
+// IsZero returns true if the location is unknown
+func (l SourceLocation) IsZero() bool {
+	return l.Line == 0
+}
+
+// before returns true if this location is before the given one, in the same file
+func (l SourceLocation) before(other SourceLocation) bool {
+	return l.Filename == other.Filename &&
+		(l.Line < other.Line || (l.Line == other.Line && l.Column < other.Column))
+}
+
+// advance returns the location after the given content, which starts at this location
+func (l SourceLocation) advance(content string) SourceLocation {
+	for _, r := range content {
+		if r == '\n' {
+			l.Line++
+			l.Column = 1
+		} else {
+			l.Column++
+		}
+	}
+	return l
+}
+
+// Position the position of an element in the source files: the location of its first character (`Start`)
+// and the location right after its last character (`End`), excluding the trailing line terminator.
+// When the element was read from an included file, the locations refer to this file.
+// The position is empty unless the document was parsed with the `configuration.WithSourcePositions` setting.
+// Note: this type is embedded in the elements, so its methods are promoted to all of them.
+type Position struct {
+	Start SourceLocation
+	End   SourceLocation
+}
+
+// GetPosition returns the position of the element in the source files.
+// This method is promoted to all the elements in which `Position` is embedded.
+func (p Position) GetPosition() Position {
+	return p
+}
+
+// extend extends this position until the end of the given element if it is located further in the same file.
+// If this position is unknown, it takes the position of the element.
+func (p *Position) extend(element interface{}) {
+	other, ok := PositionOf(element)
+	if !ok || other.Start.IsZero() {
+		return
+	}
+	if p.Start.IsZero() {
+		*p = other
+		return
+	}
+	if p.End.before(other.End) {
+		p.End = other.End
+	}
+}
+
+// Span returns the position which spans from the start of the first given element with a position
+// until the end of the last one located in the same file
+func Span(elements ...interface{}) Position {
+	p := Position{}
+	for _, e := range elements {
+		p.extend(e)
+	}
+	return p
+}
+
+// Positioned an element with a position in the source files
+type Positioned interface {
+	GetPosition() Position
+}
+
+var _ Positioned = Position{}
+
+// PositionOf returns the position of the given element (which may be a pointer),
+// or `false` if the element has no position
+func PositionOf(element interface{}) (Position, bool) {
+	if e, ok := element.(Positioned); ok {
+		return e.GetPosition(), true
+	}
+	return Position{}, false
+}
+
+var positionType = reflect.TypeOf(Position{})
+
+// WithPosition returns a copy of the given element with the given position, or updates the element
+// if it is a pointer. The element is returned as-is if it has no position.
+func WithPosition(element interface{}, p Position) interface{} {
+	v := reflect.ValueOf(element)
+	switch v.Kind() {
+	case reflect.Ptr:
+		if f := positionField(v.Elem()); f.IsValid() {
+			f.Set(reflect.ValueOf(p))
+		}
+		return element
+	case reflect.Struct:
+		if f := positionField(v); !f.IsValid() {
+			return element
+		}
+		c := reflect.New(v.Type()).Elem()
+		c.Set(v)
+		positionField(c).Set(reflect.ValueOf(p))
+		return c.Interface()
+	default:
+		return element
+	}
+}
+
+func positionField(v reflect.Value) reflect.Value {
+	if v.Kind() != reflect.Struct {
+		return reflect.Value{}
+	}
+	f := v.FieldByName("Position")
+	if !f.IsValid() || f.Type() != positionType {
+		return reflect.Value{}
+	}
+	return f
+}
+
+// FillPositions sets the position of the string elements which have none, based on the positions of their
+// siblings: each one of them spans from the end of the previous sibling (or from the start of the given span)
+// until the start of the next sibling (or until the end of the given span)
+func FillPositions(elements []interface{}, span Position) {
+	if span.Start.IsZero() {
+		return
+	}
+	cursor := span.Start
+	for i, e := range elements {
+		s, ok := e.(StringElement)
+		if !ok {
+			if p, ok := PositionOf(e); ok && !p.Start.IsZero() {
+				cursor = p.End
+			}
+			continue
+		}
+		if !s.Position.Start.IsZero() {
+			cursor = s.End
+			continue
+		}
+		end := span.End
+		for _, next := range elements[i+1:] {
+			if p, ok := PositionOf(next); ok && !p.Start.IsZero() {
+				end = p.Start
+				break
+			}
+		}
+		if cursor.Filename != end.Filename {
+			// the gap spans over multiple files (the end is unknown)
+			end = cursor.advance(s.Content)
+		}
+		s.Position = Position{
+			Start: cursor,
+			End:   end,
+		}
+		elements[i] = s
+		cursor = end
+	}
+}
+
+// positionSpan the span of the content merged into a single string element
+type positionSpan struct {
+	position Position
+	prefix   string // the content preceding the first element with a position
+}
+
+// add adds the given content, which may have a position
+func (s *positionSpan) add(content string, p Position) {
+	if p.Start.IsZero() {
+		if s.position.Start.IsZero() {
+			s.prefix += content
+		} else {
+			s.position.End = s.position.End.advance(content)
+		}
+		return
+	}
+	if s.position.Start.IsZero() {
+		s.position = p
+		// move the start before the content without position, if it is on the same line
+		if n := utf8.RuneCountInString(s.prefix); n > 0 && n < p.Start.Column && !strings.Contains(s.prefix, "\n") {
+			s.position.Start.Column -= n
+		}
+		return
+	}
+	if s.position.End.before(p.End) || s.position.End.Filename != p.End.Filename {
+		s.position.End = p.End
+	}
+}
+
+func (s *positionSpan) reset() {
+	s.position = Position{}
+	s.prefix = ""
+}
@@ -0,0 +1,143 @@
+package types_test
+
+import (
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	. "github.com/onsi/ginkgo" //nolint golint
+	. "github.com/onsi/gomega" //nolint golint
+)
+
+var _ = Describe("source positions", func() {
+
+	position := func(startLine, startCol, endLine, endCol int) types.Position {
+		return types.Position{
+			Start: types.SourceLocation{Filename: "test.adoc", Line: startLine, Column: startCol},
+			End:   types.SourceLocation{Filename: "test.adoc", Line: endLine, Column: endCol},
+		}
+	}
+
+	Context("locations", func() {
+
+		It("should format location with column", func() {
+			Expect(types.SourceLocation{Filename: "test.adoc", Line: 3, Column: 5}.String()).To(Equal("test.adoc:3:5"))
+		})
+
+		It("should format location without column", func() {
+			Expect(types.SourceLocation{Filename: "test.adoc", Line: 3}.String()).To(Equal("test.adoc:3"))
+		})
+	})
+
+	Context("accessors", func() {
+
+		It("should get position of element", func() {
+			p, ok := types.PositionOf(types.Paragraph{
+				Position: position(1, 1, 2, 6),
+			})
+			Expect(ok).To(BeTrue())
+			Expect(p).To(Equal(position(1, 1, 2, 6)))
+		})
+
+		It("should get position of element pointer", func() {
+			p, ok := types.PositionOf(&types.OrderedList{
+				Position: position(1, 1, 2, 6),
+			})
+			Expect(ok).To(BeTrue())
+			Expect(p).To(Equal(position(1, 1, 2, 6)))
+		})
+
+		It("should not get position of element without position", func() {
+			_, ok := types.PositionOf(types.Attributes{})
+			Expect(ok).To(BeFalse())
+		})
+
+		It("should set position on copy of element", func() {
+			e := types.StringElement{
+				Content: "foo",
+			}
+			Expect(types.WithPosition(e, position(1, 1, 1, 4))).To(Equal(types.StringElement{
+				Content:  "foo",
+				Position: position(1, 1, 1, 4),
+			}))
+			Expect(e.Position).To(Equal(types.Position{})) // unchanged
+		})
+
+		It("should set position on element pointer", func() {
+			e := &types.UnorderedList{}
+			types.WithPosition(e, position(1, 1, 1, 4))
+			Expect(e.Position).To(Equal(position(1, 1, 1, 4)))
+		})
+
+		It("should ignore element without position", func() {
+			Expect(types.WithPosition("foo", position(1, 1, 1, 4))).To(Equal("foo"))
+		})
+	})
+
+	Context("spans", func() {
+
+		It("should span over elements", func() {
+			Expect(types.Span(
+				types.BlankLine{},
+				types.Paragraph{
+					Position: position(2, 1, 3, 6),
+				},
+				types.Paragraph{
+					Position: position(5, 1, 5, 8),
+				},
+			)).To(Equal(position(2, 1, 5, 8)))
+		})
+
+		It("should not span over elements in other files", func() {
+			other := position(1, 1, 5, 8)
+			other.Start.Filename = "other.adoc"
+			other.End.Filename = "other.adoc"
+			Expect(types.Span(
+				types.Paragraph{
+					Position: position(2, 1, 3, 6),
+				},
+				types.Paragraph{
+					Position: other,
+				},
+			)).To(Equal(position(2, 1, 3, 6)))
+		})
+
+		It("should extend section with its elements", func() {
+			s := types.Section{
+				Position: position(1, 1, 1, 10),
+			}
+			s.AddElement(types.Paragraph{
+				Position: position(3, 1, 4, 6),
+			})
+			Expect(s.Position).To(Equal(position(1, 1, 4, 6)))
+		})
+
+		It("should fill positions of string elements between their siblings", func() {
+			elements := []interface{}{
+				types.StringElement{
+					Content: "a ",
+				},
+				types.QuotedText{
+					Kind:     types.Bold,
+					Position: position(1, 3, 1, 9),
+				},
+				types.StringElement{
+					Content: " text",
+				},
+			}
+			types.FillPositions(elements, position(1, 1, 1, 14))
+			Expect(elements).To(Equal([]interface{}{
+				types.StringElement{
+					Content:  "a ",
+					Position: position(1, 1, 1, 3),
+				},
+				types.QuotedText{
+					Kind:     types.Bold,
+					Position: position(1, 3, 1, 9),
+				},
+				types.StringElement{
+					Content:  " text",
+					Position: position(1, 9, 1, 14),
+				},
+			}))
+		})
+	})
+})
@@ -123,6 +123,8 @@ type Metadata struct {
 	TableOfContents TableOfContents
 	Authors         []DocumentAuthor
 	Revision        DocumentRevision
+	// Diagnostics the problems found while converting the document (nil if none)
+	Diagnostics []Diagnostic
 }
 
 // TableOfContents the table of contents
@@ -242,6 +244,7 @@ func NewDocumentRevision(revnumber, revdate, revremark interface{}) (DocumentRev
 type AttributeDeclaration struct {
 	Name  string
 	Value string
+	Position
 }
 
 // NewAttributeDeclaration initializes a new AttributeDeclaration with the given name and optional value
@@ -267,6 +270,7 @@ func NewAttributeDeclaration(name string, value interface{}) (AttributeDeclarati
 // AttributeReset the type for AttributeReset
 type AttributeReset struct {
 	Name string
+	Position
 }
 
 // NewAttributeReset initializes a new Document Attribute Resets.
@@ -278,6 +282,7 @@ func NewAttributeReset(attrName string) (AttributeReset, error) {
 // AttributeSubstitution the type for AttributeSubstitution
 type AttributeSubstitution struct {
 	Name string
+	Position
 }
 
 // NewAttributeSubstitution initializes a new Document Attribute Substitutions
@@ -286,6 +291,10 @@ func NewAttributeSubstitution(attrName string) (AttributeSubstitution, error) {
 	return AttributeSubstitution{Name: attrName}, nil
 }
 
+func (s AttributeSubstitution) String() string {
+	return "{" + s.Name + "}"
+}
+
 // ------------------------------------------
 // Element kinds
 // ------------------------------------------
@@ -318,6 +327,8 @@ const (
 	Source BlockKind = "source"
 	// Passthrough a passthrough block
 	Passthrough BlockKind = "passthrough"
+	// Open an open block
+	Open BlockKind = "open"
 )
 
 // ------------------------------------------
@@ -327,6 +338,7 @@ const (
 // TableOfContentsPlaceHolder a place holder for Table of Contents, so
 // the renderer knows when to render it.
 type TableOfContentsPlaceHolder struct {
+	Position
 }
 
 // ------------------------------------------
@@ -345,40 +357,54 @@ type MacroKind string
 
 // UserMacro the structure for User Macro
 type UserMacro struct {
-	Kind       MacroKind
-	Name       string
-	Value      string
+	Kind  MacroKind
+	Name  string
+	Value string
+	// Positionals the positional attributes of the macro, in the order in which they were declared
+	// (eg: `[Fix this, role=x]` has a single positional attribute: `Fix this`)
+	Positionals []string
+	// Attributes the named attributes of the macro (eg: `[Fix this, role=x]` has a single named attribute: `role`)
 	Attributes Attributes
 	RawText    string
+	Position
 }
 
 // NewUserMacroBlock returns an UserMacro
 func NewUserMacroBlock(name string, value string, attributes interface{}, raw string) (UserMacro, error) {
-	attrs, err := NewAttributes(attributes)
-	if err != nil {
-		return UserMacro{}, errors.Wrap(err, "failed to initialize a UserMacro element")
-	}
-	return UserMacro{
-		Name:       name,
-		Kind:       BlockMacro,
-		Value:      value,
-		Attributes: attrs,
-		RawText:    raw,
-	}, nil
+	return newUserMacro(BlockMacro, name, value, attributes, raw)
 }
 
 // NewInlineUserMacro returns an UserMacro
 func NewInlineUserMacro(name, value string, attributes interface{}, raw string) (UserMacro, error) {
-	attrs, err := NewAttributes(attributes)
-	if err != nil {
-		return UserMacro{}, errors.Wrap(err, "failed to initialize a UserMacro element")
+	return newUserMacro(InlineMacro, name, value, attributes, raw)
+}
+
+// newUserMacro returns an UserMacro with the given attributes, which are the entries of an attribute group
+// (ie, positional and named attributes)
+func newUserMacro(kind MacroKind, name, value string, attributes interface{}, raw string) (UserMacro, error) {
+	var positionals []string
+	var attrs Attributes
+	entries, _ := attributes.([]interface{})
+	for _, e := range entries {
+		switch e := e.(type) {
+		case PositionalAttribute:
+			positionals = append(positionals, e.Value)
+		case Attributes:
+			if attrs == nil {
+				attrs = Attributes{}
+			}
+			attrs.Add(e)
+		default:
+			return UserMacro{}, errors.Errorf("failed to initialize a UserMacro element: unexpected type of attribute: '%T'", e)
+		}
 	}
 	return UserMacro{
-		Name:       name,
-		Kind:       InlineMacro,
-		Value:      value,
-		Attributes: attrs,
-		RawText:    raw,
+		Name:        name,
+		Kind:        kind,
+		Value:       value,
+		Positionals: positionals,
+		Attributes:  attrs,
+		RawText:     raw,
 	}, nil
 }
 
@@ -389,6 +415,7 @@ func NewInlineUserMacro(name, value string, attributes interface{}, raw string)
 // Preamble the structure for document Preamble
 type Preamble struct {
 	Elements []interface{}
+	Position
 }
 
 // HasContent returns `true` if this Preamble has at least one element which is neither a
@@ -412,6 +439,7 @@ func (p Preamble) HasContent() bool {
 // FrontMatter the structure for document front-matter
 type FrontMatter struct {
 	Content map[string]interface{}
+	Position
 }
 
 // NewYamlFrontMatter initializes a new FrontMatter from the given `content`
@@ -435,6 +463,7 @@ type Section struct {
 	Attributes Attributes
 	Title      []interface{}
 	Elements   []interface{}
+	Position
 }
 
 // NewSection initializes a new `Section` from the given section title and elements
@@ -473,6 +502,7 @@ func (s Section) ResolveID(docAttributes AttributesWithOverrides) (Section, erro
 // AddElement adds the given child element to this section
 func (s *Section) AddElement(e interface{}) {
 	s.Elements = append(s.Elements, e)
+	s.Position.extend(e)
 }
 
 var _ FootnotesContainer = Section{}
@@ -621,6 +651,7 @@ type ListItem interface { // TODO: convert to struct and use as composant in Ord
 type ContinuedListItemElement struct {
 	Offset  int // the relative ancestor. Should be a negative number
 	Element interface{}
+	Position
 }
 
 // NewContinuedListItemElement returns a wrapper for an element which should be attached to a list item (same level or an ancestor)
@@ -640,6 +671,7 @@ func NewContinuedListItemElement(element interface{}) (ContinuedListItemElement,
 type OrderedList struct {
 	Attributes Attributes
 	Items      []OrderedListItem
+	Position
 }
 
 var _ List = &OrderedList{}
@@ -677,30 +709,17 @@ func NewOrderedList(item *OrderedListItem) *OrderedList {
 		Items: []OrderedListItem{
 			*item,
 		},
+		Position: item.Position,
 	}
 }
 
-// moves the "upperroman", etc. attributes as values of the `AttrNumberingStyle` key
+// moves the "upperroman", etc. styles as values of the `AttrNumberingStyle` key
 func rearrangeListAttributes(attributes Attributes) Attributes {
-	for k := range attributes {
-		switch k {
-		case "upperalpha":
-			attributes[AttrNumberingStyle] = "upperalpha"
-			delete(attributes, k)
-		case "upperroman":
-			attributes[AttrNumberingStyle] = "upperroman"
-			delete(attributes, k)
-		case "lowerroman":
-			attributes[AttrNumberingStyle] = "lowerroman"
-			delete(attributes, k)
-		case "loweralpha":
-			attributes[AttrNumberingStyle] = "loweralpha"
-			delete(attributes, k)
-		case "arabic":
-			attributes[AttrNumberingStyle] = "arabic"
-			delete(attributes, k)
-		}
-
+	switch style := attributes.GetStyle(); style {
+	case "upperalpha", "upperroman", "lowerroman", "loweralpha", "arabic":
+		attributes[AttrNumberingStyle] = style
+		delete(attributes, AttrStyle)
+		delete(attributes, AttrPositional+"1")
 	}
 	return attributes
 }
@@ -708,6 +727,7 @@ func rearrangeListAttributes(attributes Attributes) Attributes {
 // AddItem adds the given item
 func (l *OrderedList) AddItem(item OrderedListItem) {
 	l.Items = append(l.Items, item)
+	l.Position.extend(item)
 }
 
 // LastItem returns the last item in this list
@@ -721,6 +741,7 @@ type OrderedListItem struct {
 	Level          int
 	NumberingStyle NumberingStyle
 	Elements       []interface{} // TODO: rename to `Blocks`?
+	Position
 }
 
 // making sure that the `ListItem` interface is implemented by `OrderedListItem`
@@ -749,6 +770,7 @@ func (i OrderedListItem) GetAttributes() Attributes {
 // AddElement add an element to this OrderedListItem
 func (i *OrderedListItem) AddElement(element interface{}) {
 	i.Elements = append(i.Elements, element)
+	i.Position.extend(element)
 }
 
 // OrderedListItemPrefix the prefix used to construct an OrderedListItem
@@ -773,6 +795,7 @@ func NewOrderedListItemPrefix(s NumberingStyle, l int) (OrderedListItemPrefix, e
 type UnorderedList struct {
 	Attributes Attributes
 	Items      []UnorderedListItem
+	Position
 }
 
 var _ List = &UnorderedList{}
@@ -787,6 +810,7 @@ func NewUnorderedList(item *UnorderedListItem) *UnorderedList {
 		Items: []UnorderedListItem{
 			*item,
 		},
+		Position: item.Position,
 	}
 	return list
 }
@@ -794,6 +818,7 @@ func NewUnorderedList(item *UnorderedListItem) *UnorderedList {
 // AddItem adds the given item
 func (l *UnorderedList) AddItem(item UnorderedListItem) {
 	l.Items = append(l.Items, item)
+	l.Position.extend(item)
 }
 
 // LastItem returns the last item in this list
@@ -808,6 +833,7 @@ type UnorderedListItem struct {
 	CheckStyle  UnorderedListItemCheckStyle
 	Attributes  Attributes
 	Elements    []interface{} // TODO: rename to `Blocks`?
+	Position
 }
 
 // NewUnorderedListItem initializes a new `UnorderedListItem` from the given content
@@ -845,6 +871,7 @@ func (i UnorderedListItem) GetAttributes() Attributes {
 // AddElement add an element to this UnorderedListItem
 func (i *UnorderedListItem) AddElement(element interface{}) {
 	i.Elements = append(i.Elements, element)
+	i.Position.extend(element)
 }
 
 // UnorderedListItemCheckStyle the check style that applies on an unordered list item
@@ -955,6 +982,7 @@ func NewListItemContent(content []interface{}) ([]interface{}, error) {
 type LabeledList struct {
 	Attributes Attributes
 	Items      []LabeledListItem
+	Position
 }
 
 var _ List = &LabeledList{}
@@ -969,6 +997,7 @@ func NewLabeledList(item LabeledListItem) *LabeledList {
 		Items: []LabeledListItem{
 			item,
 		},
+		Position: item.Position,
 	}
 	return &result
 }
@@ -976,6 +1005,7 @@ func NewLabeledList(item LabeledListItem) *LabeledList {
 // AddItem adds the given item
 func (l *LabeledList) AddItem(item LabeledListItem) {
 	l.Items = append(l.Items, item)
+	l.Position.extend(item)
 }
 
 // LastItem returns the last item in this list
@@ -989,6 +1019,7 @@ type LabeledListItem struct {
 	Level      int
 	Attributes Attributes
 	Elements   []interface{} // TODO: rename to `Blocks`?
+	Position
 }
 
 // making sure that the `ListItem` interface is implemented by `LabeledListItem`
@@ -1023,6 +1054,7 @@ func (i LabeledListItem) GetAttributes() Attributes {
 // AddElement add an element to this LabeledListItem
 func (i *LabeledListItem) AddElement(element interface{}) {
 	i.Elements = append(i.Elements, element)
+	i.Position.extend(element)
 }
 
 // ------------------------------------------
@@ -1033,10 +1065,11 @@ func (i *LabeledListItem) AddElement(element interface{}) {
 type Paragraph struct {
 	Attributes Attributes
 	Lines      [][]interface{}
+	Position
 }
 
-// AttrHardBreaks the attribute to set on a paragraph to render with hard breaks on each line
-const AttrHardBreaks = "%hardbreaks"
+// AttrHardBreaks the option to set on a paragraph to render with hard breaks on each line (eg: `[%hardbreaks]`)
+const AttrHardBreaks = "hardbreaks"
 
 // DocumentAttrHardBreaks the attribute to set at the document level to render with hard breaks on each line of all paragraphs
 const DocumentAttrHardBreaks = "hardbreaks"
@@ -1132,6 +1165,7 @@ func NewInlineElements(elements ...interface{}) ([]interface{}, error) {
 type InternalCrossReference struct {
 	ID    string
 	Label string
+	Position
 }
 
 // NewInternalCrossReference initializes a new `InternalCrossReference` from the given ID
@@ -1151,6 +1185,7 @@ func NewInternalCrossReference(id string, label interface{}) (InternalCrossRefer
 type ExternalCrossReference struct {
 	Location Location
 	Label    []interface{}
+	Position
 }
 
 // NewExternalCrossReference initializes a new `InternalCrossReference` from the given ID
@@ -1173,6 +1208,30 @@ func (r ExternalCrossReference) ResolveLocation(attrs AttributesWithOverrides) E
 	return r
 }
 
+// ------------------------------------------
+// Inline Anchors
+// ------------------------------------------
+
+// InlineAnchor an anchor within a paragraph, declared with the `[[id,reftext]]` or the `anchor:id[reftext]` syntax
+type InlineAnchor struct {
+	ID      string
+	RefText string
+	Position
+}
+
+// NewInlineAnchor initializes a new `InlineAnchor` from the given ID and optional reftext
+func NewInlineAnchor(id string, reftext interface{}) (InlineAnchor, error) {
+	log.Debugf("initializing a new InlineAnchor with ID=%s", id)
+	var r string
+	if reftext, ok := reftext.(string); ok {
+		r = strings.TrimSpace(reftext)
+	}
+	return InlineAnchor{
+		ID:      id,
+		RefText: r,
+	}, nil
+}
+
 // ------------------------------------------
 // Images
 // ------------------------------------------
@@ -1181,6 +1240,7 @@ func (r ExternalCrossReference) ResolveLocation(attrs AttributesWithOverrides) E
 type ImageBlock struct {
 	Location   Location
 	Attributes Attributes
+	Position
 }
 
 // NewImageBlock initializes a new `ImageBlock`
@@ -1214,6 +1274,7 @@ func (b ImageBlock) ResolveLocation(attrs AttributesWithOverrides) ImageBlock {
 type InlineImage struct {
 	Location   Location
 	Attributes Attributes
+	Position
 }
 
 // NewInlineImage initializes a new `InlineImage` (similar to ImageBlock, but without attributes)
@@ -1274,6 +1335,7 @@ func NewImageAttributes(alt, width, height interface{}, otherattrs []interface{}
 type Icon struct {
 	Class      string
 	Attributes Attributes
+	Position
 }
 
 // NewIconAttributes returns a map of icon attributes, some of which have implicit keys (`alt`, `width` and `height`)
@@ -1320,6 +1382,7 @@ type Footnote struct {
 	Ref string
 	// the footnote content (can be "rich")
 	Elements []interface{}
+	Position
 }
 
 // NewFootnote returns a new Footnote with the given content
@@ -1344,6 +1407,7 @@ type FootnoteReference struct {
 	ID        int
 	Ref       string // the user-specified reference (optional)
 	Duplicate bool   // indicates if this reference targets an already-existing footnote // TODO: find a better name?
+	Position
 }
 
 // FootnotesContainer interface for all types which may contain footnotes
@@ -1384,7 +1448,9 @@ const (
 
 // Reference adds the given footnote and returns a FootnoteReference in replacement
 func (f *Footnotes) Reference(note Footnote) FootnoteReference {
-	ref := FootnoteReference{}
+	ref := FootnoteReference{
+		Position: note.Position,
+	}
 	if len(note.Elements) > 0 {
 		note.ID = f.sequence.nextVal()
 		f.notes = append(f.notes, note)
@@ -1426,6 +1492,7 @@ type DelimitedBlock struct {
 	Kind       BlockKind
 	Attributes Attributes
 	Elements   []interface{} // TODO: rename to `Blocks`?
+	Position
 }
 
 // NewDelimitedBlock initializes a new `DelimitedBlock` of the given kind with the given elements
@@ -1446,6 +1513,22 @@ func NewDelimitedBlock(kind BlockKind, elements []interface{}, attributes interf
 	}, nil
 }
 
+// NewPassthroughBlock initializes a new passthrough `DelimitedBlock` with the given lines,
+// which are written as-is in the output (eg: the content rendered by a block processor)
+func NewPassthroughBlock(lines ...string) DelimitedBlock {
+	elements := make([]interface{}, len(lines))
+	for i, l := range lines {
+		elements[i] = VerbatimLine{
+			Content: l,
+		}
+	}
+	return DelimitedBlock{
+		Kind:       Passthrough,
+		Attributes: Attributes{},
+		Elements:   elements,
+	}
+}
+
 // ------------------------------------------
 // Callouts
 // ------------------------------------------
@@ -1453,6 +1536,7 @@ func NewDelimitedBlock(kind BlockKind, elements []interface{}, attributes interf
 // Callout a reference at the end of a line in a delimited block with verbatim content (eg: listing, source code)
 type Callout struct {
 	Ref int
+	Position
 }
 
 // NewCallout returns a new Callout with the given reference
@@ -1467,6 +1551,7 @@ type CalloutListItem struct {
 	Attributes Attributes
 	Ref        int
 	Elements   []interface{}
+	Position
 }
 
 var _ ListItem = &CalloutListItem{}
@@ -1481,6 +1566,7 @@ func (i CalloutListItem) GetAttributes() Attributes {
 // AddElement add an element to this CalloutListItem
 func (i *CalloutListItem) AddElement(element interface{}) {
 	i.Elements = append(i.Elements, element)
+	i.Position.extend(element)
 }
 
 // NewCalloutListItem returns a new CalloutListItem
@@ -1496,6 +1582,7 @@ func NewCalloutListItem(ref int, description []interface{}) (CalloutListItem, er
 type CalloutList struct {
 	Attributes Attributes
 	Items      []CalloutListItem
+	Position
 }
 
 var _ List = &CalloutList{}
@@ -1509,12 +1596,14 @@ func NewCalloutList(item CalloutListItem) *CalloutList {
 		Items: []CalloutListItem{
 			item,
 		},
+		Position: item.Position,
 	}
 }
 
 // AddItem adds the given item to the list
 func (l *CalloutList) AddItem(item CalloutListItem) {
 	l.Items = append(l.Items, item)
+	l.Position.extend(item)
 }
 
 // LastItem returns the last item in the list
@@ -1531,6 +1620,7 @@ type Table struct {
 	Attributes Attributes
 	Header     TableLine
 	Lines      []TableLine
+	Position
 }
 
 // NewTable initializes a new table with the given lines and attributes
@@ -1549,6 +1639,7 @@ func NewTable(header interface{}, lines []interface{}, attributes interface{}) (
 	}
 	// need to regroup columns of all lines, they dispatch on lines
 	cells := make([][]interface{}, 0)
+	positions := make([]Position, 0) // the position of the line of each cell
 	for _, l := range lines {
 		if l, ok := l.(TableLine); ok {
 			// if no header line was set, inspect the first line to determine the number of columns per line
@@ -1556,6 +1647,9 @@ func NewTable(header interface{}, lines []interface{}, attributes interface{}) (
 				columnsPerLine = len(l.Cells)
 			}
 			cells = append(cells, l.Cells...)
+			for range l.Cells {
+				positions = append(positions, l.Position)
+			}
 		}
 	}
 	t.Lines = make([]TableLine, 0, len(cells))
@@ -1567,6 +1661,7 @@ func NewTable(header interface{}, lines []interface{}, attributes interface{}) (
 		for i, c := range cells {
 			log.Debugf("adding cell with content '%v' in table line at offset %d", c, (i % columnsPerLine))
 			l.Cells[i%columnsPerLine] = c
+			l.Position.extend(positions[i])
 			if (i+1)%columnsPerLine == 0 { // switch to next line
 				log.Debugf("adding line with content '%v' in table", l)
 				t.Lines = append(t.Lines, l)
@@ -1583,6 +1678,7 @@ func NewTable(header interface{}, lines []interface{}, attributes interface{}) (
 // TableLine a table line is made of columns, each column being a group of []interface{} (to support quoted text, etc.)
 type TableLine struct {
 	Cells [][]interface{}
+	Position
 }
 
 // NewTableLine initializes a new TableLine with the given columns
@@ -1609,6 +1705,7 @@ func NewTableLine(columns []interface{}) (TableLine, error) {
 type LiteralBlock struct {
 	Attributes Attributes
 	Lines      []string
+	Position
 }
 
 const (
@@ -1650,6 +1747,7 @@ func NewLiteralBlock(origin string, lines []interface{}, attributes interface{})
 
 // BlankLine the structure for the empty lines, which are used to separate logical blocks
 type BlankLine struct {
+	Position
 }
 
 // NewBlankLine initializes a new `BlankLine`
@@ -1665,6 +1763,7 @@ func NewBlankLine() (BlankLine, error) {
 // SingleLineComment a single line comment
 type SingleLineComment struct {
 	Content string
+	Position
 }
 
 // NewSingleLineComment initializes a new single line content
@@ -1682,6 +1781,7 @@ func NewSingleLineComment(content string) (SingleLineComment, error) {
 // StringElement the structure for strings
 type StringElement struct {
 	Content string
+	Position
 }
 
 // NewStringElement initializes a new `StringElement` from the given content
@@ -1702,6 +1802,7 @@ func (s StringElement) String() string {
 type VerbatimLine struct {
 	Content  string
 	Callouts []Callout
+	Position
 }
 
 // NewVerbatimLine initializes a new `VerbatimLine` from the given content
@@ -1742,7 +1843,9 @@ func (s VerbatimLine) String() string {
 // ------------------------------------------
 
 // LineBreak an explicit line break in a paragraph
-type LineBreak struct{}
+type LineBreak struct {
+	Position
+}
 
 // NewLineBreak returns a new line break, that's all.
 func NewLineBreak() (LineBreak, error) {
@@ -1758,6 +1861,7 @@ type QuotedText struct {
 	Kind       QuotedTextKind
 	Elements   []interface{}
 	Attributes Attributes
+	Position
 }
 
 // QuotedTextKind the type for
@@ -1833,6 +1937,7 @@ func NewEscapedQuotedText(backslashes string, punctuation string, content interf
 type QuotedString struct {
 	Kind     QuotedStringKind
 	Elements []interface{}
+	Position
 }
 
 func NewQuotedString(kind QuotedStringKind, elements []interface{}) (QuotedString, error) {
@@ -1847,6 +1952,7 @@ func NewQuotedString(kind QuotedStringKind, elements []interface{}) (QuotedStrin
 type InlinePassthrough struct {
 	Kind     PassthroughKind
 	Elements []interface{}
+	Position
 }
 
 // PassthroughKind the kind of passthrough
@@ -1878,6 +1984,7 @@ func NewInlinePassthrough(kind PassthroughKind, elements []interface{}) (InlineP
 type InlineLink struct {
 	Location   Location
 	Attributes Attributes
+	Position
 }
 
 // NewInlineLink initializes a new inline `InlineLink`
@@ -1925,10 +2032,12 @@ type FileInclusion struct {
 	Attributes Attributes
 	Location   Location
 	RawText    string
+	Line       int // the line of the `include::` directive in the document
+	Position
 }
 
-// NewFileInclusion initializes a new inline `InlineLink`
-func NewFileInclusion(location Location, attributes interface{}, rawtext string) (FileInclusion, error) {
+// NewFileInclusion initializes a new `FileInclusion`
+func NewFileInclusion(location Location, attributes interface{}, rawtext string, line int) (FileInclusion, error) {
 	attrs, err := NewAttributes(attributes)
 	if err != nil {
 		return FileInclusion{}, errors.Wrap(err, "failed to initialize a FileInclusion element")
@@ -1937,6 +2046,7 @@ func NewFileInclusion(location Location, attributes interface{}, rawtext string)
 		Attributes: attrs,
 		Location:   location,
 		RawText:    rawtext,
+		Line:       line,
 	}, nil
 }
 
@@ -1953,6 +2063,21 @@ func (f *FileInclusion) LineRanges() (LineRanges, bool) {
 	}, false
 }
 
+// IsOptional returns `true` if the file to include is optional (`opts=optional`),
+// i.e., if it is silently skipped when it cannot be read
+func (f *FileInclusion) IsOptional() bool {
+	for _, k := range []string{AttrOpts, AttrOptions} {
+		if opts, ok := f.Attributes[k].(string); ok {
+			for _, o := range strings.Split(opts, ",") {
+				if strings.TrimSpace(o) == "optional" {
+					return true
+				}
+			}
+		}
+	}
+	return f.Attributes.HasOption("optional")
+}
+
 // TagRanges returns the tag ranges of the file to include.
 func (f *FileInclusion) TagRanges() (TagRanges, bool) {
 	if lr, ok := f.Attributes[AttrTagRanges].(TagRanges); ok {
@@ -1987,6 +2112,7 @@ func NewLineRangesAttribute(ranges interface{}) (Attributes, error) {
 // `Start` and `End` are the included limits of the child document
 // - if there's a single line to include, then `End = Start`
 // - if there is all remaining content after a given line (included), then `End = -1`
+// - other negative values count from the end of the child document (eg: `-2` is the line before the last one)
 type LineRange struct {
 	StartLine int
 	EndLine   int
@@ -2031,6 +2157,23 @@ func (r LineRanges) Match(line int) bool {
 	return false
 }
 
+// Resolve returns the line ranges in which the negative line numbers, which count from the end
+// of the content, are replaced with their actual value, given the total number of lines
+func (r LineRanges) Resolve(total int) LineRanges {
+	result := make(LineRanges, len(r))
+	for i, lr := range r {
+		if lr.StartLine < 0 {
+			lr.StartLine = total + 1 + lr.StartLine
+		}
+		if lr.EndLine < 0 {
+			lr.EndLine = total + 1 + lr.EndLine
+		}
+		result[i] = lr
+	}
+	sort.Sort(result)
+	return result
+}
+
 // make sure that the LineRanges type implements the `sort.Interface
 var _ sort.Interface = LineRanges{}
 
@@ -2285,6 +2428,7 @@ func (l *Location) Resolve(attrs AttributesWithOverrides) Location {
 // IndexTerm a index term, with a single term
 type IndexTerm struct {
 	Term []interface{}
+	Position
 }
 
 // NewIndexTerm returns a new IndexTerm
@@ -2299,6 +2443,7 @@ type ConcealedIndexTerm struct {
 	Term1 interface{}
 	Term2 interface{}
 	Term3 interface{}
+	Position
 }
 
 // NewConcealedIndexTerm returns a new ConcealedIndexTerm
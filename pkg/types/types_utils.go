@@ -14,10 +14,12 @@ func NilSafe(elements []interface{}) []interface{} {
 	return make([]interface{}, 0)
 }
 
-// Merge merge string elements together
+// Merge merge string elements together. The position of the resulting string elements spans over
+// the positions of the merged elements.
 func Merge(elements ...interface{}) []interface{} {
 	result := make([]interface{}, 0)
 	buf := bytes.NewBuffer(nil)
+	span := &positionSpan{}
 	for _, element := range elements {
 		if element == nil {
 			continue
@@ -25,33 +27,38 @@ func Merge(elements ...interface{}) []interface{} {
 		switch element := element.(type) {
 		case string:
 			buf.WriteString(element)
+			span.add(element, Position{})
 		case []byte:
 			buf.Write(element)
+			span.add(string(element), Position{})
 		case StringElement:
 			content := element.Content
 			buf.WriteString(content)
+			span.add(content, element.Position)
 		case []interface{}:
 			if len(element) > 0 {
 				f := Merge(element...)
-				result, buf = appendBuffer(result, buf)
+				result, buf = appendBuffer(result, buf, span)
 				result = Merge(append(result, f...)...)
 			}
 		default:
 			// log.Debugf("Merging with 'default' case an element of type %[1]T", element)
-			result, buf = appendBuffer(result, buf)
+			result, buf = appendBuffer(result, buf, span)
 			result = append(result, element)
 		}
 	}
 	// if buf was filled because some text was found
-	result, _ = appendBuffer(result, buf)
+	result, _ = appendBuffer(result, buf, span)
 	return result
 }
 
 // appendBuffer appends the content of the given buffer to the given array of elements,
 // and returns a new buffer, or returns the given arguments if the buffer was empty
-func appendBuffer(elements []interface{}, buf *bytes.Buffer) ([]interface{}, *bytes.Buffer) {
+func appendBuffer(elements []interface{}, buf *bytes.Buffer, span *positionSpan) ([]interface{}, *bytes.Buffer) {
+	defer span.reset()
 	if buf.Len() > 0 {
 		s, _ := NewStringElement(buf.String())
+		s.Position = span.position
 		return append(elements, s), bytes.NewBuffer(nil)
 	}
 	return elements, buf
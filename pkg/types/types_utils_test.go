@@ -27,4 +27,35 @@ var _ = Describe("convert to inline elements", func() {
 		}
 		Expect(Merge(source...)).To(Equal(expected))
 	})
+	It("inline content with positions", func() {
+		source := []interface{}{
+			[]byte(" "),
+			StringElement{
+				Content: "hello",
+				Position: Position{
+					Start: SourceLocation{Filename: "test.adoc", Line: 1, Column: 2},
+					End:   SourceLocation{Filename: "test.adoc", Line: 1, Column: 7},
+				},
+			},
+			", ",
+			StringElement{
+				Content: "world",
+				Position: Position{
+					Start: SourceLocation{Filename: "test.adoc", Line: 1, Column: 9},
+					End:   SourceLocation{Filename: "test.adoc", Line: 1, Column: 14},
+				},
+			},
+			"!",
+		}
+		expected := []interface{}{
+			StringElement{
+				Content: " hello, world!",
+				Position: Position{
+					Start: SourceLocation{Filename: "test.adoc", Line: 1, Column: 1},
+					End:   SourceLocation{Filename: "test.adoc", Line: 1, Column: 15},
+				},
+			},
+		}
+		Expect(Merge(source...)).To(Equal(expected))
+	})
 })
@@ -0,0 +1,438 @@
+package types
+
+// Visitor a visitor of the elements of a document (see `Walk()`)
+type Visitor interface {
+	// Enter is called on an element before its nested elements are visited.
+	// The nested elements are skipped (and `Leave` is not called) if `false` is returned.
+	Enter(element interface{}) (bool, error)
+	// Leave is called on an element after its nested elements were visited
+	Leave(element interface{}) error
+}
+
+// VisitorFuncs a `Visitor` which calls the given functions (if not nil) when entering and leaving the elements
+type VisitorFuncs struct {
+	EnterFunc func(element interface{}) (bool, error)
+	LeaveFunc func(element interface{}) error
+}
+
+var _ Visitor = VisitorFuncs{}
+
+// Enter calls the `EnterFunc` on the given element (if not nil)
+func (v VisitorFuncs) Enter(element interface{}) (bool, error) {
+	if v.EnterFunc == nil {
+		return true, nil
+	}
+	return v.EnterFunc(element)
+}
+
+// Leave calls the `LeaveFunc` on the given element (if not nil)
+func (v VisitorFuncs) Leave(element interface{}) error {
+	if v.LeaveFunc == nil {
+		return nil
+	}
+	return v.LeaveFunc(element)
+}
+
+// Walk visits the given element (eg: a `Document`) and its nested elements in depth-first order:
+// the visitor enters an element, then visits its nested elements, and then leaves the element.
+// The nested elements are the blocks of the documents, sections, preambles, delimited blocks, list items and footnotes,
+// the titles of the sections, the terms of the labeled list items, the lines of the paragraphs, the table lines and their cells,
+// and the inline elements of the quoted texts, quoted strings, passthroughs, cross references and index terms.
+// The list items and table lines are visited as well, whereas the `[]interface{}` slices of elements (eg: the lines
+// of a paragraph or the cells of a table) are not. Walking stops at the first error returned by the visitor.
+func Walk(element interface{}, v Visitor) error {
+	switch e := element.(type) {
+	case []interface{}:
+		return walkElements(e, v)
+	case [][]interface{}:
+		return walkLines(e, v)
+	}
+	enter, err := v.Enter(element)
+	if err != nil || !enter {
+		return err
+	}
+	if err := walkNestedElements(element, v); err != nil {
+		return err
+	}
+	return v.Leave(element)
+}
+
+// walkNestedElements visits the nested elements of the given element
+// nolint: gocyclo
+func walkNestedElements(element interface{}, v Visitor) error {
+	switch e := element.(type) {
+	case Document:
+		if err := walkElements(e.Elements, v); err != nil {
+			return err
+		}
+		for _, f := range e.Footnotes {
+			if err := Walk(f, v); err != nil {
+				return err
+			}
+		}
+		return nil
+	case DraftDocument:
+		return walkElements(e.Blocks, v)
+	case Section:
+		if err := walkElements(e.Title, v); err != nil {
+			return err
+		}
+		return walkElements(e.Elements, v)
+	case Preamble:
+		return walkElements(e.Elements, v)
+	case Paragraph:
+		return walkLines(e.Lines, v)
+	case DelimitedBlock:
+		return walkElements(e.Elements, v)
+	case ContinuedListItemElement:
+		return Walk(e.Element, v)
+	case OrderedList:
+		for _, item := range e.Items {
+			if err := Walk(item, v); err != nil {
+				return err
+			}
+		}
+		return nil
+	case OrderedListItem:
+		return walkElements(e.Elements, v)
+	case UnorderedList:
+		for _, item := range e.Items {
+			if err := Walk(item, v); err != nil {
+				return err
+			}
+		}
+		return nil
+	case UnorderedListItem:
+		return walkElements(e.Elements, v)
+	case LabeledList:
+		for _, item := range e.Items {
+			if err := Walk(item, v); err != nil {
+				return err
+			}
+		}
+		return nil
+	case LabeledListItem:
+		if err := walkElements(e.Term, v); err != nil {
+			return err
+		}
+		return walkElements(e.Elements, v)
+	case CalloutList:
+		for _, item := range e.Items {
+			if err := Walk(item, v); err != nil {
+				return err
+			}
+		}
+		return nil
+	case CalloutListItem:
+		return walkElements(e.Elements, v)
+	case Table:
+		if len(e.Header.Cells) > 0 {
+			if err := Walk(e.Header, v); err != nil {
+				return err
+			}
+		}
+		for _, l := range e.Lines {
+			if err := Walk(l, v); err != nil {
+				return err
+			}
+		}
+		return nil
+	case TableLine:
+		return walkLines(e.Cells, v)
+	case Footnote:
+		return walkElements(e.Elements, v)
+	case QuotedText:
+		return walkElements(e.Elements, v)
+	case QuotedString:
+		return walkElements(e.Elements, v)
+	case InlinePassthrough:
+		return walkElements(e.Elements, v)
+	case ExternalCrossReference:
+		return walkElements(e.Label, v)
+	case IndexTerm:
+		return walkElements(e.Term, v)
+	default:
+		return nil
+	}
+}
+
+func walkElements(elements []interface{}, v Visitor) error {
+	for _, element := range elements {
+		if err := Walk(element, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func walkLines(lines [][]interface{}, v Visitor) error {
+	for _, line := range lines {
+		if err := walkElements(line, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Rewriter a function which returns the elements which replace the given element (and `true`),
+// or `false` if the element is kept, in which case its nested elements are rewritten in turn.
+// An element is removed when it is replaced with an empty slice.
+type Rewriter func(element interface{}) ([]interface{}, bool, error)
+
+// Rewrite returns a copy of the given document in which the elements (including the elements of the footnotes)
+// were replaced with the result of the given rewriter, in depth-first order.
+// The rewriter is called on the elements which belong to a `[]interface{}` slice (eg: the blocks of a section
+// or the inline elements of a paragraph line), since they can be replaced with any number of elements,
+// whereas the list items, table lines and footnotes are not passed to the rewriter, but their nested elements are.
+// The elements returned by the rewriter are not rewritten any further. The given document is not modified.
+func Rewrite(doc Document, rewrite Rewriter) (Document, error) {
+	elements, _, err := rewriteElements(doc.Elements, rewrite)
+	if err != nil {
+		return Document{}, err
+	}
+	doc.Elements = elements
+	var footnotes []Footnote // only initialized when a footnote is rewritten
+	for i, f := range doc.Footnotes {
+		footnote, rewritten, err := rewriteNestedElements(f, rewrite)
+		if err != nil {
+			return Document{}, err
+		}
+		if rewritten {
+			if footnotes == nil {
+				footnotes = append([]Footnote{}, doc.Footnotes...)
+			}
+			footnotes[i] = footnote.(Footnote)
+		}
+	}
+	if footnotes != nil {
+		doc.Footnotes = footnotes
+	}
+	return doc, nil
+}
+
+// RewriteElements returns a copy of the given elements, which were replaced with the result
+// of the given rewriter, in the same way as in `Rewrite()`
+func RewriteElements(elements []interface{}, rewrite Rewriter) ([]interface{}, error) {
+	result, _, err := rewriteElements(elements, rewrite)
+	return result, err
+}
+
+// rewriteNestedElements rewrites the nested elements of the given element.
+// Returns the given element unchanged (and `false`) if no nested element was rewritten.
+// nolint: gocyclo
+func rewriteNestedElements(element interface{}, rewrite Rewriter) (interface{}, bool, error) {
+	switch e := element.(type) {
+	case Section:
+		title, rewrittenTitle, err := rewriteElements(e.Title, rewrite)
+		if err != nil {
+			return nil, false, err
+		}
+		elements, rewrittenElements, err := rewriteElements(e.Elements, rewrite)
+		if err != nil {
+			return nil, false, err
+		}
+		e.Title, e.Elements = title, elements
+		return e, rewrittenTitle || rewrittenElements, nil
+	case Preamble:
+		elements, rewritten, err := rewriteElements(e.Elements, rewrite)
+		e.Elements = elements
+		return e, rewritten, err
+	case Paragraph:
+		lines, rewritten, err := rewriteLines(e.Lines, rewrite)
+		e.Lines = lines
+		return e, rewritten, err
+	case DelimitedBlock:
+		elements, rewritten, err := rewriteElements(e.Elements, rewrite)
+		e.Elements = elements
+		return e, rewritten, err
+	case ContinuedListItemElement:
+		nested, rewritten, err := rewriteNestedElements(e.Element, rewrite)
+		e.Element = nested
+		return e, rewritten, err
+	case OrderedList:
+		var items []OrderedListItem // only initialized when an item is rewritten
+		for i, item := range e.Items {
+			elements, rewritten, err := rewriteElements(item.Elements, rewrite)
+			if err != nil {
+				return nil, false, err
+			}
+			if rewritten {
+				if items == nil {
+					items = append([]OrderedListItem{}, e.Items...)
+				}
+				items[i].Elements = elements
+			}
+		}
+		if items == nil {
+			return e, false, nil
+		}
+		e.Items = items
+		return e, true, nil
+	case UnorderedList:
+		var items []UnorderedListItem // only initialized when an item is rewritten
+		for i, item := range e.Items {
+			elements, rewritten, err := rewriteElements(item.Elements, rewrite)
+			if err != nil {
+				return nil, false, err
+			}
+			if rewritten {
+				if items == nil {
+					items = append([]UnorderedListItem{}, e.Items...)
+				}
+				items[i].Elements = elements
+			}
+		}
+		if items == nil {
+			return e, false, nil
+		}
+		e.Items = items
+		return e, true, nil
+	case LabeledList:
+		var items []LabeledListItem // only initialized when an item is rewritten
+		for i, item := range e.Items {
+			term, rewrittenTerm, err := rewriteElements(item.Term, rewrite)
+			if err != nil {
+				return nil, false, err
+			}
+			elements, rewrittenElements, err := rewriteElements(item.Elements, rewrite)
+			if err != nil {
+				return nil, false, err
+			}
+			if rewrittenTerm || rewrittenElements {
+				if items == nil {
+					items = append([]LabeledListItem{}, e.Items...)
+				}
+				items[i].Term, items[i].Elements = term, elements
+			}
+		}
+		if items == nil {
+			return e, false, nil
+		}
+		e.Items = items
+		return e, true, nil
+	case CalloutList:
+		var items []CalloutListItem // only initialized when an item is rewritten
+		for i, item := range e.Items {
+			elements, rewritten, err := rewriteElements(item.Elements, rewrite)
+			if err != nil {
+				return nil, false, err
+			}
+			if rewritten {
+				if items == nil {
+					items = append([]CalloutListItem{}, e.Items...)
+				}
+				items[i].Elements = elements
+			}
+		}
+		if items == nil {
+			return e, false, nil
+		}
+		e.Items = items
+		return e, true, nil
+	case Table:
+		cells, rewritten, err := rewriteLines(e.Header.Cells, rewrite)
+		if err != nil {
+			return nil, false, err
+		}
+		e.Header.Cells = cells
+		var lines []TableLine // only initialized when a line is rewritten
+		for i, l := range e.Lines {
+			cells, r, err := rewriteLines(l.Cells, rewrite)
+			if err != nil {
+				return nil, false, err
+			}
+			if r {
+				if lines == nil {
+					lines = append([]TableLine{}, e.Lines...)
+				}
+				lines[i].Cells = cells
+			}
+		}
+		if lines != nil {
+			e.Lines = lines
+		}
+		return e, rewritten || lines != nil, nil
+	case Footnote:
+		elements, rewritten, err := rewriteElements(e.Elements, rewrite)
+		e.Elements = elements
+		return e, rewritten, err
+	case QuotedText:
+		elements, rewritten, err := rewriteElements(e.Elements, rewrite)
+		e.Elements = elements
+		return e, rewritten, err
+	case QuotedString:
+		elements, rewritten, err := rewriteElements(e.Elements, rewrite)
+		e.Elements = elements
+		return e, rewritten, err
+	case InlinePassthrough:
+		elements, rewritten, err := rewriteElements(e.Elements, rewrite)
+		e.Elements = elements
+		return e, rewritten, err
+	case ExternalCrossReference:
+		label, rewritten, err := rewriteElements(e.Label, rewrite)
+		e.Label = label
+		return e, rewritten, err
+	case IndexTerm:
+		term, rewritten, err := rewriteElements(e.Term, rewrite)
+		e.Term = term
+		return e, rewritten, err
+	default:
+		return element, false, nil
+	}
+}
+
+// rewriteElements replaces the given elements (or their nested elements) with the result of the given rewriter.
+// Returns the given elements unchanged (and `false`) if no element was rewritten.
+func rewriteElements(elements []interface{}, rewrite Rewriter) ([]interface{}, bool, error) {
+	var result []interface{} // only initialized when an element is rewritten
+	for i, element := range elements {
+		replacement, replaced, err := rewrite(element)
+		if err != nil {
+			return nil, false, err
+		}
+		if !replaced {
+			e, rewritten, err := rewriteNestedElements(element, rewrite)
+			if err != nil {
+				return nil, false, err
+			}
+			if !rewritten {
+				if result != nil {
+					result = append(result, element)
+				}
+				continue
+			}
+			replacement = []interface{}{e}
+		}
+		if result == nil {
+			result = make([]interface{}, i, len(elements)+len(replacement))
+			copy(result, elements[:i])
+		}
+		result = append(result, replacement...)
+	}
+	if result == nil {
+		return elements, false, nil
+	}
+	return result, true, nil
+}
+
+// rewriteLines rewrites the elements in the given lines (or table cells).
+// Returns the given lines unchanged (and `false`) if no element was rewritten.
+func rewriteLines(lines [][]interface{}, rewrite Rewriter) ([][]interface{}, bool, error) {
+	var result [][]interface{} // only initialized when a line is rewritten
+	for i, line := range lines {
+		l, rewritten, err := rewriteElements(line, rewrite)
+		if err != nil {
+			return nil, false, err
+		}
+		if rewritten {
+			if result == nil {
+				result = append([][]interface{}{}, lines...)
+			}
+			result[i] = l
+		}
+	}
+	if result == nil {
+		return lines, false, nil
+	}
+	return result, true, nil
+}
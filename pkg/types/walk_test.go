@@ -0,0 +1,289 @@
+package types_test
+
+import (
+	"fmt"
+
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	. "github.com/onsi/ginkgo" //nolint golint
+	. "github.com/onsi/gomega" //nolint golint
+	"github.com/pkg/errors"
+)
+
+var _ = Describe("document walk", func() {
+
+	doc := types.Document{
+		Elements: []interface{}{
+			types.Section{
+				Level: 1,
+				Title: []interface{}{
+					types.StringElement{Content: "title"},
+				},
+				Elements: []interface{}{
+					types.Paragraph{
+						Lines: [][]interface{}{
+							{
+								types.StringElement{Content: "a "},
+								types.QuotedText{
+									Kind: types.Bold,
+									Elements: []interface{}{
+										types.StringElement{Content: "bold"},
+									},
+								},
+							},
+						},
+					},
+					types.UnorderedList{
+						Items: []types.UnorderedListItem{
+							{
+								Level: 1,
+								Elements: []interface{}{
+									types.Paragraph{
+										Lines: [][]interface{}{
+											{
+												types.StringElement{Content: "item"},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Footnotes: []types.Footnote{
+			{
+				ID: 1,
+				Elements: []interface{}{
+					types.StringElement{Content: "note"},
+				},
+			},
+		},
+	}
+
+	// name returns a short name of the given element, for the tests
+	name := func(element interface{}) string {
+		switch e := element.(type) {
+		case types.StringElement:
+			return "'" + e.Content + "'"
+		default:
+			return fmt.Sprintf("%T", element)[len("types."):]
+		}
+	}
+
+	Context("walk", func() {
+
+		It("should enter and leave all elements in order", func() {
+			var events []string
+			err := types.Walk(doc, types.VisitorFuncs{
+				EnterFunc: func(element interface{}) (bool, error) {
+					events = append(events, "enter "+name(element))
+					return true, nil
+				},
+				LeaveFunc: func(element interface{}) error {
+					events = append(events, "leave "+name(element))
+					return nil
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(events).To(Equal([]string{
+				"enter Document",
+				"enter Section",
+				"enter 'title'",
+				"leave 'title'",
+				"enter Paragraph",
+				"enter 'a '",
+				"leave 'a '",
+				"enter QuotedText",
+				"enter 'bold'",
+				"leave 'bold'",
+				"leave QuotedText",
+				"leave Paragraph",
+				"enter UnorderedList",
+				"enter UnorderedListItem",
+				"enter Paragraph",
+				"enter 'item'",
+				"leave 'item'",
+				"leave Paragraph",
+				"leave UnorderedListItem",
+				"leave UnorderedList",
+				"leave Section",
+				"enter Footnote",
+				"enter 'note'",
+				"leave 'note'",
+				"leave Footnote",
+				"leave Document",
+			}))
+		})
+
+		It("should skip the nested elements", func() {
+			var entered []string
+			err := types.Walk(doc, types.VisitorFuncs{
+				EnterFunc: func(element interface{}) (bool, error) {
+					entered = append(entered, name(element))
+					_, isParagraph := element.(types.Paragraph)
+					return !isParagraph, nil
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(entered).To(Equal([]string{
+				"Document",
+				"Section",
+				"'title'",
+				"Paragraph",
+				"UnorderedList",
+				"UnorderedListItem",
+				"Paragraph",
+				"Footnote",
+				"'note'",
+			}))
+		})
+
+		It("should walk the given elements", func() {
+			var entered []string
+			err := types.Walk(doc.Footnotes[0].Elements, types.VisitorFuncs{
+				EnterFunc: func(element interface{}) (bool, error) {
+					entered = append(entered, name(element))
+					return true, nil
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(entered).To(Equal([]string{"'note'"}))
+		})
+
+		It("should stop at the first error", func() {
+			var entered []string
+			err := types.Walk(doc, types.VisitorFuncs{
+				EnterFunc: func(element interface{}) (bool, error) {
+					entered = append(entered, name(element))
+					if _, ok := element.(types.QuotedText); ok {
+						return false, errors.New("mock error")
+					}
+					return true, nil
+				},
+			})
+			Expect(err).To(MatchError("mock error"))
+			Expect(entered).To(Equal([]string{
+				"Document",
+				"Section",
+				"'title'",
+				"Paragraph",
+				"'a '",
+				"QuotedText",
+			}))
+		})
+	})
+
+	Context("rewrite", func() {
+
+		It("should replace elements", func() {
+			result, err := types.Rewrite(doc, func(element interface{}) ([]interface{}, bool, error) {
+				switch e := element.(type) {
+				case types.QuotedText:
+					// remove the formatting but keep the content
+					return e.Elements, true, nil
+				case types.StringElement:
+					if e.Content == "item" || e.Content == "note" {
+						return []interface{}{
+							types.StringElement{Content: "new " + e.Content},
+						}, true, nil
+					}
+				}
+				return nil, false, nil
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Elements).To(Equal([]interface{}{
+				types.Section{
+					Level: 1,
+					Title: []interface{}{
+						types.StringElement{Content: "title"},
+					},
+					Elements: []interface{}{
+						types.Paragraph{
+							Lines: [][]interface{}{
+								{
+									types.StringElement{Content: "a "},
+									types.StringElement{Content: "bold"},
+								},
+							},
+						},
+						types.UnorderedList{
+							Items: []types.UnorderedListItem{
+								{
+									Level: 1,
+									Elements: []interface{}{
+										types.Paragraph{
+											Lines: [][]interface{}{
+												{
+													types.StringElement{Content: "new item"},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			}))
+			Expect(result.Footnotes).To(Equal([]types.Footnote{
+				{
+					ID: 1,
+					Elements: []interface{}{
+						types.StringElement{Content: "new note"},
+					},
+				},
+			}))
+			// the original document is not modified
+			Expect(doc.Elements[0].(types.Section).Elements[1].(types.UnorderedList).Items[0].Elements[0].(types.Paragraph).Lines[0][0]).
+				To(Equal(types.StringElement{Content: "item"}))
+			Expect(doc.Footnotes[0].Elements[0]).To(Equal(types.StringElement{Content: "note"}))
+		})
+
+		It("should remove elements", func() {
+			result, err := types.Rewrite(doc, func(element interface{}) ([]interface{}, bool, error) {
+				if _, ok := element.(types.UnorderedList); ok {
+					return []interface{}{}, true, nil
+				}
+				return nil, false, nil
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Elements[0].(types.Section).Elements).To(HaveLen(1))
+			Expect(doc.Elements[0].(types.Section).Elements).To(HaveLen(2))
+		})
+
+		It("should not rewrite the replacements", func() {
+			result, err := types.RewriteElements(doc.Footnotes[0].Elements, func(element interface{}) ([]interface{}, bool, error) {
+				if s, ok := element.(types.StringElement); ok {
+					return []interface{}{
+						types.QuotedText{
+							Kind:     types.Italic,
+							Elements: []interface{}{s},
+						},
+					}, true, nil
+				}
+				return nil, false, nil
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal([]interface{}{
+				types.QuotedText{
+					Kind: types.Italic,
+					Elements: []interface{}{
+						types.StringElement{Content: "note"},
+					},
+				},
+			}))
+		})
+
+		It("should return the error of the rewriter", func() {
+			_, err := types.Rewrite(doc, func(element interface{}) ([]interface{}, bool, error) {
+				if _, ok := element.(types.QuotedText); ok {
+					return nil, false, errors.New("mock error")
+				}
+				return nil, false, nil
+			})
+			Expect(err).To(MatchError("mock error"))
+		})
+	})
+})
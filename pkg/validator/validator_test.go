@@ -169,6 +169,41 @@ var _ = Describe("document validator", func() {
 				Expect(doc.Attributes.GetAsStringWithDefault(types.AttrDocType, "")).To(Equal("article")) // changed
 			})
 
+			It("missing header - with position", func() {
+				// given
+				position := types.Position{
+					Start: types.SourceLocation{Filename: "test.adoc", Line: 1, Column: 1},
+					End:   types.SourceLocation{Filename: "test.adoc", Line: 1, Column: 12},
+				}
+				doc := types.Document{
+					Attributes: types.Attributes{
+						types.AttrDocType: "manpage",
+					},
+					Elements: []interface{}{
+						types.Paragraph{
+							Lines: [][]interface{}{
+								{
+									types.StringElement{
+										Content: "a paragraph",
+									},
+								},
+							},
+							Position: position,
+						},
+					},
+				}
+
+				// when
+				problems := Validate(&doc)
+
+				// then
+				Expect(problems).To(ContainElement(Problem{
+					Severity: Error,
+					Message:  "manpage document is missing a header",
+					Position: position,
+				}))
+			})
+
 			It("missing name section - invalid level", func() {
 				// given
 				doc := types.Document{
@@ -11,8 +11,9 @@ import (
 )
 
 // DocumentMetadata processes the actual input into a document and returns its metadata
-func DocumentMetadata(actual string, lastUpdated time.Time) (types.Metadata, error) {
+func DocumentMetadata(actual string, lastUpdated time.Time, settings ...configuration.Setting) (types.Metadata, error) {
+	settings = append([]configuration.Setting{configuration.WithLastUpdated(lastUpdated), configuration.WithBackEnd("html5")}, settings...)
 	return libasciidoc.Convert(strings.NewReader(actual),
 		bytes.NewBuffer(nil),
-		configuration.NewConfiguration(configuration.WithLastUpdated(lastUpdated), configuration.WithBackEnd("html5")))
+		configuration.NewConfiguration(settings...))
 }